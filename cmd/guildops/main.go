@@ -6,6 +6,8 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	logger "github.com/antony-ramos/guildops/pkg/logger"
@@ -61,6 +63,17 @@ func main() {
 
 	ctx = logger.AddLoggerToContext(ctx, zapLog)
 
+	// Migrations
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err = app.Migrate(ctx, cfg, os.Args[2:], os.Stdout)
+		if err != nil {
+			logger.FromContext(ctx).Error(err.Error())
+			_ = zapLog.Sync()
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Tracing
 	logger.FromContext(ctx).Info("Starting telemetry")
 	logger.FromContext(ctx).Info("Starting telemetry")
@@ -90,7 +103,9 @@ func main() {
 		}
 	}()
 
-	// Run
+	// Run, discord and the scheduler stop when guildops is interrupted or terminated
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
 	logger.FromContext(ctx).Info("Starting app")
 	app.Run(ctx, cfg)
 }
@@ -10,11 +10,25 @@ import (
 type (
 	// Config -.
 	Config struct {
-		App     `yaml:"app"`
-		Discord `yaml:"discord"`
-		Log     `yaml:"logger"`
-		Metrics `yaml:"metrics"`
-		PG      `yaml:"postgres"`
+		App         `yaml:"app"`
+		Guild       `yaml:"guild"`
+		Discord     `yaml:"discord"`
+		Log         `yaml:"logger"`
+		Metrics     `yaml:"metrics"`
+		Backend     `yaml:"backend"`
+		PG          `yaml:"postgres"`
+		SQLite      `yaml:"sqlite"`
+		Permissions `yaml:"permissions"`
+		Strikes     `yaml:"strikes"`
+		Loot        `yaml:"loot"`
+		Points      `yaml:"points"`
+		Items       `yaml:"items"`
+		Links       `yaml:"links"`
+		Scheduler   `yaml:"scheduler"`
+		Templates   `yaml:"templates"`
+		Trash       `yaml:"trash"`
+		Seasons     []Season  `yaml:"seasons"`
+		Holidays    []Holiday `yaml:"holidays"`
 	}
 
 	// App -.
@@ -24,11 +38,18 @@ type (
 		Env     string `env:"APP_ENV"     env-required:"true" yaml:"environment"`
 	}
 
+	// Guild -.
+	Guild struct {
+		Timezone  string `env:"GUILD_TIMEZONE"   env-default:"UTC"   yaml:"timezone"`
+		RaidStart string `env:"GUILD_RAID_START" env-default:"21:00" yaml:"raid_start"`
+	}
+
 	// Discord -.
 	Discord struct {
-		Token          string `env:"DISCORD_TOKEN"           env-required:"true" yaml:"token"`
-		GuildID        int    `env:"DISCORD_GUILD_ID"        env-required:"true" yaml:"guild_id"`
-		DeleteCommands bool   `env:"DISCORD_DELETE_COMMANDS" env-required:"true" yaml:"delete_commands"`
+		Token            string `env:"DISCORD_TOKEN"              env-required:"true" yaml:"token"`
+		GuildID          int    `env:"DISCORD_GUILD_ID"           env-required:"true" yaml:"guild_id"`
+		DeleteCommands   bool   `env:"DISCORD_DELETE_COMMANDS"    env-required:"true" yaml:"delete_commands"`
+		OfficerChannelID string `env:"DISCORD_OFFICER_CHANNEL_ID"                     yaml:"officer_channel_id"`
 	}
 
 	// Log -.
@@ -41,13 +62,117 @@ type (
 		Port string `env:"METRICS_PORT" env-required:"true" yaml:"port"`
 	}
 
+	// Backend -.
+	Backend struct {
+		Driver string `env:"BACKEND_DRIVER" env-default:"postgres" yaml:"driver"`
+	}
+
 	// PG -.
 	PG struct {
-		PoolMax      int           `env:"PG_POOL_MAX"      env-required:"true" yaml:"pool_max"`
-		URL          string        `env:"PG_URL"           env-required:"true" yaml:"url"`
-		ConnAttempts int           `env:"PG_CONN_ATTEMPTS" env-required:"true" yaml:"conn_attempts"`
-		ConnTimeOut  time.Duration `env:"PG_CONN_TIMEOUT"  env-required:"true" yaml:"conn_timeout"`
+		PoolMax      int           `env:"PG_POOL_MAX"      env-default:"10" yaml:"pool_max"`
+		URL          string        `env:"PG_URL"                            yaml:"url"`
+		ConnAttempts int           `env:"PG_CONN_ATTEMPTS" env-default:"10" yaml:"conn_attempts"`
+		ConnTimeOut  time.Duration `env:"PG_CONN_TIMEOUT"  env-default:"2s" yaml:"conn_timeout"`
+	}
+
+	// SQLite -.
+	SQLite struct {
+		Path        string        `env:"SQLITE_PATH"         env-default:"guildops.db" yaml:"path"`
+		BusyTimeout time.Duration `env:"SQLITE_BUSY_TIMEOUT" env-default:"5s"          yaml:"busy_timeout"`
+	}
+
+	// Permissions -.
+	Permissions struct {
+		OfficerRoles []string          `env:"PERMISSIONS_OFFICER_ROLES" env-default:"Staff"   yaml:"officer_roles"`
+		DefaultLevel string            `env:"PERMISSIONS_DEFAULT_LEVEL" env-default:"officer" yaml:"default_level"`
+		Commands     map[string]string `env:"PERMISSIONS_COMMANDS"                            yaml:"commands"`
+	}
+
+	// Strikes -.
+	Strikes struct {
+		ExpiryWeeks       int  `env:"STRIKES_EXPIRY_WEEKS"         env-default:"0"     yaml:"expiry_weeks"`
+		ExpireOnSeasonEnd bool `env:"STRIKES_EXPIRE_ON_SEASON_END" env-default:"false" yaml:"expire_on_season_end"`
+		AlertThreshold    int  `env:"STRIKES_ALERT_THRESHOLD"      env-default:"0"     yaml:"alert_threshold"`
+	}
+
+	// Loot -.
+	Loot struct {
+		Strategy string `env:"LOOT_STRATEGY" env-default:"lowest-count" yaml:"strategy"`
 	}
+
+	// Points -.
+	Points struct {
+		Attendance   int `env:"POINTS_ATTENDANCE"    env-default:"0" yaml:"attendance"`
+		LootCost     int `env:"POINTS_LOOT_COST"     env-default:"0" yaml:"loot_cost"`
+		DecayPercent int `env:"POINTS_DECAY_PERCENT" env-default:"0" yaml:"decay_percent"`
+	}
+
+	// Items -.
+	Items struct {
+		Catalogue string `env:"ITEMS_CATALOGUE" yaml:"catalogue"`
+	}
+
+	// Links -.
+	Links struct {
+		Lookup           string `env:"LINKS_LOOKUP"            env-default:"guild" yaml:"lookup"`
+		File             string `env:"LINKS_FILE"                                  yaml:"file"`
+		RemoveUnresolved bool   `env:"LINKS_REMOVE_UNRESOLVED" env-default:"false" yaml:"remove_unresolved"`
+	}
+
+	// Scheduler -.
+	Scheduler struct {
+		ChannelID      string `env:"SCHEDULER_CHANNEL_ID"                          yaml:"channel_id"`
+		Announcement   string `env:"SCHEDULER_ANNOUNCEMENT"                        yaml:"announcement"`
+		AnnounceDays   int    `env:"SCHEDULER_ANNOUNCE_DAYS"   env-default:"7"     yaml:"announce_days"`
+		ReminderHours  int    `env:"SCHEDULER_REMINDER_HOURS"  env-default:"0"     yaml:"reminder_hours"`
+		OfficerSummary string `env:"SCHEDULER_OFFICER_SUMMARY"                     yaml:"officer_summary"`
+	}
+
+	// Templates -.
+	Templates struct {
+		WeeksAhead int    `env:"TEMPLATES_WEEKS_AHEAD" env-default:"2" yaml:"weeks_ahead"`
+		Generation string `env:"TEMPLATES_GENERATION"                  yaml:"generation"`
+	}
+
+	// Trash -.
+	Trash struct {
+		RetentionDays int    `env:"TRASH_RETENTION_DAYS" env-default:"30" yaml:"retention_days"`
+		Purge         string `env:"TRASH_PURGE"                           yaml:"purge"`
+	}
+
+	// Season -.
+	Season struct {
+		Name  string `yaml:"name"`
+		Start string `yaml:"start"`
+		End   string `yaml:"end"`
+	}
+
+	// Holiday -.
+	Holiday struct {
+		Name  string `yaml:"name"`
+		Start string `yaml:"start"`
+		End   string `yaml:"end"`
+	}
+)
+
+// SeasonDateLayout is the layout of season and holiday dates in config, the end date is the last day.
+const SeasonDateLayout = "2006-01-02"
+
+// ClockLayout is the layout of times of day in config.
+const ClockLayout = "15:04"
+
+// Backend drivers.
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+	DriverMemory   = "memory"
+)
+
+// Lookups resolving the discord user IDs of players still linked by discord username.
+const (
+	LookupGuild = "guild"
+	LookupFile  = "file"
+	LookupNone  = "none"
 )
 
 // NewConfig returns app config.
@@ -66,5 +191,146 @@ func NewConfig(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("config error: %w", err)
 	}
 
+	switch cfg.Backend.Driver {
+	case DriverPostgres:
+		if cfg.PG.URL == "" {
+			return nil, fmt.Errorf("config error: postgres url is required with the %s driver", DriverPostgres)
+		}
+	case DriverSQLite:
+		if cfg.SQLite.Path == "" {
+			return nil, fmt.Errorf("config error: sqlite path is required with the %s driver", DriverSQLite)
+		}
+	case DriverMemory:
+	default:
+		return nil, fmt.Errorf("config error: unknown backend driver %q, use %s, %s or %s",
+			cfg.Backend.Driver, DriverPostgres, DriverSQLite, DriverMemory)
+	}
+
+	if cfg.Strikes.ExpiryWeeks < 0 || cfg.Strikes.AlertThreshold < 0 {
+		return nil, fmt.Errorf("config error: strikes expiry_weeks and alert_threshold must not be negative")
+	}
+
+	if cfg.Points.Attendance < 0 || cfg.Points.LootCost < 0 {
+		return nil, fmt.Errorf("config error: points attendance and loot_cost must not be negative")
+	}
+	if cfg.Points.DecayPercent < 0 || cfg.Points.DecayPercent > 100 {
+		return nil, fmt.Errorf("config error: points decay_percent must be between 0 and 100")
+	}
+
+	switch cfg.Links.Lookup {
+	case LookupGuild, LookupNone:
+	case LookupFile:
+		if cfg.Links.File == "" {
+			return nil, fmt.Errorf("config error: links file is required with the %s lookup", LookupFile)
+		}
+	default:
+		return nil, fmt.Errorf("config error: unknown links lookup %q, use %s, %s or %s",
+			cfg.Links.Lookup, LookupGuild, LookupFile, LookupNone)
+	}
+
+	err = checkGuild(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkScheduler(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, season := range cfg.Seasons {
+		if _, err := time.Parse(SeasonDateLayout, season.Start); err != nil {
+			return nil, fmt.Errorf("config error: start of season %q: %w", season.Name, err)
+		}
+		if _, err := time.Parse(SeasonDateLayout, season.End); err != nil {
+			return nil, fmt.Errorf("config error: end of season %q: %w", season.Name, err)
+		}
+	}
+
+	err = checkTemplates(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkTrash(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return cfg, nil
 }
+
+// checkGuild checks the time zone and the default raid start of the guild.
+func checkGuild(cfg *Config) error {
+	if _, err := time.LoadLocation(cfg.Guild.Timezone); err != nil {
+		return fmt.Errorf("config error: guild timezone must be an IANA time zone like Europe/Paris: %w", err)
+	}
+	if _, err := time.Parse(ClockLayout, cfg.Guild.RaidStart); err != nil {
+		return fmt.Errorf("config error: guild raid_start must be a time of day like 21:00: %w", err)
+	}
+	return nil
+}
+
+// checkScheduler checks times of day of the scheduler and the channels its jobs post to.
+func checkScheduler(cfg *Config) error {
+	for name, clock := range map[string]string{
+		"announcement":    cfg.Scheduler.Announcement,
+		"officer_summary": cfg.Scheduler.OfficerSummary,
+	} {
+		if clock == "" {
+			continue
+		}
+		if _, err := time.Parse(ClockLayout, clock); err != nil {
+			return fmt.Errorf("config error: scheduler %s must be a time of day like 21:00: %w", name, err)
+		}
+	}
+	if cfg.Scheduler.AnnounceDays < 0 || cfg.Scheduler.ReminderHours < 0 {
+		return fmt.Errorf("config error: scheduler announce_days and reminder_hours must not be negative")
+	}
+	if (cfg.Scheduler.Announcement != "" || cfg.Scheduler.ReminderHours > 0) && cfg.Scheduler.ChannelID == "" {
+		return fmt.Errorf("config error: scheduler channel_id is required to announce raids and remind players")
+	}
+	if cfg.Scheduler.OfficerSummary != "" && cfg.Discord.OfficerChannelID == "" {
+		return fmt.Errorf("config error: discord officer_channel_id is required by the scheduler officer_summary")
+	}
+	return nil
+}
+
+// checkTemplates checks the generation of raids from templates and the dates of holidays.
+func checkTemplates(cfg *Config) error {
+	if cfg.Templates.WeeksAhead <= 0 {
+		return fmt.Errorf("config error: templates weeks_ahead must be a positive number")
+	}
+	if cfg.Templates.Generation != "" {
+		if _, err := time.Parse(ClockLayout, cfg.Templates.Generation); err != nil {
+			return fmt.Errorf("config error: templates generation must be a time of day like 21:00: %w", err)
+		}
+	}
+	for _, holiday := range cfg.Holidays {
+		start, err := time.Parse(SeasonDateLayout, holiday.Start)
+		if err != nil {
+			return fmt.Errorf("config error: start of holiday %q: %w", holiday.Name, err)
+		}
+		end, err := time.Parse(SeasonDateLayout, holiday.End)
+		if err != nil {
+			return fmt.Errorf("config error: end of holiday %q: %w", holiday.Name, err)
+		}
+		if end.Before(start) {
+			return fmt.Errorf("config error: holiday %q ends before it starts", holiday.Name)
+		}
+	}
+	return nil
+}
+
+// checkTrash checks the retention of deleted objects and the time of day they are purged.
+func checkTrash(cfg *Config) error {
+	if cfg.Trash.RetentionDays < 0 {
+		return fmt.Errorf("config error: trash retention_days must not be negative")
+	}
+	if cfg.Trash.Purge != "" {
+		if _, err := time.Parse(ClockLayout, cfg.Trash.Purge); err != nil {
+			return fmt.Errorf("config error: trash purge must be a time of day like 21:00: %w", err)
+		}
+	}
+	return nil
+}
@@ -2,16 +2,26 @@ package app
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/alitto/pond"
 	"github.com/antony-ramos/guildops/pkg/logger"
 	"github.com/pkg/errors"
 
 	"github.com/antony-ramos/guildops/config"
 	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/internal/entity"
 	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/memorybackend"
 	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
+	"github.com/antony-ramos/guildops/internal/usecase/sqlitebackend"
 	"github.com/antony-ramos/guildops/pkg/discord"
 	"github.com/antony-ramos/guildops/pkg/postgres"
+	"github.com/antony-ramos/guildops/pkg/scheduler"
+	"github.com/antony-ramos/guildops/pkg/sqlite"
 	"github.com/bwmarrin/discordgo"
 	"go.uber.org/zap"
 )
@@ -19,54 +29,17 @@ import (
 func Run(ctx context.Context, cfg *config.Config) {
 	logger.FromContext(ctx).Info("loading backend")
 
-	pgHandler, err := postgres.New(
-		ctx,
-		cfg.URL,
-		postgres.MaxPoolSize(cfg.PoolMax),
-		postgres.ConnAttempts(cfg.ConnAttempts),
-		postgres.ConnTimeout(cfg.ConnTimeOut))
-	if err != nil {
-		logger.FromContext(ctx).Fatal(err.Error())
-	}
-
-	ctx = logger.AddLoggerToContext(ctx, logger.FromContext(ctx).With(zap.String("backend", "postgres")))
-
-	backend := postgresbackend.PG{Postgres: pgHandler}
-	err = backend.Init(ctx, cfg.URL, nil)
+	backend, err := newBackend(ctx, cfg)
 	if err != nil {
 		logger.FromContext(ctx).Fatal(err.Error())
 		return
 	}
 
-	mapHandler := map[string]func(ctx context.Context, interaction *discordgo.InteractionCreate) (string, error){}
-
-	auc := usecase.NewAbsenceUseCase(&backend)
-	puc := usecase.NewPlayerUseCase(&backend)
-	luc := usecase.NewLootUseCase(&backend)
-	ruc := usecase.NewRaidUseCase(&backend)
-	suc := usecase.NewStrikeUseCase(&backend)
-	fuc := usecase.NewFailUseCase(&backend)
-
-	disc := discordHandler.Discord{
-		AbsenceUseCase: auc,
-		PlayerUseCase:  puc,
-		LootUseCase:    luc,
-		RaidUseCase:    ruc,
-		StrikeUseCase:  suc,
-		FailUseCase:    fuc,
-	}
-
-	var inits []func() map[string]func(
-		ctx context.Context, interaction *discordgo.InteractionCreate) (string, error)
-
-	inits = append(inits,
-		disc.InitAbsence, disc.InitAdmin, disc.InitLoot,
-		disc.InitPlayer, disc.InitRaid, disc.InitStrike, disc.InitFail)
-	for _, v := range inits {
-		for k, v := range v() {
-			mapHandler[k] = v
-		}
-	}
+	// Command handlers and completers are added to their map once use cases are created,
+	// use cases need the discord server to notify officers.
+	mapHandler := map[string]discord.Handler{}
+	components := discord.NewRouter()
+	completers := discord.Completers{}
 
 	var handlers []*discordgo.ApplicationCommand
 	handlers = append(handlers,
@@ -77,29 +50,381 @@ func Run(ctx context.Context, cfg *config.Config) {
 		&discordHandler.LootDescriptors[4])
 	handlers = append(handlers,
 		&discordHandler.PlayerDescriptors[0], &discordHandler.PlayerDescriptors[1],
-		&discordHandler.PlayerDescriptors[2], &discordHandler.PlayerDescriptors[3], &discordHandler.PlayerDescriptors[4])
+		&discordHandler.PlayerDescriptors[2], &discordHandler.PlayerDescriptors[3], &discordHandler.PlayerDescriptors[4],
+		&discordHandler.PlayerDescriptors[5], &discordHandler.PlayerDescriptors[6])
 	handlers = append(handlers,
 		&discordHandler.RaidDescriptors[0], &discordHandler.RaidDescriptors[1],
-		&discordHandler.RaidDescriptors[2], &discordHandler.RaidDescriptors[3])
+		&discordHandler.RaidDescriptors[2], &discordHandler.RaidDescriptors[3],
+		&discordHandler.RaidDescriptors[4], &discordHandler.RaidDescriptors[5])
 	handlers = append(handlers,
 		&discordHandler.StrikeDescriptors[0], &discordHandler.StrikeDescriptors[1], &discordHandler.StrikeDescriptors[2])
 	handlers = append(handlers,
 		&discordHandler.FailDescriptors[0], &discordHandler.FailDescriptors[1],
 		&discordHandler.FailDescriptors[2], &discordHandler.FailDescriptors[3])
+	handlers = append(handlers,
+		&discordHandler.AttendanceDescriptors[0])
+	handlers = append(handlers,
+		&discordHandler.SeasonDescriptors[0], &discordHandler.SeasonDescriptors[1])
+	handlers = append(handlers,
+		&discordHandler.PointsDescriptors[0], &discordHandler.PointsDescriptors[1],
+		&discordHandler.PointsDescriptors[2], &discordHandler.PointsDescriptors[3],
+		&discordHandler.PointsDescriptors[4])
+	handlers = append(handlers,
+		&discordHandler.WishlistDescriptors[0], &discordHandler.WishlistDescriptors[1],
+		&discordHandler.WishlistDescriptors[2])
+	handlers = append(handlers,
+		&discordHandler.ItemDescriptors[0])
+	handlers = append(handlers,
+		&discordHandler.SignupDescriptors[0])
+	handlers = append(handlers,
+		&discordHandler.RaidTemplateDescriptors[0], &discordHandler.RaidTemplateDescriptors[1],
+		&discordHandler.RaidTemplateDescriptors[2], &discordHandler.RaidTemplateDescriptors[3])
 	handlers = append(handlers,
 		&discordHandler.AdminDescriptor[0], &discordHandler.AdminDescriptor[1])
+	handlers = append(handlers,
+		&discordHandler.TrashDescriptors[0])
+
+	// Player commands are open to everyone, config can override any command
+	commandPermissions := make(map[string]string)
+	for _, command := range discordHandler.PlayerCommands {
+		commandPermissions[command] = string(discord.LevelEveryone)
+	}
+	for command, requirement := range cfg.Permissions.Commands {
+		commandPermissions[command] = requirement
+	}
 
 	serve := discord.New(
 		discord.CommandHandlers(mapHandler),
+		discord.Components(components),
+		discord.Autocomplete(completers),
 		discord.Token(cfg.Discord.Token),
 		discord.Command(handlers),
 		discord.GuildID(cfg.Discord.GuildID),
-		discord.DeleteCommands(cfg.Discord.DeleteCommands))
+		discord.DeleteCommands(cfg.Discord.DeleteCommands),
+		discord.OfficerChannel(cfg.Discord.OfficerChannelID),
+		discord.AnnouncementChannel(cfg.Scheduler.ChannelID),
+		discord.Permissions(discord.Policy{
+			OfficerRoles: cfg.Permissions.OfficerRoles,
+			Default:      cfg.Permissions.DefaultLevel,
+			Commands:     commandPermissions,
+		}))
+
+	// Officers are notified in their channel when it is set
+	var notifier usecase.Notifier
+	if cfg.Discord.OfficerChannelID != "" {
+		notifier = serve
+	}
+	strikePolicy := entity.StrikePolicy{
+		ExpiryWeeks:       cfg.Strikes.ExpiryWeeks,
+		ExpireOnSeasonEnd: cfg.Strikes.ExpireOnSeasonEnd,
+		AlertThreshold:    cfg.Strikes.AlertThreshold,
+	}
 
+	pointsPolicy := entity.PointsPolicy{
+		Attendance:   cfg.Points.Attendance,
+		LootCost:     cfg.Points.LootCost,
+		DecayPercent: cfg.Points.DecayPercent,
+	}
+	lootStrategy, err := entity.ParseLootStrategy(cfg.Loot.Strategy)
+	if err != nil {
+		logger.FromContext(ctx).Fatal(errors.Wrap(err, "read loot strategy from config").Error())
+		return
+	}
+
+	// Time zone and times of day are checked when config is loaded
+	location, _ := time.LoadLocation(cfg.Guild.Timezone)
+	raidStart, _ := time.Parse(config.ClockLayout, cfg.Guild.RaidStart)
+	schedulePolicy := entity.SchedulePolicy{
+		RaidStart:     entity.TimeOfDay(raidStart),
+		AnnounceDays:  cfg.Scheduler.AnnounceDays,
+		ReminderHours: cfg.Scheduler.ReminderHours,
+		Location:      location,
+	}
+
+	auc := usecase.NewAbsenceUseCase(backend, location)
+	puc := usecase.NewPlayerUseCase(backend, strikePolicy)
+	luc := usecase.NewLootUseCase(backend, lootStrategy, pointsPolicy)
+	ruc := usecase.NewRaidUseCase(backend, pointsPolicy, schedulePolicy)
+	suc := usecase.NewStrikeUseCase(backend, strikePolicy, notifier)
+	fuc := usecase.NewFailUseCase(backend)
+	atuc := usecase.NewAttendanceUseCase(backend, location)
+	seuc := usecase.NewSeasonUseCase(backend)
+	pouc := usecase.NewPointsUseCase(backend, pointsPolicy)
+	wuc := usecase.NewWishlistUseCase(backend)
+	iuc := usecase.NewItemUseCase(backend)
+	siuc := usecase.NewSignupUseCase(backend, auc, location)
+	tuc := usecase.NewRaidTemplateUseCase(backend, cfg.Templates.WeeksAhead, configHolidays(cfg), location, notifier)
+	truc := usecase.NewTrashUseCase(backend, time.Duration(cfg.Trash.RetentionDays)*24*time.Hour)
+
+	var announcer usecase.Announcer
+	if cfg.Scheduler.ChannelID != "" {
+		announcer = serve
+	}
+	scuc := usecase.NewScheduleUseCase(backend, schedulePolicy, announcer, notifier)
+
+	err = seuc.ImportSeasons(ctx, configSeasons(cfg))
+	if err != nil {
+		logger.FromContext(ctx).Fatal(errors.Wrap(err, "import seasons from config").Error())
+		return
+	}
+
+	if cfg.Items.Catalogue != "" {
+		items, err := readItemCatalogue(cfg.Items.Catalogue)
+		if err != nil {
+			logger.FromContext(ctx).Fatal(errors.Wrap(err, "read item catalogue").Error())
+			return
+		}
+		err = iuc.ImportItems(ctx, items)
+		if err != nil {
+			logger.FromContext(ctx).Fatal(errors.Wrap(err, "import item catalogue").Error())
+			return
+		}
+		logger.FromContext(ctx).Info("item catalogue loaded", zap.Int("items", len(items)))
+	}
+
+	err = migrateDiscordLinks(ctx, cfg, puc, serve)
+	if err != nil {
+		logger.FromContext(ctx).Fatal(errors.Wrap(err, "migrate discord links").Error())
+		return
+	}
+
+	disc := discordHandler.Discord{
+		AbsenceUseCase: auc,
+		PlayerUseCase:  puc,
+		LootUseCase:    luc,
+		RaidUseCase:    ruc,
+		StrikeUseCase:  suc,
+		FailUseCase:    fuc,
+
+		AttendanceUseCase: atuc,
+		SeasonUseCase:     seuc,
+		PointsUseCase:     pouc,
+		WishlistUseCase:   wuc,
+		ItemUseCase:       iuc,
+		SignupUseCase:     siuc,
+
+		RaidTemplateUseCase: tuc,
+		TrashUseCase:        truc,
+
+		Location:      location,
+		Pages:         discordHandler.NewPages(),
+		Confirmations: discordHandler.NewConfirmations(),
+	}
+
+	for _, v := range []func() map[string]discord.Handler{
+		disc.InitAbsence, disc.InitAdmin,
+		disc.InitStrike, disc.InitAttendance,
+		disc.InitSeason, disc.InitPoints, disc.InitWishlist, disc.InitItem, disc.InitRaidTemplate,
+		disc.InitTrash,
+		disc.InitPlayer, disc.InitRaid, disc.InitLoot, disc.InitFail, disc.InitSignup,
+	} {
+		for k, v := range v() {
+			mapHandler[k] = v
+		}
+	}
+	components.HandleAll(disc.InitSignupComponents())
+	// Players answer signups posted by officers, signup buttons follow their own permission
+	components.Permission(discordHandler.SignupComponent, discordHandler.SignupComponent)
+	components.HandleAll(disc.InitPageComponents())
+	components.HandleAll(disc.InitConfirmComponents())
+	for command, options := range disc.InitAutocomplete() {
+		completers[command] = options
+	}
+
+	sched := scheduler.New(
+		scheduler.Location(schedulePolicy.Location),
+		scheduler.Jobs(scheduledJobs(cfg, scuc, tuc, truc)...))
+
+	// The scheduler stops with the discord server, when ctx is done or when one of them fails
 	logger.FromContext(ctx).Info("start guildOps")
-	err = serve.Run(ctx)
+	group, ctx := pond.New(2, 0).GroupContext(ctx)
+	group.Submit(func() error {
+		return errors.Wrap(serve.Run(ctx), "run discord")
+	})
+	group.Submit(func() error {
+		return errors.Wrap(sched.Run(ctx), "run scheduler")
+	})
+	err = group.Wait()
 	if err != nil {
-		logger.FromContext(ctx).Error(errors.Wrap(err, "run discord").Error())
+		logger.FromContext(ctx).Error(err.Error())
 		return
 	}
 }
+
+// scheduledJobs returns the jobs enabled in the scheduler, templates and trash sections of config.
+func scheduledJobs(
+	cfg *config.Config, scuc *usecase.ScheduleUseCase, tuc *usecase.RaidTemplateUseCase,
+	truc *usecase.TrashUseCase,
+) []scheduler.Job {
+	var jobs []scheduler.Job
+	if cfg.Scheduler.Announcement != "" {
+		at, _ := time.Parse(config.ClockLayout, cfg.Scheduler.Announcement)
+		jobs = append(jobs, scheduler.Job{
+			Name:     "announce-raids",
+			Schedule: scheduler.Daily(at.Hour(), at.Minute()),
+			Run: func(ctx context.Context, _, now time.Time) error {
+				return scuc.AnnounceRaids(ctx, now)
+			},
+		})
+	}
+	if cfg.Scheduler.ReminderHours > 0 {
+		jobs = append(jobs, scheduler.Job{
+			Name:     "remind-signups",
+			Schedule: scheduler.Every(5 * time.Minute),
+			Run:      scuc.RemindSignups,
+		})
+	}
+	if cfg.Scheduler.OfficerSummary != "" {
+		at, _ := time.Parse(config.ClockLayout, cfg.Scheduler.OfficerSummary)
+		jobs = append(jobs, scheduler.Job{
+			Name:     "summarize-absences",
+			Schedule: scheduler.Daily(at.Hour(), at.Minute()),
+			Run: func(ctx context.Context, _, now time.Time) error {
+				return scuc.SummarizeAbsences(ctx, now)
+			},
+		})
+	}
+	if cfg.Templates.Generation != "" {
+		at, _ := time.Parse(config.ClockLayout, cfg.Templates.Generation)
+		jobs = append(jobs, scheduler.Job{
+			Name:     "generate-raids",
+			Schedule: scheduler.Daily(at.Hour(), at.Minute()),
+			Run: func(ctx context.Context, _, now time.Time) error {
+				return tuc.ScheduleRaids(ctx, now)
+			},
+		})
+	}
+	if cfg.Trash.Purge != "" && cfg.Trash.RetentionDays > 0 {
+		at, _ := time.Parse(config.ClockLayout, cfg.Trash.Purge)
+		jobs = append(jobs, scheduler.Job{
+			Name:     "purge-deleted",
+			Schedule: scheduler.Daily(at.Hour(), at.Minute()),
+			Run: func(ctx context.Context, _, now time.Time) error {
+				purged, err := truc.PurgeDeleted(ctx, now)
+				if purged > 0 {
+					logger.FromContext(ctx).Info("deleted objects purged", zap.Int("purged", purged))
+				}
+				return err
+			},
+		})
+	}
+	return jobs
+}
+
+// configSeasons returns seasons set in config. Dates are checked when config is loaded.
+func configSeasons(cfg *config.Config) []entity.Season {
+	seasons := make([]entity.Season, 0, len(cfg.Seasons))
+	for _, season := range cfg.Seasons {
+		start, _ := time.Parse(config.SeasonDateLayout, season.Start)
+		end, _ := time.Parse(config.SeasonDateLayout, season.End)
+		seasons = append(seasons, entity.Season{Name: season.Name, Start: start, End: end})
+	}
+	return seasons
+}
+
+// configHolidays returns holidays set in config. Dates are checked when config is loaded.
+func configHolidays(cfg *config.Config) []entity.Holiday {
+	holidays := make([]entity.Holiday, 0, len(cfg.Holidays))
+	for _, holiday := range cfg.Holidays {
+		start, _ := time.Parse(config.SeasonDateLayout, holiday.Start)
+		end, _ := time.Parse(config.SeasonDateLayout, holiday.End)
+		holidays = append(holidays, entity.Holiday{Name: holiday.Name, Start: start, End: end})
+	}
+	return holidays
+}
+
+// readItemCatalogue reads the item catalogue file, its format is given by its extension.
+func readItemCatalogue(path string) ([]entity.Item, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open item catalogue")
+	}
+	defer file.Close()
+
+	return usecase.ReadItemCatalogue(file, strings.TrimPrefix(filepath.Ext(path), "."))
+}
+
+// migrateDiscordLinks links players still linked by discord username to the ID of their discord user,
+// resolved through the lookup set in config. Every link which can't be resolved is logged,
+// it is only removed when config says so.
+func migrateDiscordLinks(
+	ctx context.Context, cfg *config.Config, puc *usecase.PlayerUseCase, serve *discord.Discord,
+) error {
+	var lookup usecase.DiscordLookup
+	switch cfg.Links.Lookup {
+	case config.LookupNone:
+		return nil
+	case config.LookupFile:
+		file, err := os.Open(cfg.Links.File)
+		if err != nil {
+			return errors.Wrap(err, "open links file")
+		}
+		defer file.Close()
+		ids, err := usecase.ReadDiscordIDs(file)
+		if err != nil {
+			return errors.Wrap(err, "read links file")
+		}
+		lookup = ids
+	default:
+		lookup = serve
+	}
+
+	ctx = logger.AddLoggerToContext(ctx, logger.FromContext(ctx).With(zap.String("lookup", cfg.Links.Lookup)))
+	migrated, unresolved, err := puc.MigrateDiscordLinks(ctx, lookup, cfg.Links.RemoveUnresolved)
+	if err != nil {
+		return err
+	}
+	for _, player := range unresolved {
+		msg := "discord link can't be resolved, it is kept until links.remove_unresolved is set"
+		if cfg.Links.RemoveUnresolved {
+			msg = "discord link can't be resolved, it is removed"
+		}
+		logger.FromContext(ctx).Warn(msg,
+			zap.String("player", player.Name), zap.String("username", player.DiscordID))
+	}
+	if migrated > 0 || len(unresolved) > 0 {
+		logger.FromContext(ctx).Info("discord links migrated to discord user IDs",
+			zap.Int("migrated", migrated), zap.Int("unresolved", len(unresolved)))
+	}
+	return nil
+}
+
+// newBackend returns the backend storing guild data, chosen by the backend driver.
+func newBackend(ctx context.Context, cfg *config.Config) (usecase.Backend, error) {
+	ctx = logger.AddLoggerToContext(ctx, logger.FromContext(ctx).With(zap.String("backend", cfg.Backend.Driver)))
+
+	switch cfg.Backend.Driver {
+	case config.DriverMemory:
+		logger.FromContext(ctx).Info("data is kept in memory and lost on restart")
+		return memorybackend.New(), nil
+	case config.DriverSQLite:
+		sqliteHandler, err := sqlite.New(ctx, cfg.SQLite.Path, sqlite.BusyTimeout(cfg.SQLite.BusyTimeout))
+		if err != nil {
+			return nil, errors.Wrap(err, "open sqlite")
+		}
+
+		backend := &sqlitebackend.SQLite{SQLite: sqliteHandler}
+		err = backend.Init(ctx, cfg.SQLite.Path, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "init sqlite")
+		}
+		return backend, nil
+	default:
+		pgHandler, err := postgres.New(
+			ctx,
+			cfg.URL,
+			postgres.MaxPoolSize(cfg.PoolMax),
+			postgres.ConnAttempts(cfg.ConnAttempts),
+			postgres.ConnTimeout(cfg.ConnTimeOut))
+		if err != nil {
+			return nil, errors.Wrap(err, "connect to postgres")
+		}
+
+		backend := &postgresbackend.PG{Postgres: pgHandler}
+		err = backend.Init(ctx, cfg.URL, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "init postgres")
+		}
+		return backend, nil
+	}
+}
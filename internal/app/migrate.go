@@ -0,0 +1,105 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/antony-ramos/guildops/config"
+	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
+	"github.com/antony-ramos/guildops/internal/usecase/sqlitebackend"
+	"github.com/pkg/errors"
+)
+
+// MigrateUsage describes the migrate subcommand.
+const MigrateUsage = `usage: guildops migrate <command>
+
+commands:
+  up        apply every migration which is not applied yet
+  down [n]  revert the last n migrations (default 1)
+  status    print the current and the latest schema version`
+
+// migrator applies the embedded migrations of a backend on the database at url.
+type migrator interface {
+	MigrateUp(ctx context.Context, url string, database *sql.DB) error
+	MigrateDown(ctx context.Context, url string, database *sql.DB, steps int) error
+	MigrationStatus(ctx context.Context, url string, database *sql.DB) (uint, bool, error)
+}
+
+// newMigrator returns the migrator of the configured backend, the url of its database
+// and the version of its last embedded migration.
+func newMigrator(cfg *config.Config) (migrator, string, func() (uint, error), error) {
+	switch cfg.Backend.Driver {
+	case config.DriverPostgres:
+		return &postgresbackend.PG{}, cfg.PG.URL, postgresbackend.LatestMigration, nil
+	case config.DriverSQLite:
+		return &sqlitebackend.SQLite{}, cfg.SQLite.Path, sqlitebackend.LatestMigration, nil
+	default:
+		return nil, "", nil, errors.Errorf("the %s backend has no migrations", cfg.Backend.Driver)
+	}
+}
+
+// Migrate runs the migrate subcommand with the given args (without "migrate")
+// and writes its result to out.
+func Migrate(ctx context.Context, cfg *config.Config, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(MigrateUsage)
+	}
+
+	backend, url, latestMigration, err := newMigrator(cfg)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		if len(args) > 1 {
+			return errors.New(MigrateUsage)
+		}
+		err = backend.MigrateUp(ctx, url, nil)
+		if err != nil {
+			return errors.Wrap(err, "migrate up")
+		}
+	case "down":
+		steps := 1
+		if len(args) > 2 {
+			return errors.New(MigrateUsage)
+		}
+		if len(args) == 2 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return errors.Errorf("migrate down: %s is not a positive number of migrations", args[1])
+			}
+		}
+		err = backend.MigrateDown(ctx, url, nil, steps)
+		if err != nil {
+			return errors.Wrap(err, "migrate down")
+		}
+	case "status":
+		if len(args) > 1 {
+			return errors.New(MigrateUsage)
+		}
+	default:
+		return errors.New(MigrateUsage)
+	}
+
+	version, dirty, err := backend.MigrationStatus(ctx, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "migrate status")
+	}
+	latest, err := latestMigration()
+	if err != nil {
+		return errors.Wrap(err, "migrate status")
+	}
+
+	status := fmt.Sprintf("schema version: %d, latest: %d", version, latest)
+	if dirty {
+		status += " (dirty: last migration failed, fix the schema then run migrate again)"
+	} else if version < latest {
+		status += fmt.Sprintf(" (%d pending)", latest-version)
+	}
+	_, err = fmt.Fprintln(out, status)
+	return err
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
+
 	"github.com/bwmarrin/discordgo"
 	"github.com/pkg/errors"
 )
@@ -64,9 +68,8 @@ var AbsenceDescriptor = []discordgo.ApplicationCommand{
 	},
 }
 
-func (d Discord) InitAbsence() map[string]func(
-	ctx context.Context, interaction *discordgo.InteractionCreate) (string, error) {
-	return map[string]func(ctx context.Context, interaction *discordgo.InteractionCreate) (string, error){
+func (d Discord) InitAbsence() map[string]discord.Handler {
+	return map[string]discord.Handler{
 		"guildops-absence-create": d.AbsenceHandler,
 		"guildops-absence-delete": d.AbsenceHandler,
 		"guildops-absence-list":   d.ListAbsenceHandler,
@@ -75,7 +78,7 @@ func (d Discord) InitAbsence() map[string]func(
 
 func (d Discord) ListAbsenceHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -96,63 +99,62 @@ func (d Discord) ListAbsenceHandler(
 		attribute.String("date", date),
 	)
 
-	dates, err := ParseDate(date, "")
+	listDate, err := ParseDay(date, d.now())
 	if err != nil {
 		msg := "Error while parsing date:" + HumanReadableError(err)
-		return msg, fmt.Errorf("list absences parse date: %w", err)
+		return discord.Text(msg), fmt.Errorf("list absences parse date: %w", err)
 	}
 
-	absences, err := d.ListAbsence(ctx, dates[0])
+	absences, err := d.ListAbsence(ctx, listDate)
 	if err != nil {
 		msg := "Error while getting absences:" + HumanReadableError(err)
-		return msg, fmt.Errorf("list absences usecase: %w", err)
+		return discord.Text(msg), fmt.Errorf("list absences usecase: %w", err)
 	}
 
 	if len(absences) == 0 {
-		msg := "No absence for " + date + "\n"
-		return msg, nil
+		return discord.Text("No absence for " + listDate.Format("02/01/06")), nil
 	}
 
-	msg := dates[0].Format("02/01/06") + " absences :\n"
+	players := make([]string, 0, len(absences))
 	for _, absence := range absences {
-		msg += "* " + absence.Player.Name + "\n"
+		players = append(players, "* "+absence.Player.Name)
 	}
-	return msg, nil
+	return &discord.Response{
+		Title:  "Absences of " + listDate.Format("Mon 02/01/06"),
+		Fields: appendListField(nil, "Players", players),
+	}, nil
 }
 
+// GenerateAbsenceHandlerMsg creates or deletes the absences of user on the raids from fromDate to toDate
+// and returns the dates done. Over a range, days without raid are left out.
 func (d Discord) GenerateAbsenceHandlerMsg(
 	ctx context.Context, user string, fromDate string, toDate string, created bool,
-) (string, error) {
+) (*discord.Response, error) {
 	// TODO: ugly function should be split in multiple functions and refactored
 
 	errorMsg := "Error while creating absence: "
-	msg := "Absence(s) created for :\n"
+	title := "Absences created for " + user
 
 	if !created {
 		errorMsg = "Error while deleting absence: "
-		msg = "Absence(s) deleted for :\n"
+		title = "Absences deleted for " + user
 	}
 
-	dates, err := ParseDate(fromDate, toDate)
+	dates, err := ParseDate(fromDate, toDate, d.now())
 	if err != nil {
-		return errorMsg + HumanReadableError(err), err
+		return discord.Text(errorMsg + HumanReadableError(err)), err
 	}
 
-	if dates[0].Before(
-		time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 0, 0, 0, 0, time.Now().Location())) {
-		return "You can't create or delete an absence in the past",
+	if dates[0].Before(entity.Day(d.now())) {
+		return discord.Text("You can't create or delete an absence in the past"),
 			errors.New("discord - GenerateAbsenceHandlerMsg: can't create a absence in the past")
 	}
 
-	if err != nil {
-		return errorMsg + HumanReadableError(err), err
-	}
-
 	RaidNotFound := regexp.MustCompile(".*no raid found.*")
 	AbsenceAlreadyExist := regexp.MustCompile(".*absence already exist.*")
 	AbsenceNotFound := regexp.MustCompile(".*absence not found.*")
 
-	atLeastADate := false
+	var lines []string
 	for _, date := range dates {
 		date := date
 		if !created {
@@ -161,17 +163,15 @@ func (d Discord) GenerateAbsenceHandlerMsg(
 				errorRegex := fmt.Sprintf("(%s|%s)", AbsenceNotFound, RaidNotFound)
 				matched, _ := regexp.MatchString(errorRegex, err.Error())
 				if len(dates) == 1 || !matched {
-					return errorMsg + HumanReadableError(err), err
+					return discord.Text(errorMsg + HumanReadableError(err)), err
 				} else {
 					matched = RaidNotFound.MatchString(err.Error()) || AbsenceNotFound.MatchString(err.Error())
 					if !matched {
-						msg += "* " + date.Format("Mon 02/01/06") + "\n"
-						atLeastADate = true
+						lines = append(lines, "* "+date.Format("Mon 02/01/06"))
 					}
 				}
 			} else {
-				msg += "* " + date.Format("Mon 02/01/06") + "\n"
-				atLeastADate = true
+				lines = append(lines, "* "+date.Format("Mon 02/01/06"))
 			}
 		} else {
 			err = d.CreateAbsence(ctx, user, date)
@@ -179,50 +179,51 @@ func (d Discord) GenerateAbsenceHandlerMsg(
 				errorRegex := fmt.Sprintf("(%s|%s)", RaidNotFound, AbsenceAlreadyExist)
 				matched, _ := regexp.MatchString(errorRegex, err.Error())
 				if len(dates) == 1 || !matched {
-					return errorMsg + HumanReadableError(err), err
+					return discord.Text(errorMsg + HumanReadableError(err)), err
 				} else {
 					matched = RaidNotFound.MatchString(err.Error())
 					if !matched {
 						if AbsenceAlreadyExist.MatchString(err.Error()) {
-							msg += "* " + date.Format("Mon 02/01/06") + " Absence already exists" + "\n"
+							lines = append(lines, "* "+date.Format("Mon 02/01/06")+" Absence already exists")
 						} else {
-							msg += "* " + date.Format("Mon 02/01/06") + "\n"
+							lines = append(lines, "* "+date.Format("Mon 02/01/06"))
 						}
-						atLeastADate = true
 					}
 				}
 			} else {
-				msg += "* " + date.Format("Mon 02/01/06") + "\n"
-				atLeastADate = true
+				lines = append(lines, "* "+date.Format("Mon 02/01/06"))
 			}
 		}
 	}
-	if !atLeastADate {
-		return "No absence created or deleted, there is no raids on this range", nil
+	if len(lines) == 0 {
+		return discord.Text("No absence created or deleted, there is no raids on this range"), nil
 	}
-	return msg, nil
+	return &discord.Response{
+		Title:       title,
+		Description: strings.Join(lines, "\n"),
+		Colour:      discord.ColourSuccess,
+	}, nil
 }
 
 func (d Discord) AbsenceHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
 	ctx, span := otel.Tracer("Discord").Start(ctx, "Absence/AbsenceHandler")
 	defer span.End()
 
-	user := interaction.Member.User.Username
 	span.SetAttributes(
 		attribute.String("request_from", interaction.Member.User.Username),
 	)
 
-	p, err := d.ReadPlayer(ctx, "", user)
+	p, err := d.ReadPlayer(ctx, "", interaction.Member.User.ID)
 	if err != nil {
-		return "You are not linked to any existing player",
+		return discord.Text("You are not linked to any existing player"),
 			errors.Wrap(err, "discord - AbsenceHandler: read player from discord id")
 	}
-	user = p.Name
+	user := p.Name
 
 	options := interaction.ApplicationCommandData().Options
 	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
@@ -75,11 +75,12 @@ func TestDiscord_GenerateAbsenceHandlerMsg(t *testing.T) {
 
 		mockAbsenceUseCase.On("CreateAbsence", mock.Anything, "playerone", mock.Anything).Return(nil)
 
-		msg, err := discord.GenerateAbsenceHandlerMsg(
+		response, err := discord.GenerateAbsenceHandlerMsg(
 			context.Background(), "playerone", time.Now().AddDate(0, 0, 1).Format("02/01/06"), "", true)
 
 		assert.NoError(t, err)
-		assert.Equal(t, "Absence(s) created for :\n* "+time.Now().AddDate(0, 0, 1).Format("Mon 02/01/06")+"\n", msg)
+		assert.Equal(t, "Absences created for playerone", response.Title)
+		assert.Equal(t, "* "+time.Now().AddDate(0, 0, 1).Format("Mon 02/01/06"), response.Description)
 		mockAbsenceUseCase.AssertExpectations(t)
 	})
 
@@ -104,15 +105,15 @@ func TestDiscord_GenerateAbsenceHandlerMsg(t *testing.T) {
 			On("CreateAbsence", mock.Anything, "playerone", mock.Anything).
 			Return(errors.New("no raid found")).Once()
 
-		msg, err := discord.GenerateAbsenceHandlerMsg(
+		response, err := discord.GenerateAbsenceHandlerMsg(
 			context.Background(), "playerone",
 			time.Now().AddDate(0, 0, 1).Format("02/01/06"),
 			time.Now().AddDate(0, 0, 3).Format("02/01/06"), true)
 
 		assert.NoError(t, err)
-		assert.Equal(t, "Absence(s) created for :\n"+
-			"* "+time.Now().AddDate(0, 0, 1).Format("Mon 02/01/06")+"\n"+
-			"* "+time.Now().AddDate(0, 0, 2).Format("Mon 02/01/06")+" Absence already exists\n", msg)
+		assert.Equal(t, "Absences created for playerone", response.Title)
+		assert.Equal(t, "* "+time.Now().AddDate(0, 0, 1).Format("Mon 02/01/06")+"\n"+
+			"* "+time.Now().AddDate(0, 0, 2).Format("Mon 02/01/06")+" Absence already exists", response.Description)
 		mockAbsenceUseCase.AssertExpectations(t)
 	})
 
@@ -131,11 +132,12 @@ func TestDiscord_GenerateAbsenceHandlerMsg(t *testing.T) {
 
 		mockAbsenceUseCase.On("DeleteAbsence", mock.Anything, "playerone", mock.Anything).Return(nil)
 
-		msg, err := discord.GenerateAbsenceHandlerMsg(
+		response, err := discord.GenerateAbsenceHandlerMsg(
 			context.Background(), "playerone", time.Now().AddDate(0, 0, 1).Format("02/01/06"), "", false)
 
 		assert.NoError(t, err)
-		assert.Equal(t, "Absence(s) deleted for :\n* "+time.Now().AddDate(0, 0, 1).Format("Mon 02/01/06")+"\n", msg)
+		assert.Equal(t, "Absences deleted for playerone", response.Title)
+		assert.Equal(t, "* "+time.Now().AddDate(0, 0, 1).Format("Mon 02/01/06"), response.Description)
 		mockAbsenceUseCase.AssertExpectations(t)
 	})
 
@@ -154,11 +156,11 @@ func TestDiscord_GenerateAbsenceHandlerMsg(t *testing.T) {
 
 		mockAbsenceUseCase.On("CreateAbsence", mock.Anything, "playerone", mock.Anything).Return(errors.New("Backend Error"))
 
-		msg, err := discord.GenerateAbsenceHandlerMsg(
+		response, err := discord.GenerateAbsenceHandlerMsg(
 			context.Background(), "playerone", time.Now().AddDate(0, 0, 1).Format("02/01/06"), "", true)
 
 		assert.Error(t, err)
-		assert.Equal(t, "Error while creating absence: Backend Error", msg)
+		assert.Equal(t, "Error while creating absence: Backend Error", response.Description)
 		mockAbsenceUseCase.AssertExpectations(t)
 	})
 
@@ -177,11 +179,11 @@ func TestDiscord_GenerateAbsenceHandlerMsg(t *testing.T) {
 
 		mockAbsenceUseCase.On("DeleteAbsence", mock.Anything, "playerone", mock.Anything).Return(errors.New("Backend Error"))
 
-		msg, err := discord.GenerateAbsenceHandlerMsg(
+		response, err := discord.GenerateAbsenceHandlerMsg(
 			context.Background(), "playerone", time.Now().AddDate(0, 0, 1).Format("02/01/06"), "", false)
 
 		assert.Error(t, err)
-		assert.Equal(t, "Error while deleting absence: Backend Error", msg)
+		assert.Equal(t, "Error while deleting absence: Backend Error", response.Description)
 		mockAbsenceUseCase.AssertExpectations(t)
 	})
 }
@@ -241,9 +243,9 @@ func TestDiscord_ListAbsenceHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.ListAbsenceHandler(context.Background(), interaction)
+		response, err := discord.ListAbsenceHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, "29/09/23 absences :\n* Paragon\n* Paragon\n", msg)
+		assert.Equal(t, "Absences of Fri 29/09/23\n\nPlayers (2)\n* Paragon\n* Paragon\n", response.String())
 		AbsenceUseCase.AssertExpectations(t)
 	})
 }
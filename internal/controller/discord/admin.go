@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+
+	"github.com/antony-ramos/guildops/pkg/discord"
 )
 
 var AdminDescriptor = []discordgo.ApplicationCommand{
@@ -21,7 +23,7 @@ var AdminDescriptor = []discordgo.ApplicationCommand{
 			{
 				Type:        discordgo.ApplicationCommandOptionString,
 				Name:        "from",
-				Description: "ex: 11/05/23",
+				Description: "ex: 11/05/23, today, or a range like 11/05 to 15/05",
 				Required:    true,
 			},
 			{
@@ -58,9 +60,8 @@ var AdminDescriptor = []discordgo.ApplicationCommand{
 	},
 }
 
-func (d Discord) InitAdmin() map[string]func(
-	ctx context.Context, interaction *discordgo.InteractionCreate) (string, error) {
-	return map[string]func(ctx context.Context, interaction *discordgo.InteractionCreate) (string, error){
+func (d Discord) InitAdmin() map[string]discord.Handler {
+	return map[string]discord.Handler{
 		"guildops-admin-absence-create": d.AdminHandler,
 		"guildops-admin-absence-delete": d.AdminHandler,
 	}
@@ -68,7 +69,7 @@ func (d Discord) InitAdmin() map[string]func(
 
 func (d Discord) AdminHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -0,0 +1,178 @@
+package discordhandler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+var AttendanceDescriptors = []discordgo.ApplicationCommand{
+	{
+		Name:        "guildops-attendance-report",
+		Description: "Rank players by attendance on a season or a date range, or show a player attendance",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "season",
+				Description: "ex: DF/S2",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "from",
+				Description: "ex: 02/10/23, today, or a range like 11/05 to 15/05",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "to",
+				Description: "ex: 30/10/23, default is today",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "player",
+				Description: "ex: arthas",
+				Required:    false,
+			},
+		},
+	},
+}
+
+func (d Discord) InitAttendance() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		"guildops-attendance-report": d.AttendanceReportHandler,
+	}
+}
+
+// attendanceRange returns the first and the last day of a season or of a date range.
+// If to is empty, the range ends today. A range going on after today ends today, upcoming raids don't count.
+func (d Discord) attendanceRange(ctx context.Context, season, from, to string) (time.Time, time.Time, error) {
+	var first, last time.Time
+	switch {
+	case season != "" && from == "" && to == "":
+		s, err := d.ReadSeason(ctx, season)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("read season: %w", err)
+		}
+		first, last = s.Start, s.End
+	case season == "" && from != "":
+		if to == "" && !rangeSeparator.MatchString(from) {
+			to = "today"
+		}
+		dates, err := ParseDate(from, to, d.now())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("parse date range: %w", err)
+		}
+		first, last = dates[0], dates[len(dates)-1]
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("check options: should provide either a season or a from date")
+	}
+	if today := entity.Day(d.now()); last.After(today) && !first.After(today) {
+		last = today
+	}
+	return first, last, nil
+}
+
+// AttendanceReportHandler call an usecase to get attendance of players
+// and return a ranked table, or the attendance of one player, to the user.
+// It requires either a 'season' field or a 'from' date field to be passed in the interaction.
+// Optional a 'to' date field and a 'player' field can be passed.
+func (d Discord) AttendanceReportHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Attendance/AttendanceReportHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]string, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt.StringValue()
+	}
+	span.SetAttributes(
+		attribute.String("season", optionMap["season"]),
+		attribute.String("from", optionMap["from"]),
+		attribute.String("to", optionMap["to"]),
+		attribute.String("player", optionMap["player"]),
+	)
+
+	from, to, err := d.attendanceRange(ctx, optionMap["season"], optionMap["from"], optionMap["to"])
+	if err != nil {
+		msg := "Error while getting attendance: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("attendance report range: %w", err)
+	}
+	period := "from " + from.Format("02/01/06") + " to " + to.Format("02/01/06")
+
+	if playerName := optionMap["player"]; playerName != "" {
+		attendance, err := d.ReadAttendance(ctx, playerName, from, to)
+		if err != nil {
+			msg := "Error while getting attendance: " + HumanReadableError(err)
+			return discord.Text(msg), fmt.Errorf("call read attendance usecase: %w", err)
+		}
+		return playerAttendanceResponse(attendance, period), nil
+	}
+
+	attendances, err := d.ListAttendance(ctx, from, to)
+	if err != nil {
+		msg := "Error while getting attendance: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call list attendance usecase: %w", err)
+	}
+	if len(attendances) == 0 {
+		return discord.Text("no player found"), nil
+	}
+
+	msg := "```\n" +
+		fmt.Sprintf("%-3s %-12s %5s %7s %6s %5s %4s\n", "#", "Player", "Rate", "Raids", "Absent", "Bench", "Late")
+	for index, attendance := range attendances {
+		msg += fmt.Sprintf("%-3d %-12s %4.0f%% %7s %6d %5d %4d\n",
+			index+1, attendance.Player.Name, attendance.Rate(),
+			strconv.Itoa(attendance.Attended())+"/"+strconv.Itoa(len(attendance.Raids)),
+			len(attendance.Absent), len(attendance.Bench), len(attendance.Late))
+	}
+	return &discord.Response{Title: "Attendance " + period, Description: msg + "```"}, nil
+}
+
+// playerAttendanceResponse returns the attendance of a player with a field for the dates of
+// raids missed, benched or arrived late.
+func playerAttendanceResponse(attendance entity.Attendance, period string) *discord.Response {
+	response := &discord.Response{
+		Title: "Attendance of " + attendance.Player.Name + " " + period,
+		Description: fmt.Sprintf("**%.0f%%** (%d/%d raids)",
+			attendance.Rate(), attendance.Attended(), len(attendance.Raids)),
+	}
+	for _, line := range []struct {
+		title string
+		raids []entity.Raid
+	}{
+		{"Absent", attendance.Absent},
+		{"Bench", attendance.Bench},
+		{"Late", attendance.Late},
+	} {
+		dates := make([]string, 0, len(line.raids))
+		for _, raid := range line.raids {
+			dates = append(dates, raid.Date.Format("Mon 02/01/06")+" "+raid.Difficulty)
+		}
+		response.Fields = append(response.Fields, discord.Field{
+			Name:   line.title + " (" + strconv.Itoa(len(line.raids)) + ")",
+			Value:  strings.Join(dates, ", "),
+			Inline: true,
+		})
+	}
+	return response
+}
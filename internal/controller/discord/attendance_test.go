@@ -0,0 +1,166 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/internal/controller/discord/mocks"
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestDiscord_AttendanceReportHandler(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC)
+	raid := entity.Raid{Date: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC), Difficulty: "heroic"}
+
+	t.Run("Ranked table on a date range", func(t *testing.T) {
+		t.Parallel()
+		mockAttendanceUseCase := mocks.NewAttendanceUseCase(t)
+
+		discord := discordHandler.Discord{
+			AttendanceUseCase: mockAttendanceUseCase,
+		}
+
+		mockAttendanceUseCase.On("ListAttendance", mock.Anything, from, to).
+			Return([]entity.Attendance{
+				{Player: &entity.Player{Name: "jaina"}, Raids: []entity.Raid{raid, raid}, Bench: []entity.Raid{raid}},
+				{Player: &entity.Player{Name: "arthas"}, Raids: []entity.Raid{raid, raid}, Absent: []entity.Raid{raid}},
+			}, nil)
+
+		response, err := discord.AttendanceReportHandler(context.Background(),
+			commandInteraction("guildops-attendance-report",
+				stringOption("from", "01/10/23"),
+				stringOption("to", "31/10/23"),
+			))
+		assert.NoError(t, err)
+		assert.Equal(t, "Attendance from 01/10/23 to 31/10/23\n\n```\n#   Player        Rate   Raids Absent Bench Late\n"+
+			"1   jaina         100%     2/2      0     1    0\n"+
+			"2   arthas         50%     1/2      1     0    0\n```\n",
+			response.String())
+		mockAttendanceUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Player on a season", func(t *testing.T) {
+		t.Parallel()
+		mockAttendanceUseCase := mocks.NewAttendanceUseCase(t)
+		mockSeasonUseCase := mocks.NewSeasonUseCase(t)
+
+		discord := discordHandler.Discord{
+			AttendanceUseCase: mockAttendanceUseCase,
+			SeasonUseCase:     mockSeasonUseCase,
+		}
+
+		mockSeasonUseCase.On("ReadSeason", mock.Anything, "DF/S2").
+			Return(entity.Season{
+				Name:  "DF/S2",
+				Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+			}, nil)
+		mockAttendanceUseCase.On("ReadAttendance", mock.Anything, "arthas",
+			time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)).
+			Return(entity.Attendance{
+				Player: &entity.Player{Name: "arthas"},
+				Raids:  []entity.Raid{raid, raid},
+				Absent: []entity.Raid{raid},
+			}, nil)
+
+		response, err := discord.AttendanceReportHandler(context.Background(),
+			commandInteraction("guildops-attendance-report",
+				stringOption("season", "DF/S2"),
+				stringOption("player", "arthas"),
+			))
+		assert.NoError(t, err)
+		assert.Equal(t, "Attendance of arthas from 01/05/23 to 31/12/23\n\n**50%** (1/2 raids)\n\nAbsent (1)\n"+
+			"Mon 02/10/23 heroic\n\nBench (0)\n\n\nLate (0)\n\n",
+			response.String())
+		mockAttendanceUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Season going on", func(t *testing.T) {
+		t.Parallel()
+		mockAttendanceUseCase := mocks.NewAttendanceUseCase(t)
+		mockSeasonUseCase := mocks.NewSeasonUseCase(t)
+
+		discord := discordHandler.Discord{
+			AttendanceUseCase: mockAttendanceUseCase,
+			SeasonUseCase:     mockSeasonUseCase,
+		}
+
+		// Raids after today don't count, the range ends today
+		today := entity.Today(nil)
+		start := today.AddDate(0, 0, -30)
+		mockSeasonUseCase.On("ReadSeason", mock.Anything, "TWW/S1").
+			Return(entity.Season{Name: "TWW/S1", Start: start, End: today.AddDate(0, 0, 30)}, nil)
+		mockAttendanceUseCase.On("ListAttendance", mock.Anything, start, today).
+			Return([]entity.Attendance{{Player: &entity.Player{Name: "jaina"}, Raids: []entity.Raid{raid}}}, nil)
+
+		response, err := discord.AttendanceReportHandler(context.Background(),
+			commandInteraction("guildops-attendance-report", stringOption("season", "TWW/S1")))
+		assert.NoError(t, err)
+		assert.Equal(t, "Attendance from "+start.Format("02/01/06")+" to "+today.Format("02/01/06"), response.Title)
+		mockAttendanceUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Season and date range", func(t *testing.T) {
+		t.Parallel()
+		mockAttendanceUseCase := mocks.NewAttendanceUseCase(t)
+
+		discord := discordHandler.Discord{
+			AttendanceUseCase: mockAttendanceUseCase,
+		}
+
+		response, err := discord.AttendanceReportHandler(context.Background(),
+			commandInteraction("guildops-attendance-report",
+				stringOption("season", "DF/S2"),
+				stringOption("from", "01/10/23"),
+			))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while getting attendance: should provide either a season or a from date", response.Description)
+	})
+
+	t.Run("Usecase error", func(t *testing.T) {
+		t.Parallel()
+		mockAttendanceUseCase := mocks.NewAttendanceUseCase(t)
+
+		discord := discordHandler.Discord{
+			AttendanceUseCase: mockAttendanceUseCase,
+		}
+
+		mockAttendanceUseCase.On("ReadAttendance", mock.Anything, "sylvanas", from, to).
+			Return(entity.Attendance{}, errors.New("check player exists: player sylvanas not found"))
+
+		response, err := discord.AttendanceReportHandler(context.Background(),
+			commandInteraction("guildops-attendance-report",
+				stringOption("from", "01/10/23"),
+				stringOption("to", "31/10/23"),
+				stringOption("player", "sylvanas"),
+			))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while getting attendance: player sylvanas not found", response.Description)
+	})
+
+	t.Run("Unknown season", func(t *testing.T) {
+		t.Parallel()
+		mockSeasonUseCase := mocks.NewSeasonUseCase(t)
+
+		discord := discordHandler.Discord{
+			SeasonUseCase: mockSeasonUseCase,
+		}
+
+		mockSeasonUseCase.On("ReadSeason", mock.Anything, "DF/S9").
+			Return(entity.Season{}, errors.New("season DF/S9 not found"))
+
+		response, err := discord.AttendanceReportHandler(context.Background(),
+			commandInteraction("guildops-attendance-report", stringOption("season", "DF/S9")))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while getting attendance: season DF/S9 not found", response.Description)
+	})
+}
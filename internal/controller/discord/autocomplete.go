@@ -0,0 +1,217 @@
+package discordhandler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+// difficulties are the difficulties of raids, suggested in difficulty options.
+var difficulties = []string{"normal", "heroic", "mythic"}
+
+// listSeparator separates the items of options taking several players or week days.
+const listSeparator = ","
+
+// InitAutocomplete returns the completers of command options, keyed by command then option name.
+// Options of players already created, raids and loots suggest them as they are typed.
+func (d Discord) InitAutocomplete() discord.Completers {
+	player := map[string]discord.Completer{"name": d.CompletePlayer}
+	playerName := map[string]discord.Completer{"player-name": d.CompletePlayer}
+	raid := map[string]discord.Completer{"date": d.CompleteRaidDate, "difficulty": CompleteDifficulty}
+	roster := map[string]discord.Completer{
+		"date":       d.CompleteRaidDate,
+		"difficulty": CompleteDifficulty,
+		"present":    d.CompletePlayers,
+		"late":       d.CompletePlayers,
+		"bench":      d.CompletePlayers,
+		"absent":     d.CompletePlayers,
+		"remove":     d.CompletePlayers,
+	}
+	return discord.Completers{
+		"guildops-player-delete":        player,
+		"guildops-player-get":           player,
+		"guildops-player-link":          player,
+		"guildops-player-update":        player,
+		"guildops-player-alt":           {"name": d.CompletePlayer, "main": d.CompletePlayer},
+		"guildops-admin-absence-create": player,
+		"guildops-admin-absence-delete": player,
+		"guildops-attendance-report":    {"player": d.CompletePlayer},
+		"guildops-strike-create":        player,
+		"guildops-strike-list":          player,
+		"guildops-points-balance":       playerName,
+		"guildops-points-award":         playerName,
+		"guildops-wishlist-add":         playerName,
+		"guildops-wishlist-list":        playerName,
+		"guildops-fail-create":          {"name": d.CompletePlayer, "date": d.CompleteRaidDate},
+		"guildops-fail-list-player":     player,
+		"guildops-fail-list-raid":       {"date": d.CompleteRaidDate},
+		"guildops-loot-attribute": {
+			"loot-name":   d.CompleteLootName,
+			"raid-date":   d.CompleteRaidDate,
+			"player-name": d.CompletePlayer,
+		},
+		"guildops-loot-list-on-player": playerName,
+		"guildops-loot-list-on-raid":   {"date": d.CompleteRaidDate},
+		"guildops-loot-selector": {
+			"player-list": d.CompletePlayers,
+			"difficulty":  CompleteDifficulty,
+			"loot-name":   d.CompleteLootName,
+		},
+		"guildops-raid-create":          {"difficulty": CompleteDifficulty},
+		"guildops-raid-delete":          raid,
+		"guildops-raid-create-multiple": {"difficulty": CompleteDifficulty, "weekdays": CompleteWeekdays},
+		"guildops-raid-roster-set":      roster,
+		"guildops-raid-roster-show":     raid,
+		"guildops-raid-signup":          raid,
+		"guildops-raid-template-create": {"difficulty": CompleteDifficulty, "weekdays": CompleteWeekdays},
+	}
+}
+
+// CompletePlayer suggests the players whose name starts with value.
+func (d Discord) CompletePlayer(
+	ctx context.Context, value string,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Autocomplete/CompletePlayer")
+	defer span.End()
+	span.SetAttributes(attribute.String("value", value))
+
+	names, err := d.SearchPlayerNames(ctx, value, discord.MaxChoices)
+	if err != nil {
+		return nil, fmt.Errorf("search player names: %w", err)
+	}
+	return discord.Choices(names...), nil
+}
+
+// CompletePlayers suggests the players whose name starts with the last player of a comma separated list.
+// Players already in the list are not suggested again.
+func (d Discord) CompletePlayers(
+	ctx context.Context, value string,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Autocomplete/CompletePlayers")
+	defer span.End()
+	span.SetAttributes(attribute.String("value", value))
+
+	return completeList(value, func(prefix string) ([]string, error) {
+		names, err := d.SearchPlayerNames(ctx, prefix, discord.MaxChoices)
+		if err != nil {
+			return nil, fmt.Errorf("search player names: %w", err)
+		}
+		return names, nil
+	})
+}
+
+// CompleteRaidDate suggests the dates of the upcoming raids, from today, which start with value.
+func (d Discord) CompleteRaidDate(
+	ctx context.Context, value string,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Autocomplete/CompleteRaidDate")
+	defer span.End()
+	span.SetAttributes(attribute.String("value", value))
+
+	today := entity.Today(d.Location)
+	raids, err := d.ListRaids(ctx, today, today.AddDate(1, 0, 0), discord.MaxChoices, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list upcoming raids: %w", err)
+	}
+	value = strings.TrimSpace(value)
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(raids))
+	for _, raid := range raids {
+		date := raid.Date.Format("02/01/06")
+		if strings.HasPrefix(date, value) {
+			choices = append(choices, discord.Choice(raid.Name+" "+raidDay(raid)+" "+raid.Difficulty, date))
+		}
+	}
+	return choices, nil
+}
+
+// CompleteLootName suggests the names given to loots before which start with value.
+func (d Discord) CompleteLootName(
+	ctx context.Context, value string,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Autocomplete/CompleteLootName")
+	defer span.End()
+	span.SetAttributes(attribute.String("value", value))
+
+	names, err := d.SearchLootNames(ctx, value, discord.MaxChoices)
+	if err != nil {
+		return nil, fmt.Errorf("search loot names: %w", err)
+	}
+	return discord.Choices(names...), nil
+}
+
+// CompleteDifficulty suggests the difficulties of raids which start with value.
+func CompleteDifficulty(_ context.Context, value string) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	return discord.Choices(withPrefix(difficulties, value)...), nil
+}
+
+// CompleteWeekdays suggests the week days starting with the last day of a comma separated list.
+// Days already in the list are not suggested again.
+func CompleteWeekdays(_ context.Context, value string) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	days := make([]string, 0, 7)
+	for day := time.Monday; day <= time.Saturday; day++ {
+		days = append(days, strings.ToLower(day.String()))
+	}
+	days = append(days, strings.ToLower(time.Sunday.String()))
+	return completeList(value, func(prefix string) ([]string, error) {
+		return withPrefix(days, prefix), nil
+	})
+}
+
+// withPrefix returns the values starting with prefix, case is ignored.
+func withPrefix(values []string, prefix string) []string {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	var kept []string
+	for _, value := range values {
+		if strings.HasPrefix(value, prefix) {
+			kept = append(kept, value)
+		}
+	}
+	return kept
+}
+
+// completeList suggests the items of complete for the last item of a comma separated list,
+// following the items before it. Items already in the list are left out.
+func completeList(
+	value string, complete func(prefix string) ([]string, error),
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	head, last := "", value
+	if index := strings.LastIndex(value, listSeparator); index >= 0 {
+		head, last = value[:index+len(listSeparator)], value[index+len(listSeparator):]
+	}
+	listed := make(map[string]bool)
+	for _, item := range strings.Split(head, listSeparator) {
+		listed[strings.ToLower(strings.TrimSpace(item))] = true
+	}
+
+	items, err := complete(strings.TrimSpace(last))
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		if !listed[item] {
+			values = append(values, head+item)
+		}
+	}
+	return discord.Choices(values...), nil
+}
@@ -0,0 +1,176 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/internal/controller/discord/mocks"
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// choiceValues returns the values of choices.
+func choiceValues(choices []*discordgo.ApplicationCommandOptionChoice) []string {
+	values := make([]string, 0, len(choices))
+	for _, choice := range choices {
+		values = append(values, choice.Value.(string))
+	}
+	return values
+}
+
+func TestDiscord_InitAutocomplete(t *testing.T) {
+	t.Parallel()
+
+	var descriptors []discordgo.ApplicationCommand
+	for _, commands := range [][]discordgo.ApplicationCommand{
+		discordHandler.AbsenceDescriptor, discordHandler.AdminDescriptor, discordHandler.AttendanceDescriptors,
+		discordHandler.FailDescriptors, discordHandler.ItemDescriptors, discordHandler.LootDescriptors,
+		discordHandler.PlayerDescriptors, discordHandler.PointsDescriptors, discordHandler.RaidDescriptors,
+		discordHandler.SeasonDescriptors, discordHandler.SignupDescriptors, discordHandler.StrikeDescriptors,
+		discordHandler.RaidTemplateDescriptors, discordHandler.TrashDescriptors, discordHandler.WishlistDescriptors,
+	} {
+		descriptors = append(descriptors, commands...)
+	}
+
+	// every completer must be for an option of a command
+	for command, options := range (discordHandler.Discord{}).InitAutocomplete() {
+		for option := range options {
+			found := false
+			for _, descriptor := range descriptors {
+				if descriptor.Name != command {
+					continue
+				}
+				for _, descriptorOption := range descriptor.Options {
+					found = found || descriptorOption.Name == option
+				}
+			}
+			assert.True(t, found, "no option %s in command %s", option, command)
+		}
+	}
+}
+
+func TestDiscord_CompletePlayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+		discord := discordHandler.Discord{PlayerUseCase: mockPlayerUseCase}
+
+		mockPlayerUseCase.On("SearchPlayerNames", mock.Anything, "ar", 25).Return([]string{"arthas", "arugal"}, nil)
+
+		choices, err := discord.CompletePlayer(context.Background(), "ar")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"arthas", "arugal"}, choiceValues(choices))
+		assert.Equal(t, "arthas", choices[0].Name)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+		discord := discordHandler.Discord{PlayerUseCase: mockPlayerUseCase}
+
+		mockPlayerUseCase.On("SearchPlayerNames", mock.Anything, "ar", 25).Return(nil, errors.New("backend: unavailable"))
+
+		_, err := discord.CompletePlayer(context.Background(), "ar")
+		assert.Error(t, err)
+	})
+}
+
+func TestDiscord_CompletePlayers(t *testing.T) {
+	t.Parallel()
+
+	mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+	discord := discordHandler.Discord{PlayerUseCase: mockPlayerUseCase}
+
+	mockPlayerUseCase.On("SearchPlayerNames", mock.Anything, "a", 25).Return([]string{"anduin", "arthas"}, nil)
+
+	choices, err := discord.CompletePlayers(context.Background(), "arthas,jaina, a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"arthas,jaina,anduin"}, choiceValues(choices))
+}
+
+func TestDiscord_CompleteRaidDate(t *testing.T) {
+	t.Parallel()
+
+	mockRaidUseCase := mocks.NewRaidUseCase(t)
+	discord := discordHandler.Discord{RaidUseCase: mockRaidUseCase}
+
+	today := entity.Today(nil)
+	mockRaidUseCase.On("ListRaids", mock.Anything, today, today.AddDate(1, 0, 0), 25, 0).Return([]entity.Raid{
+		{
+			ID: 1, Name: "icc", Difficulty: "mythic", StartTime: 21 * time.Hour, StartTimeSet: true,
+			Date: time.Date(2030, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{ID: 2, Name: "icc", Difficulty: "heroic", Date: time.Date(2030, 11, 3, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+
+	choices, err := discord.CompleteRaidDate(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"01/10/30", "03/11/30"}, choiceValues(choices))
+	assert.Equal(t, "icc Tue 01/10/30 21:00 mythic", choices[0].Name)
+
+	choices, err = discord.CompleteRaidDate(context.Background(), "03")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"03/11/30"}, choiceValues(choices))
+}
+
+func TestDiscord_CompleteLootName(t *testing.T) {
+	t.Parallel()
+
+	mockLootUseCase := mocks.NewLootUseCase(t)
+	discord := discordHandler.Discord{LootUseCase: mockLootUseCase}
+
+	mockLootUseCase.On("SearchLootNames", mock.Anything, "frost", 25).
+		Return([]string{"frostbolt staff", "frostmourne"}, nil)
+
+	choices, err := discord.CompleteLootName(context.Background(), "frost")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"frostbolt staff", "frostmourne"}, choiceValues(choices))
+}
+
+func TestCompleteDifficulty(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "", want: []string{"normal", "heroic", "mythic"}},
+		{value: "My", want: []string{"mythic"}},
+		{value: "lfr", want: []string{}},
+	}
+	for _, tt := range tests {
+		choices, err := discordHandler.CompleteDifficulty(context.Background(), tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, choiceValues(choices), tt.value)
+	}
+}
+
+func TestCompleteWeekdays(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "s", want: []string{"saturday", "sunday"}},
+		{value: "monday,t", want: []string{"monday,tuesday", "monday,thursday"}},
+		{value: "monday,wednesday,", want: []string{
+			"monday,wednesday,tuesday", "monday,wednesday,thursday", "monday,wednesday,friday",
+			"monday,wednesday,saturday", "monday,wednesday,sunday",
+		}},
+		{value: "tuesday,tu", want: []string{}},
+	}
+	for _, tt := range tests {
+		choices, err := discordHandler.CompleteWeekdays(context.Background(), tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, choiceValues(choices), tt.value)
+	}
+}
@@ -0,0 +1,187 @@
+package discordhandler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+// ConfirmComponent starts the custom ID of the buttons of destructive commands,
+// "guildops-confirm:<confirm|cancel>:<token>".
+const ConfirmComponent = "guildops-confirm"
+
+// Answers of the confirmation buttons.
+const (
+	confirmAnswer = "confirm"
+	cancelAnswer  = "cancel"
+)
+
+// ConfirmTTL is how long a destructive command waits for its confirmation.
+const ConfirmTTL = 2 * time.Minute
+
+// Action runs a destructive command once it is confirmed and returns its message.
+type Action func(ctx context.Context) (string, error)
+
+// pendingAction is a destructive command waiting for the member who ran it to confirm it.
+type pendingAction struct {
+	action  Action
+	userID  string
+	expires time.Time
+}
+
+// Confirmations keeps the destructive commands waiting for their confirmation, keyed by the token of their buttons.
+type Confirmations struct {
+	mu      sync.Mutex
+	pending map[string]pendingAction
+}
+
+// NewConfirmations returns a store without commands waiting.
+func NewConfirmations() *Confirmations {
+	return &Confirmations{pending: make(map[string]pendingAction)}
+}
+
+// InitConfirmComponents returns the handler of the confirmation buttons, keyed by the start of their custom ID.
+func (d Discord) InitConfirmComponents() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		ConfirmComponent: d.ConfirmButtonHandler,
+	}
+}
+
+// Ask returns a message, only shown to the member who ran the command, with summary and the buttons
+// to confirm or cancel action. action only runs when the member confirms it before ConfirmTTL.
+// Without confirmations, action runs at once.
+func (c *Confirmations) Ask(
+	ctx context.Context, interaction *discordgo.InteractionCreate, summary string, action Action,
+) (*discord.Response, error) {
+	if c == nil {
+		msg, err := action(ctx)
+		if err != nil {
+			return discord.Text(msg), err
+		}
+		return discord.Success(msg), nil
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, fmt.Errorf("create confirmation token: %w", err)
+	}
+	c.save(token, pendingAction{action: action, userID: interaction.Member.User.ID})
+
+	return &discord.Response{
+		Title:       "Are you sure?",
+		Description: summary,
+		Colour:      discord.ColourWarning,
+		Footer:      "The buttons expire in " + strconv.Itoa(int(ConfirmTTL.Minutes())) + " minutes",
+		Ephemeral:   true,
+		Components: []discordgo.MessageComponent{discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Confirm",
+					Style:    discordgo.DangerButton,
+					CustomID: discord.CustomID(ConfirmComponent, confirmAnswer, token),
+				},
+				discordgo.Button{
+					Label:    "Cancel",
+					Style:    discordgo.SecondaryButton,
+					CustomID: discord.CustomID(ConfirmComponent, cancelAnswer, token),
+				},
+			},
+		}},
+	}, nil
+}
+
+// newToken returns a random token, so buttons of a previous run of the bot never match a new command.
+func newToken() (string, error) {
+	token := make([]byte, 8)
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// save keeps a command waiting for its confirmation and forgets expired ones.
+func (c *Confirmations) save(token string, pending pendingAction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for kept, waiting := range c.pending {
+		if now.After(waiting.expires) {
+			delete(c.pending, kept)
+		}
+	}
+	pending.expires = now.Add(ConfirmTTL)
+	c.pending[token] = pending
+}
+
+// take returns the command waiting with this token, if it didn't expire, and forgets it.
+// The command is kept when userID is not the member who ran it.
+func (c *Confirmations) take(token, userID string) (pendingAction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pending, ok := c.pending[token]
+	if !ok || time.Now().After(pending.expires) {
+		delete(c.pending, token)
+		return pendingAction{}, fmt.Errorf("this confirmation expired, use the command again")
+	}
+	if pending.userID != userID {
+		return pendingAction{}, fmt.Errorf("only the member who used the command can answer it")
+	}
+	delete(c.pending, token)
+	return pending, nil
+}
+
+// ConfirmButtonHandler runs or cancels the destructive command of the confirmation buttons.
+// The message of the buttons is replaced by the outcome, without buttons.
+func (d Discord) ConfirmButtonHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Confirm/ConfirmButtonHandler")
+	defer span.End()
+
+	customID := interaction.MessageComponentData().CustomID
+	args := discord.ComponentArgs(customID)
+	if len(args) != 2 || (args[0] != confirmAnswer && args[0] != cancelAnswer) || d.Confirmations == nil {
+		msg := "Error while confirming: unknown button"
+		return discord.Text(msg), fmt.Errorf("parse custom ID %s", customID)
+	}
+	span.SetAttributes(
+		attribute.String("answer", args[0]),
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	pending, err := d.Confirmations.take(args[1], interaction.Member.User.ID)
+	if err != nil {
+		msg := "Error while confirming: " + err.Error()
+		return discord.Text(msg), fmt.Errorf("take confirmation %s: %w", args[1], err)
+	}
+
+	response := &discord.Response{Ephemeral: true, Components: []discordgo.MessageComponent{}}
+	if args[0] == cancelAnswer {
+		response.Description = "Cancelled, nothing was changed"
+		return response, nil
+	}
+	msg, err := pending.action(ctx)
+	response.Description = msg
+	if err != nil {
+		response.Colour = discord.ColourError
+		return response, fmt.Errorf("run confirmed command: %w", err)
+	}
+	response.Colour = discord.ColourSuccess
+	return response, nil
+}
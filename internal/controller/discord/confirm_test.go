@@ -0,0 +1,153 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+// askConfirmation asks confirmations to confirm action for the member of buttonInteraction
+// and returns the custom IDs of its Confirm and Cancel buttons.
+func askConfirmation(
+	t *testing.T, confirmations *discordHandler.Confirmations, action discordHandler.Action,
+) (string, string) {
+	t.Helper()
+	response, err := confirmations.Ask(context.Background(), buttonInteraction(""), "Delete?", action)
+	assert.NoError(t, err)
+	assert.True(t, response.Ephemeral)
+	assert.Equal(t, "Delete?", response.Description)
+
+	buttons := response.Components[0].(discordgo.ActionsRow).Components
+	return buttons[0].(discordgo.Button).CustomID, buttons[1].(discordgo.Button).CustomID
+}
+
+func TestConfirmations_Ask(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Without confirmations", func(t *testing.T) {
+		t.Parallel()
+		var confirmations *discordHandler.Confirmations
+
+		response, err := confirmations.Ask(context.Background(), buttonInteraction(""), "Delete?",
+			func(ctx context.Context) (string, error) {
+				return "deleted", nil
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, "deleted", response.Description)
+		assert.Empty(t, response.Components)
+	})
+
+	t.Run("Buttons", func(t *testing.T) {
+		t.Parallel()
+		confirmID, cancelID := askConfirmation(t, discordHandler.NewConfirmations(),
+			func(ctx context.Context) (string, error) {
+				t.Error("action ran before its confirmation")
+				return "", nil
+			})
+		assert.Equal(t, []string{"confirm"}, discord.ComponentArgs(confirmID)[:1])
+		assert.Equal(t, []string{"cancel"}, discord.ComponentArgs(cancelID)[:1])
+		assert.NotEqual(t, discord.ComponentArgs(confirmID)[1], "")
+	})
+}
+
+func TestDiscord_ConfirmButtonHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Confirm", func(t *testing.T) {
+		t.Parallel()
+		discord := discordHandler.Discord{Confirmations: discordHandler.NewConfirmations()}
+		ran := 0
+		confirmID, _ := askConfirmation(t, discord.Confirmations, func(ctx context.Context) (string, error) {
+			ran++
+			return "Player arthas deleted successfully", nil
+		})
+
+		response, err := discord.ConfirmButtonHandler(context.Background(), buttonInteraction(confirmID))
+		assert.NoError(t, err)
+		assert.Equal(t, "Player arthas deleted successfully", response.Description)
+		assert.NotNil(t, response.Components)
+		assert.Empty(t, response.Components)
+		assert.Equal(t, 1, ran)
+
+		// the buttons only work once
+		_, err = discord.ConfirmButtonHandler(context.Background(), buttonInteraction(confirmID))
+		assert.Error(t, err)
+		assert.Equal(t, 1, ran)
+	})
+
+	t.Run("Action fails", func(t *testing.T) {
+		t.Parallel()
+		discord := discordHandler.Discord{Confirmations: discordHandler.NewConfirmations()}
+		confirmID, _ := askConfirmation(t, discord.Confirmations, func(ctx context.Context) (string, error) {
+			return "Error while deleting player: player not found", errors.New("player not found")
+		})
+
+		response, err := discord.ConfirmButtonHandler(context.Background(), buttonInteraction(confirmID))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while deleting player: player not found", response.Description)
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		t.Parallel()
+		discord := discordHandler.Discord{Confirmations: discordHandler.NewConfirmations()}
+		confirmID, cancelID := askConfirmation(t, discord.Confirmations, func(ctx context.Context) (string, error) {
+			t.Error("cancelled action ran")
+			return "", nil
+		})
+
+		response, err := discord.ConfirmButtonHandler(context.Background(), buttonInteraction(cancelID))
+		assert.NoError(t, err)
+		assert.Equal(t, "Cancelled, nothing was changed", response.Description)
+
+		_, err = discord.ConfirmButtonHandler(context.Background(), buttonInteraction(confirmID))
+		assert.Error(t, err)
+	})
+
+	t.Run("Other member", func(t *testing.T) {
+		t.Parallel()
+		discord := discordHandler.Discord{Confirmations: discordHandler.NewConfirmations()}
+		ran := false
+		confirmID, _ := askConfirmation(t, discord.Confirmations, func(ctx context.Context) (string, error) {
+			ran = true
+			return "deleted", nil
+		})
+
+		interaction := buttonInteraction(confirmID)
+		interaction.Member.User.ID = "100000000000000002"
+		response, err := discord.ConfirmButtonHandler(context.Background(), interaction)
+		assert.Error(t, err)
+		assert.Equal(t, "Error while confirming: only the member who used the command can answer it",
+			response.Description)
+		assert.False(t, ran)
+
+		// the member who used the command can still confirm it
+		_, err = discord.ConfirmButtonHandler(context.Background(), buttonInteraction(confirmID))
+		assert.NoError(t, err)
+		assert.True(t, ran)
+	})
+
+	t.Run("Unknown token", func(t *testing.T) {
+		t.Parallel()
+		discord := discordHandler.Discord{Confirmations: discordHandler.NewConfirmations()}
+
+		response, err := discord.ConfirmButtonHandler(context.Background(),
+			buttonInteraction("guildops-confirm:confirm:0123456789abcdef"))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while confirming: this confirmation expired, use the command again", response.Description)
+	})
+
+	t.Run("Unknown button", func(t *testing.T) {
+		t.Parallel()
+		discord := discordHandler.Discord{Confirmations: discordHandler.NewConfirmations()}
+
+		response, err := discord.ConfirmButtonHandler(context.Background(), buttonInteraction("guildops-confirm:maybe"))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while confirming: unknown button", response.Description)
+	})
+}
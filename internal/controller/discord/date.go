@@ -0,0 +1,182 @@
+package discordhandler
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// DateFormats lists the dates commands accept, it is shown when a date can't be read.
+const DateFormats = "dd/mm/yy, dd/mm, yyyy-mm-dd, today, tomorrow, yesterday " +
+	"or a weekday like wednesday, next wednesday or last wednesday"
+
+// dateLayouts are the layouts of explicit dates, days and months can be written with one digit.
+var dateLayouts = []string{"2/1/06", "2/1/2006", "2006-1-2"}
+
+// yearlessLayout is the layout of dates in the current year.
+const yearlessLayout = "2/1"
+
+// rangeSeparator splits a range like "11/05 to 15/05" or "11/05 - 15/05".
+var rangeSeparator = regexp.MustCompile(`\s+(?:to|-)\s+`)
+
+// weekdays by their full and short english names.
+var weekdays = func() map[string]time.Weekday {
+	names := make(map[string]time.Weekday, 14)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		names[name] = day
+		names[name[:3]] = day
+	}
+	return names
+}()
+
+// day is a date read in a command. Weekdays repeat every week and dates without a year every year,
+// the end of a range is moved by this period until it is not before its start.
+type day struct {
+	date  time.Time
+	years int
+	days  int
+}
+
+// ParseDate returns the days from fromDate to toDate, at midnight UTC.
+// If toDate is empty, it will be set to fromDate, which can also be a range like "11/05 to 15/05".
+// Relative dates like tomorrow or wednesday are resolved from now, in its time zone:
+// wednesday is today on wednesdays, next wednesday is the one after today and last wednesday the one before.
+func ParseDate(fromDate, toDate string, now time.Time) ([]time.Time, error) {
+	fromDate, toDate = strings.TrimSpace(fromDate), strings.TrimSpace(toDate)
+	if bounds := rangeSeparator.Split(fromDate, -1); len(bounds) > 1 {
+		if len(bounds) > 2 {
+			return nil, errors.Errorf("parse date: %q must be a date or a range like 11/05 to 15/05", fromDate)
+		}
+		if toDate != "" {
+			return nil, errors.Errorf("parse date: %q is already a range, leave the end date empty", fromDate)
+		}
+		fromDate, toDate = bounds[0], bounds[1]
+	}
+
+	today := entity.Day(now)
+	start, err := parseDay(fromDate, today)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse date")
+	}
+
+	end := start
+	if toDate != "" {
+		end, err = parseDay(toDate, today)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse date")
+		}
+		for (end.years != 0 || end.days != 0) && end.date.Before(start.date) {
+			end.date = end.date.AddDate(end.years, 0, end.days)
+		}
+	}
+
+	if end.date.Before(start.date) {
+		return nil, errors.Errorf("parse date: end date %s is before start date %s",
+			end.date.Format("02/01/06"), start.date.Format("02/01/06"))
+	}
+
+	var dates []time.Time
+	for currentDate := start.date; !currentDate.After(end.date); currentDate = currentDate.AddDate(0, 0, 1) {
+		dates = append(dates, currentDate)
+	}
+	return dates, nil
+}
+
+// ParseDay returns the day a single date stands for, at midnight UTC.
+// Relative dates are resolved from now, in its time zone.
+func ParseDay(value string, now time.Time) (time.Time, error) {
+	if rangeSeparator.MatchString(strings.TrimSpace(value)) {
+		return time.Time{}, errors.Errorf("parse date: %q is a range, use a single date", strings.TrimSpace(value))
+	}
+	date, err := parseDay(value, entity.Day(now))
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "parse date")
+	}
+	return date.date, nil
+}
+
+// parseDay returns the day a date of a command stands for, relative dates are resolved from today.
+func parseDay(value string, today time.Time) (day, error) {
+	value = strings.ToLower(strings.Join(strings.Fields(value), " "))
+	if value == "" {
+		return day{}, errors.New("date is empty, use " + DateFormats)
+	}
+
+	switch value {
+	case "today", "tonight":
+		return day{date: today}, nil
+	case "tomorrow":
+		return day{date: today.AddDate(0, 0, 1)}, nil
+	case "yesterday":
+		return day{date: today.AddDate(0, 0, -1)}, nil
+	}
+
+	if date, ok, err := parseWeekday(value, today); ok || err != nil {
+		return date, err
+	}
+
+	for _, layout := range dateLayouts {
+		date, err := time.Parse(layout, value)
+		if err == nil {
+			return day{date: date}, nil
+		}
+		if outOfRange(err) {
+			return day{}, errors.New(value + " is not a valid date")
+		}
+	}
+
+	date, err := time.Parse(yearlessLayout, value)
+	if err == nil {
+		inYear := time.Date(today.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		if inYear.Day() != date.Day() {
+			return day{}, errors.New(value + " is not a valid date in " + strconv.Itoa(today.Year()))
+		}
+		return day{date: inYear, years: 1}, nil
+	}
+	if outOfRange(err) {
+		return day{}, errors.New(value + " is not a valid date")
+	}
+
+	return day{}, errors.Errorf("unknown date %q, use %s", value, DateFormats)
+}
+
+// parseWeekday reads weekdays like wednesday, next wednesday or last wednesday.
+// It tells if value is a weekday, and returns an error if it looks like one but isn't.
+func parseWeekday(value string, today time.Time) (day, bool, error) {
+	words := strings.Fields(value)
+	name := words[len(words)-1]
+	weekday, known := weekdays[name]
+	if len(words) > 2 || (len(words) == 2 && words[0] != "next" && words[0] != "last") {
+		if known {
+			return day{}, false, errors.Errorf("unknown date %q, use %s", value, DateFormats)
+		}
+		return day{}, false, nil
+	}
+	if !known {
+		if len(words) == 2 {
+			return day{}, false, errors.Errorf("unknown weekday %q, use a weekday like wednesday", name)
+		}
+		return day{}, false, nil
+	}
+
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	switch {
+	case len(words) == 2 && words[0] == "next" && days == 0:
+		days = 7
+	case len(words) == 2 && words[0] == "last":
+		days -= 7
+	}
+	return day{date: today.AddDate(0, 0, days), days: 7}, true, nil
+}
+
+// outOfRange tells if a date was written in a known layout but doesn't exist, like 31/02/23.
+func outOfRange(err error) bool {
+	var parseErr *time.ParseError
+	return errors.As(err, &parseErr) && strings.Contains(parseErr.Message, "out of range")
+}
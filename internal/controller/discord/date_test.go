@@ -0,0 +1,202 @@
+package discordhandler_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+)
+
+func TestGenerateDateList(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.October, 4, 20, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		startDate := "01/01/21"
+		endDate := "03/01/21"
+
+		expectedDates := []time.Time{
+			time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC),
+		}
+
+		dateList, err := discordHandler.ParseDate(startDate, endDate, now)
+		if err != nil {
+			t.Errorf("Error: %v", err)
+		}
+
+		if !reflect.DeepEqual(dateList, expectedDates) {
+			t.Errorf("Expected %v, but got %v", expectedDates, dateList)
+		}
+	})
+
+	t.Run("Success with no endDate", func(t *testing.T) {
+		t.Parallel()
+
+		startDate := "01/01/22"
+		endDate := ""
+
+		expectedDates := []time.Time{
+			time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		dateList, err := discordHandler.ParseDate(startDate, endDate, now)
+		if err != nil {
+			t.Errorf("Error: %v", err)
+		}
+
+		if !reflect.DeepEqual(dateList, expectedDates) {
+			t.Errorf("Expected %v, but got %v", expectedDates, dateList)
+		}
+	})
+
+	t.Run("Error with format", func(t *testing.T) {
+		t.Parallel()
+
+		startDate := "01-01/22"
+		endDate := "01-03/21"
+
+		_, err := discordHandler.ParseDate(startDate, endDate, now)
+		if err == nil {
+			t.Errorf("Expected error, but got nil")
+		}
+	})
+
+	t.Run("Error with format", func(t *testing.T) {
+		t.Parallel()
+
+		startDate := "01/01/22"
+		endDate := "01-03/22"
+
+		_, err := discordHandler.ParseDate(startDate, endDate, now)
+		if err == nil {
+			t.Errorf("Expected error, but got nil")
+		}
+	})
+
+	t.Run("Error with date order", func(t *testing.T) {
+		t.Parallel()
+		startDate := "01/03/23"
+		endDate := "01/01/23"
+
+		_, err := discordHandler.ParseDate(startDate, endDate, now)
+		if err == nil {
+			t.Errorf("Expected error, but got nil")
+		}
+	})
+}
+
+func TestParseDate(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday
+	now := time.Date(2023, time.October, 4, 20, 0, 0, 0, time.UTC)
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2023, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		first    time.Time
+		last     time.Time
+	}{
+		{"ISO date", "2023-10-11", "", day(time.October, 11), day(time.October, 11)},
+		{"Four digits year", "11/10/2023", "", day(time.October, 11), day(time.October, 11)},
+		{"One digit day and month", "1/9/23", "", day(time.September, 1), day(time.September, 1)},
+		{"Without year", "11/05", "", day(time.May, 11), day(time.May, 11)},
+		{"Today", "Today", "", day(time.October, 4), day(time.October, 4)},
+		{"Tomorrow", " tomorrow ", "", day(time.October, 5), day(time.October, 5)},
+		{"Yesterday", "yesterday", "", day(time.October, 3), day(time.October, 3)},
+		{"Weekday is today", "wednesday", "", day(time.October, 4), day(time.October, 4)},
+		{"Short weekday", "fri", "", day(time.October, 6), day(time.October, 6)},
+		{"Next weekday", "next wednesday", "", day(time.October, 11), day(time.October, 11)},
+		{"Last weekday", "last monday", "", day(time.October, 2), day(time.October, 2)},
+		{"Range", "11/05 to 15/05", "", day(time.May, 11), day(time.May, 15)},
+		{"Range with a dash", "2023-10-11 - 2023-10-12", "", day(time.October, 11), day(time.October, 12)},
+		{"Range over new year", "30/12 to 02/01", "", day(time.December, 30), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"Weekdays range", "monday to friday", "", day(time.October, 9), day(time.October, 13)},
+		{"Keywords", "today", "tomorrow", day(time.October, 4), day(time.October, 5)},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dates, err := discordHandler.ParseDate(tt.from, tt.to, now)
+
+			assert.NoError(t, err)
+			if assert.NotEmpty(t, dates) {
+				assert.Equal(t, tt.first, dates[0])
+				assert.Equal(t, tt.last, dates[len(dates)-1])
+			}
+		})
+	}
+
+	t.Run("Guild time zone", func(t *testing.T) {
+		t.Parallel()
+
+		// It is already Thursday in Tokyo
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		assert.NoError(t, err)
+
+		dates, err := discordHandler.ParseDate("today", "", now.In(tokyo))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Time{day(time.October, 5)}, dates)
+	})
+
+	errorTests := []struct {
+		name     string
+		from, to string
+		err      string
+	}{
+		{"Extra text", "08/10/23A", "", `parse date: unknown date "08/10/23a", use dd/mm/yy`},
+		{"Invalid day", "31/02/23", "", "parse date: 31/02/23 is not a valid date"},
+		{"Invalid day without year", "29/02", "", "parse date: 29/02 is not a valid date in 2023"},
+		{"Unknown weekday", "next wensday", "", `parse date: unknown weekday "wensday"`},
+		{"Range and end date", "11/05 to 15/05", "20/05", `parse date: "11/05 to 15/05" is already a range`},
+		{"End before start", "15/05/23", "11/05/23", "parse date: end date 11/05/23 is before start date 15/05/23"},
+		{"Empty", " ", "", "parse date: date is empty"},
+	}
+	for _, tt := range errorTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := discordHandler.ParseDate(tt.from, tt.to, now)
+
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseDay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.October, 4, 20, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		date, err := discordHandler.ParseDay("next monday", now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, time.October, 9, 0, 0, 0, 0, time.UTC), date)
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := discordHandler.ParseDay("11/05 to 15/05", now)
+
+		assert.ErrorContains(t, err, `parse date: "11/05 to 15/05" is a range, use a single date`)
+	})
+}
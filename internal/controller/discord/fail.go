@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -34,7 +35,7 @@ var FailDescriptors = []discordgo.ApplicationCommand{
 			{
 				Type:        discordgo.ApplicationCommandOptionString,
 				Name:        "date",
-				Description: "ex: 03/05/2023",
+				Description: "ex: 03/05/2023, tomorrow, next wednesday",
 				Required:    true,
 			},
 		},
@@ -49,6 +50,12 @@ var FailDescriptors = []discordgo.ApplicationCommand{
 				Description: "ex: Milowenn",
 				Required:    true,
 			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "season",
+				Description: "ex: DF/S2",
+				Required:    false,
+			},
 		},
 	},
 	{
@@ -58,7 +65,7 @@ var FailDescriptors = []discordgo.ApplicationCommand{
 			{
 				Type:        discordgo.ApplicationCommandOptionString,
 				Name:        "date",
-				Description: "ex: 03/05/2021",
+				Description: "ex: 03/05/2021, tomorrow, next wednesday",
 				Required:    true,
 			},
 		},
@@ -77,9 +84,8 @@ var FailDescriptors = []discordgo.ApplicationCommand{
 	},
 }
 
-func (d Discord) InitFail() map[string]func(
-	ctx context.Context, interaction *discordgo.InteractionCreate) (string, error) {
-	return map[string]func(ctx context.Context, interaction *discordgo.InteractionCreate) (string, error){
+func (d Discord) InitFail() map[string]discord.Handler {
+	return map[string]discord.Handler{
 		"guildops-fail-create":      d.CreateFailHandler,
 		"guildops-fail-delete":      d.DeleteFailHandler,
 		"guildops-fail-list-player": d.ListFailsOnPlayerHandler,
@@ -89,7 +95,7 @@ func (d Discord) InitFail() map[string]func(
 
 func (d Discord) CreateFailHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -114,23 +120,23 @@ func (d Discord) CreateFailHandler(
 		attribute.String("date", optionMap["date"].StringValue()),
 	)
 
-	raidDate, err := ParseDate(optionMap["date"].StringValue(), "")
+	raidDate, err := ParseDay(optionMap["date"].StringValue(), d.now())
 	if err != nil {
 		msg := "Error while creating fail: " + HumanReadableError(err)
-		return msg, fmt.Errorf("create fail parse date: %w", err)
+		return discord.Text(msg), fmt.Errorf("create fail parse date: %w", err)
 	}
 
-	err = d.CreateFail(ctx, reason, raidDate[0], name)
+	err = d.CreateFail(ctx, reason, raidDate, name)
 	if err != nil {
 		msg := "Error while creating fail: " + HumanReadableError(err)
-		return msg, fmt.Errorf("create fail usecase: %w", err)
+		return discord.Text(msg), fmt.Errorf("create fail usecase: %w", err)
 	}
-	return "Fail created successfully", nil
+	return discord.Success("Fail created successfully"), nil
 }
 
 func (d Discord) ListFailsOnPlayerHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -148,30 +154,40 @@ func (d Discord) ListFailsOnPlayerHandler(
 	}
 
 	playerName := optionMap["name"].StringValue()
+	season := ""
+	if opt, ok := optionMap["season"]; ok {
+		season = opt.StringValue()
+	}
 	span.SetAttributes(
 		attribute.String("player_name", playerName),
+		attribute.String("season", season),
 	)
 
-	fails, err := d.ListFailOnPLayer(ctx, playerName)
+	response, err := d.Pages.Show(ctx, List{
+		Title: "Fails of " + playerName,
+		Empty: "No fails found for " + playerName,
+		Fetch: func(ctx context.Context, limit, offset int) ([]string, error) {
+			fails, err := d.ListFailOnPLayer(ctx, playerName, season, limit, offset)
+			if err != nil {
+				return nil, err
+			}
+			lines := make([]string, 0, len(fails))
+			for _, fail := range fails {
+				lines = append(lines,
+					"* "+fail.Raid.Date.Format("02/01/06")+" - "+fail.Reason+" - "+strconv.Itoa(fail.ID))
+			}
+			return lines, nil
+		},
+	})
 	if err != nil {
-		return "Fail to list fails on player", fmt.Errorf("error while listing fails on player: %w", err)
-	}
-
-	if len(fails) == 0 {
-		return "No fails found for " + playerName, nil
-	}
-
-	msg := "Fails of " + playerName + " (" + strconv.Itoa(len(fails)) + ") :\n"
-	for _, fail := range fails {
-		msg += "* " + fail.Raid.Date.Format("02/01/06") + " - " + fail.Reason + " - " + strconv.Itoa(fail.ID) + "\n"
+		return discord.Text("Fail to list fails on player"), fmt.Errorf("error while listing fails on player: %w", err)
 	}
-
-	return msg, nil
+	return response, nil
 }
 
 func (d Discord) ListFailsOnRaidHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -191,23 +207,23 @@ func (d Discord) ListFailsOnRaidHandler(
 	span.SetAttributes(
 		attribute.String("date", optionMap["date"].StringValue()),
 	)
-	raidDate, err := ParseDate(optionMap["date"].StringValue(), "")
+	raidDate, err := ParseDay(optionMap["date"].StringValue(), d.now())
 	if err != nil {
 		msg := "Error while getting fails: " + HumanReadableError(err)
-		return msg, fmt.Errorf("list fail parse date: %w", err)
+		return discord.Text(msg), fmt.Errorf("list fail parse date: %w", err)
 	}
 
-	fails, err := d.ListFailOnRaid(ctx, raidDate[0])
+	fails, err := d.ListFailOnRaid(ctx, raidDate)
 	if err != nil {
 		msg := "Error while getting fails: " + HumanReadableError(err)
-		return msg, fmt.Errorf("list fail call usecase: %w", err)
+		return discord.Text(msg), fmt.Errorf("list fail call usecase: %w", err)
 	}
 
 	if len(fails) == 0 {
-		return "No fails found for " + raidDate[0].Format("02/01/06"), nil
+		return discord.Text("No fails found for " + raidDate.Format("02/01/06")), nil
 	}
 
-	msg := "Fails for " + raidDate[0].Format("02/01/06") + " (" + strconv.Itoa(len(fails)) + ") :\n"
+	lines := make([]string, 0, len(fails))
 	var players []entity.Player
 	for _, fail := range fails {
 		players = append(players, *fail.Player)
@@ -215,15 +231,18 @@ func (d Discord) ListFailsOnRaidHandler(
 	}
 	for _, player := range players {
 		for _, fail := range player.Fails {
-			msg += "* " + player.Name + " - " + fail.Reason + "\n"
+			lines = append(lines, "* "+player.Name+" - "+fail.Reason)
 		}
 	}
-	return msg, nil
+	return &discord.Response{
+		Title:  "Fails of " + raidDate.Format("Mon 02/01/06"),
+		Fields: appendListField(nil, "Fails", lines),
+	}, nil
 }
 
 func (d Discord) DeleteFailHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -247,14 +266,26 @@ func (d Discord) DeleteFailHandler(
 	failID, err := strconv.ParseInt(idString, 10, 64)
 	if err != nil {
 		msg := "Error while deleting fail: " + HumanReadableError(err)
-		return msg, fmt.Errorf("delete fail parse id: %w", err)
+		return discord.Text(msg), fmt.Errorf("delete fail parse id: %w", err)
 	}
 
-	err = d.DeleteFail(ctx, int(failID))
+	fail, err := d.ReadFail(ctx, int(failID))
 	if err != nil {
 		msg := "Error while deleting fail: " + HumanReadableError(err)
-		return msg, fmt.Errorf("delete fail usecase: %w", err)
+		return discord.Text(msg), fmt.Errorf("read fail usecase: %w", err)
+	}
+	if fail.ID == 0 {
+		return discord.Text("Error while deleting fail: fail not found"), fmt.Errorf("fail %d not found", failID)
 	}
 
-	return "Fail successfully deleted", nil
+	summary := fmt.Sprintf("Delete fail #%d of %s on %s for %s?",
+		fail.ID, fail.Player.Name, fail.Raid.Date.Format("02/01/06"), fail.Reason)
+	return d.Confirmations.Ask(ctx, interaction, summary, func(ctx context.Context) (string, error) {
+		err := d.DeleteFail(ctx, fail.ID)
+		if err != nil {
+			msg := "Error while deleting fail: " + HumanReadableError(err)
+			return msg, fmt.Errorf("delete fail usecase: %w", err)
+		}
+		return "Fail successfully deleted", nil
+	})
 }
@@ -64,9 +64,9 @@ func TestDiscord_CreateFailHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.CreateFailHandler(context.Background(), interaction)
+		response, err := discord.CreateFailHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Fail created successfully")
+		assert.Equal(t, response.Description, "Fail created successfully")
 		mockFailUseCase.AssertExpectations(t)
 	})
 
@@ -112,9 +112,9 @@ func TestDiscord_CreateFailHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.CreateFailHandler(context.Background(), interaction)
+		response, err := discord.CreateFailHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Regexp(t, regexp.MustCompile("Error while creating fail: .*"), msg)
+		assert.Regexp(t, regexp.MustCompile("Error while creating fail: .*"), response.Description)
 		mockFailUseCase.AssertExpectations(t)
 	})
 
@@ -163,9 +163,9 @@ func TestDiscord_CreateFailHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.CreateFailHandler(context.Background(), interaction)
+		response, err := discord.CreateFailHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Regexp(t, regexp.MustCompile("Error while creating fail: .*"), msg)
+		assert.Regexp(t, regexp.MustCompile("Error while creating fail: .*"), response.Description)
 		mockFailUseCase.AssertExpectations(t)
 	})
 }
@@ -181,7 +181,7 @@ func TestDiscord_ListFailsOnPlayerHandler(t *testing.T) {
 			FailUseCase: mockFailUseCase,
 		}
 
-		mockFailUseCase.On("ListFailOnPLayer", mock.Anything, mock.Anything).
+		mockFailUseCase.On("ListFailOnPLayer", mock.Anything, "Milowenn", "", 0, 0).
 			Return([]entity.Fail{
 				{
 					ID: 1,
@@ -223,12 +223,13 @@ func TestDiscord_ListFailsOnPlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.ListFailsOnPlayerHandler(context.Background(), interaction)
+		response, err := discord.ListFailsOnPlayerHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, "Fails of Milowenn (2) :\n* "+
+		assert.Equal(t, "Fails of Milowenn", response.Title)
+		assert.Equal(t, "* "+
 			time.Now().Format("02/01/06")+" - why not - 1\n* "+
-			time.Now().Format("02/01/06")+" - why not 2 - 1\n",
-			msg)
+			time.Now().Format("02/01/06")+" - why not 2 - 1",
+			response.Description)
 		mockFailUseCase.AssertExpectations(t)
 	})
 
@@ -240,7 +241,7 @@ func TestDiscord_ListFailsOnPlayerHandler(t *testing.T) {
 			FailUseCase: mockFailUseCase,
 		}
 
-		mockFailUseCase.On("ListFailOnPLayer", mock.Anything, mock.Anything).
+		mockFailUseCase.On("ListFailOnPLayer", mock.Anything, "Milowenn", "", 0, 0).
 			Return([]entity.Fail{}, nil)
 
 		interaction := &discordgo.InteractionCreate{
@@ -267,9 +268,9 @@ func TestDiscord_ListFailsOnPlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.ListFailsOnPlayerHandler(context.Background(), interaction)
+		response, err := discord.ListFailsOnPlayerHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, "No fails found for Milowenn", msg)
+		assert.Equal(t, "No fails found for Milowenn", response.Description)
 		mockFailUseCase.AssertExpectations(t)
 	})
 }
@@ -333,9 +334,10 @@ func TestDiscord_ListFailsOnRaidHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.ListFailsOnRaidHandler(context.Background(), interaction)
+		response, err := discord.ListFailsOnRaidHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Fails for 29/09/23 (2) :\n* Paragon - why not\n* Milowenn - why not 2\n")
+		assert.Equal(t, "Fails of Fri 29/09/23\n\n"+
+			"Fails (2)\n* Paragon - why not\n* Milowenn - why not 2\n", response.String())
 		mockFailUseCase.AssertExpectations(t)
 	})
 
@@ -374,9 +376,9 @@ func TestDiscord_ListFailsOnRaidHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.ListFailsOnRaidHandler(context.Background(), interaction)
+		response, err := discord.ListFailsOnRaidHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "No fails found for 29/09/23")
+		assert.Equal(t, response.Description, "No fails found for 29/09/23")
 		mockFailUseCase.AssertExpectations(t)
 	})
 }
@@ -393,6 +395,8 @@ func TestDiscord_DeleteFailOnPlayerHandler(t *testing.T) {
 			FailUseCase: mockFailUseCase,
 		}
 
+		mockFailUseCase.On("ReadFail", mock.Anything, 1).
+			Return(entity.Fail{ID: 1, Reason: "wipe", Player: &entity.Player{Name: "arthas"}, Raid: &entity.Raid{}}, nil)
 		mockFailUseCase.On("DeleteFail", mock.Anything, mock.Anything).
 			Return(nil)
 
@@ -420,9 +424,9 @@ func TestDiscord_DeleteFailOnPlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.DeleteFailHandler(context.Background(), interaction)
+		response, err := discord.DeleteFailHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Fail successfully deleted")
+		assert.Equal(t, response.Description, "Fail successfully deleted")
 		mockFailUseCase.AssertExpectations(t)
 	})
 
@@ -458,9 +462,9 @@ func TestDiscord_DeleteFailOnPlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.DeleteFailHandler(context.Background(), interaction)
+		response, err := discord.DeleteFailHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Regexp(t, regexp.MustCompile("Error while deleting fail: .*"), msg)
+		assert.Regexp(t, regexp.MustCompile("Error while deleting fail: .*"), response.Description)
 		mockFailUseCase.AssertExpectations(t)
 	})
 
@@ -472,6 +476,8 @@ func TestDiscord_DeleteFailOnPlayerHandler(t *testing.T) {
 			FailUseCase: mockFailUseCase,
 		}
 
+		mockFailUseCase.On("ReadFail", mock.Anything, 1).
+			Return(entity.Fail{ID: 1, Reason: "wipe", Player: &entity.Player{Name: "arthas"}, Raid: &entity.Raid{}}, nil)
 		mockFailUseCase.On("DeleteFail", mock.Anything, mock.Anything).
 			Return(errors.New("Backend Error"))
 
@@ -499,9 +505,48 @@ func TestDiscord_DeleteFailOnPlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.DeleteFailHandler(context.Background(), interaction)
+		response, err := discord.DeleteFailHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Regexp(t, regexp.MustCompile("Error while deleting fail: .*"), msg)
+		assert.Regexp(t, regexp.MustCompile("Error while deleting fail: .*"), response.Description)
 		mockFailUseCase.AssertExpectations(t)
 	})
+
+	t.Run("Asks for confirmation", func(t *testing.T) {
+		t.Parallel()
+		mockFailUseCase := mocks.NewFailUseCase(t)
+
+		discord := discordHandler.Discord{
+			FailUseCase:   mockFailUseCase,
+			Confirmations: discordHandler.NewConfirmations(),
+		}
+
+		mockFailUseCase.On("ReadFail", mock.Anything, 3).Return(entity.Fail{
+			ID: 3, Reason: "stood in fire", Player: &entity.Player{Name: "arthas"},
+			Raid: &entity.Raid{Date: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)},
+		}, nil)
+
+		response, err := discord.DeleteFailHandler(context.Background(),
+			commandInteraction("guildops-fail-delete", stringOption("id", "3")))
+		assert.NoError(t, err)
+		assert.True(t, response.Ephemeral)
+		assert.Equal(t, "Delete fail #3 of arthas on 02/10/23 for stood in fire?", response.Description)
+		mockFailUseCase.AssertNotCalled(t, "DeleteFail", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Fail not found", func(t *testing.T) {
+		t.Parallel()
+		mockFailUseCase := mocks.NewFailUseCase(t)
+
+		discord := discordHandler.Discord{
+			FailUseCase:   mockFailUseCase,
+			Confirmations: discordHandler.NewConfirmations(),
+		}
+
+		mockFailUseCase.On("ReadFail", mock.Anything, 3).Return(entity.Fail{}, nil)
+
+		response, err := discord.DeleteFailHandler(context.Background(),
+			commandInteraction("guildops-fail-delete", stringOption("id", "3")))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while deleting fail: fail not found", response.Description)
+	})
 }
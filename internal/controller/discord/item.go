@@ -0,0 +1,79 @@
+package discordhandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+var ItemDescriptors = []discordgo.ApplicationCommand{
+	{
+		Name:        "guildops-item-search",
+		Description: "Search items of the catalogue",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "query",
+				Description: "item ID or part of its name, ex: 19019 or frostm",
+				Required:    true,
+			},
+		},
+	},
+}
+
+func (d Discord) InitItem() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		"guildops-item-search": d.SearchItemsHandler,
+	}
+}
+
+// SearchItemsHandler call an usecase to search items of the catalogue
+// and return them to the user.
+// It requires a query field to be passed in the interaction.
+func (d Discord) SearchItemsHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Item/SearchItemsHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	query := optionMap["query"].StringValue()
+	span.SetAttributes(
+		attribute.String("query", query),
+	)
+
+	items, err := d.SearchItems(ctx, query)
+	if err != nil {
+		msg := "Error while searching items: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call search items usecase: %w", err)
+	}
+	if len(items) == 0 {
+		return discord.Text("no item found"), nil
+	}
+
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, "* "+item.String())
+	}
+	return &discord.Response{
+		Title:  "Items matching " + query,
+		Fields: appendListField(nil, "Items", lines),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/internal/controller/discord/mocks"
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestDiscord_SearchItemsHandler(t *testing.T) {
+	t.Parallel()
+
+	interaction := commandInteraction("guildops-item-search",
+		stringOption("query", "frost"))
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockItemUseCase := mocks.NewItemUseCase(t)
+
+		discord := discordHandler.Discord{
+			ItemUseCase: mockItemUseCase,
+		}
+
+		mockItemUseCase.On("SearchItems", mock.Anything, "frost").Return([]entity.Item{
+			{ID: 19019, Name: "frostmourne", Slot: "two-hand", ItemLevel: 284},
+			{ID: 19020, Name: "frostguard", Slot: "chest", ItemLevel: 270, ArmorType: "plate"},
+		}, nil)
+
+		response, err := discord.SearchItemsHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "Items matching frost\n\nItems (2)\n* frostmourne (#19019, two-hand, ilvl 284)\n"+
+			"* frostguard (#19020, chest, plate, ilvl 270)\n",
+			response.String())
+	})
+
+	t.Run("No item", func(t *testing.T) {
+		t.Parallel()
+		mockItemUseCase := mocks.NewItemUseCase(t)
+
+		discord := discordHandler.Discord{
+			ItemUseCase: mockItemUseCase,
+		}
+
+		mockItemUseCase.On("SearchItems", mock.Anything, "frost").Return(nil, nil)
+
+		response, err := discord.SearchItemsHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "no item found", response.Description)
+	})
+
+	t.Run("Item not found", func(t *testing.T) {
+		t.Parallel()
+		mockItemUseCase := mocks.NewItemUseCase(t)
+
+		discord := discordHandler.Discord{
+			ItemUseCase: mockItemUseCase,
+		}
+
+		mockItemUseCase.On("SearchItems", mock.Anything, "frost").
+			Return(nil, errors.New("read item: item 12 not found"))
+
+		response, err := discord.SearchItemsHandler(context.Background(), interaction)
+		assert.Error(t, err)
+		assert.Equal(t, "Error while searching items: item 12 not found", response.Description)
+	})
+}
@@ -11,6 +11,9 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/bwmarrin/discordgo"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
 )
 
 var LootDescriptors = []discordgo.ApplicationCommand{
@@ -27,7 +30,7 @@ var LootDescriptors = []discordgo.ApplicationCommand{
 			{
 				Type:        discordgo.ApplicationCommandOptionString,
 				Name:        "raid-date",
-				Description: "(ex: 02/10/23)",
+				Description: "(ex: 02/10/23, yesterday, last wednesday)",
 				Required:    true,
 			},
 			{
@@ -48,6 +51,12 @@ var LootDescriptors = []discordgo.ApplicationCommand{
 				Description: "(ex: milowenn)",
 				Required:    true,
 			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "season",
+				Description: "ex: DF/S2",
+				Required:    false,
+			},
 		},
 	},
 	{
@@ -77,7 +86,7 @@ var LootDescriptors = []discordgo.ApplicationCommand{
 	{
 		Name:        "guildops-loot-selector",
 		Description: "Donner la liste des joueurs qui peuvent avoir un loot",
-		Options: []*discordgo.ApplicationCommandOption{
+		Options: append([]*discordgo.ApplicationCommandOption{
 			{
 				Type:        discordgo.ApplicationCommandOptionString,
 				Name:        "player-list",
@@ -90,13 +99,37 @@ var LootDescriptors = []discordgo.ApplicationCommand{
 				Description: "(ex: mythic, heroic, normal)",
 				Required:    true,
 			},
-		},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "strategy",
+				Description: "(ex: lowest-count, attendance, last-loot, points)",
+				Required:    false,
+				Choices:     lootStrategyChoices(),
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "loot-name",
+				Description: "players who wished it come first, ex: Tête de Nefarian",
+				Required:    false,
+			},
+		}, playerFilterOptions()...),
 	},
 }
 
-func (d Discord) InitLoot() map[string]func(
-	ctx context.Context, interaction *discordgo.InteractionCreate) (string, error) {
-	return map[string]func(ctx context.Context, interaction *discordgo.InteractionCreate) (string, error){
+// lootStrategyChoices returns the loot strategies a player can be selected with.
+func lootStrategyChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(entity.LootStrategies))
+	for _, strategy := range entity.LootStrategies {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  string(strategy),
+			Value: string(strategy),
+		})
+	}
+	return choices
+}
+
+func (d Discord) InitLoot() map[string]discord.Handler {
+	return map[string]discord.Handler{
 		"guildops-loot-attribute":      d.AttributeLootHandler,
 		"guildops-loot-list-on-player": d.ListLootsOnPlayerHandler,
 		"guildops-loot-list-on-raid":   d.ListLootsOnRaidHandler,
@@ -107,7 +140,7 @@ func (d Discord) InitLoot() map[string]func(
 
 func (d Discord) AttributeLootHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -133,23 +166,31 @@ func (d Discord) AttributeLootHandler(
 		attribute.String("player_name", playerName),
 	)
 
-	raidDates, err := ParseDate(raidDate, "")
+	parsedDate, err := ParseDay(raidDate, d.now())
 	if err != nil {
-		return "invalid date", fmt.Errorf("discord - AttributeLootHandler - ParseDate: %w", err)
+		msg := "invalid date: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("discord - AttributeLootHandler - ParseDay: %w", err)
 	}
 
-	err = d.LootUseCase.CreateLoot(ctx, lootName, raidDates[0], playerName)
+	err = d.LootUseCase.CreateLoot(ctx, lootName, parsedDate, playerName)
 	if err != nil {
 		msg := "Error while proceeding loot attribution: " + HumanReadableError(err)
-		return msg, fmt.Errorf("discord - AttributeLootHandler - d.LootUseCase.CreateLoot: %w", err)
+		return discord.Text(msg), fmt.Errorf("discord - AttributeLootHandler - d.LootUseCase.CreateLoot: %w", err)
 	}
-	msg := "Loot successfully attributed"
-	return msg, nil
+	response := discord.Success("Loot successfully attributed")
+
+	// Officers see who else is waiting for the item, most wanted first
+	wishes, err := d.ListWishes(ctx, "", lootName)
+	if err != nil {
+		return response, fmt.Errorf("discord - AttributeLootHandler - d.ListWishes: %w", err)
+	}
+	response.Fields = appendListField(response.Fields, "Still wished by", wishLines(wishes))
+	return response, nil
 }
 
 func (d Discord) ListLootsOnPlayerHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -167,29 +208,41 @@ func (d Discord) ListLootsOnPlayerHandler(
 	}
 
 	playerName := optionMap["player-name"].StringValue()
+	season := ""
+	if opt, ok := optionMap["season"]; ok {
+		season = opt.StringValue()
+	}
 	span.SetAttributes(
 		attribute.String("player_name", playerName),
+		attribute.String("season", season),
 	)
 
-	lootList, err := d.LootUseCase.ListLootOnPLayer(ctx, playerName)
+	response, err := d.Pages.Show(ctx, List{
+		Title: "Loots of " + playerName,
+		Empty: "no loot for " + playerName,
+		Fetch: func(ctx context.Context, limit, offset int) ([]string, error) {
+			lootList, err := d.LootUseCase.ListLootOnPLayer(ctx, playerName, season, limit, offset)
+			if err != nil {
+				return nil, err
+			}
+			lines := make([]string, 0, len(lootList))
+			for _, loot := range lootList {
+				lines = append(lines, "* "+lootItemName(loot)+" "+loot.Raid.Date.Format("02/01/06")+" "+
+					loot.Raid.Difficulty+" "+strconv.Itoa(loot.ID))
+			}
+			return lines, nil
+		},
+	})
 	if err != nil {
 		msg := "Error while getting loot for player: " + HumanReadableError(err)
-		return msg, fmt.Errorf("discord - ListLootsOnPlayerHandler - d.LootUseCase.ListLootOnPLayer: %w", err)
-	}
-	if len(lootList) == 0 {
-		return "no loot for " + playerName, nil
-	}
-	msg := "All loots of " + playerName + ":\n"
-	for _, loot := range lootList {
-		msg += "* " + loot.Name + " " + loot.Raid.Date.Format("02/01/06") + " " +
-			loot.Raid.Difficulty + " " + strconv.Itoa(loot.ID) + "\n"
+		return discord.Text(msg), fmt.Errorf("discord - ListLootsOnPlayerHandler - d.LootUseCase.ListLootOnPLayer: %w", err)
 	}
-	return msg, nil
+	return response, nil
 }
 
 func (d Discord) ListLootsOnRaidHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -208,29 +261,34 @@ func (d Discord) ListLootsOnRaidHandler(
 	span.SetAttributes(
 		attribute.String("date", dateString),
 	)
-	date, err := ParseDate(dateString, "")
+	date, err := ParseDay(dateString, d.now())
 	if err != nil {
-		return "invalid date", fmt.Errorf("discord - ListLootsOnRaidHandler - ParseDate: %w", err)
+		msg := "invalid date: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("discord - ListLootsOnRaidHandler - ParseDay: %w", err)
 	}
 
-	lootList, err := d.LootUseCase.ListLootOnRaid(ctx, date[0])
+	lootList, err := d.LootUseCase.ListLootOnRaid(ctx, date)
 	if err != nil {
 		msg := "Error while listing loot for raid: " + HumanReadableError(err)
-		return msg, fmt.Errorf("discord - ListLootsOnPlayerHandler - d.LootUseCase.ListLootOnPLayer: %w", err)
+		return discord.Text(msg), fmt.Errorf("discord - ListLootsOnPlayerHandler - d.LootUseCase.ListLootOnPLayer: %w", err)
 	}
 	if len(lootList) == 0 {
-		return "no loot for " + date[0].Format("02/01/06"), nil
+		return discord.Text("no loot for " + date.Format("02/01/06")), nil
 	}
-	msg := "All loots of  " + date[0].Format("02/01/06") + ":\n"
+	lines := make([]string, 0, len(lootList))
 	for _, loot := range lootList {
-		msg += "* " + loot.Name + " " + loot.Player.Name + " " + loot.Raid.Difficulty + " " + strconv.Itoa(loot.ID) + "\n"
+		lines = append(lines, "* "+lootItemName(loot)+" "+loot.Player.Name+" "+loot.Raid.Difficulty+" "+
+			strconv.Itoa(loot.ID))
 	}
-	return msg, nil
+	return &discord.Response{
+		Title:  "Loots of " + date.Format("Mon 02/01/06"),
+		Fields: appendListField(nil, "Loots", lines),
+	}, nil
 }
 
 func (d Discord) DeleteLootHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -250,21 +308,30 @@ func (d Discord) DeleteLootHandler(
 		attribute.String("id", optionMap["id"].StringValue()))
 	id, err := strconv.Atoi(optionMap["id"].StringValue())
 	if err != nil {
-		return "id format is invalid", fmt.Errorf("discord - DeleteLootHandler - strconv.Atoi: %w", err)
+		return discord.Text("id format is invalid"), fmt.Errorf("discord - DeleteLootHandler - strconv.Atoi: %w", err)
 	}
 
-	err = d.LootUseCase.DeleteLoot(ctx, id)
+	loot, err := d.LootUseCase.ReadLoot(ctx, id)
 	if err != nil {
 		msg := "Error while deleting loot: " + HumanReadableError(err)
-		return msg, fmt.Errorf("discord - DeleteLootHandler - d.LootUseCase.DeleteLoot: %w", err)
+		return discord.Text(msg), fmt.Errorf("discord - DeleteLootHandler - d.LootUseCase.ReadLoot: %w", err)
 	}
-	msg := "Loot successfully deleted"
-	return msg, nil
+
+	summary := fmt.Sprintf("Delete loot #%d %s of %s on %s? The points spent on it are given back.",
+		loot.ID, lootItemName(loot), loot.Player.Name, loot.Raid.Date.Format("02/01/06"))
+	return d.Confirmations.Ask(ctx, interaction, summary, func(ctx context.Context) (string, error) {
+		err := d.LootUseCase.DeleteLoot(ctx, loot.ID)
+		if err != nil {
+			msg := "Error while deleting loot: " + HumanReadableError(err)
+			return msg, fmt.Errorf("discord - DeleteLootHandler - d.LootUseCase.DeleteLoot: %w", err)
+		}
+		return "Loot successfully deleted", nil
+	})
 }
 
 func (d Discord) LootCounterCheckerHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -284,17 +351,59 @@ func (d Discord) LootCounterCheckerHandler(
 	playerList = strings.ReplaceAll(playerList, " ", "")
 	playerNames := strings.Split(playerList, ",")
 	difficulty := optionMap["difficulty"].StringValue()
+	strategy := ""
+	if opt, ok := optionMap["strategy"]; ok {
+		strategy = opt.StringValue()
+	}
+	lootName := ""
+	if opt, ok := optionMap["loot-name"]; ok {
+		lootName = opt.StringValue()
+	}
+	filter, err := playerFilter(optionMap)
+	if err != nil {
+		msg := "Error while searching a player to attribute loot: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("discord - LootCounterCheckerHandler - playerFilter: %w", err)
+	}
 	span.SetAttributes(
 		attribute.String("player_list", optionMap["player-list"].StringValue()),
 		attribute.String("difficulty", difficulty),
+		attribute.String("strategy", strategy),
+		attribute.String("loot_name", lootName),
+		attribute.String("filter", filter.String()),
 	)
 
-	player, err := d.LootUseCase.SelectPlayerToAssign(ctx, playerNames, difficulty)
+	selection, err := d.LootUseCase.SelectPlayerToAssign(ctx, playerNames, difficulty, strategy, lootName, filter)
 	if err != nil {
 		msg := "Error while searching a player to attribute loot: " + HumanReadableError(err)
-		return msg, fmt.Errorf("discord - LootCounterCheckerHandler - d.LootUseCase.SelectPlayerToAssign: %w", err)
+		return discord.Text(msg),
+			fmt.Errorf("discord - LootCounterCheckerHandler - d.LootUseCase.SelectPlayerToAssign: %w", err)
+	}
+
+	response := &discord.Response{
+		Title:       selection.Winner.Name + " have been selected to receive the loot",
+		Description: "Strategy " + string(selection.Strategy),
+	}
+	if selection.Tied > 1 {
+		response.Description += fmt.Sprintf(", drawn among %d tied players", selection.Tied)
 	}
+	response.Fields = appendListField(response.Fields, "Candidates", candidateLines(selection.Candidates))
+	response.Fields = appendListField(response.Fields, "Excluded", candidateLines(selection.Excluded))
+	return response, nil
+}
 
-	msg := player.Name + " have been selected to receive the loot"
-	return msg, nil
+// candidateLines returns a line for each candidate with the reason of its rank.
+func candidateLines(candidates []entity.LootCandidate) []string {
+	lines := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		lines = append(lines, "* "+candidate.Player.Name+" : "+candidate.Reason)
+	}
+	return lines
+}
+
+// lootItemName returns the name of a loot, with its item ID and metadata when the item is in the catalogue.
+func lootItemName(loot entity.Loot) string {
+	if loot.Item == nil {
+		return loot.Name
+	}
+	return loot.Item.String()
 }
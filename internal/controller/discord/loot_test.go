@@ -20,17 +20,22 @@ func TestDiscord_AttributeLootHandler(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		t.Parallel()
 		mockLootUseCase := mocks.NewLootUseCase(t)
+		mockWishlistUseCase := mocks.NewWishlistUseCase(t)
 
 		discord := discordHandler.Discord{
-			AbsenceUseCase: nil,
-			PlayerUseCase:  nil,
-			StrikeUseCase:  nil,
-			LootUseCase:    mockLootUseCase,
-			RaidUseCase:    nil,
+			AbsenceUseCase:  nil,
+			PlayerUseCase:   nil,
+			StrikeUseCase:   nil,
+			LootUseCase:     mockLootUseCase,
+			RaidUseCase:     nil,
+			WishlistUseCase: mockWishlistUseCase,
 		}
 
 		mockLootUseCase.On("CreateLoot", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil)
+		mockWishlistUseCase.On("ListWishes", mock.Anything, "", "TestLoot").Return([]entity.Wish{
+			{ID: 3, Player: &entity.Player{Name: "prism"}, Item: "testloot", Priority: 2, Spec: "holy"},
+		}, nil)
 
 		interaction := &discordgo.InteractionCreate{
 			Interaction: &discordgo.Interaction{
@@ -66,9 +71,10 @@ func TestDiscord_AttributeLootHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.AttributeLootHandler(context.Background(), interaction)
+		response, err := discord.AttributeLootHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Loot successfully attributed")
+		assert.Equal(t, "Loot successfully attributed\n\n"+
+			"Still wished by (1)\n* #3 prism : testloot, priority 2, holy\n", response.String())
 		mockLootUseCase.AssertExpectations(t)
 	})
 }
@@ -88,7 +94,7 @@ func TestDiscord_ListLootsOnPlayerHandler(t *testing.T) {
 			RaidUseCase:    nil,
 		}
 
-		mockLootUseCase.On("ListLootOnPLayer", mock.Anything, mock.Anything).
+		mockLootUseCase.On("ListLootOnPLayer", mock.Anything, "TestPlayer", "", 0, 0).
 			Return([]entity.Loot{
 				{
 					ID:   1,
@@ -100,7 +106,8 @@ func TestDiscord_ListLootsOnPlayerHandler(t *testing.T) {
 				},
 				{
 					ID:   2,
-					Name: "TestLoot2",
+					Name: "testloot2",
+					Item: &entity.Item{ID: 19019, Name: "testloot2", Slot: "head", ItemLevel: 450},
 					Raid: &entity.Raid{
 						Date:       time.Now(),
 						Difficulty: "Heroic",
@@ -132,11 +139,11 @@ func TestDiscord_ListLootsOnPlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.ListLootsOnPlayerHandler(context.Background(), interaction)
+		response, err := discord.ListLootsOnPlayerHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "All loots of TestPlayer:\n"+
-			"* TestLoot "+time.Now().Format("02/01/06")+" Heroic 1\n"+
-			"* TestLoot2 "+time.Now().Format("02/01/06")+" Heroic 2\n")
+		assert.Equal(t, "Loots of TestPlayer", response.Title)
+		assert.Equal(t, "* TestLoot "+time.Now().Format("02/01/06")+" Heroic 1\n"+
+			"* testloot2 (#19019, head, ilvl 450) "+time.Now().Format("02/01/06")+" Heroic 2", response.Description)
 		mockLootUseCase.AssertExpectations(t)
 	})
 }
@@ -157,6 +164,8 @@ func TestDiscord_DeleteLootHandler(t *testing.T) {
 			RaidUseCase:    nil,
 		}
 
+		mockLootUseCase.On("ReadLoot", mock.Anything, 1).
+			Return(entity.Loot{ID: 1, Name: "sword", Player: &entity.Player{Name: "arthas"}, Raid: &entity.Raid{}}, nil)
 		mockLootUseCase.On("DeleteLoot", mock.Anything, mock.Anything).
 			Return(nil)
 
@@ -184,9 +193,134 @@ func TestDiscord_DeleteLootHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.DeleteLootHandler(context.Background(), interaction)
+		response, err := discord.DeleteLootHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, response.Description, "Loot successfully deleted")
+		mockLootUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Asks for confirmation", func(t *testing.T) {
+		t.Parallel()
+		mockLootUseCase := mocks.NewLootUseCase(t)
+
+		discord := discordHandler.Discord{
+			LootUseCase:   mockLootUseCase,
+			Confirmations: discordHandler.NewConfirmations(),
+		}
+
+		mockLootUseCase.On("ReadLoot", mock.Anything, 1).Return(entity.Loot{
+			ID: 1, Name: "sword", Player: &entity.Player{Name: "arthas"},
+			Raid: &entity.Raid{Date: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)},
+		}, nil)
+
+		response, err := discord.DeleteLootHandler(context.Background(),
+			commandInteraction("guildops-loot-delete", stringOption("id", "1")))
+		assert.NoError(t, err)
+		assert.True(t, response.Ephemeral)
+		assert.Equal(t, "Delete loot #1 sword of arthas on 02/10/23? The points spent on it are given back.",
+			response.Description)
+		mockLootUseCase.AssertNotCalled(t, "DeleteLoot", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDiscord_LootCounterCheckerHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockLootUseCase := mocks.NewLootUseCase(t)
+
+		discord := discordHandler.Discord{
+			LootUseCase: mockLootUseCase,
+		}
+
+		milowenn := entity.Player{ID: 1, Name: "milowenn"}
+		prism := entity.Player{ID: 2, Name: "prism"}
+		mockLootUseCase.On("SelectPlayerToAssign",
+			mock.Anything, []string{"milowenn", "prism"}, "mythic", "attendance", "", entity.PlayerFilter{}).
+			Return(entity.LootSelection{
+				Strategy: entity.LootStrategyAttendance,
+				Winner:   milowenn,
+				Candidates: []entity.LootCandidate{
+					{Player: milowenn, Score: 100, Reason: "100% attendance, 0 loots in mythic"},
+					{Player: prism, Score: 40, Reason: "80% attendance, 1 loots in mythic"},
+				},
+				Tied: 1,
+			}, nil)
+
+		interaction := &discordgo.InteractionCreate{
+			Interaction: &discordgo.Interaction{
+				Type: discordgo.InteractionApplicationCommand,
+				Member: &discordgo.Member{
+					User: &discordgo.User{
+						Username: "test",
+					},
+				},
+				Data: discordgo.ApplicationCommandInteractionData{
+					ID:       "mock",
+					Name:     "mock",
+					TargetID: "mock",
+					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
+					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Name:  "player-list",
+							Type:  discordgo.ApplicationCommandOptionString,
+							Value: "milowenn, prism",
+						},
+						{
+							Name:  "difficulty",
+							Type:  discordgo.ApplicationCommandOptionString,
+							Value: "mythic",
+						},
+						{
+							Name:  "strategy",
+							Type:  discordgo.ApplicationCommandOptionString,
+							Value: "attendance",
+						},
+					},
+				},
+			},
+		}
+
+		response, err := discord.LootCounterCheckerHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "milowenn have been selected to receive the loot\n\nStrategy attendance\n\nCandidates (2)\n"+
+			"* milowenn : 100% attendance, 0 loots in mythic\n* prism : 80% attendance, 1 loots in mythic\n",
+			response.String())
+		mockLootUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Filtered by role", func(t *testing.T) {
+		t.Parallel()
+		mockLootUseCase := mocks.NewLootUseCase(t)
+
+		discord := discordHandler.Discord{
+			LootUseCase: mockLootUseCase,
+		}
+
+		uther := entity.Player{ID: 1, Name: "uther"}
+		arthas := entity.Player{ID: 2, Name: "arthas"}
+		mockLootUseCase.On("SelectPlayerToAssign",
+			mock.Anything, []string{"uther", "arthas"}, "mythic", "", "", entity.PlayerFilter{Role: entity.RoleHealer}).
+			Return(entity.LootSelection{
+				Strategy:   entity.LootStrategyLowestCount,
+				Winner:     uther,
+				Candidates: []entity.LootCandidate{{Player: uther, Reason: "0 loots in mythic"}},
+				Excluded:   []entity.LootCandidate{{Player: arthas, Reason: "not healer"}},
+				Tied:       1,
+			}, nil)
+
+		interaction := commandInteraction("guildops-loot-selector",
+			&discordgo.ApplicationCommandInteractionDataOption{
+				Name: "player-list", Type: discordgo.ApplicationCommandOptionString, Value: "uther, arthas",
+			},
+			stringOption("difficulty", "mythic"),
+			stringOption("role", "healer"),
+		)
+
+		response, err := discord.LootCounterCheckerHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Loot successfully deleted")
+		assert.Contains(t, response.String(), "Excluded (1)\n* arthas : not healer\n")
 		mockLootUseCase.AssertExpectations(t)
 	})
 }
@@ -5,8 +5,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/pkg/errors"
-
 	"github.com/antony-ramos/guildops/internal/entity"
 )
 
@@ -17,6 +15,43 @@ type Discord struct {
 	LootUseCase
 	RaidUseCase
 	FailUseCase
+	AttendanceUseCase
+	SeasonUseCase
+	PointsUseCase
+	WishlistUseCase
+	ItemUseCase
+	SignupUseCase
+	RaidTemplateUseCase
+	TrashUseCase
+
+	// Location is the time zone of the guild, dates and times of day are read and shown in it. UTC when nil.
+	Location *time.Location
+	// Pages keeps the page paginated lists are at. Lists are shown at once when nil.
+	Pages *Pages
+	// Confirmations keeps the destructive commands waiting for a confirmation. They run at once when nil.
+	Confirmations *Confirmations
+}
+
+// now returns the current time in the time zone of the guild.
+func (d Discord) now() time.Time {
+	if d.Location == nil {
+		return time.Now().UTC()
+	}
+	return time.Now().In(d.Location)
+}
+
+// PlayerCommands lists the commands any guild member can run by default, with the signup buttons.
+// Every other command is restricted to officers unless the permission policy says otherwise.
+var PlayerCommands = []string{
+	SignupComponent,
+	"guildops-player-link",
+	"guildops-player-info",
+	"guildops-absence-create",
+	"guildops-absence-delete",
+	"guildops-points-balance",
+	"guildops-points-standings",
+	"guildops-wishlist-list",
+	"guildops-item-search",
 }
 
 type AbsenceUseCase interface {
@@ -28,36 +63,57 @@ type AbsenceUseCase interface {
 type PlayerUseCase interface {
 	CreatePlayer(ctx context.Context, playerName string) (int, error)
 	DeletePlayer(ctx context.Context, playerName string) error
-	ReadPlayer(ctx context.Context, playerName, playerLinkName string) (entity.Player, error)
-	LinkPlayer(ctx context.Context, playerName string, discordID string) error
+	ReadPlayerCascade(ctx context.Context, playerName string) (entity.Player, entity.Cascade, error)
+	ReadPlayer(ctx context.Context, playerName, discordID string) (entity.Player, error)
+	SearchPlayerNames(ctx context.Context, prefix string, limit int) ([]string, error)
+	LinkPlayer(ctx context.Context, playerName, discordID, discordName string) (entity.Player, error)
+	SetMain(ctx context.Context, playerName, mainName string) (entity.Player, error)
+	UpdatePlayer(ctx context.Context, playerName, class, mainSpec, offSpec, role string) (entity.Player, error)
 }
 
 type RaidUseCase interface {
-	CreateRaid(ctx context.Context, raidName, difficulty string, date time.Time) (entity.Raid, error)
+	CreateRaid(ctx context.Context, raidName, difficulty string, date time.Time, startTime string) (entity.Raid, error)
+	CreateRaids(
+		ctx context.Context, raidName, difficulty string, dates []time.Time, startTime string,
+	) (entity.RaidGeneration, error)
 	DeleteRaidWithID(ctx context.Context, raidID int) error
 	DeleteRaidOnDate(ctx context.Context, date time.Time, difficulty string) error
+	ReadRaidCascade(
+		ctx context.Context, raidID int, date time.Time, difficulty string,
+	) (entity.Raid, entity.Cascade, error)
 	ReadRaid(ctx context.Context, date time.Time) (entity.Raid, error)
+	ListRaids(ctx context.Context, from, to time.Time, limit, offset int) ([]entity.Raid, error)
+	SetRaidRoster(
+		ctx context.Context, date time.Time, difficulty string, roster map[entity.ParticipantStatus][]string,
+		removed []string,
+	) (entity.Raid, error)
+	ReadRaidRoster(
+		ctx context.Context, date time.Time, difficulty string, filter entity.PlayerFilter,
+	) (entity.Raid, error)
 }
 
 type StrikeUseCase interface {
 	CreateStrike(ctx context.Context, strikeReason, playerName string) error
 	DeleteStrike(ctx context.Context, id int) error
-	ReadStrikes(ctx context.Context, playerName string) ([]entity.Strike, error)
+	ReadStrike(ctx context.Context, id int) (entity.Strike, error)
+	ReadStrikes(ctx context.Context, playerName, season string) ([]entity.Strike, error)
 }
 
 type LootUseCase interface {
 	CreateLoot(ctx context.Context, lootName string, raidDate time.Time, playerName string) error
-	ListLootOnPLayer(ctx context.Context, playerName string) ([]entity.Loot, error)
+	ListLootOnPLayer(ctx context.Context, playerName, season string, limit, offset int) ([]entity.Loot, error)
 	ListLootOnRaid(ctx context.Context, raidDate time.Time) ([]entity.Loot, error)
+	SearchLootNames(ctx context.Context, prefix string, limit int) ([]string, error)
 	SelectPlayerToAssign(
-		ctx context.Context, playerNames []string, difficulty string,
-	) (entity.Player, error)
+		ctx context.Context, playerNames []string, difficulty, strategy, item string, filter entity.PlayerFilter,
+	) (entity.LootSelection, error)
+	ReadLoot(ctx context.Context, lootID int) (entity.Loot, error)
 	DeleteLoot(ctx context.Context, lootID int) error
 }
 
 type FailUseCase interface {
 	CreateFail(ctx context.Context, failReason string, date time.Time, playerName string) error
-	ListFailOnPLayer(ctx context.Context, playerName string) ([]entity.Fail, error)
+	ListFailOnPLayer(ctx context.Context, playerName, season string, limit, offset int) ([]entity.Fail, error)
 	ListFailOnRaid(ctx context.Context, date time.Time) ([]entity.Fail, error)
 	ListFailOnRaidAndPlayer(
 		ctx context.Context, raidName string, playerName string,
@@ -67,6 +123,54 @@ type FailUseCase interface {
 	ReadFail(ctx context.Context, failID int) (entity.Fail, error)
 }
 
+type AttendanceUseCase interface {
+	ListAttendance(ctx context.Context, from, to time.Time) ([]entity.Attendance, error)
+	ReadAttendance(ctx context.Context, playerName string, from, to time.Time) (entity.Attendance, error)
+}
+
+type SeasonUseCase interface {
+	CreateSeason(ctx context.Context, name string, start, end time.Time) (entity.Season, error)
+	ListSeasons(ctx context.Context) ([]entity.Season, error)
+	ReadSeason(ctx context.Context, name string) (entity.Season, error)
+}
+
+type PointsUseCase interface {
+	ReadBalance(ctx context.Context, playerName string) (entity.PointsBalance, error)
+	ListStandings(ctx context.Context) ([]entity.PointsBalance, error)
+	AwardPoints(ctx context.Context, playerName string, amount int, reason, author string) (entity.PointsEntry, error)
+	RevertPointsEntry(ctx context.Context, entryID int, reason, author string) (entity.PointsEntry, error)
+	PreviewDecay(ctx context.Context, percent int, author string) ([]entity.PointsEntry, error)
+	DecayPoints(ctx context.Context, percent int, author string) ([]entity.PointsEntry, error)
+}
+
+type WishlistUseCase interface {
+	AddWish(ctx context.Context, playerName, item string, priority int, spec, note string) (entity.Wish, error)
+	RemoveWish(ctx context.Context, wishID int) error
+	ListWishes(ctx context.Context, playerName, item string) ([]entity.Wish, error)
+}
+
+type ItemUseCase interface {
+	SearchItems(ctx context.Context, query string) ([]entity.Item, error)
+}
+
+type SignupUseCase interface {
+	OpenSignup(ctx context.Context, date time.Time, difficulty string) (entity.Raid, error)
+	SignUp(ctx context.Context, raidID int, discordID string, status string) (entity.Raid, error)
+}
+
+type RaidTemplateUseCase interface {
+	CreateRaidTemplate(
+		ctx context.Context, name, difficulty, weekdays, startTime, timezone string,
+	) (entity.RaidTemplate, error)
+	ListRaidTemplates(ctx context.Context) ([]entity.RaidTemplate, error)
+	DeleteRaidTemplate(ctx context.Context, templateID int) error
+	GenerateRaids(ctx context.Context, now time.Time, weeks int) (entity.RaidGeneration, error)
+}
+
+type TrashUseCase interface {
+	RestoreLast(ctx context.Context) (entity.Deleted, error)
+}
+
 // HumanReadableError returns the error message without the package name.
 func HumanReadableError(err error) string {
 	str := strings.Split(err.Error(), ": ")
@@ -77,33 +181,3 @@ func HumanReadableError(err error) string {
 	}
 	return err.Error()
 }
-
-// ParseDate returns a list of dates between the two dates passed as parameters.
-// If toDate is empty, it will be set to fromDate.
-func ParseDate(fromDate, toDate string) ([]time.Time, error) {
-	layout := "02/01/06"
-	startDate, err := time.Parse(layout, fromDate)
-	if err != nil {
-		return nil, errors.Wrap(err, "parse date")
-	}
-
-	if toDate == "" {
-		toDate = fromDate
-	}
-
-	endDate, err := time.Parse(layout, toDate)
-	if err != nil {
-		return nil, errors.Wrap(err, "parse date")
-	}
-
-	// check if endDate is before startDate
-	if endDate.Before(startDate) {
-		return nil, errors.New("endDate is before startDate")
-	}
-
-	var dates []time.Time
-	for currentDate := startDate; !currentDate.After(endDate); currentDate = currentDate.AddDate(0, 0, 1) {
-		dates = append(dates, currentDate)
-	}
-	return dates, nil
-}
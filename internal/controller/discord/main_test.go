@@ -2,13 +2,45 @@ package discordhandler_test
 
 import (
 	"fmt"
-	"reflect"
 	"testing"
-	"time"
+
+	"github.com/bwmarrin/discordgo"
 
 	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
 )
 
+// commandInteraction returns the interaction of the command name with options, run by the member thrall.
+func commandInteraction(
+	name string, options ...*discordgo.ApplicationCommandInteractionDataOption,
+) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			Type: discordgo.InteractionApplicationCommand,
+			Member: &discordgo.Member{
+				User: &discordgo.User{
+					ID:       "100000000000000001",
+					Username: "thrall",
+				},
+			},
+			Data: discordgo.ApplicationCommandInteractionData{
+				ID:       "mock",
+				Name:     name,
+				Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
+				Options:  options,
+			},
+		},
+	}
+}
+
+// stringOption returns a string option of a command.
+func stringOption(name, value string) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{
+		Name:  name,
+		Type:  discordgo.ApplicationCommandOptionString,
+		Value: value,
+	}
+}
+
 func TestHumanReadableError(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -39,84 +71,3 @@ func TestHumanReadableError(t *testing.T) {
 		})
 	}
 }
-
-func TestGenerateDateList(t *testing.T) {
-	t.Parallel()
-
-	t.Run("Success", func(t *testing.T) {
-		t.Parallel()
-
-		startDate := "01/01/21"
-		endDate := "03/01/21"
-
-		expectedDates := []time.Time{
-			time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
-			time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC),
-			time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC),
-		}
-
-		dateList, err := discordHandler.ParseDate(startDate, endDate)
-		if err != nil {
-			t.Errorf("Error: %v", err)
-		}
-
-		if !reflect.DeepEqual(dateList, expectedDates) {
-			t.Errorf("Expected %v, but got %v", expectedDates, dateList)
-		}
-	})
-
-	t.Run("Success with no endDate", func(t *testing.T) {
-		t.Parallel()
-
-		startDate := "01/01/22"
-		endDate := ""
-
-		expectedDates := []time.Time{
-			time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
-		}
-
-		dateList, err := discordHandler.ParseDate(startDate, endDate)
-		if err != nil {
-			t.Errorf("Error: %v", err)
-		}
-
-		if !reflect.DeepEqual(dateList, expectedDates) {
-			t.Errorf("Expected %v, but got %v", expectedDates, dateList)
-		}
-	})
-
-	t.Run("Error with format", func(t *testing.T) {
-		t.Parallel()
-
-		startDate := "01-01/22"
-		endDate := "01-03/21"
-
-		_, err := discordHandler.ParseDate(startDate, endDate)
-		if err == nil {
-			t.Errorf("Expected error, but got nil")
-		}
-	})
-
-	t.Run("Error with format", func(t *testing.T) {
-		t.Parallel()
-
-		startDate := "01/01/22"
-		endDate := "01-03/22"
-
-		_, err := discordHandler.ParseDate(startDate, endDate)
-		if err == nil {
-			t.Errorf("Expected error, but got nil")
-		}
-	})
-
-	t.Run("Error with date order", func(t *testing.T) {
-		t.Parallel()
-		startDate := "01/03/23"
-		endDate := "01/01/23"
-
-		_, err := discordHandler.ParseDate(startDate, endDate)
-		if err == nil {
-			t.Errorf("Expected error, but got nil")
-		}
-	})
-}
@@ -0,0 +1,82 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antony-ramos/guildops/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AttendanceUseCase is an autogenerated mock type for the AttendanceUseCase type
+type AttendanceUseCase struct {
+	mock.Mock
+}
+
+// ListAttendance provides a mock function with given fields: ctx, from, to
+func (_m *AttendanceUseCase) ListAttendance(ctx context.Context, from time.Time, to time.Time) ([]entity.Attendance, error) {
+	ret := _m.Called(ctx, from, to)
+
+	var r0 []entity.Attendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.Attendance, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.Attendance); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Attendance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadAttendance provides a mock function with given fields: ctx, playerName, from, to
+func (_m *AttendanceUseCase) ReadAttendance(ctx context.Context, playerName string, from time.Time, to time.Time) (entity.Attendance, error) {
+	ret := _m.Called(ctx, playerName, from, to)
+
+	var r0 entity.Attendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (entity.Attendance, error)); ok {
+		return rf(ctx, playerName, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) entity.Attendance); ok {
+		r0 = rf(ctx, playerName, from, to)
+	} else {
+		r0 = ret.Get(0).(entity.Attendance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, playerName, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAttendanceUseCase creates a new instance of AttendanceUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttendanceUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttendanceUseCase {
+	mock := &AttendanceUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
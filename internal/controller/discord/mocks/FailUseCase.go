@@ -45,25 +45,25 @@ func (_m *FailUseCase) DeleteFail(ctx context.Context, failID int) error {
 	return r0
 }
 
-// ListFailOnPLayer provides a mock function with given fields: ctx, playerName
-func (_m *FailUseCase) ListFailOnPLayer(ctx context.Context, playerName string) ([]entity.Fail, error) {
-	ret := _m.Called(ctx, playerName)
+// ListFailOnPLayer provides a mock function with given fields: ctx, playerName, season, limit, offset
+func (_m *FailUseCase) ListFailOnPLayer(ctx context.Context, playerName string, season string, limit int, offset int) ([]entity.Fail, error) {
+	ret := _m.Called(ctx, playerName, season, limit, offset)
 
 	var r0 []entity.Fail
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.Fail, error)); ok {
-		return rf(ctx, playerName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int) ([]entity.Fail, error)); ok {
+		return rf(ctx, playerName, season, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Fail); ok {
-		r0 = rf(ctx, playerName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int) []entity.Fail); ok {
+		r0 = rf(ctx, playerName, season, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Fail)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, playerName)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, int) error); ok {
+		r1 = rf(ctx, playerName, season, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
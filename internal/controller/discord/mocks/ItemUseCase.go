@@ -0,0 +1,56 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antony-ramos/guildops/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ItemUseCase is an autogenerated mock type for the ItemUseCase type
+type ItemUseCase struct {
+	mock.Mock
+}
+
+// SearchItems provides a mock function with given fields: ctx, query
+func (_m *ItemUseCase) SearchItems(ctx context.Context, query string) ([]entity.Item, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []entity.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.Item, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Item); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewItemUseCase creates a new instance of ItemUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewItemUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ItemUseCase {
+	mock := &ItemUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
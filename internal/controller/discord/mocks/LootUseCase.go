@@ -45,25 +45,25 @@ func (_m *LootUseCase) DeleteLoot(ctx context.Context, lootID int) error {
 	return r0
 }
 
-// ListLootOnPLayer provides a mock function with given fields: ctx, playerName
-func (_m *LootUseCase) ListLootOnPLayer(ctx context.Context, playerName string) ([]entity.Loot, error) {
-	ret := _m.Called(ctx, playerName)
+// ListLootOnPLayer provides a mock function with given fields: ctx, playerName, season, limit, offset
+func (_m *LootUseCase) ListLootOnPLayer(ctx context.Context, playerName string, season string, limit int, offset int) ([]entity.Loot, error) {
+	ret := _m.Called(ctx, playerName, season, limit, offset)
 
 	var r0 []entity.Loot
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.Loot, error)); ok {
-		return rf(ctx, playerName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int) ([]entity.Loot, error)); ok {
+		return rf(ctx, playerName, season, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Loot); ok {
-		r0 = rf(ctx, playerName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int) []entity.Loot); ok {
+		r0 = rf(ctx, playerName, season, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Loot)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, playerName)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, int) error); ok {
+		r1 = rf(ctx, playerName, season, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -97,23 +97,73 @@ func (_m *LootUseCase) ListLootOnRaid(ctx context.Context, raidDate time.Time) (
 	return r0, r1
 }
 
-// SelectPlayerToAssign provides a mock function with given fields: ctx, playerNames, difficulty
-func (_m *LootUseCase) SelectPlayerToAssign(ctx context.Context, playerNames []string, difficulty string) (entity.Player, error) {
-	ret := _m.Called(ctx, playerNames, difficulty)
+// ReadLoot provides a mock function with given fields: ctx, lootID
+func (_m *LootUseCase) ReadLoot(ctx context.Context, lootID int) (entity.Loot, error) {
+	ret := _m.Called(ctx, lootID)
+
+	var r0 entity.Loot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (entity.Loot, error)); ok {
+		return rf(ctx, lootID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) entity.Loot); ok {
+		r0 = rf(ctx, lootID)
+	} else {
+		r0 = ret.Get(0).(entity.Loot)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, lootID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchLootNames provides a mock function with given fields: ctx, prefix, limit
+func (_m *LootUseCase) SearchLootNames(ctx context.Context, prefix string, limit int) ([]string, error) {
+	ret := _m.Called(ctx, prefix, limit)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]string, error)); ok {
+		return rf(ctx, prefix, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []string); ok {
+		r0 = rf(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectPlayerToAssign provides a mock function with given fields: ctx, playerNames, difficulty, strategy, item, filter
+func (_m *LootUseCase) SelectPlayerToAssign(ctx context.Context, playerNames []string, difficulty string, strategy string, item string, filter entity.PlayerFilter) (entity.LootSelection, error) {
+	ret := _m.Called(ctx, playerNames, difficulty, strategy, item, filter)
 
-	var r0 entity.Player
+	var r0 entity.LootSelection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string, string) (entity.Player, error)); ok {
-		return rf(ctx, playerNames, difficulty)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, string, string, entity.PlayerFilter) (entity.LootSelection, error)); ok {
+		return rf(ctx, playerNames, difficulty, strategy, item, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string, string) entity.Player); ok {
-		r0 = rf(ctx, playerNames, difficulty)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, string, string, entity.PlayerFilter) entity.LootSelection); ok {
+		r0 = rf(ctx, playerNames, difficulty, strategy, item, filter)
 	} else {
-		r0 = ret.Get(0).(entity.Player)
+		r0 = ret.Get(0).(entity.LootSelection)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string, string) error); ok {
-		r1 = rf(ctx, playerNames, difficulty)
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, string, string, entity.PlayerFilter) error); ok {
+		r1 = rf(ctx, playerNames, difficulty, strategy, item, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -53,37 +53,152 @@ func (_m *PlayerUseCase) DeletePlayer(ctx context.Context, playerName string) er
 	return r0
 }
 
-// LinkPlayer provides a mock function with given fields: ctx, playerName, discordID
-func (_m *PlayerUseCase) LinkPlayer(ctx context.Context, playerName string, discordID string) error {
+// LinkPlayer provides a mock function with given fields: ctx, playerName, discordID, discordName
+func (_m *PlayerUseCase) LinkPlayer(ctx context.Context, playerName string, discordID string, discordName string) (entity.Player, error) {
+	ret := _m.Called(ctx, playerName, discordID, discordName)
+
+	var r0 entity.Player
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (entity.Player, error)); ok {
+		return rf(ctx, playerName, discordID, discordName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) entity.Player); ok {
+		r0 = rf(ctx, playerName, discordID, discordName)
+	} else {
+		r0 = ret.Get(0).(entity.Player)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, playerName, discordID, discordName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadPlayer provides a mock function with given fields: ctx, playerName, discordID
+func (_m *PlayerUseCase) ReadPlayer(ctx context.Context, playerName string, discordID string) (entity.Player, error) {
 	ret := _m.Called(ctx, playerName, discordID)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+	var r0 entity.Player
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (entity.Player, error)); ok {
+		return rf(ctx, playerName, discordID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) entity.Player); ok {
 		r0 = rf(ctx, playerName, discordID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(entity.Player)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, playerName, discordID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ReadPlayer provides a mock function with given fields: ctx, playerName, playerLinkName
-func (_m *PlayerUseCase) ReadPlayer(ctx context.Context, playerName string, playerLinkName string) (entity.Player, error) {
-	ret := _m.Called(ctx, playerName, playerLinkName)
+// ReadPlayerCascade provides a mock function with given fields: ctx, playerName
+func (_m *PlayerUseCase) ReadPlayerCascade(ctx context.Context, playerName string) (entity.Player, entity.Cascade, error) {
+	ret := _m.Called(ctx, playerName)
+
+	var r0 entity.Player
+	var r1 entity.Cascade
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Player, entity.Cascade, error)); ok {
+		return rf(ctx, playerName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Player); ok {
+		r0 = rf(ctx, playerName)
+	} else {
+		r0 = ret.Get(0).(entity.Player)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) entity.Cascade); ok {
+		r1 = rf(ctx, playerName)
+	} else {
+		r1 = ret.Get(1).(entity.Cascade)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, playerName)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SearchPlayerNames provides a mock function with given fields: ctx, prefix, limit
+func (_m *PlayerUseCase) SearchPlayerNames(ctx context.Context, prefix string, limit int) ([]string, error) {
+	ret := _m.Called(ctx, prefix, limit)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]string, error)); ok {
+		return rf(ctx, prefix, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []string); ok {
+		r0 = rf(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetMain provides a mock function with given fields: ctx, playerName, mainName
+func (_m *PlayerUseCase) SetMain(ctx context.Context, playerName string, mainName string) (entity.Player, error) {
+	ret := _m.Called(ctx, playerName, mainName)
 
 	var r0 entity.Player
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) (entity.Player, error)); ok {
-		return rf(ctx, playerName, playerLinkName)
+		return rf(ctx, playerName, mainName)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) entity.Player); ok {
-		r0 = rf(ctx, playerName, playerLinkName)
+		r0 = rf(ctx, playerName, mainName)
 	} else {
 		r0 = ret.Get(0).(entity.Player)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, playerName, playerLinkName)
+		r1 = rf(ctx, playerName, mainName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePlayer provides a mock function with given fields: ctx, playerName, class, mainSpec, offSpec, role
+func (_m *PlayerUseCase) UpdatePlayer(ctx context.Context, playerName string, class string, mainSpec string, offSpec string, role string) (entity.Player, error) {
+	ret := _m.Called(ctx, playerName, class, mainSpec, offSpec, role)
+
+	var r0 entity.Player
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) (entity.Player, error)); ok {
+		return rf(ctx, playerName, class, mainSpec, offSpec, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) entity.Player); ok {
+		r0 = rf(ctx, playerName, class, mainSpec, offSpec, role)
+	} else {
+		r0 = ret.Get(0).(entity.Player)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string) error); ok {
+		r1 = rf(ctx, playerName, class, mainSpec, offSpec, role)
 	} else {
 		r1 = ret.Error(1)
 	}
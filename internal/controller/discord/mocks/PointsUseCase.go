@@ -0,0 +1,180 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antony-ramos/guildops/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PointsUseCase is an autogenerated mock type for the PointsUseCase type
+type PointsUseCase struct {
+	mock.Mock
+}
+
+// AwardPoints provides a mock function with given fields: ctx, playerName, amount, reason, author
+func (_m *PointsUseCase) AwardPoints(ctx context.Context, playerName string, amount int, reason string, author string) (entity.PointsEntry, error) {
+	ret := _m.Called(ctx, playerName, amount, reason, author)
+
+	var r0 entity.PointsEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string, string) (entity.PointsEntry, error)); ok {
+		return rf(ctx, playerName, amount, reason, author)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string, string) entity.PointsEntry); ok {
+		r0 = rf(ctx, playerName, amount, reason, author)
+	} else {
+		r0 = ret.Get(0).(entity.PointsEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string, string) error); ok {
+		r1 = rf(ctx, playerName, amount, reason, author)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecayPoints provides a mock function with given fields: ctx, percent, author
+func (_m *PointsUseCase) DecayPoints(ctx context.Context, percent int, author string) ([]entity.PointsEntry, error) {
+	ret := _m.Called(ctx, percent, author)
+
+	var r0 []entity.PointsEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]entity.PointsEntry, error)); ok {
+		return rf(ctx, percent, author)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []entity.PointsEntry); ok {
+		r0 = rf(ctx, percent, author)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PointsEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, percent, author)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStandings provides a mock function with given fields: ctx
+func (_m *PointsUseCase) ListStandings(ctx context.Context) ([]entity.PointsBalance, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.PointsBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.PointsBalance, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.PointsBalance); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PointsBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewDecay provides a mock function with given fields: ctx, percent, author
+func (_m *PointsUseCase) PreviewDecay(ctx context.Context, percent int, author string) ([]entity.PointsEntry, error) {
+	ret := _m.Called(ctx, percent, author)
+
+	var r0 []entity.PointsEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]entity.PointsEntry, error)); ok {
+		return rf(ctx, percent, author)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []entity.PointsEntry); ok {
+		r0 = rf(ctx, percent, author)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PointsEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, percent, author)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadBalance provides a mock function with given fields: ctx, playerName
+func (_m *PointsUseCase) ReadBalance(ctx context.Context, playerName string) (entity.PointsBalance, error) {
+	ret := _m.Called(ctx, playerName)
+
+	var r0 entity.PointsBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.PointsBalance, error)); ok {
+		return rf(ctx, playerName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.PointsBalance); ok {
+		r0 = rf(ctx, playerName)
+	} else {
+		r0 = ret.Get(0).(entity.PointsBalance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, playerName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevertPointsEntry provides a mock function with given fields: ctx, entryID, reason, author
+func (_m *PointsUseCase) RevertPointsEntry(ctx context.Context, entryID int, reason string, author string) (entity.PointsEntry, error) {
+	ret := _m.Called(ctx, entryID, reason, author)
+
+	var r0 entity.PointsEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) (entity.PointsEntry, error)); ok {
+		return rf(ctx, entryID, reason, author)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) entity.PointsEntry); ok {
+		r0 = rf(ctx, entryID, reason, author)
+	} else {
+		r0 = ret.Get(0).(entity.PointsEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string) error); ok {
+		r1 = rf(ctx, entryID, reason, author)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPointsUseCase creates a new instance of PointsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPointsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PointsUseCase {
+	mock := &PointsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,120 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antony-ramos/guildops/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RaidTemplateUseCase is an autogenerated mock type for the RaidTemplateUseCase type
+type RaidTemplateUseCase struct {
+	mock.Mock
+}
+
+// CreateRaidTemplate provides a mock function with given fields: ctx, name, difficulty, weekdays, startTime, timezone
+func (_m *RaidTemplateUseCase) CreateRaidTemplate(ctx context.Context, name string, difficulty string, weekdays string, startTime string, timezone string) (entity.RaidTemplate, error) {
+	ret := _m.Called(ctx, name, difficulty, weekdays, startTime, timezone)
+
+	var r0 entity.RaidTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) (entity.RaidTemplate, error)); ok {
+		return rf(ctx, name, difficulty, weekdays, startTime, timezone)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) entity.RaidTemplate); ok {
+		r0 = rf(ctx, name, difficulty, weekdays, startTime, timezone)
+	} else {
+		r0 = ret.Get(0).(entity.RaidTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string) error); ok {
+		r1 = rf(ctx, name, difficulty, weekdays, startTime, timezone)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRaidTemplate provides a mock function with given fields: ctx, templateID
+func (_m *RaidTemplateUseCase) DeleteRaidTemplate(ctx context.Context, templateID int) error {
+	ret := _m.Called(ctx, templateID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, templateID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GenerateRaids provides a mock function with given fields: ctx, now, weeks
+func (_m *RaidTemplateUseCase) GenerateRaids(ctx context.Context, now time.Time, weeks int) (entity.RaidGeneration, error) {
+	ret := _m.Called(ctx, now, weeks)
+
+	var r0 entity.RaidGeneration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (entity.RaidGeneration, error)); ok {
+		return rf(ctx, now, weeks)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) entity.RaidGeneration); ok {
+		r0 = rf(ctx, now, weeks)
+	} else {
+		r0 = ret.Get(0).(entity.RaidGeneration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, weeks)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRaidTemplates provides a mock function with given fields: ctx
+func (_m *RaidTemplateUseCase) ListRaidTemplates(ctx context.Context) ([]entity.RaidTemplate, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.RaidTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.RaidTemplate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.RaidTemplate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.RaidTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRaidTemplateUseCase creates a new instance of RaidTemplateUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRaidTemplateUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RaidTemplateUseCase {
+	mock := &RaidTemplateUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -17,23 +17,47 @@ type RaidUseCase struct {
 	mock.Mock
 }
 
-// CreateRaid provides a mock function with given fields: ctx, raidName, difficulty, date
-func (_m *RaidUseCase) CreateRaid(ctx context.Context, raidName string, difficulty string, date time.Time) (entity.Raid, error) {
-	ret := _m.Called(ctx, raidName, difficulty, date)
+// CreateRaid provides a mock function with given fields: ctx, raidName, difficulty, date, startTime
+func (_m *RaidUseCase) CreateRaid(ctx context.Context, raidName string, difficulty string, date time.Time, startTime string) (entity.Raid, error) {
+	ret := _m.Called(ctx, raidName, difficulty, date, startTime)
 
 	var r0 entity.Raid
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (entity.Raid, error)); ok {
-		return rf(ctx, raidName, difficulty, date)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, string) (entity.Raid, error)); ok {
+		return rf(ctx, raidName, difficulty, date, startTime)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) entity.Raid); ok {
-		r0 = rf(ctx, raidName, difficulty, date)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, string) entity.Raid); ok {
+		r0 = rf(ctx, raidName, difficulty, date, startTime)
 	} else {
 		r0 = ret.Get(0).(entity.Raid)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
-		r1 = rf(ctx, raidName, difficulty, date)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time, string) error); ok {
+		r1 = rf(ctx, raidName, difficulty, date, startTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRaids provides a mock function with given fields: ctx, raidName, difficulty, dates, startTime
+func (_m *RaidUseCase) CreateRaids(ctx context.Context, raidName string, difficulty string, dates []time.Time, startTime string) (entity.RaidGeneration, error) {
+	ret := _m.Called(ctx, raidName, difficulty, dates, startTime)
+
+	var r0 entity.RaidGeneration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []time.Time, string) (entity.RaidGeneration, error)); ok {
+		return rf(ctx, raidName, difficulty, dates, startTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []time.Time, string) entity.RaidGeneration); ok {
+		r0 = rf(ctx, raidName, difficulty, dates, startTime)
+	} else {
+		r0 = ret.Get(0).(entity.RaidGeneration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []time.Time, string) error); ok {
+		r1 = rf(ctx, raidName, difficulty, dates, startTime)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -69,6 +93,32 @@ func (_m *RaidUseCase) DeleteRaidWithID(ctx context.Context, raidID int) error {
 	return r0
 }
 
+// ListRaids provides a mock function with given fields: ctx, from, to, limit, offset
+func (_m *RaidUseCase) ListRaids(ctx context.Context, from time.Time, to time.Time, limit int, offset int) ([]entity.Raid, error) {
+	ret := _m.Called(ctx, from, to, limit, offset)
+
+	var r0 []entity.Raid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int, int) ([]entity.Raid, error)); ok {
+		return rf(ctx, from, to, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int, int) []entity.Raid); ok {
+		r0 = rf(ctx, from, to, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Raid)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int, int) error); ok {
+		r1 = rf(ctx, from, to, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReadRaid provides a mock function with given fields: ctx, date
 func (_m *RaidUseCase) ReadRaid(ctx context.Context, date time.Time) (entity.Raid, error) {
 	ret := _m.Called(ctx, date)
@@ -93,6 +143,85 @@ func (_m *RaidUseCase) ReadRaid(ctx context.Context, date time.Time) (entity.Rai
 	return r0, r1
 }
 
+// ReadRaidCascade provides a mock function with given fields: ctx, raidID, date, difficulty
+func (_m *RaidUseCase) ReadRaidCascade(ctx context.Context, raidID int, date time.Time, difficulty string) (entity.Raid, entity.Cascade, error) {
+	ret := _m.Called(ctx, raidID, date, difficulty)
+
+	var r0 entity.Raid
+	var r1 entity.Cascade
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, string) (entity.Raid, entity.Cascade, error)); ok {
+		return rf(ctx, raidID, date, difficulty)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, string) entity.Raid); ok {
+		r0 = rf(ctx, raidID, date, difficulty)
+	} else {
+		r0 = ret.Get(0).(entity.Raid)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, string) entity.Cascade); ok {
+		r1 = rf(ctx, raidID, date, difficulty)
+	} else {
+		r1 = ret.Get(1).(entity.Cascade)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, time.Time, string) error); ok {
+		r2 = rf(ctx, raidID, date, difficulty)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReadRaidRoster provides a mock function with given fields: ctx, date, difficulty, filter
+func (_m *RaidUseCase) ReadRaidRoster(ctx context.Context, date time.Time, difficulty string, filter entity.PlayerFilter) (entity.Raid, error) {
+	ret := _m.Called(ctx, date, difficulty, filter)
+
+	var r0 entity.Raid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string, entity.PlayerFilter) (entity.Raid, error)); ok {
+		return rf(ctx, date, difficulty, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string, entity.PlayerFilter) entity.Raid); ok {
+		r0 = rf(ctx, date, difficulty, filter)
+	} else {
+		r0 = ret.Get(0).(entity.Raid)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, string, entity.PlayerFilter) error); ok {
+		r1 = rf(ctx, date, difficulty, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetRaidRoster provides a mock function with given fields: ctx, date, difficulty, roster, removed
+func (_m *RaidUseCase) SetRaidRoster(ctx context.Context, date time.Time, difficulty string, roster map[entity.ParticipantStatus][]string, removed []string) (entity.Raid, error) {
+	ret := _m.Called(ctx, date, difficulty, roster, removed)
+
+	var r0 entity.Raid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string, map[entity.ParticipantStatus][]string, []string) (entity.Raid, error)); ok {
+		return rf(ctx, date, difficulty, roster, removed)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string, map[entity.ParticipantStatus][]string, []string) entity.Raid); ok {
+		r0 = rf(ctx, date, difficulty, roster, removed)
+	} else {
+		r0 = ret.Get(0).(entity.Raid)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, string, map[entity.ParticipantStatus][]string, []string) error); ok {
+		r1 = rf(ctx, date, difficulty, roster, removed)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewRaidUseCase creates a new instance of RaidUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRaidUseCase(t interface {
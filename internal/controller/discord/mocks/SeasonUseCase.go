@@ -0,0 +1,106 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antony-ramos/guildops/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SeasonUseCase is an autogenerated mock type for the SeasonUseCase type
+type SeasonUseCase struct {
+	mock.Mock
+}
+
+// CreateSeason provides a mock function with given fields: ctx, name, start, end
+func (_m *SeasonUseCase) CreateSeason(ctx context.Context, name string, start time.Time, end time.Time) (entity.Season, error) {
+	ret := _m.Called(ctx, name, start, end)
+
+	var r0 entity.Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (entity.Season, error)); ok {
+		return rf(ctx, name, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) entity.Season); ok {
+		r0 = rf(ctx, name, start, end)
+	} else {
+		r0 = ret.Get(0).(entity.Season)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, name, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSeasons provides a mock function with given fields: ctx
+func (_m *SeasonUseCase) ListSeasons(ctx context.Context) ([]entity.Season, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.Season, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Season); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Season)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadSeason provides a mock function with given fields: ctx, name
+func (_m *SeasonUseCase) ReadSeason(ctx context.Context, name string) (entity.Season, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 entity.Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Season, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Season); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(entity.Season)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSeasonUseCase creates a new instance of SeasonUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSeasonUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SeasonUseCase {
+	mock := &SeasonUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,80 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antony-ramos/guildops/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SignupUseCase is an autogenerated mock type for the SignupUseCase type
+type SignupUseCase struct {
+	mock.Mock
+}
+
+// OpenSignup provides a mock function with given fields: ctx, date, difficulty
+func (_m *SignupUseCase) OpenSignup(ctx context.Context, date time.Time, difficulty string) (entity.Raid, error) {
+	ret := _m.Called(ctx, date, difficulty)
+
+	var r0 entity.Raid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string) (entity.Raid, error)); ok {
+		return rf(ctx, date, difficulty)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string) entity.Raid); ok {
+		r0 = rf(ctx, date, difficulty)
+	} else {
+		r0 = ret.Get(0).(entity.Raid)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, string) error); ok {
+		r1 = rf(ctx, date, difficulty)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignUp provides a mock function with given fields: ctx, raidID, discordID, status
+func (_m *SignupUseCase) SignUp(ctx context.Context, raidID int, discordID string, status string) (entity.Raid, error) {
+	ret := _m.Called(ctx, raidID, discordID, status)
+
+	var r0 entity.Raid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) (entity.Raid, error)); ok {
+		return rf(ctx, raidID, discordID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) entity.Raid); ok {
+		r0 = rf(ctx, raidID, discordID, status)
+	} else {
+		r0 = ret.Get(0).(entity.Raid)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string) error); ok {
+		r1 = rf(ctx, raidID, discordID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSignupUseCase creates a new instance of SignupUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSignupUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SignupUseCase {
+	mock := &SignupUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -43,25 +43,49 @@ func (_m *StrikeUseCase) DeleteStrike(ctx context.Context, id int) error {
 	return r0
 }
 
-// ReadStrikes provides a mock function with given fields: ctx, playerName
-func (_m *StrikeUseCase) ReadStrikes(ctx context.Context, playerName string) ([]entity.Strike, error) {
-	ret := _m.Called(ctx, playerName)
+// ReadStrike provides a mock function with given fields: ctx, id
+func (_m *StrikeUseCase) ReadStrike(ctx context.Context, id int) (entity.Strike, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 entity.Strike
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (entity.Strike, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) entity.Strike); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Strike)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadStrikes provides a mock function with given fields: ctx, playerName, season
+func (_m *StrikeUseCase) ReadStrikes(ctx context.Context, playerName string, season string) ([]entity.Strike, error) {
+	ret := _m.Called(ctx, playerName, season)
 
 	var r0 []entity.Strike
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.Strike, error)); ok {
-		return rf(ctx, playerName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]entity.Strike, error)); ok {
+		return rf(ctx, playerName, season)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Strike); ok {
-		r0 = rf(ctx, playerName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []entity.Strike); ok {
+		r0 = rf(ctx, playerName, season)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Strike)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, playerName)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, playerName, season)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,54 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antony-ramos/guildops/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TrashUseCase is an autogenerated mock type for the TrashUseCase type
+type TrashUseCase struct {
+	mock.Mock
+}
+
+// RestoreLast provides a mock function with given fields: ctx
+func (_m *TrashUseCase) RestoreLast(ctx context.Context) (entity.Deleted, error) {
+	ret := _m.Called(ctx)
+
+	var r0 entity.Deleted
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.Deleted, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.Deleted); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.Deleted)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTrashUseCase creates a new instance of TrashUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTrashUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TrashUseCase {
+	mock := &TrashUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antony-ramos/guildops/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistUseCase is an autogenerated mock type for the WishlistUseCase type
+type WishlistUseCase struct {
+	mock.Mock
+}
+
+// AddWish provides a mock function with given fields: ctx, playerName, item, priority, spec, note
+func (_m *WishlistUseCase) AddWish(ctx context.Context, playerName string, item string, priority int, spec string, note string) (entity.Wish, error) {
+	ret := _m.Called(ctx, playerName, item, priority, spec, note)
+
+	var r0 entity.Wish
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string, string) (entity.Wish, error)); ok {
+		return rf(ctx, playerName, item, priority, spec, note)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string, string) entity.Wish); ok {
+		r0 = rf(ctx, playerName, item, priority, spec, note)
+	} else {
+		r0 = ret.Get(0).(entity.Wish)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string, string) error); ok {
+		r1 = rf(ctx, playerName, item, priority, spec, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWishes provides a mock function with given fields: ctx, playerName, item
+func (_m *WishlistUseCase) ListWishes(ctx context.Context, playerName string, item string) ([]entity.Wish, error) {
+	ret := _m.Called(ctx, playerName, item)
+
+	var r0 []entity.Wish
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]entity.Wish, error)); ok {
+		return rf(ctx, playerName, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []entity.Wish); ok {
+		r0 = rf(ctx, playerName, item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Wish)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, playerName, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveWish provides a mock function with given fields: ctx, wishID
+func (_m *WishlistUseCase) RemoveWish(ctx context.Context, wishID int) error {
+	ret := _m.Called(ctx, wishID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, wishID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewWishlistUseCase creates a new instance of WishlistUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistUseCase {
+	mock := &WishlistUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,215 @@
+package discordhandler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+// PageComponent starts the custom ID of the buttons of paginated lists, "guildops-page:<previous|next>".
+const PageComponent = "guildops-page"
+
+// Directions of the page buttons.
+const (
+	pagePrevious = "previous"
+	pageNext     = "next"
+)
+
+// PageSize is the number of lines on a page of a list.
+const PageSize = 15
+
+// PageTTL is how long the buttons of a list keep working after it was last shown.
+const PageTTL = 15 * time.Minute
+
+// PageFetcher returns the lines of a list, at most limit lines after the first offset ones.
+type PageFetcher func(ctx context.Context, limit, offset int) ([]string, error)
+
+// List is a list of lines shown one page at a time, with Previous and Next buttons.
+type List struct {
+	Title string
+	// Empty is the message shown when the list has no line.
+	Empty string
+	Fetch PageFetcher
+}
+
+// listPage is a list shown in a message, at one of its pages.
+type listPage struct {
+	list    List
+	index   int
+	expires time.Time
+}
+
+// Pages keeps the page each paginated list is at, keyed by the ID of its message.
+type Pages struct {
+	mu    sync.Mutex
+	pages map[string]*listPage
+}
+
+// NewPages returns an empty store of pages.
+func NewPages() *Pages {
+	return &Pages{pages: make(map[string]*listPage)}
+}
+
+// InitPageComponents returns the handler of the page buttons, keyed by the start of their custom ID.
+func (d Discord) InitPageComponents() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		PageComponent: d.PageButtonHandler,
+	}
+}
+
+// Show returns the first page of list. When it has more than one, the page is kept
+// once the response is sent so the buttons of its message can browse the list.
+// Without pages, the whole list is shown at once.
+func (p *Pages) Show(ctx context.Context, list List) (*discord.Response, error) {
+	if p == nil {
+		lines, err := list.Fetch(ctx, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(lines) == 0 {
+			return discord.Text(list.Empty), nil
+		}
+		return &discord.Response{Title: list.Title, Description: strings.Join(lines, "\n")}, nil
+	}
+
+	response, hasNext, err := renderPage(ctx, list, 0)
+	if err != nil {
+		return nil, err
+	}
+	if response.Description == "" {
+		return discord.Text(list.Empty), nil
+	}
+	if hasNext {
+		response.Sent = func(messageID string) {
+			p.save(messageID, &listPage{list: list})
+		}
+	}
+	return response, nil
+}
+
+// save keeps the page of the list of a message and forgets expired ones.
+func (p *Pages) save(messageID string, page *listPage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for id, kept := range p.pages {
+		if now.After(kept.expires) {
+			delete(p.pages, id)
+		}
+	}
+	page.expires = now.Add(PageTTL)
+	p.pages[messageID] = page
+}
+
+// load returns the page of the list of a message, if it didn't expire.
+func (p *Pages) load(messageID string) (listPage, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	page, ok := p.pages[messageID]
+	if !ok || time.Now().After(page.expires) {
+		delete(p.pages, messageID)
+		return listPage{}, false
+	}
+	return *page, true
+}
+
+// renderPage returns the page index of a list, with its buttons, and tells if a page follows it.
+func renderPage(ctx context.Context, list List, index int) (*discord.Response, bool, error) {
+	// one more line tells if there is a next page
+	lines, err := list.Fetch(ctx, PageSize+1, index*PageSize)
+	if err != nil {
+		return nil, false, err
+	}
+	hasNext := len(lines) > PageSize
+	if hasNext {
+		lines = lines[:PageSize]
+	}
+
+	response := &discord.Response{
+		Title:       list.Title,
+		Description: strings.Join(lines, "\n"),
+	}
+	if index > 0 || hasNext {
+		response.Footer = "Page " + strconv.Itoa(index+1)
+		response.Components = []discordgo.MessageComponent{discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Previous",
+					Style:    discordgo.SecondaryButton,
+					CustomID: discord.CustomID(PageComponent, pagePrevious),
+					Disabled: index == 0,
+				},
+				discordgo.Button{
+					Label:    "Next",
+					Style:    discordgo.SecondaryButton,
+					CustomID: discord.CustomID(PageComponent, pageNext),
+					Disabled: !hasNext,
+				},
+			},
+		}}
+	}
+	return response, hasNext, nil
+}
+
+// PageButtonHandler shows the previous or next page of the list of the message of the button.
+func (d Discord) PageButtonHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Pagination/PageButtonHandler")
+	defer span.End()
+
+	customID := interaction.MessageComponentData().CustomID
+	args := discord.ComponentArgs(customID)
+	if len(args) != 1 || (args[0] != pagePrevious && args[0] != pageNext) {
+		msg := "Error while changing page: unknown button"
+		return discord.Text(msg), fmt.Errorf("parse custom ID %s", customID)
+	}
+	if interaction.Message == nil || d.Pages == nil {
+		msg := "Error while changing page: unknown list"
+		return discord.Text(msg), fmt.Errorf("no message for custom ID %s", customID)
+	}
+	messageID := interaction.Message.ID
+	span.SetAttributes(
+		attribute.String("direction", args[0]),
+		attribute.String("messageID", messageID),
+	)
+
+	page, ok := d.Pages.load(messageID)
+	if !ok {
+		msg := "Error while changing page: this list expired, use the command again"
+		return discord.Text(msg), fmt.Errorf("no page for message %s", messageID)
+	}
+	index := page.index + 1
+	if args[0] == pagePrevious {
+		index = page.index - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+
+	response, _, err := renderPage(ctx, page.list, index)
+	if err != nil {
+		msg := "Error while changing page: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("fetch page %d of message %s: %w", index, messageID, err)
+	}
+	if response.Description == "" {
+		msg := "Error while changing page: the list changed, use the command again"
+		return discord.Text(msg), fmt.Errorf("page %d of message %s is empty", index, messageID)
+	}
+	d.Pages.save(messageID, &listPage{list: page.list, index: index})
+	return response, nil
+}
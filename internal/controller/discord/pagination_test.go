@@ -0,0 +1,164 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+)
+
+// numbers returns a list of count lines, "* 1" to "* count".
+func numbers(count int) discordHandler.List {
+	return discordHandler.List{
+		Title: "Numbers",
+		Empty: "no number",
+		Fetch: func(ctx context.Context, limit, offset int) ([]string, error) {
+			var lines []string
+			for i := offset + 1; i <= count && (limit == 0 || i <= offset+limit); i++ {
+				lines = append(lines, "* "+strconv.Itoa(i))
+			}
+			return lines, nil
+		},
+	}
+}
+
+// lines returns the lines "* from" to "* to".
+func lines(from, to int) string {
+	list := make([]string, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		list = append(list, "* "+strconv.Itoa(i))
+	}
+	return strings.Join(list, "\n")
+}
+
+// pageButtons returns the page buttons of a response.
+func pageButtons(t *testing.T, components []discordgo.MessageComponent) (discordgo.Button, discordgo.Button) {
+	t.Helper()
+	if !assert.Len(t, components, 1) {
+		t.FailNow()
+	}
+	row := components[0].(discordgo.ActionsRow)
+	if !assert.Len(t, row.Components, 2) {
+		t.FailNow()
+	}
+	return row.Components[0].(discordgo.Button), row.Components[1].(discordgo.Button)
+}
+
+// pageInteraction returns the interaction of a page button of the message messageID.
+func pageInteraction(direction, messageID string) *discordgo.InteractionCreate {
+	interaction := buttonInteraction(discordHandler.PageComponent + ":" + direction)
+	interaction.Message = &discordgo.Message{ID: messageID}
+	return interaction
+}
+
+func TestPages_Show(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Several pages", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := discordHandler.NewPages().Show(context.Background(), numbers(20))
+		assert.NoError(t, err)
+		assert.Equal(t, "Numbers", response.Title)
+		assert.Equal(t, lines(1, discordHandler.PageSize), response.Description)
+		assert.Equal(t, "Page 1", response.Footer)
+		assert.NotNil(t, response.Sent)
+
+		previous, next := pageButtons(t, response.Components)
+		assert.Equal(t, "guildops-page:previous", previous.CustomID)
+		assert.True(t, previous.Disabled)
+		assert.Equal(t, "guildops-page:next", next.CustomID)
+		assert.False(t, next.Disabled)
+	})
+
+	t.Run("Single page", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := discordHandler.NewPages().Show(context.Background(), numbers(discordHandler.PageSize))
+		assert.NoError(t, err)
+		assert.Equal(t, lines(1, discordHandler.PageSize), response.Description)
+		assert.Empty(t, response.Footer)
+		assert.Empty(t, response.Components)
+		assert.Nil(t, response.Sent)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := discordHandler.NewPages().Show(context.Background(), numbers(0))
+		assert.NoError(t, err)
+		assert.Equal(t, "no number", response.Description)
+		assert.Empty(t, response.Components)
+	})
+
+	t.Run("Without pages", func(t *testing.T) {
+		t.Parallel()
+
+		var pages *discordHandler.Pages
+		response, err := pages.Show(context.Background(), numbers(20))
+		assert.NoError(t, err)
+		assert.Equal(t, lines(1, 20), response.Description)
+		assert.Empty(t, response.Components)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		list := numbers(20)
+		list.Fetch = func(ctx context.Context, limit, offset int) ([]string, error) {
+			return nil, errors.New("backend: unavailable")
+		}
+		_, err := discordHandler.NewPages().Show(context.Background(), list)
+		assert.Error(t, err)
+	})
+}
+
+func TestDiscord_PageButtonHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Next and previous", func(t *testing.T) {
+		t.Parallel()
+		discord := discordHandler.Discord{Pages: discordHandler.NewPages()}
+
+		response, err := discord.Pages.Show(context.Background(), numbers(20))
+		assert.NoError(t, err)
+		response.Sent("1234")
+
+		response, err = discord.PageButtonHandler(context.Background(), pageInteraction("next", "1234"))
+		assert.NoError(t, err)
+		assert.Equal(t, lines(discordHandler.PageSize+1, 20), response.Description)
+		assert.Equal(t, "Page 2", response.Footer)
+		previous, next := pageButtons(t, response.Components)
+		assert.False(t, previous.Disabled)
+		assert.True(t, next.Disabled)
+
+		response, err = discord.PageButtonHandler(context.Background(), pageInteraction("previous", "1234"))
+		assert.NoError(t, err)
+		assert.Equal(t, lines(1, discordHandler.PageSize), response.Description)
+		assert.Equal(t, "Page 1", response.Footer)
+	})
+
+	t.Run("Unknown message", func(t *testing.T) {
+		t.Parallel()
+		discord := discordHandler.Discord{Pages: discordHandler.NewPages()}
+
+		response, err := discord.PageButtonHandler(context.Background(), pageInteraction("next", "1234"))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while changing page: this list expired, use the command again", response.Description)
+	})
+
+	t.Run("Unknown button", func(t *testing.T) {
+		t.Parallel()
+		discord := discordHandler.Discord{Pages: discordHandler.NewPages()}
+
+		response, err := discord.PageButtonHandler(context.Background(), pageInteraction("last", "1234"))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while changing page: unknown button", response.Description)
+	})
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -68,25 +70,149 @@ var PlayerDescriptors = []discordgo.ApplicationCommand{
 		Name:        "guildops-player-info",
 		Description: "Show info about yourself",
 	},
+	{
+		Name:        "guildops-player-update",
+		Description: "Set the class, specs and role of a player",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "name",
+				Description: "ex: Milowenn",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "class",
+				Description: "changing the class clears specs and role",
+				Required:    false,
+				Choices:     classChoices(),
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "main-spec",
+				Description: "ex: holy",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "off-spec",
+				Description: "ex: retribution",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "role",
+				Description: "role of the main spec by default",
+				Required:    false,
+				Choices:     roleChoices(),
+			},
+		},
+	},
+	{
+		Name:        "guildops-player-alt",
+		Description: "Make a player the alt of a main",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "name",
+				Description: "ex: Milowenn",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "main",
+				Description: "leave empty to make the player a main again",
+				Required:    false,
+			},
+		},
+	},
+}
+
+// classChoices returns the known classes, sorted by name.
+func classChoices() []*discordgo.ApplicationCommandOptionChoice {
+	names := make([]string, 0, len(entity.Classes))
+	for name := range entity.Classes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(names))
+	for _, name := range names {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: name, Value: name})
+	}
+	return choices
 }
 
-func (d Discord) InitPlayer() map[string]func(
-	ctx context.Context, interaction *discordgo.InteractionCreate) (string, error) {
-	return map[string]func(ctx context.Context, interaction *discordgo.InteractionCreate) (string, error){
+// roleChoices returns the roles a player can have.
+func roleChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(entity.Roles))
+	for _, role := range entity.Roles {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: string(role), Value: string(role)})
+	}
+	return choices
+}
+
+// playerFilterOptions returns the options of commands listing players which can be filtered
+// by class, spec or role. They are read by playerFilter.
+func playerFilterOptions() []*discordgo.ApplicationCommandOption {
+	return []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "class",
+			Description: "only players of this class",
+			Required:    false,
+			Choices:     classChoices(),
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "spec",
+			Description: "only players with this main or off spec, ex: holy",
+			Required:    false,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "role",
+			Description: "only players with this role",
+			Required:    false,
+			Choices:     roleChoices(),
+		},
+	}
+}
+
+// playerFilter returns the filter set by the options of playerFilterOptions.
+func playerFilter(
+	optionMap map[string]*discordgo.ApplicationCommandInteractionDataOption,
+) (entity.PlayerFilter, error) {
+	var class, spec, role string
+	if opt, ok := optionMap["class"]; ok {
+		class = opt.StringValue()
+	}
+	if opt, ok := optionMap["spec"]; ok {
+		spec = opt.StringValue()
+	}
+	if opt, ok := optionMap["role"]; ok {
+		role = opt.StringValue()
+	}
+	return entity.NewPlayerFilter(class, spec, role)
+}
+
+func (d Discord) InitPlayer() map[string]discord.Handler {
+	return map[string]discord.Handler{
 		"guildops-player-create": d.PlayerHandler,
-		"guildops-player-delete": d.PlayerHandler,
+		"guildops-player-delete": d.DeletePlayerHandler,
 		"guildops-player-get":    d.GetPlayerHandler,
 		"guildops-player-link":   d.LinkPlayerHandler,
 		"guildops-player-info":   d.GetPlayerHandler,
+		"guildops-player-update": d.UpdatePlayerHandler,
+		"guildops-player-alt":    d.SetMainHandler,
 	}
 }
 
-// PlayerHandler call an usecase to create or delete a player
+// PlayerHandler call an usecase to create a player
 // and return a message to the user.
 // It requires a player name field to be passed in the interaction.
 func (d Discord) PlayerHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -111,34 +237,66 @@ func (d Discord) PlayerHandler(
 		if err != nil {
 			alreadyExists := regexp.MustCompile(".*player already exists.*")
 			if alreadyExists.MatchString(err.Error()) {
-				return "Player " + strings.ToLower(name) + " already exists", err
+				return discord.Text("Player " + strings.ToLower(name) + " already exists"), err
 			}
 			msg := "Error while creating player: " + HumanReadableError(err)
-			return msg, fmt.Errorf("call create player usecase : %w", err)
+			return discord.Text(msg), fmt.Errorf("call create player usecase : %w", err)
 		}
-		return "Player " + strings.ToLower(name) + " created successfully: ID " + strconv.Itoa(playerID), nil
+		return discord.Success("Player " + strings.ToLower(name) + " created successfully: ID " + strconv.Itoa(playerID)), nil
+	}
+
+	return discord.Text("error while handling player command"), nil
+}
+
+// DeletePlayerHandler call an usecase to find a player and what is removed along with it,
+// and asks the user to confirm before deleting them.
+// It requires a player name field to be passed in the interaction.
+func (d Discord) DeletePlayerHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Player/DeletePlayerHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	name := optionMap["name"].StringValue()
+	span.SetAttributes(
+		attribute.String("player", name),
+	)
+
+	player, cascade, err := d.ReadPlayerCascade(ctx, name)
+	if err != nil {
+		msg := "Error while deleting player: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call read player cascade usecase: %w", err)
 	}
 
-	if interaction.ApplicationCommandData().Name == "guildops-player-delete" {
-		err := d.DeletePlayer(ctx, name)
+	summary := "Delete player " + player.Name + "? It also removes:\n" + cascade.String()
+	return d.Confirmations.Ask(ctx, interaction, summary, func(ctx context.Context) (string, error) {
+		err := d.DeletePlayer(ctx, player.Name)
 		if err != nil {
 			msg := "Error while deleting player: " + HumanReadableError(err)
 			return msg, fmt.Errorf("call delete player usecase: %w", err)
-		} else {
-			return "Player " + strings.ToLower(name) + " deleted successfully", nil
 		}
-	}
-
-	return "error while handling player command", nil
+		return "Player " + player.Name + " deleted successfully", nil
+	})
 }
 
 // GetPlayerHandler call an usecase to get player infos
-// and return a message to the user.
+// and return them to the user, one field for each kind of info.
 // It requires a player name field to be passed in the interaction for admin
 // Or it will catch infos about the user who called the command.
 func (d Discord) GetPlayerHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -157,7 +315,7 @@ func (d Discord) GetPlayerHandler(
 	player, err := func() (entity.Player, error) {
 		switch interaction.ApplicationCommandData().Name {
 		case "guildops-player-info":
-			return d.ReadPlayer(ctx, "", interaction.Member.User.Username)
+			return d.ReadPlayer(ctx, "", interaction.Member.User.ID)
 		default:
 			name := optionMap["name"].StringValue()
 			span.SetAttributes(
@@ -167,61 +325,102 @@ func (d Discord) GetPlayerHandler(
 	}()
 	if err != nil {
 		msg := "Error while getting player infos: " + HumanReadableError(err)
-		return msg, fmt.Errorf("call read player usecase : %w", err)
+		return discord.Text(msg), fmt.Errorf("call read player usecase : %w", err)
 	}
 
-	msg := "Name : **" + player.Name + "**\n"
-	msg += "ID : **" + strconv.Itoa(player.ID) + "**\n"
+	// Players see their own infos alone
+	response := &discord.Response{
+		Title:     player.Name,
+		Ephemeral: interaction.ApplicationCommandData().Name == "guildops-player-info",
+		Fields:    []discord.Field{{Name: "ID", Value: strconv.Itoa(player.ID), Inline: true}},
+	}
 	if player.DiscordName != "" {
-		msg += "Discord ID : **" + player.DiscordName + "**\n"
+		response.Fields = append(response.Fields,
+			discord.Field{Name: "Discord Name", Value: player.DiscordName, Inline: true})
+	}
+	if class := player.ClassString(); class != "" {
+		response.Fields = append(response.Fields, discord.Field{Name: "Class", Value: class, Inline: true})
+	}
+	if player.IsAlt() {
+		response.Fields = append(response.Fields, discord.Field{Name: "Alt of", Value: player.Main().Name, Inline: true})
+	}
+
+	characters := make([]string, 0, len(player.Characters))
+	for _, character := range player.Characters {
+		line := "* " + character.Name
+		if !character.IsAlt() {
+			line += " (main)"
+		}
+		if class := character.ClassString(); class != "" {
+			line += " | " + class
+		}
+		characters = append(characters, line+" | "+strconv.Itoa(len(character.Loots))+" loots")
 	}
+	response.Fields = appendListField(response.Fields, "Characters", characters)
 
 	lootCounter := make(map[string]int)
 	for _, loot := range player.Loots {
 		lootCounter[loot.Raid.Difficulty]++
 	}
-	if len(lootCounter) > 0 {
-		msg += "**Loots Count:**\n"
-		for difficulty, count := range lootCounter {
-			msg += "*  " + difficulty + " | " + strconv.Itoa(count) + " loots \n"
-		}
+	difficulties := make([]string, 0, len(lootCounter))
+	for difficulty := range lootCounter {
+		difficulties = append(difficulties, difficulty)
+	}
+	sort.Strings(difficulties)
+	counts := make([]string, 0, len(difficulties))
+	for _, difficulty := range difficulties {
+		counts = append(counts, "* "+difficulty+" | "+strconv.Itoa(lootCounter[difficulty])+" loots")
+	}
+	if len(counts) > 0 {
+		response.Fields = append(response.Fields, discord.Field{Name: "Loots Count", Value: strings.Join(counts, "\n")})
 	}
 
-	if len(player.Strikes) > 0 {
-		msg += "**Strikes (" + strconv.Itoa(len(player.Strikes)) + ") :**\n"
-		for _, strike := range player.Strikes {
-			msg += "*  " + strike.Date.Format("02/01/06") +
-				" | " + strike.Reason + " | " + strike.Season + " | " + strconv.Itoa(strike.ID) + "\n"
+	activeStrikes, expiredStrikes := splitStrikes(player.Strikes, d.now())
+	for _, strikes := range []struct {
+		name    string
+		strikes []entity.Strike
+	}{
+		{"Strikes", activeStrikes},
+		{"Expired strikes", expiredStrikes},
+	} {
+		lines := make([]string, 0, len(strikes.strikes))
+		for _, strike := range strikes.strikes {
+			lines = append(lines, "* "+strike.Date.Format("02/01/06")+
+				" | "+strike.Reason+" | "+strike.Season+" | "+strconv.Itoa(strike.ID))
 		}
+		response.Fields = appendListField(response.Fields, strikes.name, lines)
 	}
 
-	if len(player.MissedRaids) > 0 {
-		msg += "**Absences (" + strconv.Itoa(len(player.MissedRaids)) + ") :**\n"
-		for _, raid := range player.MissedRaids {
-			msg += "*  " + raid.Date.Format("02/01/06") +
-				" | " + raid.Difficulty +
-				" | " + raid.Name + "\n"
-		}
+	absences := make([]string, 0, len(player.MissedRaids))
+	for _, raid := range player.MissedRaids {
+		absences = append(absences, "* "+raid.Date.Format("02/01/06")+" | "+raid.Difficulty+" | "+raid.Name)
 	}
+	response.Fields = appendListField(response.Fields, "Absences", absences)
 
-	if len(player.Loots) > 0 {
-		msg += "**Loots (" + strconv.Itoa(len(player.Loots)) + ") :**\n"
-		for _, loot := range player.Loots {
-			msg += "*  " + loot.Raid.Date.Format("02/01/06") +
-				" | " + loot.Raid.Difficulty +
-				" | " + loot.Name + "\n"
-		}
+	loots := make([]string, 0, len(player.Loots))
+	for _, loot := range player.Loots {
+		loots = append(loots, "* "+loot.Raid.Date.Format("02/01/06")+" | "+loot.Raid.Difficulty+" | "+lootItemName(loot))
 	}
+	response.Fields = appendListField(response.Fields, "Loots", loots)
 
-	if len(player.Fails) > 0 {
-		msg += "**Fails (" + strconv.Itoa(len(player.Fails)) + ") :**\n"
-		for _, fail := range player.Fails {
-			msg += "*  " + fail.Raid.Date.Format("02/01/06") +
-				" | " + fail.Reason + "\n"
-		}
+	fails := make([]string, 0, len(player.Fails))
+	for _, fail := range player.Fails {
+		fails = append(fails, "* "+fail.Raid.Date.Format("02/01/06")+" | "+fail.Reason)
 	}
+	response.Fields = appendListField(response.Fields, "Fails", fails)
 
-	return msg, nil
+	return response, nil
+}
+
+// appendListField appends a field listing lines, titled with their count. Nothing is appended without lines.
+func appendListField(fields []discord.Field, name string, lines []string) []discord.Field {
+	if len(lines) == 0 {
+		return fields
+	}
+	return append(fields, discord.Field{
+		Name:  name + " (" + strconv.Itoa(len(lines)) + ")",
+		Value: strings.Join(lines, "\n"),
+	})
 }
 
 // LinkPlayerHandler call an usecase to link a discord account to a player name
@@ -229,7 +428,7 @@ func (d Discord) GetPlayerHandler(
 // It requires a player name field to be passed in the interaction.
 func (d Discord) LinkPlayerHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -245,21 +444,119 @@ func (d Discord) LinkPlayerHandler(
 		optionMap[opt.Name] = opt
 	}
 	playerName := optionMap["name"].StringValue()
+	discordID := interaction.Member.User.ID
 	discordName := interaction.Member.User.Username
 	span.SetAttributes(
 		attribute.String("player", playerName),
+		attribute.String("discord_id", discordID),
 		attribute.String("discord_name", discordName),
 	)
 
-	err := d.LinkPlayer(ctx, playerName, discordName)
+	player, err := d.LinkPlayer(ctx, playerName, discordID, discordName)
 	if err != nil {
 		msg := "Error while linking player: " + HumanReadableError(err)
-		return msg, fmt.Errorf("call link player usecase : %w", err)
+		return discord.Text(msg), fmt.Errorf("call link player usecase : %w", err)
+	}
+
+	response := &discord.Response{
+		Title:  "You are now linked to " + player.Name,
+		Colour: discord.ColourSuccess,
+		Fields: []discord.Field{{Name: "Name", Value: player.Name, Inline: true}},
 	}
+	if player.IsAlt() {
+		response.Fields = append(response.Fields, discord.Field{Name: "Alt of", Value: player.Main().Name, Inline: true})
+	}
+	response.Fields = append(response.Fields,
+		discord.Field{Name: "Discord Name", Value: strings.ToLower(discordName), Inline: true})
+	return response, nil
+}
 
-	msg := "You are now linked to this player : \n"
-	msg += "Name : **" + strings.ToLower(playerName) + "**\n"
-	msg += "Discord Name : **" + strings.ToLower(discordName) + "**\n"
+// UpdatePlayerHandler call an usecase to set the class, specs and role of a player
+// and return a message to the user.
+// It requires a player name field to be passed in the interaction.
+// Optional 'class', 'main-spec', 'off-spec' and 'role' fields can be passed.
+func (d Discord) UpdatePlayerHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
 
-	return msg, nil
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Player/UpdatePlayerHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	name := optionMap["name"].StringValue()
+	values := make(map[string]string)
+	for _, option := range []string{"class", "main-spec", "off-spec", "role"} {
+		if opt, ok := optionMap[option]; ok {
+			values[option] = opt.StringValue()
+		}
+	}
+	span.SetAttributes(
+		attribute.String("player", name),
+		attribute.String("class", values["class"]),
+		attribute.String("main_spec", values["main-spec"]),
+		attribute.String("off_spec", values["off-spec"]),
+		attribute.String("role", values["role"]),
+	)
+
+	player, err := d.UpdatePlayer(ctx, name, values["class"], values["main-spec"], values["off-spec"], values["role"])
+	if err != nil {
+		msg := "Error while updating player: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call update player usecase: %w", err)
+	}
+	class := player.ClassString()
+	if class == "" {
+		class = "no class"
+	}
+	return discord.Success("Player " + player.Name + " updated : " + class), nil
+}
+
+// SetMainHandler call an usecase to make a player the alt of a main, or a main again,
+// and return a message to the user.
+// It requires a player name field to be passed in the interaction.
+// An optional 'main' field can be passed.
+func (d Discord) SetMainHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Player/SetMainHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	name := optionMap["name"].StringValue()
+	var main string
+	if opt, ok := optionMap["main"]; ok {
+		main = opt.StringValue()
+	}
+	span.SetAttributes(
+		attribute.String("player", name),
+		attribute.String("main", main),
+	)
+
+	player, err := d.SetMain(ctx, name, main)
+	if err != nil {
+		msg := "Error while setting main of player: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call set main usecase: %w", err)
+	}
+	if !player.IsAlt() {
+		return discord.Success("Player " + player.Name + " is now a main"), nil
+	}
+	return discord.Success("Player " + player.Name + " is now an alt of " + player.Main().Name), nil
 }
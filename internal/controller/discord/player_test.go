@@ -57,55 +57,94 @@ func TestDiscord_PlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.PlayerHandler(context.Background(), interaction)
+		response, err := discord.PlayerHandler(context.Background(), interaction)
 		mockPlayerUseCase.AssertExpectations(t)
-		assert.Equal(t, msg, "Player testplayer created successfully: ID 1")
+		assert.Equal(t, response.Description, "Player testplayer created successfully: ID 1")
 		assert.NoError(t, err)
 	})
+}
+
+func TestDiscord_DeletePlayerHandler(t *testing.T) {
+	t.Parallel()
+
+	interaction := &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			Type: discordgo.InteractionApplicationCommand,
+			Member: &discordgo.Member{
+				User: &discordgo.User{
+					ID:       "100000000000000001",
+					Username: "test",
+				},
+			},
+			Data: discordgo.ApplicationCommandInteractionData{
+				ID:       "mock",
+				Name:     "guildops-player-delete",
+				TargetID: "mock",
+				Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
+				Options: []*discordgo.ApplicationCommandInteractionDataOption{
+					{
+						Name:  "name",
+						Type:  discordgo.ApplicationCommandOptionString,
+						Value: "TestPlayer",
+					},
+				},
+			},
+		},
+	}
 
-	t.Run("Success Delete Player", func(t *testing.T) {
+	t.Run("Ask for confirmation", func(t *testing.T) {
 		t.Parallel()
 		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
 
 		discord := discordHandler.Discord{
-			AbsenceUseCase: nil,
-			PlayerUseCase:  mockPlayerUseCase,
-			StrikeUseCase:  nil,
-			LootUseCase:    nil,
-			RaidUseCase:    nil,
+			PlayerUseCase: mockPlayerUseCase,
+			Confirmations: discordHandler.NewConfirmations(),
 		}
 
-		mockPlayerUseCase.On("DeletePlayer", mock.Anything, mock.Anything).
-			Return(nil)
+		mockPlayerUseCase.On("ReadPlayerCascade", mock.Anything, "TestPlayer").
+			Return(entity.Player{ID: 1, Name: "testplayer"}, entity.Cascade{Strikes: 2, Loots: 1}, nil)
 
-		interaction := &discordgo.InteractionCreate{
-			Interaction: &discordgo.Interaction{
-				Type: discordgo.InteractionApplicationCommand,
-				Member: &discordgo.Member{
-					User: &discordgo.User{
-						Username: "test",
-					},
-				},
-				Data: discordgo.ApplicationCommandInteractionData{
-					ID:       "mock",
-					Name:     "guildops-player-delete",
-					TargetID: "mock",
-					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
-					Options: []*discordgo.ApplicationCommandInteractionDataOption{
-						{
-							Name:  "name",
-							Type:  discordgo.ApplicationCommandOptionString,
-							Value: "TestPlayer",
-						},
-					},
-				},
-			},
+		response, err := discord.DeletePlayerHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.True(t, response.Ephemeral)
+		assert.Equal(t, "Delete player testplayer? It also removes:\n* 2 strikes\n* 1 loot", response.Description)
+		assert.Len(t, response.Components, 1)
+		mockPlayerUseCase.AssertNotCalled(t, "DeletePlayer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Without confirmations", func(t *testing.T) {
+		t.Parallel()
+		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+
+		discord := discordHandler.Discord{
+			PlayerUseCase: mockPlayerUseCase,
 		}
 
-		msg, err := discord.PlayerHandler(context.Background(), interaction)
-		assert.Equal(t, msg, "Player testplayer deleted successfully")
-		mockPlayerUseCase.AssertExpectations(t)
+		mockPlayerUseCase.On("ReadPlayerCascade", mock.Anything, "TestPlayer").
+			Return(entity.Player{ID: 1, Name: "testplayer"}, entity.Cascade{}, nil)
+		mockPlayerUseCase.On("DeletePlayer", mock.Anything, "testplayer").
+			Return(nil)
+
+		response, err := discord.DeletePlayerHandler(context.Background(), interaction)
 		assert.NoError(t, err)
+		assert.Equal(t, "Player testplayer deleted successfully", response.Description)
+	})
+
+	t.Run("Unknown player", func(t *testing.T) {
+		t.Parallel()
+		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+
+		discord := discordHandler.Discord{
+			PlayerUseCase: mockPlayerUseCase,
+			Confirmations: discordHandler.NewConfirmations(),
+		}
+
+		mockPlayerUseCase.On("ReadPlayerCascade", mock.Anything, "TestPlayer").
+			Return(entity.Player{}, entity.Cascade{}, errors.New("player not found"))
+
+		response, err := discord.DeletePlayerHandler(context.Background(), interaction)
+		assert.Error(t, err)
+		assert.Empty(t, response.Components)
 	})
 }
 
@@ -214,25 +253,25 @@ func TestDiscord_GetPlayerHandler(t *testing.T) {
 			},
 		}
 
-		exceptMsg := `Name : **TestPlayer**
-ID : **1**
-Discord ID : **TestDiscordName**
-**Loots Count:**
-*  TestDifficulty | 1 loots 
-**Strikes (2) :**
-*  ` + time.Now().Format("02/01/06") + ` | TestReason | DF/S2 | 1
-*  ` + time.Now().Format("02/01/06") + ` | TestReason | DF/S2 | 1
-**Absences (1) :**
-*  ` + time.Now().Format("02/01/06") + ` | TestDifficulty | TestRaid
-**Loots (1) :**
-*  ` + time.Now().Format("02/01/06") + ` | TestDifficulty | TestLoot
-**Fails (1) :**
-*  ` + time.Now().Format("02/01/06") + ` | TestReason
-`
-		msg, err := discord.GetPlayerHandler(context.Background(), interaction)
+		today := time.Now().Format("02/01/06")
+		response, err := discord.GetPlayerHandler(context.Background(), interaction)
 		mockPlayerUseCase.AssertExpectations(t)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, exceptMsg)
+		assert.Equal(t, "TestPlayer", response.Title)
+		assert.False(t, response.Ephemeral)
+		fields := make([]string, 0, len(response.Fields))
+		for _, field := range response.Fields {
+			fields = append(fields, field.Name+"\n"+field.Value)
+		}
+		assert.Equal(t, []string{
+			"ID\n1",
+			"Discord Name\nTestDiscordName",
+			"Loots Count\n* TestDifficulty | 1 loots",
+			"Strikes (2)\n* " + today + " | TestReason | DF/S2 | 1\n* " + today + " | TestReason | DF/S2 | 1",
+			"Absences (1)\n* " + today + " | TestDifficulty | TestRaid",
+			"Loots (1)\n* " + today + " | TestDifficulty | TestLoot",
+			"Fails (1)\n* " + today + " | TestReason",
+		}, fields)
 	})
 
 	t.Run("Player doesnt exist", func(t *testing.T) {
@@ -274,10 +313,179 @@ Discord ID : **TestDiscordName**
 			},
 		}
 
-		msg, err := discord.GetPlayerHandler(context.Background(), interaction)
+		response, err := discord.GetPlayerHandler(context.Background(), interaction)
 
 		mockPlayerUseCase.AssertExpectations(t)
 		assert.Error(t, err)
-		assert.Equal(t, msg, "Error while getting player infos: player not found")
+		assert.Equal(t, "Error while getting player infos: player not found", response.Description)
+	})
+}
+
+func TestDiscord_GetPlayerHandler_Own(t *testing.T) {
+	t.Parallel()
+
+	mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+
+	discord := discordHandler.Discord{
+		PlayerUseCase: mockPlayerUseCase,
+	}
+
+	// Players read their own infos through the ID of their discord user, not their username
+	mockPlayerUseCase.On("ReadPlayer", mock.Anything, "", "100000000000000001").
+		Return(entity.Player{ID: 1, Name: "thrall", DiscordID: "100000000000000001", DiscordName: "thrall"}, nil)
+
+	response, err := discord.GetPlayerHandler(context.Background(), commandInteraction("guildops-player-info"))
+	assert.NoError(t, err)
+	assert.Equal(t, "thrall", response.Title)
+	assert.True(t, response.Ephemeral)
+	assert.Len(t, response.Fields, 2)
+	mockPlayerUseCase.AssertExpectations(t)
+}
+
+func TestDiscord_UpdatePlayerHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+
+		discord := discordHandler.Discord{
+			PlayerUseCase: mockPlayerUseCase,
+		}
+
+		mockPlayerUseCase.On("UpdatePlayer", mock.Anything, "uther", "paladin", "holy", "", "").
+			Return(entity.Player{Name: "uther", Class: "paladin", MainSpec: "holy", Role: entity.RoleHealer}, nil)
+
+		interaction := commandInteraction("guildops-player-update",
+			stringOption("name", "uther"),
+			stringOption("class", "paladin"),
+			stringOption("main-spec", "holy"),
+		)
+
+		response, err := discord.UpdatePlayerHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "Player uther updated : paladin holy, healer", response.Description)
+		mockPlayerUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+
+		discord := discordHandler.Discord{
+			PlayerUseCase: mockPlayerUseCase,
+		}
+
+		mockPlayerUseCase.On("UpdatePlayer", mock.Anything, "uther", "", "", "", "healer").
+			Return(entity.Player{}, errors.New("set class of player: class is required to set a spec"))
+
+		interaction := commandInteraction("guildops-player-update",
+			stringOption("name", "uther"),
+			stringOption("role", "healer"),
+		)
+
+		response, err := discord.UpdatePlayerHandler(context.Background(), interaction)
+		assert.Error(t, err)
+		assert.Equal(t, "Error while updating player: class is required to set a spec", response.Description)
+	})
+}
+
+func TestDiscord_LinkPlayerHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Linked as an alt", func(t *testing.T) {
+		t.Parallel()
+		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+
+		discord := discordHandler.Discord{
+			PlayerUseCase: mockPlayerUseCase,
+		}
+
+		arthas := entity.Player{ID: 1, Name: "arthas"}
+		lichking := entity.Player{ID: 2, Name: "lichking", MainID: 1}
+		lichking.Characters = []entity.Player{arthas, lichking}
+		mockPlayerUseCase.On("LinkPlayer", mock.Anything, "LichKing", "100000000000000001", "thrall").
+			Return(lichking, nil)
+
+		interaction := commandInteraction("guildops-player-link",
+			stringOption("name", "LichKing"),
+		)
+
+		response, err := discord.LinkPlayerHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "You are now linked to lichking\n\n"+
+			"Name\nlichking\n\nAlt of\narthas\n\nDiscord Name\nthrall\n", response.String())
+		mockPlayerUseCase.AssertExpectations(t)
+	})
+}
+
+func TestDiscord_SetMainHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Alt", func(t *testing.T) {
+		t.Parallel()
+		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+
+		discord := discordHandler.Discord{
+			PlayerUseCase: mockPlayerUseCase,
+		}
+
+		arthas := entity.Player{ID: 1, Name: "arthas"}
+		lichking := entity.Player{ID: 2, Name: "lichking", MainID: 1}
+		lichking.Characters = []entity.Player{arthas, lichking}
+		mockPlayerUseCase.On("SetMain", mock.Anything, "lichking", "arthas").Return(lichking, nil)
+
+		interaction := commandInteraction("guildops-player-alt",
+			stringOption("name", "lichking"),
+			stringOption("main", "arthas"),
+		)
+
+		response, err := discord.SetMainHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "Player lichking is now an alt of arthas", response.Description)
+		mockPlayerUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Main again", func(t *testing.T) {
+		t.Parallel()
+		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+
+		discord := discordHandler.Discord{
+			PlayerUseCase: mockPlayerUseCase,
+		}
+
+		mockPlayerUseCase.On("SetMain", mock.Anything, "lichking", "").
+			Return(entity.Player{ID: 2, Name: "lichking"}, nil)
+
+		interaction := commandInteraction("guildops-player-alt",
+			stringOption("name", "lichking"),
+		)
+
+		response, err := discord.SetMainHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "Player lichking is now a main", response.Description)
+		mockPlayerUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+		mockPlayerUseCase := mocks.NewPlayerUseCase(t)
+
+		discord := discordHandler.Discord{
+			PlayerUseCase: mockPlayerUseCase,
+		}
+
+		mockPlayerUseCase.On("SetMain", mock.Anything, "jaina", "arthas").
+			Return(entity.Player{}, errors.New("set main: player jaina has alts, it can't become an alt"))
+
+		interaction := commandInteraction("guildops-player-alt",
+			stringOption("name", "jaina"),
+			stringOption("main", "arthas"),
+		)
+
+		response, err := discord.SetMainHandler(context.Background(), interaction)
+		assert.Error(t, err)
+		assert.Equal(t, "Error while setting main of player: player jaina has alts, it can't become an alt",
+			response.Description)
 	})
 }
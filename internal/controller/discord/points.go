@@ -0,0 +1,320 @@
+package discordhandler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+// balanceEntries is how many entries of the ledger of a player are shown, latest first.
+const balanceEntries = 15
+
+var PointsDescriptors = []discordgo.ApplicationCommand{
+	{
+		Name:        "guildops-points-balance",
+		Description: "Show the points of a player and their last ledger entries",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "player-name",
+				Description: "(ex: arthas)",
+				Required:    true,
+			},
+		},
+	},
+	{
+		Name:        "guildops-points-award",
+		Description: "Give points to a player, or take them back with a negative amount",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "player-name",
+				Description: "(ex: arthas)",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "amount",
+				Description: "(ex: 10, -5)",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "reason",
+				Description: "(ex: first kill)",
+				Required:    true,
+			},
+		},
+	},
+	{
+		Name:        "guildops-points-standings",
+		Description: "List players by points",
+	},
+	{
+		Name:        "guildops-points-revert",
+		Description: "Undo an entry of the points ledger",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "id",
+				Description: "(ex: 42)",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "reason",
+				Description: "(ex: wrong player)",
+				Required:    false,
+			},
+		},
+	},
+	{
+		Name:        "guildops-points-decay",
+		Description: "Remove a share of every positive balance",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "percent",
+				Description: "decay of the guild by default, ex: 10",
+				Required:    false,
+			},
+		},
+	},
+}
+
+func (d Discord) InitPoints() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		"guildops-points-balance":   d.PointsBalanceHandler,
+		"guildops-points-award":     d.AwardPointsHandler,
+		"guildops-points-standings": d.PointsStandingsHandler,
+		"guildops-points-revert":    d.RevertPointsHandler,
+		"guildops-points-decay":     d.DecayPointsHandler,
+	}
+}
+
+// pointsEntryLine returns an entry of the ledger as shown to users.
+func pointsEntryLine(entry entity.PointsEntry, reversed bool) string {
+	line := fmt.Sprintf("#%d %s %+d %s : %s (by %s)",
+		entry.ID, entry.Date.Format("02/01/06"), entry.Amount, entry.Kind, entry.Reason, entry.Author)
+	if reversed {
+		line += " (reverted)"
+	}
+	return line
+}
+
+// PointsBalanceHandler call an usecase to get the ledger of a player
+// and return their points with their last entries.
+func (d Discord) PointsBalanceHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Points/PointsBalanceHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	playerName := optionMap["player-name"].StringValue()
+	span.SetAttributes(
+		attribute.String("player_name", playerName),
+	)
+
+	balance, err := d.ReadBalance(ctx, playerName)
+	if err != nil {
+		msg := "Error while reading points: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call read balance usecase: %w", err)
+	}
+
+	response := &discord.Response{Title: fmt.Sprintf("%s has %d points", balance.Player.Name, balance.Points)}
+	reversed := entity.Reversed(balance.Entries)
+	lines := make([]string, 0, balanceEntries)
+	for i := len(balance.Entries) - 1; i >= 0 && i >= len(balance.Entries)-balanceEntries; i-- {
+		lines = append(lines, "* "+pointsEntryLine(balance.Entries[i], reversed[balance.Entries[i].ID]))
+	}
+	if len(lines) > 0 {
+		response.Fields = []discord.Field{{Name: "Last entries", Value: strings.Join(lines, "\n")}}
+	}
+	return response, nil
+}
+
+// AwardPointsHandler call an usecase to give points to a player
+// and return a message to the user.
+func (d Discord) AwardPointsHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Points/AwardPointsHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	playerName := optionMap["player-name"].StringValue()
+	amount := int(optionMap["amount"].IntValue())
+	reason := optionMap["reason"].StringValue()
+	span.SetAttributes(
+		attribute.String("player_name", playerName),
+		attribute.Int("amount", amount),
+		attribute.String("reason", reason),
+	)
+
+	entry, err := d.AwardPoints(ctx, playerName, amount, reason, interaction.Member.User.Username)
+	if err != nil {
+		msg := "Error while awarding points: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call award points usecase: %w", err)
+	}
+	return discord.Success(fmt.Sprintf("%+d points for %s (entry #%d)", entry.Amount, entry.Player.Name, entry.ID)), nil
+}
+
+// PointsStandingsHandler call an usecase to get the points of every player
+// and return them to the user, most points first.
+func (d Discord) PointsStandingsHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Points/PointsStandingsHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	balances, err := d.ListStandings(ctx)
+	if err != nil {
+		msg := "Error while listing standings: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call list standings usecase: %w", err)
+	}
+	if len(balances) == 0 {
+		return discord.Text("no player found"), nil
+	}
+
+	lines := make([]string, 0, len(balances))
+	for i, balance := range balances {
+		lines = append(lines, fmt.Sprintf("%d. %s : %d", i+1, balance.Player.Name, balance.Points))
+	}
+	return &discord.Response{Title: "Standings", Description: strings.Join(lines, "\n")}, nil
+}
+
+// RevertPointsHandler call an usecase to undo an entry of the ledger
+// and return a message to the user.
+func (d Discord) RevertPointsHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Points/RevertPointsHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	span.SetAttributes(
+		attribute.String("id", optionMap["id"].StringValue()))
+	id, err := strconv.Atoi(optionMap["id"].StringValue())
+	if err != nil {
+		return discord.Text("id format is invalid"), fmt.Errorf("discord - RevertPointsHandler - strconv.Atoi: %w", err)
+	}
+	reason := ""
+	if opt, ok := optionMap["reason"]; ok {
+		reason = opt.StringValue()
+	}
+
+	entry, err := d.RevertPointsEntry(ctx, id, reason, interaction.Member.User.Username)
+	if err != nil {
+		msg := "Error while reverting points: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call revert points entry usecase: %w", err)
+	}
+	return discord.Success(fmt.Sprintf("Entry #%d reverted, %+d points for %s (entry #%d)",
+		entry.Reverts, entry.Amount, entry.Player.Name, entry.ID)), nil
+}
+
+// DecayPointsHandler call an usecase to decay every positive balance
+// and return a message to the user.
+func (d Discord) DecayPointsHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Points/DecayPointsHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	percent := 0
+	if opt, ok := optionMap["percent"]; ok {
+		percent = int(opt.IntValue())
+	}
+	span.SetAttributes(
+		attribute.Int("percent", percent),
+	)
+
+	author := interaction.Member.User.Username
+	entries, err := d.PreviewDecay(ctx, percent, author)
+	if err != nil {
+		msg := "Error while decaying points: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call preview decay usecase: %w", err)
+	}
+	if len(entries) == 0 {
+		return discord.Text("no points to decay"), nil
+	}
+
+	summary := fmt.Sprintf("Apply a %s to %d players?\n%s", entries[0].Reason, len(entries), decayLines(entries))
+	return d.Confirmations.Ask(ctx, interaction, summary, func(ctx context.Context) (string, error) {
+		entries, err := d.DecayPoints(ctx, percent, author)
+		if err != nil {
+			msg := "Error while decaying points: " + HumanReadableError(err)
+			return msg, fmt.Errorf("call decay points usecase: %w", err)
+		}
+		if len(entries) == 0 {
+			return "no points to decay", nil
+		}
+		return "Points decayed:\n" + decayLines(entries), nil
+	})
+}
+
+// decayLines returns one line for each decay entry, with the player and the points removed.
+func decayLines(entries []entity.PointsEntry) string {
+	lines := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		lines = append(lines, fmt.Sprintf("* %s : %+d", entry.Player.Name, entry.Amount))
+	}
+	return strings.Join(lines, "\n")
+}
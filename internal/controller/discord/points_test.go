@@ -0,0 +1,210 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/internal/controller/discord/mocks"
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestDiscord_PointsBalanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arthas := &entity.Player{ID: 1, Name: "arthas"}
+	date := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockPointsUseCase := mocks.NewPointsUseCase(t)
+
+		discord := discordHandler.Discord{
+			PointsUseCase: mockPointsUseCase,
+		}
+
+		mockPointsUseCase.On("ReadBalance", mock.Anything, "arthas").Return(entity.PointsBalance{
+			Player: arthas,
+			Points: 10,
+			Entries: []entity.PointsEntry{
+				{ID: 1, Player: arthas, Amount: 10, Kind: entity.PointsAttendance, Reason: "raid heroic on 01/10/23",
+					Author: "guildops", Date: date},
+				{ID: 2, Player: arthas, Amount: 5, Kind: entity.PointsAward, Reason: "first kill",
+					Author: "thrall", Date: date},
+				{ID: 3, Player: arthas, Amount: -5, Kind: entity.PointsReversal, Reason: "wrong player",
+					Author: "thrall", Date: date, Reverts: 2},
+			},
+		}, nil)
+
+		response, err := discord.PointsBalanceHandler(context.Background(), commandInteraction("guildops-points-balance",
+			stringOption("player-name", "arthas")))
+		assert.NoError(t, err)
+		assert.Equal(t, "arthas has 10 points\n\nLast entries\n* #3 01/10/23 -5 reversal : wrong player (by thrall)\n"+
+			"* #2 01/10/23 +5 award : first kill (by thrall) (reverted)\n"+
+			"* #1 01/10/23 +10 attendance : raid heroic on 01/10/23 (by guildops)\n",
+			response.String())
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		t.Parallel()
+		mockPointsUseCase := mocks.NewPointsUseCase(t)
+
+		discord := discordHandler.Discord{
+			PointsUseCase: mockPointsUseCase,
+		}
+
+		mockPointsUseCase.On("ReadBalance", mock.Anything, "arthas").
+			Return(entity.PointsBalance{}, errors.New("check player exists: player arthas not found"))
+
+		response, err := discord.PointsBalanceHandler(context.Background(), commandInteraction("guildops-points-balance",
+			stringOption("player-name", "arthas")))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while reading points: player arthas not found", response.Description)
+	})
+}
+
+func TestDiscord_AwardPointsHandler(t *testing.T) {
+	t.Parallel()
+
+	mockPointsUseCase := mocks.NewPointsUseCase(t)
+
+	discord := discordHandler.Discord{
+		PointsUseCase: mockPointsUseCase,
+	}
+
+	mockPointsUseCase.On("AwardPoints", mock.Anything, "arthas", -5, "late", "thrall").
+		Return(entity.PointsEntry{ID: 4, Player: &entity.Player{ID: 1, Name: "arthas"}, Amount: -5}, nil)
+
+	response, err := discord.AwardPointsHandler(context.Background(), commandInteraction("guildops-points-award",
+		stringOption("player-name", "arthas"),
+		&discordgo.ApplicationCommandInteractionDataOption{
+			Name: "amount", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(-5),
+		},
+		stringOption("reason", "late")))
+	assert.NoError(t, err)
+	assert.Equal(t, "-5 points for arthas (entry #4)", response.Description)
+}
+
+func TestDiscord_PointsStandingsHandler(t *testing.T) {
+	t.Parallel()
+
+	mockPointsUseCase := mocks.NewPointsUseCase(t)
+
+	discord := discordHandler.Discord{
+		PointsUseCase: mockPointsUseCase,
+	}
+
+	mockPointsUseCase.On("ListStandings", mock.Anything).Return([]entity.PointsBalance{
+		{Player: &entity.Player{ID: 2, Name: "jaina"}, Points: 30},
+		{Player: &entity.Player{ID: 1, Name: "arthas"}, Points: -10},
+	}, nil)
+
+	response, err := discord.PointsStandingsHandler(context.Background(), commandInteraction("guildops-points-standings"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Standings\n\n1. jaina : 30\n2. arthas : -10\n", response.String())
+}
+
+func TestDiscord_RevertPointsHandler(t *testing.T) {
+	t.Parallel()
+
+	mockPointsUseCase := mocks.NewPointsUseCase(t)
+
+	discord := discordHandler.Discord{
+		PointsUseCase: mockPointsUseCase,
+	}
+
+	mockPointsUseCase.On("RevertPointsEntry", mock.Anything, 2, "", "thrall").
+		Return(entity.PointsEntry{ID: 3, Player: &entity.Player{ID: 1, Name: "arthas"}, Amount: -5, Reverts: 2}, nil)
+
+	response, err := discord.RevertPointsHandler(context.Background(), commandInteraction("guildops-points-revert",
+		stringOption("id", "2")))
+	assert.NoError(t, err)
+	assert.Equal(t, "Entry #2 reverted, -5 points for arthas (entry #3)", response.Description)
+}
+
+func TestDiscord_DecayPointsHandler(t *testing.T) {
+	t.Parallel()
+
+	arthas := &entity.Player{ID: 1, Name: "arthas"}
+	jaina := &entity.Player{ID: 2, Name: "jaina"}
+	entries := []entity.PointsEntry{
+		{Player: arthas, Amount: -10, Kind: entity.PointsDecay, Reason: "decay of 10%"},
+		{Player: jaina, Amount: -3, Kind: entity.PointsDecay, Reason: "decay of 10%"},
+	}
+
+	t.Run("Asks for confirmation", func(t *testing.T) {
+		t.Parallel()
+		mockPointsUseCase := mocks.NewPointsUseCase(t)
+
+		discord := discordHandler.Discord{
+			PointsUseCase: mockPointsUseCase,
+			Confirmations: discordHandler.NewConfirmations(),
+		}
+
+		mockPointsUseCase.On("PreviewDecay", mock.Anything, 10, "thrall").Return(entries, nil)
+
+		response, err := discord.DecayPointsHandler(context.Background(),
+			commandInteraction("guildops-points-decay", &discordgo.ApplicationCommandInteractionDataOption{
+				Name: "percent", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(10),
+			}))
+		assert.NoError(t, err)
+		assert.True(t, response.Ephemeral)
+		assert.Equal(t, "Apply a decay of 10% to 2 players?\n* arthas : -10\n* jaina : -3", response.Description)
+		mockPointsUseCase.AssertNotCalled(t, "DecayPoints", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Without confirmations", func(t *testing.T) {
+		t.Parallel()
+		mockPointsUseCase := mocks.NewPointsUseCase(t)
+
+		discord := discordHandler.Discord{
+			PointsUseCase: mockPointsUseCase,
+		}
+
+		mockPointsUseCase.On("PreviewDecay", mock.Anything, 0, "thrall").Return(entries, nil)
+		mockPointsUseCase.On("DecayPoints", mock.Anything, 0, "thrall").Return(entries, nil)
+
+		response, err := discord.DecayPointsHandler(context.Background(), commandInteraction("guildops-points-decay"))
+		assert.NoError(t, err)
+		assert.Equal(t, "Points decayed:\n* arthas : -10\n* jaina : -3", response.Description)
+	})
+
+	t.Run("Nothing to decay", func(t *testing.T) {
+		t.Parallel()
+		mockPointsUseCase := mocks.NewPointsUseCase(t)
+
+		discord := discordHandler.Discord{
+			PointsUseCase: mockPointsUseCase,
+			Confirmations: discordHandler.NewConfirmations(),
+		}
+
+		mockPointsUseCase.On("PreviewDecay", mock.Anything, 0, "thrall").Return(nil, nil)
+
+		response, err := discord.DecayPointsHandler(context.Background(), commandInteraction("guildops-points-decay"))
+		assert.NoError(t, err)
+		assert.Equal(t, "no points to decay", response.Description)
+		assert.Empty(t, response.Components)
+	})
+
+	t.Run("Invalid percent", func(t *testing.T) {
+		t.Parallel()
+		mockPointsUseCase := mocks.NewPointsUseCase(t)
+
+		discord := discordHandler.Discord{
+			PointsUseCase: mockPointsUseCase,
+		}
+
+		mockPointsUseCase.On("PreviewDecay", mock.Anything, 0, "thrall").
+			Return(nil, errors.New("check decay: percent must be between 1 and 100"))
+
+		response, err := discord.DecayPointsHandler(context.Background(), commandInteraction("guildops-points-decay"))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while decaying points: percent must be between 1 and 100", response.Description)
+	})
+}
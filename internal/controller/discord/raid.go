@@ -5,25 +5,25 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
 
-	"github.com/alitto/pond"
 	"github.com/bwmarrin/discordgo"
 )
 
-func (d Discord) InitRaid() map[string]func(
-	ctx context.Context, interaction *discordgo.InteractionCreate) (string, error) {
-	return map[string]func(ctx context.Context, interaction *discordgo.InteractionCreate) (string, error){
+func (d Discord) InitRaid() map[string]discord.Handler {
+	return map[string]discord.Handler{
 		"guildops-raid-create":          d.CreateRaidHandler,
 		"guildops-raid-delete":          d.DeleteRaidHandler,
 		"guildops-raid-list":            d.ListRaidHandler,
 		"guildops-raid-create-multiple": d.GenerateRaidsOnRangeHandler,
+		"guildops-raid-roster-set":      d.SetRaidRosterHandler,
+		"guildops-raid-roster-show":     d.ShowRaidRosterHandler,
 	}
 }
 
@@ -41,7 +41,7 @@ var RaidDescriptors = []discordgo.ApplicationCommand{
 			{
 				Type:        discordgo.ApplicationCommandOptionString,
 				Name:        "date",
-				Description: "ex: 11/05/23",
+				Description: "ex: 11/05/23, tomorrow, next wednesday",
 				Required:    true,
 			},
 			{
@@ -50,6 +50,12 @@ var RaidDescriptors = []discordgo.ApplicationCommand{
 				Description: "Must be one of: Normal, Heroic, Mythic",
 				Required:    true,
 			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "start",
+				Description: "Start time in the guild time zone, ex: 21:00",
+				Required:    false,
+			},
 		},
 	},
 	{
@@ -65,7 +71,7 @@ var RaidDescriptors = []discordgo.ApplicationCommand{
 			{
 				Type:        discordgo.ApplicationCommandOptionString,
 				Name:        "date",
-				Description: "ex: 03/09/23",
+				Description: "ex: 03/09/23, tomorrow, next wednesday",
 				Required:    false,
 			},
 			{
@@ -83,7 +89,7 @@ var RaidDescriptors = []discordgo.ApplicationCommand{
 			{
 				Type:        discordgo.ApplicationCommandOptionString,
 				Name:        "from",
-				Description: "ex: 02/10/23",
+				Description: "ex: 02/10/23, today, or a range like 11/05 to 15/05",
 				Required:    true,
 			},
 			{
@@ -98,10 +104,16 @@ var RaidDescriptors = []discordgo.ApplicationCommand{
 		Name:        "guildops-raid-create-multiple",
 		Description: "Create multiple raids on a date range",
 		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "name",
+				Description: "ex: Raid Milo",
+				Required:    true,
+			},
 			{
 				Type:        discordgo.ApplicationCommandOptionString,
 				Name:        "from",
-				Description: "ex: 02/10/23",
+				Description: "ex: 02/10/23, today, next monday",
 				Required:    true,
 			},
 			{
@@ -122,16 +134,89 @@ var RaidDescriptors = []discordgo.ApplicationCommand{
 				Description: "Must be one of: Monday, Tuesday, Wednesday, Thursday, Friday, Saturday, Sunday",
 				Required:    true,
 			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "start",
+				Description: "Start time in the guild time zone, ex: 21:00",
+				Required:    false,
+			},
+		},
+	},
+	{
+		Name:        "guildops-raid-roster-set",
+		Description: "Record who raided, was late, benched or absent on a raid",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "date",
+				Description: "ex: 02/10/23, tomorrow, next wednesday",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "difficulty",
+				Description: "Required if there are several raids on this date",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "present",
+				Description: "ex: arthas,jaina",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "late",
+				Description: "ex: arthas,jaina",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "bench",
+				Description: "ex: arthas,jaina",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "absent",
+				Description: "ex: arthas,jaina",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "remove",
+				Description: "Players to take off the roster, ex: arthas,jaina",
+				Required:    false,
+			},
 		},
 	},
+	{
+		Name:        "guildops-raid-roster-show",
+		Description: "Show who raided, was late, benched or absent on a raid",
+		Options: append([]*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "date",
+				Description: "ex: 02/10/23, tomorrow, next wednesday",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "difficulty",
+				Description: "Required if there are several raids on this date",
+				Required:    false,
+			},
+		}, playerFilterOptions()...),
+	},
 }
 
 // CreateRaidHandler call an usecase to create a raid
 // and return a message to the user.
 // It requires a raid name, a date and a difficulty field to be passed in the interaction.
+// Optional a 'start' field can be passed, raids start at the default raid start of the guild without it.
 func (d Discord) CreateRaidHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -147,32 +232,37 @@ func (d Discord) CreateRaidHandler(
 		optionMap[opt.Name] = opt
 	}
 	name := optionMap["name"].StringValue()
-	date, err := ParseDate(optionMap["date"].StringValue(), "")
+	date, err := ParseDay(optionMap["date"].StringValue(), d.now())
 	if err != nil {
 		msg := "Error while creating raid: " + HumanReadableError(err)
-		return msg, fmt.Errorf("create raid parse date: %w", err)
+		return discord.Text(msg), fmt.Errorf("create raid parse date: %w", err)
 	}
 	difficulty := optionMap["difficulty"].StringValue()
+	var startTime string
+	if opt, ok := optionMap["start"]; ok {
+		startTime = opt.StringValue()
+	}
 	span.SetAttributes(
 		attribute.String("name", name),
-		attribute.String("date", date[0].String()),
+		attribute.String("date", date.String()),
 		attribute.String("difficulty", difficulty),
+		attribute.String("start", startTime),
 	)
 
-	raid, err := d.CreateRaid(ctx, name, difficulty, date[0])
+	raid, err := d.CreateRaid(ctx, name, difficulty, date, startTime)
 	if err != nil {
 		msg := "Error while creating raid: " + HumanReadableError(err)
-		return msg, fmt.Errorf("call create raid usecase: %w", err)
+		return discord.Text(msg), fmt.Errorf("call create raid usecase: %w", err)
 	}
-	return "Raid successfully created with ID " + strconv.Itoa(raid.ID), nil
+	return discord.Success("Raid successfully created with ID " + strconv.Itoa(raid.ID) + " on " + raidDay(raid)), nil
 }
 
-// DeleteRaidHandler call an usecase to delete a raid
-// and return a message to the user.
-// It requires a raid ID field to be passed in the interaction.
+// DeleteRaidHandler call an usecase to find a raid and what is removed along with it,
+// and asks the user to confirm before deleting it.
+// It requires a raid ID field, or date and difficulty fields, to be passed in the interaction.
 func (d Discord) DeleteRaidHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -216,46 +306,58 @@ func (d Discord) DeleteRaidHandler(
 		)
 	}
 
+	var raid entity.Raid
+	var cascade entity.Cascade
+	var done string
 	switch {
 	case raidID != "":
-		raid, err := strconv.Atoi(raidID)
+		id, err := strconv.Atoi(raidID)
 		if err != nil {
 			msg := "Error while deleting raid: " + HumanReadableError(err)
-			return msg, fmt.Errorf("delete raid convert user output id to int: %w", err)
+			return discord.Text(msg), fmt.Errorf("delete raid convert user output id to int: %w", err)
 		}
-
-		err = d.DeleteRaidWithID(ctx, raid)
+		raid, cascade, err = d.ReadRaidCascade(ctx, id, time.Time{}, "")
 		if err != nil {
 			msg := "Error while deleting raid: " + HumanReadableError(err)
-			return msg, fmt.Errorf("call delete raid usecase : %w", err)
+			return discord.Text(msg), fmt.Errorf("call read raid cascade usecase : %w", err)
 		}
-
-		return "Raid with ID " + raidID + " successfully deleted", nil
+		done = "Raid with ID " + raidID + " successfully deleted"
 	case raidDate != "" && raidDifficulty != "":
-		date, err := ParseDate(raidDate, "")
+		date, err := ParseDay(raidDate, d.now())
 		if err != nil {
 			msg := "Error while deleting raid: " + HumanReadableError(err)
-			return msg, fmt.Errorf("delete raid parse date: %w", err)
+			return discord.Text(msg), fmt.Errorf("delete raid parse date: %w", err)
 		}
-		err = d.DeleteRaidOnDate(ctx, date[0], raidDifficulty)
+		raid, cascade, err = d.ReadRaidCascade(ctx, -1, date, raidDifficulty)
 		if err != nil {
 			msg := "Error while deleting raid: " + HumanReadableError(err)
-			return msg, fmt.Errorf("call delete raid usecase : %w", err)
+			return discord.Text(msg), fmt.Errorf("call read raid cascade usecase : %w", err)
 		}
-		return "Raid on " + raidDate + " with difficulty " + raidDifficulty + " successfully deleted", nil
+		done = "Raid on " + raidDate + " with difficulty " + raidDifficulty + " successfully deleted"
 	default:
 		msg := "Should provide either id or date and difficulty"
-		return msg, fmt.Errorf("missing parameters")
+		return discord.Text(msg), fmt.Errorf("missing parameters")
 	}
+
+	summary := "Delete raid " + raid.Name + " " + raidDay(raid) + " " + raid.Difficulty +
+		" (ID " + strconv.Itoa(raid.ID) + ")? It also removes:\n" + cascade.String()
+	return d.Confirmations.Ask(ctx, interaction, summary, func(ctx context.Context) (string, error) {
+		err := d.DeleteRaidWithID(ctx, raid.ID)
+		if err != nil {
+			msg := "Error while deleting raid: " + HumanReadableError(err)
+			return msg, fmt.Errorf("call delete raid usecase : %w", err)
+		}
+		return done, nil
+	})
 }
 
 // ListRaidHandler call an usecase to get raids on a date range
-// and return a message to the user.
+// and return them to the user, one line for each raid.
 // It requires a 'from' date field to be passed in the interaction.
 // Optional a 'to' date field can be passed.
 func (d Discord) ListRaidHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
 
@@ -282,61 +384,32 @@ func (d Discord) ListRaidHandler(
 		attribute.String("to", toDate),
 	)
 
-	dates, err := ParseDate(from, toDate)
+	dates, err := ParseDate(from, toDate, d.now())
 	if err != nil {
 		msg := "error while list raids: " + HumanReadableError(err)
-		return msg, fmt.Errorf("list raids parse date: %w", err)
+		return discord.Text(msg), fmt.Errorf("list raids parse date: %w", err)
 	}
 
-	raidsLock := &sync.Mutex{}
-	var raids []entity.Raid
-	pool := pond.New(len(dates), 5, pond.Context(ctx))
-
-	for _, date := range dates {
-		date := date
-		pool.Submit(func() {
-			raid, err := d.ReadRaid(ctx, date)
-			if err == nil {
-				raidsLock.Lock()
-				raids = append(raids, raid)
-				raidsLock.Unlock()
+	response, err := d.Pages.Show(ctx, List{
+		Title: "Raid List",
+		Empty: "no raid found",
+		Fetch: func(ctx context.Context, limit, offset int) ([]string, error) {
+			raids, err := d.ListRaids(ctx, dates[0], dates[len(dates)-1], limit, offset)
+			if err != nil {
+				return nil, err
 			}
-		})
-	}
-	pool.StopAndWaitFor(2 * time.Second)
-	select {
-	case <-ctx.Done():
-		msg := "error while list raids: " + HumanReadableError(ctx.Err())
-		return msg, fmt.Errorf("list raids wait goroutines: %w", ctx.Err())
-	default:
-		if len(raids) == 0 {
-			msg := "no raid found"
-			return msg, nil
-		}
-
-		swap := true
-		for swap {
-			swap = false
-			for k, v := range raids {
-				if k == 0 {
-					continue
-				}
-				if raids[k-1].Date.After(v.Date) {
-					raids[k-1], raids[k] = raids[k], raids[k-1]
-					swap = true
-				}
+			lines := make([]string, 0, len(raids))
+			for _, raid := range raids {
+				lines = append(lines, "* "+raid.Name+" "+raidDay(raid)+" "+raid.Difficulty+" "+strconv.Itoa(raid.ID))
 			}
-		}
-
-		msg := "Raid List:\n"
-		for _, raid := range raids {
-			msg += "* " + raid.Name + " " +
-				raid.Date.Format("Mon 02/01/06") + " " +
-				raid.Difficulty + " " +
-				strconv.Itoa(raid.ID) + "\n"
-		}
-		return msg, nil
+			return lines, nil
+		},
+	})
+	if err != nil {
+		msg := "error while list raids: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("list raids usecase: %w", err)
 	}
+	return response, nil
 }
 
 func contains(s []string, str string) bool {
@@ -351,8 +424,8 @@ func contains(s []string, str string) bool {
 
 func (d Discord) GenerateRaidsOnRangeHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
-	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
 	ctx, span := otel.Tracer("Discord").Start(ctx, "Raid/GenerateRaidsOnRangeHandler")
@@ -364,7 +437,7 @@ func (d Discord) GenerateRaidsOnRangeHandler(
 	select {
 	case <-ctx.Done():
 		msg := "error while creating multiple raids: " + HumanReadableError(ctx.Err())
-		return msg, fmt.Errorf("create multiple raids wait goroutines: %w", ctx.Err())
+		return discord.Text(msg), fmt.Errorf("create multiple raids: %w", ctx.Err())
 	default:
 
 		options := interaction.ApplicationCommandData().Options
@@ -374,22 +447,27 @@ func (d Discord) GenerateRaidsOnRangeHandler(
 			optionMap[opt.Name] = opt
 		}
 
+		name := optionMap["name"].StringValue()
 		from := optionMap["from"].StringValue()
 		toDate := ""
-		if len(optionMap) > 3 {
-			toDate = optionMap["to"].StringValue()
+		if opt, ok := optionMap["to"]; ok {
+			toDate = opt.StringValue()
+		}
+		startTime := ""
+		if opt, ok := optionMap["start"]; ok {
+			startTime = opt.StringValue()
 		}
 
-		dates, err := ParseDate(from, toDate)
+		dates, err := ParseDate(from, toDate, d.now())
 		if err != nil {
 			msg := "error while creating multiple raids: " + HumanReadableError(err)
-			return msg, fmt.Errorf("create multiple raids parse date: %w", err)
+			return discord.Text(msg), fmt.Errorf("create multiple raids parse date: %w", err)
 		}
 
 		difficulty := optionMap["difficulty"].StringValue()
 		difficulty = strings.ToLower(difficulty)
 		if difficulty != "normal" && difficulty != "heroic" && difficulty != "mythic" {
-			return "difficulty must be one of: Normal, Heroic, Mythic",
+			return discord.Text("difficulty must be one of: Normal, Heroic, Mythic"),
 				fmt.Errorf("create multiple raids parse difficulty: %w", err)
 		}
 
@@ -403,8 +481,8 @@ func (d Discord) GenerateRaidsOnRangeHandler(
 				weekDay != "wednesday" && weekDay != "thursday" &&
 				weekDay != "friday" && weekDay != "saturday" &&
 				weekDay != "sunday" {
-				return "week days must be one of: Monday, Tuesday, Wednesday, " +
-					"Thursday, Friday, Saturday, Sunday", fmt.Errorf("create multiple raids parse week days: %w", err)
+				return discord.Text("week days must be one of: Monday, Tuesday, Wednesday, " +
+					"Thursday, Friday, Saturday, Sunday"), fmt.Errorf("create multiple raids parse week days: %w", err)
 			}
 		}
 
@@ -417,35 +495,173 @@ func (d Discord) GenerateRaidsOnRangeHandler(
 			}
 		}
 
-		raidsLock := &sync.Mutex{}
-		var raids []entity.Raid
-		pool := pond.New(len(dates), 5, pond.Context(ctx))
-
-		for _, date := range raidsDays {
-			date := date
-			pool.Submit(func() {
-				raid, err := d.CreateRaid(ctx, "Raid", difficulty, date)
-				if err == nil {
-					raidsLock.Lock()
-					raids = append(raids, raid)
-					raidsLock.Unlock()
-				}
-			})
+		generation, err := d.CreateRaids(ctx, name, difficulty, raidsDays, startTime)
+		if err != nil {
+			msg := "error while creating multiple raids: " + HumanReadableError(err)
+			return discord.Text(msg), fmt.Errorf("create multiple raids usecase: %w", err)
 		}
-		pool.StopAndWait()
+		if generation.IsEmpty() {
+			return discord.Text("no raid on these week days"), nil
+		}
+		title := "Raids from " + dates[0].Format("02/01/06") + " to " + dates[len(dates)-1].Format("02/01/06")
+		return raidGenerationResponse(title, generation), nil
+	}
+}
+
+// raidDay returns the day of a raid and its start time, in the guild time zone.
+func raidDay(raid entity.Raid) string {
+	day := raid.Date.Format("Mon 02/01/06")
+	if !raid.StartTimeSet {
+		return day
+	}
+	return day + " " + entity.FormatStartTime(raid.StartTime)
+}
 
-		if len(raids) == 0 || raids == nil {
-			msg := "no raid created"
-			return msg, nil
+// rosterResponse returns the roster of a raid, one field per status and one for its composition.
+func rosterResponse(raid entity.Raid) *discord.Response {
+	response := &discord.Response{
+		Title: "Roster of " + raid.Name + " " + raid.Date.Format("Mon 02/01/06") + " " + raid.Difficulty,
+	}
+	for _, line := range []struct {
+		title   string
+		players []*entity.Player
+	}{
+		{"Present", raid.Players},
+		{"Late", raid.Late},
+		{"Bench", raid.Bench},
+		{"Absent", raid.Absences},
+	} {
+		names := make([]string, 0, len(line.players))
+		for _, player := range line.players {
+			names = append(names, player.Name)
 		}
+		response.Fields = append(response.Fields, discord.Field{
+			Name:   line.title + " (" + strconv.Itoa(len(line.players)) + ")",
+			Value:  strings.Join(names, ", "),
+			Inline: true,
+		})
+	}
 
-		msg := "Raid List:\n"
-		for _, raid := range raids {
-			msg += "* " + raid.Name + " " +
-				raid.Date.Format("Mon 02/01/06") + " " +
-				raid.Difficulty + " " +
-				strconv.Itoa(raid.ID) + "\n"
+	composition := raid.Composition()
+	counts := make([]string, 0, len(entity.Roles)+1)
+	for _, role := range entity.Roles {
+		counts = append(counts, strconv.Itoa(composition[role])+" "+string(role))
+	}
+	if composition[""] > 0 {
+		counts = append(counts, strconv.Itoa(composition[""])+" without role")
+	}
+	response.Fields = append(response.Fields, discord.Field{Name: "Composition", Value: strings.Join(counts, ", ")})
+	return response
+}
+
+// SetRaidRosterHandler call an usecase to record the status of players on a raid
+// and return the roster to the user.
+// It requires a date field to be passed in the interaction, and at least one of
+// 'present', 'late', 'bench', 'absent' or 'remove' fields, which are comma separated player names.
+// Optional a 'difficulty' field can be passed.
+func (d Discord) SetRaidRosterHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Raid/SetRaidRosterHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+
+	date, err := ParseDay(optionMap["date"].StringValue(), d.now())
+	if err != nil {
+		msg := "Error while setting raid roster: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("set raid roster parse date: %w", err)
+	}
+
+	var difficulty string
+	if opt, ok := optionMap["difficulty"]; ok {
+		difficulty = opt.StringValue()
+	}
+
+	roster := make(map[entity.ParticipantStatus][]string)
+	for _, status := range entity.ParticipantStatuses {
+		if opt, ok := optionMap[string(status)]; ok {
+			roster[status] = strings.Split(opt.StringValue(), ",")
 		}
-		return msg, nil
 	}
+	var removed []string
+	if opt, ok := optionMap["remove"]; ok {
+		removed = strings.Split(opt.StringValue(), ",")
+	}
+	span.SetAttributes(
+		attribute.String("date", date.String()),
+		attribute.String("difficulty", difficulty),
+	)
+
+	raid, err := d.SetRaidRoster(ctx, date, difficulty, roster, removed)
+	if err != nil {
+		msg := "Error while setting raid roster: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call set raid roster usecase: %w", err)
+	}
+	return rosterResponse(raid), nil
+}
+
+// ShowRaidRosterHandler call an usecase to get the roster of a raid
+// and return it to the user.
+// It requires a date field to be passed in the interaction.
+// Optional a 'difficulty' field can be passed.
+func (d Discord) ShowRaidRosterHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Raid/ShowRaidRosterHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+
+	date, err := ParseDay(optionMap["date"].StringValue(), d.now())
+	if err != nil {
+		msg := "Error while showing raid roster: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("show raid roster parse date: %w", err)
+	}
+
+	var difficulty string
+	if opt, ok := optionMap["difficulty"]; ok {
+		difficulty = opt.StringValue()
+	}
+	filter, err := playerFilter(optionMap)
+	if err != nil {
+		msg := "Error while showing raid roster: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("show raid roster parse filter: %w", err)
+	}
+	span.SetAttributes(
+		attribute.String("date", date.String()),
+		attribute.String("difficulty", difficulty),
+		attribute.String("filter", filter.String()),
+	)
+
+	raid, err := d.ReadRaidRoster(ctx, date, difficulty, filter)
+	if err != nil {
+		msg := "Error while showing raid roster: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call read raid roster usecase: %w", err)
+	}
+	response := rosterResponse(raid)
+	if !filter.IsEmpty() {
+		response.Description = "Only " + filter.String() + " players"
+	}
+	return response, nil
 }
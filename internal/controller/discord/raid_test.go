@@ -27,8 +27,10 @@ func TestDiscord_CreateRaidHandler(t *testing.T) {
 			RaidUseCase: mockRaidUseCase,
 		}
 
-		mockRaidUseCase.On("CreateRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return(entity.Raid{}, nil)
+		mockRaidUseCase.On("CreateRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "").
+			Return(entity.Raid{
+				Date: time.Date(2023, 5, 3, 0, 0, 0, 0, time.UTC), StartTime: 21 * time.Hour, StartTimeSet: true,
+			}, nil)
 
 		interaction := &discordgo.InteractionCreate{
 			Interaction: &discordgo.Interaction{
@@ -64,11 +66,55 @@ func TestDiscord_CreateRaidHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.CreateRaidHandler(context.Background(), interaction)
+		response, err := discord.CreateRaidHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Raid successfully created with ID 0")
+		assert.Equal(t, response.Description, "Raid successfully created with ID 0 on Wed 03/05/23 21:00")
 		mockRaidUseCase.AssertExpectations(t)
 	})
+
+	t.Run("Start time", func(t *testing.T) {
+		t.Parallel()
+		mockRaidUseCase := mocks.NewRaidUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidUseCase: mockRaidUseCase,
+		}
+
+		date := time.Date(2023, 5, 3, 0, 0, 0, 0, time.UTC)
+		mockRaidUseCase.On("CreateRaid", mock.Anything, "random raid", "Heroic", date, "20:30").
+			Return(entity.Raid{ID: 1, Date: date, StartTime: 20*time.Hour + 30*time.Minute, StartTimeSet: true}, nil)
+
+		response, err := discord.CreateRaidHandler(context.Background(), commandInteraction("guildops-raid-create",
+			stringOption("name", "random raid"),
+			stringOption("date", "03/05/23"),
+			stringOption("difficulty", "Heroic"),
+			stringOption("start", "20:30"),
+		))
+		assert.NoError(t, err)
+		assert.Equal(t, "Raid successfully created with ID 1 on Wed 03/05/23 20:30", response.Description)
+	})
+
+	t.Run("Midnight", func(t *testing.T) {
+		t.Parallel()
+		mockRaidUseCase := mocks.NewRaidUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidUseCase: mockRaidUseCase,
+		}
+
+		date := time.Date(2023, 5, 3, 0, 0, 0, 0, time.UTC)
+		mockRaidUseCase.On("CreateRaid", mock.Anything, "random raid", "Heroic", date, "00:00").
+			Return(entity.Raid{ID: 1, Date: date, StartTimeSet: true}, nil)
+
+		response, err := discord.CreateRaidHandler(context.Background(), commandInteraction("guildops-raid-create",
+			stringOption("name", "random raid"),
+			stringOption("date", "03/05/23"),
+			stringOption("difficulty", "Heroic"),
+			stringOption("start", "00:00"),
+		))
+		assert.NoError(t, err)
+		assert.Equal(t, "Raid successfully created with ID 1 on Wed 03/05/23 00:00", response.Description)
+	})
 }
 
 //nolint:dupl
@@ -83,7 +129,9 @@ func TestDiscord_DeleteRaidHandler(t *testing.T) {
 			RaidUseCase: mockRaidUseCase,
 		}
 
-		mockRaidUseCase.On("DeleteRaidWithID", mock.Anything, mock.Anything).
+		mockRaidUseCase.On("ReadRaidCascade", mock.Anything, 1, time.Time{}, "").
+			Return(entity.Raid{ID: 1, Name: "icc", Difficulty: "heroic"}, entity.Cascade{}, nil)
+		mockRaidUseCase.On("DeleteRaidWithID", mock.Anything, 1).
 			Return(nil)
 
 		interaction := &discordgo.InteractionCreate{
@@ -110,9 +158,9 @@ func TestDiscord_DeleteRaidHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.DeleteRaidHandler(context.Background(), interaction)
+		response, err := discord.DeleteRaidHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Raid with ID 1 successfully deleted")
+		assert.Equal(t, "Raid with ID 1 successfully deleted", response.Description)
 		mockRaidUseCase.AssertExpectations(t)
 	})
 
@@ -124,7 +172,9 @@ func TestDiscord_DeleteRaidHandler(t *testing.T) {
 			RaidUseCase: mockRaidUseCase,
 		}
 
-		mockRaidUseCase.On("DeleteRaidOnDate", mock.Anything, mock.Anything, mock.Anything).
+		mockRaidUseCase.On("ReadRaidCascade", mock.Anything, -1, mock.Anything, "Heroic").
+			Return(entity.Raid{ID: 2, Name: "icc", Difficulty: "heroic"}, entity.Cascade{}, nil)
+		mockRaidUseCase.On("DeleteRaidWithID", mock.Anything, 2).
 			Return(nil)
 
 		interaction := &discordgo.InteractionCreate{
@@ -156,11 +206,55 @@ func TestDiscord_DeleteRaidHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.DeleteRaidHandler(context.Background(), interaction)
+		response, err := discord.DeleteRaidHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Raid on 30/09/30 with difficulty Heroic successfully deleted")
+		assert.Equal(t, "Raid on 30/09/30 with difficulty Heroic successfully deleted", response.Description)
 		mockRaidUseCase.AssertExpectations(t)
 	})
+
+	t.Run("Ask for confirmation", func(t *testing.T) {
+		t.Parallel()
+		mockRaidUseCase := mocks.NewRaidUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidUseCase:   mockRaidUseCase,
+			Confirmations: discordHandler.NewConfirmations(),
+		}
+
+		mockRaidUseCase.On("ReadRaidCascade", mock.Anything, 3, time.Time{}, "").
+			Return(entity.Raid{
+				ID: 3, Name: "icc", Difficulty: "heroic", Date: time.Date(2030, 10, 1, 0, 0, 0, 0, time.UTC),
+			}, entity.Cascade{Roster: 20, Refunds: 4}, nil)
+
+		interaction := &discordgo.InteractionCreate{
+			Interaction: &discordgo.Interaction{
+				Type: discordgo.InteractionApplicationCommand,
+				Member: &discordgo.Member{
+					User: &discordgo.User{
+						ID:       "100000000000000001",
+						Username: "test",
+					},
+				},
+				Data: discordgo.ApplicationCommandInteractionData{
+					Name: "guildops-raid-delete",
+					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Name:  "id",
+							Type:  discordgo.ApplicationCommandOptionString,
+							Value: "3",
+						},
+					},
+				},
+			},
+		}
+
+		response, err := discord.DeleteRaidHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "Delete raid icc Tue 01/10/30 heroic (ID 3)? It also removes:\n"+
+			"* 20 roster entries\n* 4 points refunds", response.Description)
+		assert.Len(t, response.Components, 1)
+		mockRaidUseCase.AssertNotCalled(t, "DeleteRaidWithID", mock.Anything, mock.Anything)
+	})
 }
 
 func TestDiscord_ListRaidHandler(t *testing.T) {
@@ -174,21 +268,23 @@ func TestDiscord_ListRaidHandler(t *testing.T) {
 			RaidUseCase: mockRaidUseCase,
 		}
 
-		mockRaidUseCase.On("ReadRaid", mock.Anything, time.Date(2030, time.September, 5, 0, 0, 0, 0, time.UTC)).
-			Return(entity.Raid{
-				ID:         1,
-				Name:       "random raid",
-				Difficulty: "Heroic",
-				Date:       time.Date(2030, time.September, 5, 0, 0, 0, 0, time.UTC),
-			}, nil).Once()
-		mockRaidUseCase.On("ReadRaid", mock.Anything, time.Date(2030, time.September, 30, 0, 0, 0, 0, time.UTC)).
-			Return(entity.Raid{
-				ID:         1,
-				Name:       "random raid",
-				Difficulty: "Heroic",
-				Date:       time.Date(2030, time.September, 4, 0, 0, 0, 0, time.UTC),
-			}, nil).Once()
-		mockRaidUseCase.On("ReadRaid", mock.Anything, mock.Anything).Return(entity.Raid{}, errors.New("Not found"))
+		mockRaidUseCase.On("ListRaids", mock.Anything,
+			time.Date(2030, time.September, 5, 0, 0, 0, 0, time.UTC),
+			time.Date(2030, time.October, 5, 0, 0, 0, 0, time.UTC), 0, 0).
+			Return([]entity.Raid{
+				{
+					ID:         1,
+					Name:       "random raid",
+					Difficulty: "Heroic",
+					Date:       time.Date(2030, time.September, 4, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:         1,
+					Name:       "random raid",
+					Difficulty: "Heroic",
+					Date:       time.Date(2030, time.September, 5, 0, 0, 0, 0, time.UTC),
+				},
+			}, nil)
 
 		interaction := &discordgo.InteractionCreate{
 			Interaction: &discordgo.Interaction{
@@ -219,9 +315,11 @@ func TestDiscord_ListRaidHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.ListRaidHandler(context.Background(), interaction)
+		response, err := discord.ListRaidHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, "Raid List:\n* random raid Wed 04/09/30 Heroic 1\n* random raid Thu 05/09/30 Heroic 1\n", msg)
+		assert.Equal(t, "Raid List", response.Title)
+		assert.Equal(t, "* random raid Wed 04/09/30 Heroic 1\n* random raid Thu 05/09/30 Heroic 1", response.Description)
+		assert.Empty(t, response.Components)
 		mockRaidUseCase.AssertExpectations(t)
 	})
 }
@@ -230,6 +328,10 @@ func TestDiscord_ListRaidHandler(t *testing.T) {
 func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 	t.Parallel()
 
+	nighthold := func(day int) entity.Raid {
+		return entity.Raid{Name: "nighthold", Difficulty: "heroic", Date: time.Date(2030, 9, day, 0, 0, 0, 0, time.UTC)}
+	}
+
 	t.Run("Context is done", func(t *testing.T) {
 		t.Parallel()
 		mockRaidUseCase := mocks.NewRaidUseCase(t)
@@ -255,6 +357,11 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 					TargetID: "mock",
 					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
 					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Type:  discordgo.ApplicationCommandOptionString,
+							Name:  "name",
+							Value: "Nighthold",
+						},
 						{
 							Type:  discordgo.ApplicationCommandOptionString,
 							Name:  "from",
@@ -280,9 +387,9 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.GenerateRaidsOnRangeHandler(ctx, interaction)
-		assert.EqualError(t, err, "create multiple raids wait goroutines: context canceled")
-		assert.Equal(t, msg, "error while creating multiple raids: context canceled")
+		response, err := discord.GenerateRaidsOnRangeHandler(ctx, interaction)
+		assert.EqualError(t, err, "create multiple raids: context canceled")
+		assert.Equal(t, response.Description, "error while creating multiple raids: context canceled")
 		mockRaidUseCase.AssertExpectations(t)
 	})
 
@@ -294,8 +401,16 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 			RaidUseCase: mockRaidUseCase,
 		}
 
-		mockRaidUseCase.On("CreateRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return(entity.Raid{}, nil).Times(8)
+		mockRaidUseCase.On("CreateRaids", mock.Anything, "Nighthold", "heroic",
+			mock.MatchedBy(func(dates []time.Time) bool { return len(dates) == 8 }), "").
+			Return(entity.RaidGeneration{
+				Created:  []entity.Raid{nighthold(9)},
+				Existing: []entity.Raid{nighthold(10)},
+				Failed: []entity.FailedRaid{{
+					Raid:   nighthold(16),
+					Reason: "database is locked",
+				}},
+			}, nil)
 
 		interaction := &discordgo.InteractionCreate{
 			Interaction: &discordgo.Interaction{
@@ -311,6 +426,11 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 					TargetID: "mock",
 					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
 					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Type:  discordgo.ApplicationCommandOptionString,
+							Name:  "name",
+							Value: "Nighthold",
+						},
 						{
 							Type:  discordgo.ApplicationCommandOptionString,
 							Name:  "from",
@@ -336,11 +456,13 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
+		response, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Raid List:\n*  Mon 01/01/01  0\n*  Mon 01/01/01  0\n*  Mon 01/01/01"+
-			"  0\n*  Mon 01/01/01  0\n*  Mon 01/01/01  0\n*  "+
-			"Mon 01/01/01  0\n*  Mon 01/01/01  0\n*  Mon 01/01/01  0\n")
+		assert.Equal(t, "Raids from 05/09/30 to 05/10/30\n\n"+
+			"Created (1)\n* nighthold heroic Mon 09/09/30\n\n"+
+			"Already there (1)\n* nighthold heroic Tue 10/09/30\n\n"+
+			"Failed (1)\n* nighthold heroic Mon 16/09/30 : database is locked\n",
+			response.String())
 		mockRaidUseCase.AssertExpectations(t)
 	})
 
@@ -352,8 +474,11 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 			RaidUseCase: mockRaidUseCase,
 		}
 
-		mockRaidUseCase.On("CreateRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return(entity.Raid{}, nil).Times(1)
+		mockRaidUseCase.On("CreateRaids", mock.Anything, "Nighthold", "heroic",
+			[]time.Time{time.Date(2030, 9, 5, 0, 0, 0, 0, time.UTC)}, "").
+			Return(entity.RaidGeneration{
+				Created: []entity.Raid{nighthold(5)},
+			}, nil)
 
 		interaction := &discordgo.InteractionCreate{
 			Interaction: &discordgo.Interaction{
@@ -369,6 +494,11 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 					TargetID: "mock",
 					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
 					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Type:  discordgo.ApplicationCommandOptionString,
+							Name:  "name",
+							Value: "Nighthold",
+						},
 						{
 							Type:  discordgo.ApplicationCommandOptionString,
 							Name:  "from",
@@ -389,13 +519,14 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
+		response, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Raid List:\n*  Mon 01/01/01  0\n")
+		assert.Equal(t, "Raids from 05/09/30 to 05/09/30\n\nCreated (1)\n* nighthold heroic Thu 05/09/30\n",
+			response.String())
 		mockRaidUseCase.AssertExpectations(t)
 	})
 
-	t.Run("Create a no raid", func(t *testing.T) {
+	t.Run("Invalid name", func(t *testing.T) {
 		t.Parallel()
 		mockRaidUseCase := mocks.NewRaidUseCase(t)
 
@@ -403,8 +534,8 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 			RaidUseCase: mockRaidUseCase,
 		}
 
-		mockRaidUseCase.On("CreateRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return(entity.Raid{}, errors.New("already exists"))
+		mockRaidUseCase.On("CreateRaids", mock.Anything, "Nighthold", "heroic", mock.Anything, "").
+			Return(entity.RaidGeneration{}, errors.New("name must only contain letters"))
 
 		interaction := &discordgo.InteractionCreate{
 			Interaction: &discordgo.Interaction{
@@ -420,6 +551,11 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 					TargetID: "mock",
 					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
 					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Type:  discordgo.ApplicationCommandOptionString,
+							Name:  "name",
+							Value: "Nighthold",
+						},
 						{
 							Type:  discordgo.ApplicationCommandOptionString,
 							Name:  "from",
@@ -440,9 +576,9 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
-		assert.NoError(t, err)
-		assert.Equal(t, msg, "no raid created")
+		response, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
+		assert.Error(t, err)
+		assert.Equal(t, "error while creating multiple raids: name must only contain letters", response.Description)
 		mockRaidUseCase.AssertExpectations(t)
 	})
 
@@ -468,6 +604,11 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 					TargetID: "mock",
 					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
 					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Type:  discordgo.ApplicationCommandOptionString,
+							Name:  "name",
+							Value: "Nighthold",
+						},
 						{
 							Type:  discordgo.ApplicationCommandOptionString,
 							Name:  "from",
@@ -493,9 +634,10 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
+		response, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Equal(t, msg, "error while creating multiple raids: endDate is before startDate")
+		assert.Equal(t, response.Description,
+			"error while creating multiple raids: end date 05/09/30 is before start date 05/10/30")
 		mockRaidUseCase.AssertExpectations(t)
 	})
 
@@ -521,6 +663,11 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 					TargetID: "mock",
 					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
 					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Type:  discordgo.ApplicationCommandOptionString,
+							Name:  "name",
+							Value: "Nighthold",
+						},
 						{
 							Type:  discordgo.ApplicationCommandOptionString,
 							Name:  "from",
@@ -541,9 +688,9 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
+		response, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Equal(t, msg, "difficulty must be one of: Normal, Heroic, Mythic")
+		assert.Equal(t, response.Description, "difficulty must be one of: Normal, Heroic, Mythic")
 		mockRaidUseCase.AssertExpectations(t)
 	})
 
@@ -569,6 +716,11 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 					TargetID: "mock",
 					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
 					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Type:  discordgo.ApplicationCommandOptionString,
+							Name:  "name",
+							Value: "Nighthold",
+						},
 						{
 							Type:  discordgo.ApplicationCommandOptionString,
 							Name:  "from",
@@ -589,10 +741,145 @@ func TestDiscord_GenerateRaidsOnRangeHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
+		response, err := discord.GenerateRaidsOnRangeHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Equal(t, msg, "week days must be one of: Monday, Tuesday, "+
+		assert.Equal(t, response.Description, "week days must be one of: Monday, Tuesday, "+
 			"Wednesday, Thursday, Friday, Saturday, Sunday")
 		mockRaidUseCase.AssertExpectations(t)
 	})
 }
+
+func TestDiscord_SetRaidRosterHandler(t *testing.T) {
+	t.Parallel()
+
+	raidDate := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockRaidUseCase := mocks.NewRaidUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidUseCase: mockRaidUseCase,
+		}
+
+		roster := map[entity.ParticipantStatus][]string{
+			entity.ParticipantPresent: {"arthas", " jaina"},
+			entity.ParticipantBench:   {"thrall"},
+		}
+		mockRaidUseCase.On("SetRaidRoster", mock.Anything, raidDate, "heroic", roster, []string{"sylvanas"}).
+			Return(entity.Raid{
+				Name:       "raid",
+				Difficulty: "heroic",
+				Date:       raidDate,
+				Players:    []*entity.Player{{Name: "arthas"}, {Name: "jaina"}},
+				Bench:      []*entity.Player{{Name: "thrall"}},
+			}, nil)
+
+		interaction := commandInteraction("guildops-raid-roster-set",
+			stringOption("date", "02/10/23"),
+			stringOption("difficulty", "heroic"),
+			stringOption("present", "arthas, jaina"),
+			stringOption("bench", "thrall"),
+			stringOption("remove", "sylvanas"),
+		)
+
+		response, err := discord.SetRaidRosterHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "Roster of raid Mon 02/10/23 heroic\n\nPresent (2)\narthas, jaina\n\nLate (0)\n\n\nBench (1)\n"+
+			"thrall\n\nAbsent (0)\n\n\nComposition\n0 tank, 0 healer, 0 dps, 2 without role\n",
+			response.String())
+		mockRaidUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Usecase error", func(t *testing.T) {
+		t.Parallel()
+		mockRaidUseCase := mocks.NewRaidUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidUseCase: mockRaidUseCase,
+		}
+
+		mockRaidUseCase.On("SetRaidRoster", mock.Anything, raidDate, "", mock.Anything, mock.Anything).
+			Return(entity.Raid{}, errors.New("check player exists: player sylvanas not found"))
+
+		interaction := commandInteraction("guildops-raid-roster-set",
+			stringOption("date", "02/10/23"),
+			stringOption("absent", "sylvanas"),
+		)
+
+		response, err := discord.SetRaidRosterHandler(context.Background(), interaction)
+		assert.Error(t, err)
+		assert.Equal(t, "Error while setting raid roster: player sylvanas not found", response.Description)
+		mockRaidUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Invalid date", func(t *testing.T) {
+		t.Parallel()
+		mockRaidUseCase := mocks.NewRaidUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidUseCase: mockRaidUseCase,
+		}
+
+		interaction := commandInteraction("guildops-raid-roster-set",
+			stringOption("date", "2023/10/02"),
+		)
+
+		_, err := discord.SetRaidRosterHandler(context.Background(), interaction)
+		assert.Error(t, err)
+	})
+}
+
+func TestDiscord_ShowRaidRosterHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockRaidUseCase := mocks.NewRaidUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidUseCase: mockRaidUseCase,
+		}
+
+		raidDate := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+		mockRaidUseCase.On("ReadRaidRoster", mock.Anything, raidDate, "", entity.PlayerFilter{}).
+			Return(entity.Raid{
+				Name:       "raid",
+				Difficulty: "mythic",
+				Date:       raidDate,
+				Late:       []*entity.Player{{Name: "arthas"}},
+				Absences:   []*entity.Player{{Name: "jaina"}},
+			}, nil)
+
+		interaction := &discordgo.InteractionCreate{
+			Interaction: &discordgo.Interaction{
+				Type: discordgo.InteractionApplicationCommand,
+				Member: &discordgo.Member{
+					User: &discordgo.User{
+						Username: "test",
+					},
+				},
+				Data: discordgo.ApplicationCommandInteractionData{
+					ID:       "mock",
+					Name:     "mock",
+					TargetID: "mock",
+					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
+					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Name:  "date",
+							Type:  discordgo.ApplicationCommandOptionString,
+							Value: "02/10/23",
+						},
+					},
+				},
+			},
+		}
+
+		response, err := discord.ShowRaidRosterHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "Roster of raid Mon 02/10/23 mythic\n\nPresent (0)\n\n\nLate (1)\narthas\n\nBench (0)\n\n\n"+
+			"Absent (1)\njaina\n\nComposition\n0 tank, 0 healer, 0 dps, 1 without role\n",
+			response.String())
+		mockRaidUseCase.AssertExpectations(t)
+	})
+}
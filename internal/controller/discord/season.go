@@ -0,0 +1,136 @@
+package discordhandler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+var SeasonDescriptors = []discordgo.ApplicationCommand{
+	{
+		Name:        "guildops-season-create",
+		Description: "Add a season to the calendar",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "name",
+				Description: "ex: DF/S3",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "start",
+				Description: "first day, ex: 14/11/23",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "end",
+				Description: "last day, ex: 22/04/24",
+				Required:    true,
+			},
+		},
+	},
+	{
+		Name:        "guildops-season-list",
+		Description: "List seasons of the calendar",
+	},
+}
+
+func (d Discord) InitSeason() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		"guildops-season-create": d.CreateSeasonHandler,
+		"guildops-season-list":   d.ListSeasonsHandler,
+	}
+}
+
+// CreateSeasonHandler call an usecase to add a season to the calendar
+// and return a message to the user.
+// It requires a name, a start date and an end date fields to be passed in the interaction.
+func (d Discord) CreateSeasonHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Season/CreateSeasonHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	name := optionMap["name"].StringValue()
+	span.SetAttributes(
+		attribute.String("name", name),
+		attribute.String("start", optionMap["start"].StringValue()),
+		attribute.String("end", optionMap["end"].StringValue()),
+	)
+
+	start, err := ParseDay(optionMap["start"].StringValue(), d.now())
+	if err != nil {
+		msg := "Error while creating season: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("create season parse start date: %w", err)
+	}
+	end, err := ParseDay(optionMap["end"].StringValue(), d.now())
+	if err != nil {
+		msg := "Error while creating season: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("create season parse end date: %w", err)
+	}
+
+	season, err := d.CreateSeason(ctx, name, start, end)
+	if err != nil {
+		msg := "Error while creating season: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call create season usecase: %w", err)
+	}
+	return discord.Success("Season " + season.Name + " successfully created from " +
+		season.Start.Format("02/01/06") + " to " + season.End.Format("02/01/06")), nil
+}
+
+// ListSeasonsHandler call an usecase to get every season
+// and return them to the user.
+func (d Discord) ListSeasonsHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Season/ListSeasonsHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	seasons, err := d.ListSeasons(ctx)
+	if err != nil {
+		msg := "Error while listing seasons: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call list seasons usecase: %w", err)
+	}
+	if len(seasons) == 0 {
+		return discord.Text("no season found"), nil
+	}
+
+	now := entity.Day(d.now())
+	lines := make([]string, 0, len(seasons))
+	for _, season := range seasons {
+		line := "* " + season.Name + " : " + season.Start.Format("02/01/06") + " - " + season.End.Format("02/01/06")
+		if season.Contains(now) {
+			line += " (current)"
+		}
+		lines = append(lines, line)
+	}
+	return &discord.Response{Title: "Seasons", Description: strings.Join(lines, "\n")}, nil
+}
@@ -0,0 +1,128 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/internal/controller/discord/mocks"
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestDiscord_CreateSeasonHandler(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 4, 22, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockSeasonUseCase := mocks.NewSeasonUseCase(t)
+
+		discord := discordHandler.Discord{
+			SeasonUseCase: mockSeasonUseCase,
+		}
+
+		mockSeasonUseCase.On("CreateSeason", mock.Anything, "DF/S3", start, end).
+			Return(entity.Season{ID: 1, Name: "DF/S3", Start: start, End: end}, nil)
+
+		response, err := discord.CreateSeasonHandler(context.Background(), commandInteraction("guildops-season-create",
+			stringOption("name", "DF/S3"),
+			stringOption("start", "14/11/23"),
+			stringOption("end", "22/04/24"),
+		))
+		assert.NoError(t, err)
+		assert.Equal(t, "Season DF/S3 successfully created from 14/11/23 to 22/04/24", response.Description)
+		mockSeasonUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Invalid date", func(t *testing.T) {
+		t.Parallel()
+		mockSeasonUseCase := mocks.NewSeasonUseCase(t)
+
+		discord := discordHandler.Discord{
+			SeasonUseCase: mockSeasonUseCase,
+		}
+
+		_, err := discord.CreateSeasonHandler(context.Background(), commandInteraction("guildops-season-create",
+			stringOption("name", "DF/S3"),
+			stringOption("start", "31/11/23"),
+			stringOption("end", "22/04/24"),
+		))
+		assert.Error(t, err)
+	})
+
+	t.Run("Overlapping season", func(t *testing.T) {
+		t.Parallel()
+		mockSeasonUseCase := mocks.NewSeasonUseCase(t)
+
+		discord := discordHandler.Discord{
+			SeasonUseCase: mockSeasonUseCase,
+		}
+
+		mockSeasonUseCase.On("CreateSeason", mock.Anything, "DF/S3", start, end).
+			Return(entity.Season{}, errors.New("create season: season overlaps DF/S2"))
+
+		response, err := discord.CreateSeasonHandler(context.Background(), commandInteraction("guildops-season-create",
+			stringOption("name", "DF/S3"),
+			stringOption("start", "14/11/23"),
+			stringOption("end", "22/04/24"),
+		))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while creating season: season overlaps DF/S2", response.Description)
+	})
+}
+
+func TestDiscord_ListSeasonsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockSeasonUseCase := mocks.NewSeasonUseCase(t)
+
+		discord := discordHandler.Discord{
+			SeasonUseCase: mockSeasonUseCase,
+		}
+
+		mockSeasonUseCase.On("ListSeasons", mock.Anything).Return([]entity.Season{
+			{
+				Name:  "DF/S2",
+				Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, 11, 13, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Name:  "Now",
+				Start: time.Now().AddDate(0, 0, -7),
+				End:   time.Now().AddDate(0, 0, 7),
+			},
+		}, nil)
+
+		response, err := discord.ListSeasonsHandler(context.Background(), commandInteraction("guildops-season-list"))
+		assert.NoError(t, err)
+		assert.Equal(t, "Seasons\n\n"+
+			"* DF/S2 : 01/05/23 - 13/11/23\n"+
+			"* Now : "+time.Now().AddDate(0, 0, -7).Format("02/01/06")+" - "+
+			time.Now().AddDate(0, 0, 7).Format("02/01/06")+" (current)\n", response.String())
+		mockSeasonUseCase.AssertExpectations(t)
+	})
+
+	t.Run("No season", func(t *testing.T) {
+		t.Parallel()
+		mockSeasonUseCase := mocks.NewSeasonUseCase(t)
+
+		discord := discordHandler.Discord{
+			SeasonUseCase: mockSeasonUseCase,
+		}
+
+		mockSeasonUseCase.On("ListSeasons", mock.Anything).Return(nil, nil)
+
+		response, err := discord.ListSeasonsHandler(context.Background(), commandInteraction("guildops-season-list"))
+		assert.NoError(t, err)
+		assert.Equal(t, "no season found", response.Description)
+	})
+}
@@ -0,0 +1,196 @@
+package discordhandler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+// SignupComponent starts the custom ID of the signup buttons, "guildops-signup:<status>:<raidID>".
+const SignupComponent = "guildops-signup"
+
+func (d Discord) InitSignup() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		"guildops-raid-signup": d.OpenSignupHandler,
+	}
+}
+
+// InitSignupComponents returns the handlers of the signup buttons, keyed by the start of their custom ID.
+func (d Discord) InitSignupComponents() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		SignupComponent: d.SignupButtonHandler,
+	}
+}
+
+var SignupDescriptors = []discordgo.ApplicationCommand{
+	{
+		Name:        "guildops-raid-signup",
+		Description: "Post the signup of a raid, players answer it with buttons",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "date",
+				Description: "ex: 02/10/23, tomorrow, next wednesday",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "difficulty",
+				Description: "Required when several raids are on this date",
+				Required:    false,
+			},
+		},
+	},
+}
+
+// signupButtons are the buttons under a signup message, in the order of entity.SignupStatuses.
+var signupButtons = map[entity.SignupStatus]struct {
+	label string
+	style discordgo.ButtonStyle
+}{
+	entity.SignupAccepted:  {"Accept", discordgo.SuccessButton},
+	entity.SignupTentative: {"Tentative", discordgo.SecondaryButton},
+	entity.SignupLate:      {"Late", discordgo.PrimaryButton},
+	entity.SignupDeclined:  {"Decline", discordgo.DangerButton},
+}
+
+// signupTitles are the titles of the fields of a signup message.
+var signupTitles = map[entity.SignupStatus]string{
+	entity.SignupAccepted:  "Accepted",
+	entity.SignupTentative: "Tentative",
+	entity.SignupLate:      "Late",
+	entity.SignupDeclined:  "Declined",
+}
+
+// signupMessage returns the signup of a raid with its buttons.
+// Each status shows its count and the names of its players with the count of each role.
+func signupMessage(raid entity.Raid) *discord.Response {
+	response := &discord.Response{
+		Title: "Signup of " + raid.Name + " " + raidDay(raid) + " " + raid.Difficulty,
+	}
+	for _, status := range entity.SignupStatuses {
+		players := raid.SignedUp(status)
+		field := discord.Field{Name: signupTitles[status] + " (" + strconv.Itoa(len(players)) + ")", Value: "-"}
+		if len(players) > 0 {
+			names := make([]string, 0, len(players))
+			for _, player := range players {
+				names = append(names, player.Name)
+			}
+
+			composition := raid.SignupComposition(status)
+			counts := make([]string, 0, len(entity.Roles)+1)
+			for _, role := range entity.Roles {
+				counts = append(counts, strconv.Itoa(composition[role])+" "+string(role))
+			}
+			if composition[""] > 0 {
+				counts = append(counts, strconv.Itoa(composition[""])+" without role")
+			}
+			field.Value = strings.Join(names, ", ") + " (" + strings.Join(counts, ", ") + ")"
+		}
+		response.Fields = append(response.Fields, field)
+	}
+
+	buttons := make([]discordgo.MessageComponent, 0, len(entity.SignupStatuses))
+	for _, status := range entity.SignupStatuses {
+		buttons = append(buttons, discordgo.Button{
+			Label:    signupButtons[status].label,
+			Style:    signupButtons[status].style,
+			CustomID: discord.CustomID(SignupComponent, string(status), strconv.Itoa(raid.ID)),
+		})
+	}
+	response.Components = []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+	return response
+}
+
+// OpenSignupHandler call an usecase to get a raid and its signups
+// and post them with the buttons players answer with.
+// It requires a date field to be passed in the interaction.
+// Optional a 'difficulty' field can be passed.
+func (d Discord) OpenSignupHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Signup/OpenSignupHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+
+	date, err := ParseDay(optionMap["date"].StringValue(), d.now())
+	if err != nil {
+		msg := "Error while opening signup: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("open signup parse date: %w", err)
+	}
+
+	var difficulty string
+	if opt, ok := optionMap["difficulty"]; ok {
+		difficulty = opt.StringValue()
+	}
+	span.SetAttributes(
+		attribute.String("date", date.String()),
+		attribute.String("difficulty", difficulty),
+	)
+
+	raid, err := d.OpenSignup(ctx, date, difficulty)
+	if err != nil {
+		msg := "Error while opening signup: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call open signup usecase: %w", err)
+	}
+	return signupMessage(raid), nil
+}
+
+// SignupButtonHandler call an usecase to record the answer of a player to a raid
+// and update the signup message with it.
+// The custom ID of the button carries the status and the raid ID.
+func (d Discord) SignupButtonHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Signup/SignupButtonHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	customID := interaction.MessageComponentData().CustomID
+	args := discord.ComponentArgs(customID)
+	if len(args) != 2 {
+		msg := "Error while signing up: unknown button"
+		return discord.Text(msg), fmt.Errorf("parse custom ID %s", customID)
+	}
+	raidID, err := strconv.Atoi(args[1])
+	if err != nil {
+		msg := "Error while signing up: unknown raid"
+		return discord.Text(msg), fmt.Errorf("parse raid ID: %w", err)
+	}
+	span.SetAttributes(
+		attribute.String("status", args[0]),
+		attribute.Int("raidID", raidID),
+	)
+
+	raid, err := d.SignUp(ctx, raidID, interaction.Member.User.ID, args[0])
+	if err != nil {
+		msg := "Error while signing up: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call sign up usecase: %w", err)
+	}
+	return signupMessage(raid), nil
+}
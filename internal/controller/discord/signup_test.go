@@ -0,0 +1,159 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/internal/controller/discord/mocks"
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func buttonInteraction(customID string) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			Type: discordgo.InteractionMessageComponent,
+			Member: &discordgo.Member{
+				User: &discordgo.User{
+					ID:       "100000000000000001",
+					Username: "thrall",
+				},
+			},
+			Data: discordgo.MessageComponentInteractionData{
+				CustomID:      customID,
+				ComponentType: discordgo.ButtonComponent,
+			},
+		},
+	}
+}
+
+func TestDiscord_OpenSignupHandler(t *testing.T) {
+	t.Parallel()
+
+	raidDate := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockSignupUseCase := mocks.NewSignupUseCase(t)
+
+		discord := discordHandler.Discord{
+			SignupUseCase: mockSignupUseCase,
+		}
+
+		raid := entity.Raid{ID: 7, Name: "raid", Difficulty: "mythic", Date: raidDate}
+		raid.Signups = []entity.Signup{
+			{Player: &entity.Player{Name: "arthas", Role: entity.RoleTank}, Raid: &raid, Status: entity.SignupAccepted},
+			{Player: &entity.Player{Name: "thrall", Role: entity.RoleDPS}, Raid: &raid, Status: entity.SignupAccepted},
+			{Player: &entity.Player{Name: "jaina"}, Raid: &raid, Status: entity.SignupDeclined},
+		}
+		mockSignupUseCase.On("OpenSignup", mock.Anything, raidDate, "").Return(raid, nil)
+
+		data, err := discord.OpenSignupHandler(context.Background(), commandInteraction("guildops-raid-signup",
+			stringOption("date", "02/10/23")))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Signup of raid Mon 02/10/23 mythic", data.Title)
+		fields := make([]string, 0, len(data.Fields))
+		for _, field := range data.Fields {
+			fields = append(fields, field.Name+" : "+field.Value)
+		}
+		assert.Equal(t, []string{
+			"Accepted (2) : arthas, thrall (1 tank, 0 healer, 1 dps)",
+			"Tentative (0) : -",
+			"Late (0) : -",
+			"Declined (1) : jaina (0 tank, 0 healer, 0 dps, 1 without role)",
+		}, fields)
+		if assert.Len(t, data.Components, 1) {
+			row, ok := data.Components[0].(discordgo.ActionsRow)
+			if assert.True(t, ok) && assert.Len(t, row.Components, 4) {
+				assert.Equal(t, "guildops-signup:accepted:7", row.Components[0].(discordgo.Button).CustomID)
+				assert.Equal(t, "Decline", row.Components[3].(discordgo.Button).Label)
+			}
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+		mockSignupUseCase := mocks.NewSignupUseCase(t)
+
+		discord := discordHandler.Discord{
+			SignupUseCase: mockSignupUseCase,
+		}
+
+		mockSignupUseCase.On("OpenSignup", mock.Anything, raidDate, "").
+			Return(entity.Raid{}, errors.New("check raid exists: no raid found on 02/10/23"))
+
+		data, err := discord.OpenSignupHandler(context.Background(), commandInteraction("guildops-raid-signup",
+			stringOption("date", "02/10/23")))
+
+		assert.Error(t, err)
+		assert.Equal(t, "Error while opening signup: no raid found on 02/10/23", data.Description)
+		assert.Empty(t, data.Components)
+	})
+}
+
+func TestDiscord_SignupButtonHandler(t *testing.T) {
+	t.Parallel()
+
+	raidDate := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockSignupUseCase := mocks.NewSignupUseCase(t)
+
+		discord := discordHandler.Discord{
+			SignupUseCase: mockSignupUseCase,
+		}
+
+		raid := entity.Raid{ID: 7, Name: "raid", Difficulty: "mythic", Date: raidDate}
+		raid.Signups = []entity.Signup{
+			{Player: &entity.Player{Name: "thrall", Role: entity.RoleDPS}, Raid: &raid, Status: entity.SignupLate},
+		}
+		mockSignupUseCase.On("SignUp", mock.Anything, 7, "100000000000000001", "late").Return(raid, nil)
+
+		data, err := discord.SignupButtonHandler(context.Background(), buttonInteraction("guildops-signup:late:7"))
+
+		assert.NoError(t, err)
+		if assert.Len(t, data.Fields, 4) {
+			assert.Equal(t, "Late (1)", data.Fields[2].Name)
+			assert.Equal(t, "thrall (0 tank, 0 healer, 1 dps)", data.Fields[2].Value)
+		}
+		assert.Len(t, data.Components, 1)
+	})
+
+	t.Run("Player not linked", func(t *testing.T) {
+		t.Parallel()
+		mockSignupUseCase := mocks.NewSignupUseCase(t)
+
+		discord := discordHandler.Discord{
+			SignupUseCase: mockSignupUseCase,
+		}
+
+		mockSignupUseCase.On("SignUp", mock.Anything, 7, "100000000000000001", "accepted").
+			Return(entity.Raid{}, errors.New("didn't find a player linked to this discord user"))
+
+		data, err := discord.SignupButtonHandler(context.Background(), buttonInteraction("guildops-signup:accepted:7"))
+
+		assert.Error(t, err)
+		assert.Equal(t, "Error while signing up: didn't find a player linked to this discord user", data.Description)
+	})
+
+	t.Run("Bad custom ID", func(t *testing.T) {
+		t.Parallel()
+
+		discord := discordHandler.Discord{
+			SignupUseCase: mocks.NewSignupUseCase(t),
+		}
+
+		_, err := discord.SignupButtonHandler(context.Background(), buttonInteraction("guildops-signup:accepted:x"))
+		assert.Error(t, err)
+		_, err = discord.SignupButtonHandler(context.Background(), buttonInteraction("guildops-signup"))
+		assert.Error(t, err)
+	})
+}
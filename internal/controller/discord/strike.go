@@ -11,6 +11,9 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
+
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -43,6 +46,12 @@ var StrikeDescriptors = []discordgo.ApplicationCommand{
 				Description: "ex: Milowenn",
 				Required:    true,
 			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "season",
+				Description: "ex: DF/S2",
+				Required:    false,
+			},
 		},
 	},
 	{
@@ -61,9 +70,8 @@ var StrikeDescriptors = []discordgo.ApplicationCommand{
 
 // InitStrike return lists of func which can be processed by discord bot.
 // They are all strike related.
-func (d Discord) InitStrike() map[string]func(
-	ctx context.Context, interaction *discordgo.InteractionCreate) (string, error) {
-	return map[string]func(ctx context.Context, interaction *discordgo.InteractionCreate) (string, error){
+func (d Discord) InitStrike() map[string]discord.Handler {
+	return map[string]discord.Handler{
 		"guildops-strike-create": d.StrikeOnPlayerHandler,
 		"guildops-strike-delete": d.DeleteStrikeHandler,
 		"guildops-strike-list":   d.ListStrikesOnPlayerHandler,
@@ -75,7 +83,7 @@ func (d Discord) InitStrike() map[string]func(
 // It requires a player name and a reason field to be passed in the interaction.
 func (d Discord) StrikeOnPlayerHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 
 	ctx, span := otel.Tracer("Discord").Start(ctx, "Strike/StrikeOnPlayerHandler")
@@ -101,17 +109,18 @@ func (d Discord) StrikeOnPlayerHandler(
 	err := d.CreateStrike(ctx, reason, name)
 	if err != nil {
 		msg := "Error while creating strike: " + HumanReadableError(err)
-		return msg, fmt.Errorf("create strike call usecase: %w", err)
+		return discord.Text(msg), fmt.Errorf("create strike call usecase: %w", err)
 	}
-	return "Strike created successfully", nil
+	return discord.Success("Strike created successfully"), nil
 }
 
 // ListStrikesOnPlayerHandler call an usecase to get strikes on a player
 // and return a message to the user.
-// It requires a player name field to be passed in the interaction.
+// It requires a player name field to be passed in the interaction,
+// and accepts a season field to only list strikes of this season.
 func (d Discord) ListStrikesOnPlayerHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -127,21 +136,60 @@ func (d Discord) ListStrikesOnPlayerHandler(
 		optionMap[opt.Name] = opt
 	}
 	playerName := optionMap["name"].StringValue()
+	season := ""
+	if opt, ok := optionMap["season"]; ok {
+		season = opt.StringValue()
+	}
 	span.SetAttributes(
 		attribute.String("player", playerName),
+		attribute.String("season", season),
 	)
 
-	strikes, err := d.ReadStrikes(ctx, playerName)
+	strikes, err := d.ReadStrikes(ctx, playerName, season)
 	if err != nil {
 		msg := "Error while getting strikes on player: " + HumanReadableError(err)
-		return msg, fmt.Errorf("database - ListStrikesOnPlayerHandler - r.ReadStrikes: %w", err)
+		return discord.Text(msg), fmt.Errorf("database - ListStrikesOnPlayerHandler - r.ReadStrikes: %w", err)
+	}
+
+	active, expired := splitStrikes(strikes, d.now())
+	lines := make([]string, 0, len(active))
+	for _, strike := range active {
+		line := "* " + strike.Date.Format("02/01/06") + " | " + strike.Reason + " | " + strconv.Itoa(strike.ID)
+		if !strike.ExpiresAt.IsZero() {
+			line += " | expires " + strike.ExpiresAt.Format("02/01/06")
+		}
+		lines = append(lines, line)
+	}
+	response := &discord.Response{
+		Title: "Strikes of " + strings.ToLower(playerName),
+		Fields: []discord.Field{{
+			Name:  "Active (" + strconv.Itoa(len(active)) + ")",
+			Value: strings.Join(lines, "\n"),
+		}},
+	}
+	if len(active) > 0 {
+		response.Colour = discord.ColourWarning
 	}
 
-	msg := "Strikes of " + strings.ToLower(playerName) + " (" + strconv.Itoa(len(strikes)) + ") :\n"
+	lines = make([]string, 0, len(expired))
+	for _, strike := range expired {
+		lines = append(lines, "* "+strike.Date.Format("02/01/06")+" | "+strike.Reason+" | "+strconv.Itoa(strike.ID))
+	}
+	response.Fields = appendListField(response.Fields, "Expired", lines)
+	return response, nil
+}
+
+// splitStrikes returns strikes still active on date and expired strikes.
+func splitStrikes(strikes []entity.Strike, date time.Time) ([]entity.Strike, []entity.Strike) {
+	var active, expired []entity.Strike
 	for _, strike := range strikes {
-		msg += "* " + strike.Date.Format("02/01/06") + " | " + strike.Reason + " | " + strconv.Itoa(strike.ID) + "\n"
+		if strike.Expired(date) {
+			expired = append(expired, strike)
+		} else {
+			active = append(active, strike)
+		}
 	}
-	return msg, nil
+	return active, expired
 }
 
 // DeleteStrikeHandler call an usecase to delete a strike
@@ -151,7 +199,7 @@ func (d Discord) ListStrikesOnPlayerHandler(
 
 func (d Discord) DeleteStrikeHandler(
 	ctx context.Context, interaction *discordgo.InteractionCreate,
-) (string, error) {
+) (*discord.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
 
@@ -175,18 +223,31 @@ func (d Discord) DeleteStrikeHandler(
 	strikeID, err := strconv.ParseInt(idString, 10, 64)
 	if err != nil {
 		msg := "Error while deleting strike: " + HumanReadableError(err)
-		return msg, fmt.Errorf("delete strike parse id: %w", err)
+		return discord.Text(msg), fmt.Errorf("delete strike parse id: %w", err)
 	}
 
-	err = d.DeleteStrike(ctx, int(strikeID))
+	strike, err := d.ReadStrike(ctx, int(strikeID))
 	if err != nil {
-		msg := "Error while deleting strike: " + HumanReadableError(err)
-		strikeNotFound := regexp.MustCompile(".*strike not found.*")
-		if strikeNotFound.MatchString(msg) {
-			return "strike not found", fmt.Errorf("delete strike usecase: %w", err)
-		}
-		return msg, fmt.Errorf("delete strike usecase: %w", err)
+		return discord.Text(deleteStrikeError(err)), fmt.Errorf("read strike usecase: %w", err)
 	}
 
-	return "Strike deleted successfully", nil
+	summary := fmt.Sprintf("Delete strike #%d given on %s for %s?",
+		strike.ID, strike.Date.Format("02/01/06"), strike.Reason)
+	return d.Confirmations.Ask(ctx, interaction, summary, func(ctx context.Context) (string, error) {
+		err := d.DeleteStrike(ctx, strike.ID)
+		if err != nil {
+			return deleteStrikeError(err), fmt.Errorf("delete strike usecase: %w", err)
+		}
+		return "Strike deleted successfully", nil
+	})
+}
+
+// deleteStrikeError returns the message of an error while deleting a strike.
+func deleteStrikeError(err error) string {
+	msg := "Error while deleting strike: " + HumanReadableError(err)
+	strikeNotFound := regexp.MustCompile(".*strike not found.*")
+	if strikeNotFound.MatchString(msg) {
+		return "strike not found"
+	}
+	return msg
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
 	"github.com/antony-ramos/guildops/internal/controller/discord/mocks"
@@ -68,9 +69,9 @@ func TestDiscord_CreateStrikeOnPlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.StrikeOnPlayerHandler(context.Background(), interaction)
+		response, err := discord.StrikeOnPlayerHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Strike created successfully")
+		assert.Equal(t, response.Description, "Strike created successfully")
 		mockStrikeUseCase.AssertExpectations(t)
 	})
 
@@ -114,9 +115,9 @@ func TestDiscord_CreateStrikeOnPlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.StrikeOnPlayerHandler(context.Background(), interaction)
+		response, err := discord.StrikeOnPlayerHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Regexp(t, regexp.MustCompile("Error while creating strike: .*"), msg)
+		assert.Regexp(t, regexp.MustCompile("Error while creating strike: .*"), response.Description)
 		mockStrikeUseCase.AssertExpectations(t)
 	})
 }
@@ -133,6 +134,8 @@ func TestDiscord_DeleteStrikeHandler(t *testing.T) {
 			StrikeUseCase: mockStrikeUseCase,
 		}
 
+		mockStrikeUseCase.On("ReadStrike", mock.Anything, 123456789).
+			Return(entity.Strike{ID: 123456789, Reason: "late"}, nil)
 		mockStrikeUseCase.On("DeleteStrike", mock.Anything, mock.Anything).
 			Return(nil)
 
@@ -160,9 +163,9 @@ func TestDiscord_DeleteStrikeHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.DeleteStrikeHandler(context.Background(), interaction)
+		response, err := discord.DeleteStrikeHandler(context.Background(), interaction)
 		assert.NoError(t, err)
-		assert.Equal(t, msg, "Strike deleted successfully")
+		assert.Equal(t, response.Description, "Strike deleted successfully")
 		mockStrikeUseCase.AssertExpectations(t)
 	})
 
@@ -174,6 +177,8 @@ func TestDiscord_DeleteStrikeHandler(t *testing.T) {
 			StrikeUseCase: mockStrikeUseCase,
 		}
 
+		mockStrikeUseCase.On("ReadStrike", mock.Anything, 123456789).
+			Return(entity.Strike{ID: 123456789, Reason: "late"}, nil)
 		mockStrikeUseCase.On("DeleteStrike", mock.Anything, mock.Anything).
 			Return(errors.New("error"))
 
@@ -201,9 +206,9 @@ func TestDiscord_DeleteStrikeHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.DeleteStrikeHandler(context.Background(), interaction)
+		response, err := discord.DeleteStrikeHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Regexp(t, regexp.MustCompile("Error while deleting strike: .*"), msg)
+		assert.Regexp(t, regexp.MustCompile("Error while deleting strike: .*"), response.Description)
 		mockStrikeUseCase.AssertExpectations(t)
 	})
 
@@ -239,11 +244,49 @@ func TestDiscord_DeleteStrikeHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.DeleteStrikeHandler(context.Background(), interaction)
+		response, err := discord.DeleteStrikeHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Regexp(t, regexp.MustCompile("Error while deleting strike: .*"), msg)
+		assert.Regexp(t, regexp.MustCompile("Error while deleting strike: .*"), response.Description)
 		mockStrikeUseCase.AssertExpectations(t)
 	})
+
+	t.Run("Asks for confirmation", func(t *testing.T) {
+		t.Parallel()
+		mockStrikeUseCase := mocks.NewStrikeUseCase(t)
+
+		discord := discordHandler.Discord{
+			StrikeUseCase: mockStrikeUseCase,
+			Confirmations: discordHandler.NewConfirmations(),
+		}
+
+		mockStrikeUseCase.On("ReadStrike", mock.Anything, 2).Return(entity.Strike{
+			ID: 2, Reason: "late", Date: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+		}, nil)
+
+		response, err := discord.DeleteStrikeHandler(context.Background(),
+			commandInteraction("guildops-strike-delete", stringOption("id", "2")))
+		assert.NoError(t, err)
+		assert.True(t, response.Ephemeral)
+		assert.Equal(t, "Delete strike #2 given on 02/10/23 for late?", response.Description)
+		mockStrikeUseCase.AssertNotCalled(t, "DeleteStrike", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Strike not found", func(t *testing.T) {
+		t.Parallel()
+		mockStrikeUseCase := mocks.NewStrikeUseCase(t)
+
+		discord := discordHandler.Discord{
+			StrikeUseCase: mockStrikeUseCase,
+			Confirmations: discordHandler.NewConfirmations(),
+		}
+
+		mockStrikeUseCase.On("ReadStrike", mock.Anything, 2).Return(entity.Strike{}, errors.New("strike not found"))
+
+		response, err := discord.DeleteStrikeHandler(context.Background(),
+			commandInteraction("guildops-strike-delete", stringOption("id", "2")))
+		assert.Error(t, err)
+		assert.Equal(t, "strike not found", response.Description)
+	})
 }
 
 func TestDiscord_ListStrikesOnPlayerHandler(t *testing.T) {
@@ -257,7 +300,7 @@ func TestDiscord_ListStrikesOnPlayerHandler(t *testing.T) {
 			StrikeUseCase: mockStrikeUseCase,
 		}
 
-		mockStrikeUseCase.On("ReadStrikes", mock.Anything, mock.Anything).
+		mockStrikeUseCase.On("ReadStrikes", mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Strike{
 				{
 					ID:     1,
@@ -299,15 +342,73 @@ func TestDiscord_ListStrikesOnPlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.ListStrikesOnPlayerHandler(context.Background(), interaction)
+		response, err := discord.ListStrikesOnPlayerHandler(context.Background(), interaction)
 		assert.NoError(t, err)
 
-		wantedMsg := `Strikes of milowenn (2) :
+		wantedMsg := `Strikes of milowenn
+
+Active (2)
 * 01/01/01 | test strike | 1
 * 01/01/01 | test strike 2 | 2
 `
 
-		assert.Equal(t, msg, wantedMsg)
+		assert.Equal(t, response.String(), wantedMsg)
+		mockStrikeUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Active and expired strikes", func(t *testing.T) {
+		t.Parallel()
+		mockStrikeUseCase := mocks.NewStrikeUseCase(t)
+
+		discord := discordHandler.Discord{
+			StrikeUseCase: mockStrikeUseCase,
+		}
+
+		expiresAt := time.Now().AddDate(0, 0, 14)
+		mockStrikeUseCase.On("ReadStrikes", mock.Anything, "Milowenn", "").
+			Return([]entity.Strike{
+				{
+					ID:        1,
+					Reason:    "late",
+					Date:      time.Date(2023, 5, 3, 20, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2023, 5, 31, 20, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        2,
+					Reason:    "afk",
+					Date:      time.Date(2023, 10, 4, 20, 0, 0, 0, time.UTC),
+					ExpiresAt: expiresAt,
+				},
+			}, nil)
+
+		interaction := &discordgo.InteractionCreate{
+			Interaction: &discordgo.Interaction{
+				Type: discordgo.InteractionApplicationCommand,
+				Member: &discordgo.Member{
+					User: &discordgo.User{
+						Username: "test",
+					},
+				},
+				Data: discordgo.ApplicationCommandInteractionData{
+					ID:       "mock",
+					Name:     "mock",
+					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
+					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Name:  "name",
+							Type:  discordgo.ApplicationCommandOptionString,
+							Value: "Milowenn",
+						},
+					},
+				},
+			},
+		}
+
+		response, err := discord.ListStrikesOnPlayerHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "Strikes of milowenn\n\nActive (1)\n* 04/10/23 | afk | 2 | expires 01/11/26\n\nExpired (1)\n"+
+			"* 03/05/23 | late | 1\n",
+			response.String())
 		mockStrikeUseCase.AssertExpectations(t)
 	})
 
@@ -319,7 +420,7 @@ func TestDiscord_ListStrikesOnPlayerHandler(t *testing.T) {
 			StrikeUseCase: mockStrikeUseCase,
 		}
 
-		mockStrikeUseCase.On("ReadStrikes", mock.Anything, mock.Anything).
+		mockStrikeUseCase.On("ReadStrikes", mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, errors.New("error"))
 
 		interaction := &discordgo.InteractionCreate{
@@ -346,9 +447,9 @@ func TestDiscord_ListStrikesOnPlayerHandler(t *testing.T) {
 			},
 		}
 
-		msg, err := discord.ListStrikesOnPlayerHandler(context.Background(), interaction)
+		response, err := discord.ListStrikesOnPlayerHandler(context.Background(), interaction)
 		assert.Error(t, err)
-		assert.Regexp(t, regexp.MustCompile("Error while getting strikes on player: .*"), msg)
+		assert.Regexp(t, regexp.MustCompile("Error while getting strikes on player: .*"), response.Description)
 		mockStrikeUseCase.AssertExpectations(t)
 	})
 }
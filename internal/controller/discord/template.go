@@ -0,0 +1,287 @@
+package discordhandler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+var RaidTemplateDescriptors = []discordgo.ApplicationCommand{
+	{
+		Name:        "guildops-raid-template-create",
+		Description: "Add a template creating a raid every week",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "name",
+				Description: "(ex: nighthold)",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "difficulty",
+				Description: "Normal, Heroic or Mythic",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "weekdays",
+				Description: "(ex: Monday,Wednesday)",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "start",
+				Description: "start time (ex: 21:00)",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "timezone",
+				Description: "(ex: Europe/Paris)",
+				Required:    false,
+			},
+		},
+	},
+	{
+		Name:        "guildops-raid-template-list",
+		Description: "List raid templates",
+	},
+	{
+		Name:        "guildops-raid-template-delete",
+		Description: "Remove a raid template, raids it created are kept",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "id",
+				Description: "(ex: 2)",
+				Required:    true,
+			},
+		},
+	},
+	{
+		Name:        "guildops-raid-template-generate",
+		Description: "Create the raids of the templates for the next weeks",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "weeks",
+				Description: "weeks ahead, the configured weeks when empty",
+				Required:    false,
+			},
+		},
+	},
+}
+
+func (d Discord) InitRaidTemplate() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		"guildops-raid-template-create":   d.CreateRaidTemplateHandler,
+		"guildops-raid-template-list":     d.ListRaidTemplatesHandler,
+		"guildops-raid-template-delete":   d.DeleteRaidTemplateHandler,
+		"guildops-raid-template-generate": d.GenerateRaidsHandler,
+	}
+}
+
+// CreateRaidTemplateHandler call an usecase to add a raid template
+// and return a message to the user.
+func (d Discord) CreateRaidTemplateHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "RaidTemplate/CreateRaidTemplateHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	name := optionMap["name"].StringValue()
+	difficulty := optionMap["difficulty"].StringValue()
+	weekdays := optionMap["weekdays"].StringValue()
+	start := optionMap["start"].StringValue()
+	timezone := ""
+	if opt, ok := optionMap["timezone"]; ok {
+		timezone = opt.StringValue()
+	}
+	span.SetAttributes(
+		attribute.String("name", name),
+		attribute.String("difficulty", difficulty),
+		attribute.String("weekdays", weekdays),
+		attribute.String("start", start),
+		attribute.String("timezone", timezone),
+	)
+
+	template, err := d.CreateRaidTemplate(ctx, name, difficulty, weekdays, start, timezone)
+	if err != nil {
+		msg := "Error while creating raid template: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call create raid template usecase: %w", err)
+	}
+	return discord.Success(fmt.Sprintf("Raid template #%d successfully created : %s", template.ID, template)), nil
+}
+
+// ListRaidTemplatesHandler call an usecase to get every raid template
+// and return them to the user.
+func (d Discord) ListRaidTemplatesHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "RaidTemplate/ListRaidTemplatesHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	templates, err := d.ListRaidTemplates(ctx)
+	if err != nil {
+		msg := "Error while listing raid templates: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call list raid templates usecase: %w", err)
+	}
+	if len(templates) == 0 {
+		return discord.Text("no raid template found"), nil
+	}
+
+	lines := make([]string, 0, len(templates))
+	for _, template := range templates {
+		lines = append(lines, fmt.Sprintf("* #%d %s", template.ID, template))
+	}
+	return &discord.Response{Title: "Raid templates", Description: strings.Join(lines, "\n")}, nil
+}
+
+// DeleteRaidTemplateHandler call an usecase to remove a raid template
+// and return a message to the user.
+func (d Discord) DeleteRaidTemplateHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "RaidTemplate/DeleteRaidTemplateHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	span.SetAttributes(
+		attribute.String("id", optionMap["id"].StringValue()))
+	id, err := strconv.Atoi(optionMap["id"].StringValue())
+	if err != nil {
+		return discord.Text("id format is invalid"), fmt.Errorf("discord - DeleteRaidTemplateHandler - strconv.Atoi: %w", err)
+	}
+
+	templates, err := d.ListRaidTemplates(ctx)
+	if err != nil {
+		msg := "Error while deleting raid template: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call list raid templates usecase: %w", err)
+	}
+	var template *entity.RaidTemplate
+	for i := range templates {
+		if templates[i].ID == id {
+			template = &templates[i]
+		}
+	}
+	if template == nil {
+		return discord.Text("Error while deleting raid template: raid template not found"),
+			fmt.Errorf("raid template %d not found", id)
+	}
+
+	summary := fmt.Sprintf("Delete raid template #%d %s? No more raids are created from it, "+
+		"the raids it already created are kept.", template.ID, template)
+	return d.Confirmations.Ask(ctx, interaction, summary, func(ctx context.Context) (string, error) {
+		err := d.DeleteRaidTemplate(ctx, template.ID)
+		if err != nil {
+			msg := "Error while deleting raid template: " + HumanReadableError(err)
+			return msg, fmt.Errorf("call delete raid template usecase: %w", err)
+		}
+		return "Raid template successfully deleted", nil
+	})
+}
+
+// GenerateRaidsHandler call an usecase to create the raids of the templates for the next weeks
+// and return the raids created, already there, skipped on holidays and failed to the user.
+func (d Discord) GenerateRaidsHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "RaidTemplate/GenerateRaidsHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	weeks := 0
+	if opt, ok := optionMap["weeks"]; ok {
+		weeks = int(opt.IntValue())
+	}
+	span.SetAttributes(
+		attribute.Int("weeks", weeks),
+	)
+
+	generation, err := d.GenerateRaids(ctx, time.Now(), weeks)
+	if err != nil {
+		msg := "Error while creating raids from templates: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call generate raids usecase: %w", err)
+	}
+	if generation.IsEmpty() {
+		return discord.Text("no raid to create, add a raid template first"), nil
+	}
+	return raidGenerationResponse("Raids from templates", generation), nil
+}
+
+// raidGenerationResponse returns the report of a raid generation with a field for each outcome,
+// empty ones are left out.
+func raidGenerationResponse(title string, generation entity.RaidGeneration) *discord.Response {
+	response := &discord.Response{Title: title, Colour: discord.ColourSuccess}
+	if len(generation.Failed) > 0 {
+		response.Colour = discord.ColourWarning
+	}
+	response.Fields = appendListField(response.Fields, "Created", generationLines(generation.Created))
+	response.Fields = appendListField(response.Fields, "Already there", generationLines(generation.Existing))
+	response.Fields = appendListField(response.Fields, "Skipped on holidays", generationLines(generation.Skipped))
+	response.Fields = appendListField(response.Fields, "Deleted, not created again",
+		generationLines(generation.Deleted))
+	failed := make([]string, 0, len(generation.Failed))
+	for _, fail := range generation.Failed {
+		failed = append(failed, generationLines([]entity.Raid{fail.Raid})[0]+" : "+fail.Reason)
+	}
+	response.Fields = appendListField(response.Fields, "Failed", failed)
+	return response
+}
+
+// generationLines returns a line for each raid of a generation.
+func generationLines(raids []entity.Raid) []string {
+	lines := make([]string, 0, len(raids))
+	for _, raid := range raids {
+		lines = append(lines, "* "+raid.Name+" "+raid.Difficulty+" "+raid.Date.Format("Mon 02/01/06"))
+	}
+	return lines
+}
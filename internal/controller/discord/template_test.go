@@ -0,0 +1,193 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/internal/controller/discord/mocks"
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestDiscord_CreateRaidTemplateHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockRaidTemplateUseCase := mocks.NewRaidTemplateUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidTemplateUseCase: mockRaidTemplateUseCase,
+		}
+
+		mockRaidTemplateUseCase.On("CreateRaidTemplate", mock.Anything,
+			"nighthold", "heroic", "monday,wednesday", "21:00", "Europe/Paris").
+			Return(entity.RaidTemplate{
+				ID:         2,
+				Name:       "nighthold",
+				Difficulty: "heroic",
+				Weekdays:   []time.Weekday{time.Monday, time.Wednesday},
+				StartTime:  21 * time.Hour,
+				Timezone:   "Europe/Paris",
+			}, nil)
+
+		response, err := discord.CreateRaidTemplateHandler(context.Background(),
+			commandInteraction("guildops-raid-template-create",
+				stringOption("name", "nighthold"),
+				stringOption("difficulty", "heroic"),
+				&discordgo.ApplicationCommandInteractionDataOption{
+					Name: "weekdays", Type: discordgo.ApplicationCommandOptionString, Value: "monday,wednesday",
+				},
+				stringOption("start", "21:00"),
+				stringOption("timezone", "Europe/Paris")))
+		assert.NoError(t, err)
+		assert.Equal(t, "Raid template #2 successfully created : "+
+			"nighthold heroic on Monday, Wednesday at 21:00 Europe/Paris", response.Description)
+	})
+}
+
+func TestDiscord_DeleteRaidTemplateHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		discord := discordHandler.Discord{
+			RaidTemplateUseCase: mocks.NewRaidTemplateUseCase(t),
+		}
+
+		response, err := discord.DeleteRaidTemplateHandler(context.Background(),
+			commandInteraction("guildops-raid-template-delete",
+				stringOption("id", "two")))
+		assert.Error(t, err)
+		assert.Equal(t, "id format is invalid", response.Description)
+	})
+
+	t.Run("Asks for confirmation", func(t *testing.T) {
+		t.Parallel()
+		mockRaidTemplateUseCase := mocks.NewRaidTemplateUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidTemplateUseCase: mockRaidTemplateUseCase,
+			Confirmations:       discordHandler.NewConfirmations(),
+		}
+
+		mockRaidTemplateUseCase.On("ListRaidTemplates", mock.Anything).Return([]entity.RaidTemplate{{
+			ID: 2, Name: "nighthold", Difficulty: "heroic", Weekdays: []time.Weekday{time.Monday},
+			StartTime: 21 * time.Hour, Timezone: "Europe/Paris",
+		}}, nil)
+
+		response, err := discord.DeleteRaidTemplateHandler(context.Background(),
+			commandInteraction("guildops-raid-template-delete", stringOption("id", "2")))
+		assert.NoError(t, err)
+		assert.True(t, response.Ephemeral)
+		assert.Equal(t, "Delete raid template #2 nighthold heroic on Monday at 21:00 Europe/Paris? "+
+			"No more raids are created from it, the raids it already created are kept.", response.Description)
+		mockRaidTemplateUseCase.AssertNotCalled(t, "DeleteRaidTemplate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Without confirmations", func(t *testing.T) {
+		t.Parallel()
+		mockRaidTemplateUseCase := mocks.NewRaidTemplateUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidTemplateUseCase: mockRaidTemplateUseCase,
+		}
+
+		mockRaidTemplateUseCase.On("ListRaidTemplates", mock.Anything).Return([]entity.RaidTemplate{{ID: 2}}, nil)
+		mockRaidTemplateUseCase.On("DeleteRaidTemplate", mock.Anything, 2).Return(nil)
+
+		response, err := discord.DeleteRaidTemplateHandler(context.Background(),
+			commandInteraction("guildops-raid-template-delete", stringOption("id", "2")))
+		assert.NoError(t, err)
+		assert.Equal(t, "Raid template successfully deleted", response.Description)
+	})
+
+	t.Run("Unknown template", func(t *testing.T) {
+		t.Parallel()
+		mockRaidTemplateUseCase := mocks.NewRaidTemplateUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidTemplateUseCase: mockRaidTemplateUseCase,
+			Confirmations:       discordHandler.NewConfirmations(),
+		}
+
+		mockRaidTemplateUseCase.On("ListRaidTemplates", mock.Anything).Return(nil, nil)
+
+		response, err := discord.DeleteRaidTemplateHandler(context.Background(),
+			commandInteraction("guildops-raid-template-delete", stringOption("id", "2")))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while deleting raid template: raid template not found", response.Description)
+	})
+}
+
+func TestDiscord_GenerateRaidsHandler(t *testing.T) {
+	t.Parallel()
+
+	monday := entity.Raid{
+		ID:         1,
+		Name:       "nighthold",
+		Difficulty: "heroic",
+		Date:       time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockRaidTemplateUseCase := mocks.NewRaidTemplateUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidTemplateUseCase: mockRaidTemplateUseCase,
+		}
+
+		mockRaidTemplateUseCase.On("GenerateRaids", mock.Anything, mock.Anything, 4).
+			Return(entity.RaidGeneration{Created: []entity.Raid{monday}}, nil)
+
+		response, err := discord.GenerateRaidsHandler(context.Background(),
+			commandInteraction("guildops-raid-template-generate",
+				&discordgo.ApplicationCommandInteractionDataOption{
+					Name: "weeks", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(4),
+				}))
+		assert.NoError(t, err)
+		assert.Equal(t, "Raids from templates\n\nCreated (1)\n* nighthold heroic Mon 02/10/23\n", response.String())
+	})
+
+	t.Run("No template", func(t *testing.T) {
+		t.Parallel()
+		mockRaidTemplateUseCase := mocks.NewRaidTemplateUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidTemplateUseCase: mockRaidTemplateUseCase,
+		}
+
+		mockRaidTemplateUseCase.On("GenerateRaids", mock.Anything, mock.Anything, 0).
+			Return(entity.RaidGeneration{}, nil)
+
+		response, err := discord.GenerateRaidsHandler(context.Background(),
+			commandInteraction("guildops-raid-template-generate"))
+		assert.NoError(t, err)
+		assert.Equal(t, "no raid to create, add a raid template first", response.Description)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+		mockRaidTemplateUseCase := mocks.NewRaidTemplateUseCase(t)
+
+		discord := discordHandler.Discord{
+			RaidTemplateUseCase: mockRaidTemplateUseCase,
+		}
+
+		mockRaidTemplateUseCase.On("GenerateRaids", mock.Anything, mock.Anything, 0).
+			Return(entity.RaidGeneration{}, errors.New("search raid templates: database is down"))
+
+		response, err := discord.GenerateRaidsHandler(context.Background(),
+			commandInteraction("guildops-raid-template-generate"))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while creating raids from templates: database is down", response.Description)
+	})
+}
@@ -0,0 +1,48 @@
+package discordhandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+var TrashDescriptors = []discordgo.ApplicationCommand{
+	{
+		Name:        "guildops-undo",
+		Description: "Restore the last player, raid or loot deleted",
+	},
+}
+
+func (d Discord) InitTrash() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		"guildops-undo": d.UndoHandler,
+	}
+}
+
+// UndoHandler call an usecase to restore the last player, raid or loot deleted
+// and return a message to the user.
+func (d Discord) UndoHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Trash/UndoHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	restored, err := d.RestoreLast(ctx)
+	if err != nil {
+		msg := "Error while restoring: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call restore last usecase: %w", err)
+	}
+	return discord.Success("Restored " + restored.String()), nil
+}
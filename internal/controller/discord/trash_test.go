@@ -0,0 +1,44 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/internal/controller/discord/mocks"
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestDiscord_UndoHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockTrashUseCase := mocks.NewTrashUseCase(t)
+		discord := discordHandler.Discord{TrashUseCase: mockTrashUseCase}
+
+		mockTrashUseCase.On("RestoreLast", mock.Anything).
+			Return(entity.Deleted{Kind: entity.DeletedRaid, ID: 3, Name: "icc"}, nil)
+
+		response, err := discord.UndoHandler(context.Background(), commandInteraction("guildops-undo"))
+		assert.NoError(t, err)
+		assert.Equal(t, "Restored raid icc (ID 3)", response.Description)
+	})
+
+	t.Run("Nothing to restore", func(t *testing.T) {
+		t.Parallel()
+		mockTrashUseCase := mocks.NewTrashUseCase(t)
+		discord := discordHandler.Discord{TrashUseCase: mockTrashUseCase}
+
+		mockTrashUseCase.On("RestoreLast", mock.Anything).
+			Return(entity.Deleted{}, errors.New("nothing to restore"))
+
+		response, err := discord.UndoHandler(context.Background(), commandInteraction("guildops-undo"))
+		assert.Error(t, err)
+		assert.Equal(t, "Error while restoring: nothing to restore", response.Description)
+	})
+}
@@ -0,0 +1,225 @@
+package discordhandler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+var WishlistDescriptors = []discordgo.ApplicationCommand{
+	{
+		Name:        "guildops-wishlist-add",
+		Description: "Add an item to the wishlist of a player",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "player-name",
+				Description: "(ex: arthas)",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "item",
+				Description: "(ex: frostmourne)",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "priority",
+				Description: "1 is the item wanted the most, 5 the least",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "spec",
+				Description: "(ex: frost)",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "note",
+				Description: "(ex: best in slot)",
+				Required:    false,
+			},
+		},
+	},
+	{
+		Name:        "guildops-wishlist-remove",
+		Description: "Remove an item from the wishlist of a player",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "id",
+				Description: "(ex: 42)",
+				Required:    true,
+			},
+		},
+	},
+	{
+		Name:        "guildops-wishlist-list",
+		Description: "List the wishes of a player or on an item",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "player-name",
+				Description: "(ex: arthas)",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "item",
+				Description: "(ex: frostmourne)",
+				Required:    false,
+			},
+		},
+	},
+}
+
+func (d Discord) InitWishlist() map[string]discord.Handler {
+	return map[string]discord.Handler{
+		"guildops-wishlist-add":    d.AddWishHandler,
+		"guildops-wishlist-remove": d.RemoveWishHandler,
+		"guildops-wishlist-list":   d.ListWishesHandler,
+	}
+}
+
+// wishLines returns wishes as shown to users, one per line.
+func wishLines(wishes []entity.Wish) []string {
+	lines := make([]string, 0, len(wishes))
+	for _, wish := range wishes {
+		lines = append(lines, fmt.Sprintf("* #%d %s : %s", wish.ID, wish.Player.Name, wish))
+	}
+	return lines
+}
+
+// AddWishHandler call an usecase to add an item to the wishlist of a player
+// and return a message to the user.
+func (d Discord) AddWishHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Wish/AddWishHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	playerName := optionMap["player-name"].StringValue()
+	item := optionMap["item"].StringValue()
+	priority := int(optionMap["priority"].IntValue())
+	spec := ""
+	if opt, ok := optionMap["spec"]; ok {
+		spec = opt.StringValue()
+	}
+	note := ""
+	if opt, ok := optionMap["note"]; ok {
+		note = opt.StringValue()
+	}
+	span.SetAttributes(
+		attribute.String("player_name", playerName),
+		attribute.String("item", item),
+		attribute.Int("priority", priority),
+		attribute.String("spec", spec),
+	)
+
+	wish, err := d.AddWish(ctx, playerName, item, priority, spec, note)
+	if err != nil {
+		msg := "Error while adding wish: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call add wish usecase: %w", err)
+	}
+	return discord.Success(fmt.Sprintf("Wish #%d added for %s : %s", wish.ID, wish.Player.Name, wish)), nil
+}
+
+// RemoveWishHandler call an usecase to remove a wish
+// and return a message to the user.
+func (d Discord) RemoveWishHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Wish/RemoveWishHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	span.SetAttributes(
+		attribute.String("id", optionMap["id"].StringValue()))
+	id, err := strconv.Atoi(optionMap["id"].StringValue())
+	if err != nil {
+		return discord.Text("id format is invalid"), fmt.Errorf("discord - RemoveWishHandler - strconv.Atoi: %w", err)
+	}
+
+	err = d.RemoveWish(ctx, id)
+	if err != nil {
+		msg := "Error while removing wish: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call remove wish usecase: %w", err)
+	}
+	return discord.Success("Wish successfully removed"), nil
+}
+
+// ListWishesHandler call an usecase to list the wishes of a player or on an item
+// and return them to the user, highest priority first.
+func (d Discord) ListWishesHandler(
+	ctx context.Context, interaction *discordgo.InteractionCreate,
+) (*discord.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Discord").Start(ctx, "Wish/ListWishesHandler")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("request_from", interaction.Member.User.Username),
+	)
+
+	options := interaction.ApplicationCommandData().Options
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	playerName := ""
+	if opt, ok := optionMap["player-name"]; ok {
+		playerName = opt.StringValue()
+	}
+	item := ""
+	if opt, ok := optionMap["item"]; ok {
+		item = opt.StringValue()
+	}
+	span.SetAttributes(
+		attribute.String("player_name", playerName),
+		attribute.String("item", item),
+	)
+
+	wishes, err := d.ListWishes(ctx, playerName, item)
+	if err != nil {
+		msg := "Error while listing wishes: " + HumanReadableError(err)
+		return discord.Text(msg), fmt.Errorf("call list wishes usecase: %w", err)
+	}
+	if len(wishes) == 0 {
+		return discord.Text("no wish found"), nil
+	}
+	return &discord.Response{Title: "Wishlist", Description: strings.Join(wishLines(wishes), "\n")}, nil
+}
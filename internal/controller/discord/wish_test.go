@@ -0,0 +1,131 @@
+package discordhandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
+	"github.com/antony-ramos/guildops/internal/controller/discord/mocks"
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestDiscord_AddWishHandler(t *testing.T) {
+	t.Parallel()
+
+	arthas := &entity.Player{ID: 1, Name: "arthas"}
+	interaction := commandInteraction("guildops-wishlist-add",
+		stringOption("player-name", "arthas"),
+		stringOption("item", "frostmourne"),
+		&discordgo.ApplicationCommandInteractionDataOption{
+			Name: "priority", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(1),
+		},
+		stringOption("note", "best in slot"))
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockWishlistUseCase := mocks.NewWishlistUseCase(t)
+
+		discord := discordHandler.Discord{
+			WishlistUseCase: mockWishlistUseCase,
+		}
+
+		mockWishlistUseCase.On("AddWish", mock.Anything, "arthas", "frostmourne", 1, "", "best in slot").
+			Return(entity.Wish{ID: 3, Player: arthas, Item: "frostmourne", Priority: 1, Note: "best in slot"}, nil)
+
+		response, err := discord.AddWishHandler(context.Background(), interaction)
+		assert.NoError(t, err)
+		assert.Equal(t, "Wish #3 added for arthas : frostmourne, priority 1 (best in slot)", response.Description)
+	})
+
+	t.Run("Wish already exists", func(t *testing.T) {
+		t.Parallel()
+		mockWishlistUseCase := mocks.NewWishlistUseCase(t)
+
+		discord := discordHandler.Discord{
+			WishlistUseCase: mockWishlistUseCase,
+		}
+
+		mockWishlistUseCase.On("AddWish", mock.Anything, "arthas", "frostmourne", 1, "", "best in slot").
+			Return(entity.Wish{}, errors.New("save wish: wish already exists"))
+
+		response, err := discord.AddWishHandler(context.Background(), interaction)
+		assert.Error(t, err)
+		assert.Equal(t, "Error while adding wish: wish already exists", response.Description)
+	})
+}
+
+func TestDiscord_RemoveWishHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mockWishlistUseCase := mocks.NewWishlistUseCase(t)
+
+		discord := discordHandler.Discord{
+			WishlistUseCase: mockWishlistUseCase,
+		}
+
+		mockWishlistUseCase.On("RemoveWish", mock.Anything, 3).Return(nil)
+
+		response, err := discord.RemoveWishHandler(context.Background(), commandInteraction("guildops-wishlist-remove",
+			stringOption("id", "3")))
+		assert.NoError(t, err)
+		assert.Equal(t, "Wish successfully removed", response.Description)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		discord := discordHandler.Discord{}
+
+		response, err := discord.RemoveWishHandler(context.Background(), commandInteraction("guildops-wishlist-remove",
+			stringOption("id", "three")))
+		assert.Error(t, err)
+		assert.Equal(t, "id format is invalid", response.Description)
+	})
+}
+
+func TestDiscord_ListWishesHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("On item", func(t *testing.T) {
+		t.Parallel()
+		mockWishlistUseCase := mocks.NewWishlistUseCase(t)
+
+		discord := discordHandler.Discord{
+			WishlistUseCase: mockWishlistUseCase,
+		}
+
+		mockWishlistUseCase.On("ListWishes", mock.Anything, "", "frostmourne").Return([]entity.Wish{
+			{ID: 3, Player: &entity.Player{Name: "arthas"}, Item: "frostmourne", Priority: 1, Spec: "frost"},
+			{ID: 4, Player: &entity.Player{Name: "jaina"}, Item: "frostmourne", Priority: 2},
+		}, nil)
+
+		response, err := discord.ListWishesHandler(context.Background(), commandInteraction("guildops-wishlist-list",
+			stringOption("item", "frostmourne")))
+		assert.NoError(t, err)
+		assert.Equal(t, "Wishlist\n\n"+
+			"* #3 arthas : frostmourne, priority 1, frost\n* #4 jaina : frostmourne, priority 2\n", response.String())
+	})
+
+	t.Run("No wish", func(t *testing.T) {
+		t.Parallel()
+		mockWishlistUseCase := mocks.NewWishlistUseCase(t)
+
+		discord := discordHandler.Discord{
+			WishlistUseCase: mockWishlistUseCase,
+		}
+
+		mockWishlistUseCase.On("ListWishes", mock.Anything, "arthas", "").Return(nil, nil)
+
+		response, err := discord.ListWishesHandler(context.Background(), commandInteraction("guildops-wishlist-list",
+			stringOption("player-name", "arthas")))
+		assert.NoError(t, err)
+		assert.Equal(t, "no wish found", response.Description)
+	})
+}
@@ -0,0 +1,25 @@
+package entity
+
+// Attendance sums up how a player attended raids over a period.
+// Raids only holds raids which took place once the player was created.
+type Attendance struct {
+	Player *Player
+
+	Raids  []Raid
+	Absent []Raid
+	Bench  []Raid
+	Late   []Raid
+}
+
+// Attended returns the number of raids the player came to, benched raids included.
+func (a Attendance) Attended() int {
+	return len(a.Raids) - len(a.Absent)
+}
+
+// Rate returns the percentage of raids the player came to. It is 100 when there was no raid.
+func (a Attendance) Rate() float64 {
+	if len(a.Raids) == 0 {
+		return 100
+	}
+	return float64(a.Attended()) * 100 / float64(len(a.Raids))
+}
@@ -0,0 +1,67 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestAttendance_Rate(t *testing.T) {
+	t.Parallel()
+
+	raid := entity.Raid{}
+	tests := []struct {
+		name       string
+		attendance entity.Attendance
+		want       float64
+	}{
+		{
+			name:       "No raid",
+			attendance: entity.Attendance{},
+			want:       100,
+		},
+		{
+			name: "Bench counts as attended",
+			attendance: entity.Attendance{
+				Raids:  []entity.Raid{raid, raid, raid, raid},
+				Absent: []entity.Raid{raid},
+				Bench:  []entity.Raid{raid},
+			},
+			want: 75,
+		},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if got := test.attendance.Rate(); got != test.want {
+				t.Errorf("Rate() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPlayer_ExpectedOn(t *testing.T) {
+	t.Parallel()
+
+	player := entity.Player{CreatedAt: time.Date(2023, 10, 2, 18, 30, 0, 0, time.UTC)}
+	tests := []struct {
+		name string
+		raid entity.Raid
+		want bool
+	}{
+		{name: "Raid before creation", raid: entity.Raid{Date: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)}, want: false},
+		{name: "Raid on creation day", raid: entity.Raid{Date: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)}, want: true},
+		{name: "Raid after creation", raid: entity.Raid{Date: time.Date(2023, 10, 4, 0, 0, 0, 0, time.UTC)}, want: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if got := player.ExpectedOn(test.raid); got != test.want {
+				t.Errorf("ExpectedOn() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Cascade counts what is removed along with a deleted player or raid.
+type Cascade struct {
+	Strikes  int
+	Loots    int
+	Absences int
+	Fails    int
+	// Roster is the number of raids a player is on the roster of, or the number of players on the roster of a raid.
+	Roster  int
+	Signups int
+	// Points is the number of points entries of a deleted player.
+	Points int
+	// Refunds is the number of points entries of a deleted raid which are reverted.
+	Refunds int
+	Wishes  int
+}
+
+// IsEmpty tells if nothing is removed with the deleted object.
+func (c Cascade) IsEmpty() bool {
+	return c == Cascade{}
+}
+
+// String lists what is removed, one line for each kind, skipping the ones with nothing.
+func (c Cascade) String() string {
+	if c.IsEmpty() {
+		return "nothing else"
+	}
+	var lines []string
+	for _, count := range []struct {
+		count int
+		name  string
+	}{
+		{c.Strikes, "strike"},
+		{c.Loots, "loot"},
+		{c.Absences, "absence"},
+		{c.Fails, "fail"},
+		{c.Roster, "roster entry"},
+		{c.Signups, "signup"},
+		{c.Points, "points entry"},
+		{c.Refunds, "points refund"},
+		{c.Wishes, "wish"},
+	} {
+		if count.count > 0 {
+			lines = append(lines, "* "+strconv.Itoa(count.count)+" "+plural(count.name, count.count))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// plural returns name for one and its plural for other counts.
+func plural(name string, count int) string {
+	if count == 1 {
+		return name
+	}
+	switch {
+	case strings.HasSuffix(name, "y"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	case strings.HasSuffix(name, "sh"):
+		return name + "es"
+	}
+	return name + "s"
+}
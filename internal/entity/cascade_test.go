@@ -0,0 +1,35 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestCascade_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cascade entity.Cascade
+		want    string
+	}{
+		{name: "Nothing", cascade: entity.Cascade{}, want: "nothing else"},
+		{name: "Player", cascade: entity.Cascade{Strikes: 2, Loots: 1, Roster: 3, Points: 1, Wishes: 2},
+			want: "* 2 strikes\n* 1 loot\n* 3 roster entries\n* 1 points entry\n* 2 wishes"},
+		{name: "Raid", cascade: entity.Cascade{Absences: 1, Fails: 2, Signups: 1, Refunds: 4},
+			want: "* 1 absence\n* 2 fails\n* 1 signup\n* 4 points refunds"},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if got := test.cascade.String(); got != test.want {
+				t.Errorf("String() = %q, want %q", got, test.want)
+			}
+			if got := test.cascade.IsEmpty(); got != (test.want == "nothing else") {
+				t.Errorf("IsEmpty() = %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Role is what a player does in a raid composition.
+type Role string
+
+const (
+	RoleTank   Role = "tank"
+	RoleHealer Role = "healer"
+	RoleDPS    Role = "dps"
+)
+
+// Roles lists every role in the order a composition is displayed.
+var Roles = []Role{RoleTank, RoleHealer, RoleDPS}
+
+// NewRole returns the role matching the given string. Case is ignored.
+func NewRole(role string) (Role, error) {
+	role = strings.ToLower(strings.TrimSpace(role))
+	for _, r := range Roles {
+		if string(r) == role {
+			return r, nil
+		}
+	}
+	return "", fmt.Errorf("role must be tank, healer or dps")
+}
+
+// Class is a character class of the game, with the armor it wears and the role of each of its specs.
+type Class struct {
+	Name      string
+	ArmorType string
+	Specs     map[string]Role
+}
+
+// Classes lists the known classes by name.
+var Classes = map[string]Class{
+	"death knight": {Name: "death knight", ArmorType: "plate", Specs: map[string]Role{
+		"blood": RoleTank, "frost": RoleDPS, "unholy": RoleDPS,
+	}},
+	"demon hunter": {Name: "demon hunter", ArmorType: "leather", Specs: map[string]Role{
+		"havoc": RoleDPS, "vengeance": RoleTank,
+	}},
+	"druid": {Name: "druid", ArmorType: "leather", Specs: map[string]Role{
+		"balance": RoleDPS, "feral": RoleDPS, "guardian": RoleTank, "restoration": RoleHealer,
+	}},
+	"evoker": {Name: "evoker", ArmorType: "mail", Specs: map[string]Role{
+		"augmentation": RoleDPS, "devastation": RoleDPS, "preservation": RoleHealer,
+	}},
+	"hunter": {Name: "hunter", ArmorType: "mail", Specs: map[string]Role{
+		"beast mastery": RoleDPS, "marksmanship": RoleDPS, "survival": RoleDPS,
+	}},
+	"mage": {Name: "mage", ArmorType: "cloth", Specs: map[string]Role{
+		"arcane": RoleDPS, "fire": RoleDPS, "frost": RoleDPS,
+	}},
+	"monk": {Name: "monk", ArmorType: "leather", Specs: map[string]Role{
+		"brewmaster": RoleTank, "mistweaver": RoleHealer, "windwalker": RoleDPS,
+	}},
+	"paladin": {Name: "paladin", ArmorType: "plate", Specs: map[string]Role{
+		"holy": RoleHealer, "protection": RoleTank, "retribution": RoleDPS,
+	}},
+	"priest": {Name: "priest", ArmorType: "cloth", Specs: map[string]Role{
+		"discipline": RoleHealer, "holy": RoleHealer, "shadow": RoleDPS,
+	}},
+	"rogue": {Name: "rogue", ArmorType: "leather", Specs: map[string]Role{
+		"assassination": RoleDPS, "outlaw": RoleDPS, "subtlety": RoleDPS,
+	}},
+	"shaman": {Name: "shaman", ArmorType: "mail", Specs: map[string]Role{
+		"elemental": RoleDPS, "enhancement": RoleDPS, "restoration": RoleHealer,
+	}},
+	"warlock": {Name: "warlock", ArmorType: "cloth", Specs: map[string]Role{
+		"affliction": RoleDPS, "demonology": RoleDPS, "destruction": RoleDPS,
+	}},
+	"warrior": {Name: "warrior", ArmorType: "plate", Specs: map[string]Role{
+		"arms": RoleDPS, "fury": RoleDPS, "protection": RoleTank,
+	}},
+}
+
+// NewClass returns the known class matching the given name. Case is ignored.
+func NewClass(name string) (Class, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	class, ok := Classes[name]
+	if !ok {
+		names := make([]string, 0, len(Classes))
+		for n := range Classes {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return Class{}, fmt.Errorf("class %s not valid. Must be one of %s", name, strings.Join(names, ", "))
+	}
+	return class, nil
+}
+
+// Spec returns the spec of the class matching the given name, case ignored, and its role.
+func (c Class) Spec(name string) (string, Role, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	role, ok := c.Specs[name]
+	if !ok {
+		names := make([]string, 0, len(c.Specs))
+		for n := range c.Specs {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return "", "", fmt.Errorf("spec %s not valid for %s. Must be one of %s", name, c.Name, strings.Join(names, ", "))
+	}
+	return name, role, nil
+}
+
+// CanPlay tells if one of the specs of the class has this role.
+func (c Class) CanPlay(role Role) bool {
+	for _, r := range c.Specs {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// PlayerFilter selects players by class, spec or role. Empty fields select every player.
+type PlayerFilter struct {
+	Class string
+	Spec  string
+	Role  Role
+}
+
+// NewPlayerFilter returns a filter on the given class, spec and role, which may be empty.
+// The spec is checked against the class when both are given.
+func NewPlayerFilter(class, spec, role string) (PlayerFilter, error) {
+	var filter PlayerFilter
+	if strings.TrimSpace(class) != "" {
+		c, err := NewClass(class)
+		if err != nil {
+			return PlayerFilter{}, err
+		}
+		filter.Class = c.Name
+		if strings.TrimSpace(spec) != "" {
+			filter.Spec, _, err = c.Spec(spec)
+			if err != nil {
+				return PlayerFilter{}, err
+			}
+		}
+	} else {
+		filter.Spec = strings.ToLower(strings.TrimSpace(spec))
+	}
+	if strings.TrimSpace(role) != "" {
+		r, err := NewRole(role)
+		if err != nil {
+			return PlayerFilter{}, err
+		}
+		filter.Role = r
+	}
+	return filter, nil
+}
+
+// IsEmpty tells if the filter selects every player.
+func (f PlayerFilter) IsEmpty() bool {
+	return f.Class == "" && f.Spec == "" && f.Role == ""
+}
+
+// Matches tells if the player is selected by the filter. A spec matches the main spec or the off spec of the player.
+func (f PlayerFilter) Matches(player Player) bool {
+	if f.Class != "" && player.Class != f.Class {
+		return false
+	}
+	if f.Spec != "" && player.MainSpec != f.Spec && player.OffSpec != f.Spec {
+		return false
+	}
+	if f.Role != "" && player.Role != f.Role {
+		return false
+	}
+	return true
+}
+
+// String returns the filter as shown to users.
+func (f PlayerFilter) String() string {
+	var parts []string
+	for _, part := range []string{f.Class, f.Spec, string(f.Role)} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " ")
+}
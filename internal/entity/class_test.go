@@ -0,0 +1,125 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestNewRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		role    string
+		want    entity.Role
+		wantErr bool
+	}{
+		{name: "Tank", role: "tank", want: entity.RoleTank},
+		{name: "Case is ignored", role: " Healer ", want: entity.RoleHealer},
+		{name: "Unknown role", role: "support", wantErr: true},
+		{name: "Empty role", role: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := entity.NewRole(test.role)
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewRole() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("NewRole() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestClass_Spec(t *testing.T) {
+	t.Parallel()
+
+	class, err := entity.NewClass(" Paladin ")
+	if err != nil {
+		t.Fatalf("NewClass() error = %v", err)
+	}
+	if class.ArmorType != "plate" {
+		t.Errorf("ArmorType = %v, want plate", class.ArmorType)
+	}
+	if _, err := entity.NewClass("bard"); err == nil {
+		t.Errorf("NewClass() expected an error for an unknown class")
+	}
+
+	spec, role, err := class.Spec("Holy")
+	if err != nil || spec != "holy" || role != entity.RoleHealer {
+		t.Errorf("Spec() = %v, %v, %v", spec, role, err)
+	}
+	if _, _, err := class.Spec("frost"); err == nil {
+		t.Errorf("Spec() expected an error for a spec of another class")
+	}
+
+	if !class.CanPlay(entity.RoleTank) {
+		t.Errorf("CanPlay(tank) = false, want true")
+	}
+	hunter := entity.Classes["hunter"]
+	if hunter.CanPlay(entity.RoleHealer) {
+		t.Errorf("CanPlay(healer) = true, want false")
+	}
+}
+
+func TestPlayerFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	jaina := entity.Player{Name: "jaina", Class: "mage", MainSpec: "frost", Role: entity.RoleDPS}
+	uther := entity.Player{
+		Name: "uther", Class: "paladin", MainSpec: "holy", OffSpec: "protection", Role: entity.RoleHealer,
+	}
+	tests := []struct {
+		name      string
+		class     string
+		spec      string
+		role      string
+		wantErr   bool
+		wantJaina bool
+		wantUther bool
+	}{
+		{name: "Empty filter", wantJaina: true, wantUther: true},
+		{name: "Class", class: "Mage", wantJaina: true},
+		{name: "Off spec", class: "paladin", spec: "protection", wantUther: true},
+		{name: "Spec without class", spec: "frost", wantJaina: true},
+		{name: "Role", role: "healer", wantUther: true},
+		{name: "Unknown class", class: "bard", wantErr: true},
+		{name: "Spec of another class", class: "mage", spec: "holy", wantErr: true},
+		{name: "Unknown role", role: "support", wantErr: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			filter, err := entity.NewPlayerFilter(test.class, test.spec, test.role)
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewPlayerFilter() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := filter.Matches(jaina); got != test.wantJaina {
+				t.Errorf("Matches(jaina) = %v, want %v", got, test.wantJaina)
+			}
+			if got := filter.Matches(uther); got != test.wantUther {
+				t.Errorf("Matches(uther) = %v, want %v", got, test.wantUther)
+			}
+		})
+	}
+}
+
+func TestPlayerFilter_String(t *testing.T) {
+	t.Parallel()
+
+	filter := entity.PlayerFilter{Class: "paladin", Role: entity.RoleTank}
+	if got := filter.String(); got != "paladin tank" {
+		t.Errorf("String() = %v", got)
+	}
+	if !(entity.PlayerFilter{}).IsEmpty() || filter.IsEmpty() {
+		t.Errorf("IsEmpty() is wrong")
+	}
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// DeletedKind tells what a deleted object is.
+type DeletedKind string
+
+const (
+	DeletedPlayer DeletedKind = "player"
+	DeletedRaid   DeletedKind = "raid"
+	DeletedLoot   DeletedKind = "loot"
+)
+
+// Deleted is a player, a raid or a loot deleted. It is hidden along with what belongs to it
+// until it is restored, or purged for good.
+type Deleted struct {
+	Kind      DeletedKind
+	ID        int
+	Name      string
+	DeletedAt time.Time
+}
+
+// String returns the kind, name and ID of the deleted object, like "raid icc (ID 3)".
+func (d Deleted) String() string {
+	return fmt.Sprintf("%s %s (ID %d)", d.Kind, d.Name, d.ID)
+}
@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Item is an item of the game, as known by the item catalogue.
+type Item struct {
+	// ID is the ID of the item in the game.
+	ID        int
+	Name      string
+	Slot      string
+	ItemLevel int
+	// ArmorType is cloth, leather, mail or plate for armors, empty for other items.
+	ArmorType string
+}
+
+func NewItem(id int, name, slot string, itemLevel int, armorType string) (Item, error) {
+	if id <= 0 {
+		return Item{}, fmt.Errorf("id must be a positive number")
+	}
+
+	// Items are named like loots, so a loot can be matched with its item
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) < 1 || len(name) > 100 {
+		return Item{}, fmt.Errorf("name must be between 1 and 100 characters")
+	}
+
+	slot = strings.ToLower(strings.TrimSpace(slot))
+	if len(slot) > 20 {
+		return Item{}, fmt.Errorf("slot must be less than 20 characters")
+	}
+
+	if itemLevel < 0 {
+		return Item{}, fmt.Errorf("item level cannot be negative")
+	}
+
+	armorType = strings.ToLower(strings.TrimSpace(armorType))
+	if len(armorType) > 20 {
+		return Item{}, fmt.Errorf("armor type must be less than 20 characters")
+	}
+
+	return Item{
+		ID:        id,
+		Name:      name,
+		Slot:      slot,
+		ItemLevel: itemLevel,
+		ArmorType: armorType,
+	}, nil
+}
+
+// String returns the item as shown to users: its name, ID and known metadata.
+func (i Item) String() string {
+	details := []string{fmt.Sprintf("#%d", i.ID)}
+	if i.Slot != "" {
+		details = append(details, i.Slot)
+	}
+	if i.ArmorType != "" {
+		details = append(details, i.ArmorType)
+	}
+	if i.ItemLevel > 0 {
+		details = append(details, fmt.Sprintf("ilvl %d", i.ItemLevel))
+	}
+	return i.Name + " (" + strings.Join(details, ", ") + ")"
+}
+
+// ClosestItem returns the item whose name is the closest to name, allowing about one typo every four characters.
+// It returns false when no item is close enough or when several items are as close.
+func ClosestItem(items []Item, name string) (Item, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	maxDistance := len(name) / 4
+	if maxDistance == 0 {
+		maxDistance = 1
+	}
+
+	var closest Item
+	best := maxDistance + 1
+	tied := false
+	for _, item := range items {
+		distance := levenshtein(name, item.Name)
+		switch {
+		case distance < best:
+			closest, best, tied = item, distance, false
+		case distance == best:
+			tied = true
+		}
+	}
+	if best > maxDistance || tied {
+		return Item{}, false
+	}
+	return closest, true
+}
+
+// levenshtein returns how many characters must be inserted, deleted or replaced to turn a into b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
@@ -0,0 +1,84 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestItem_NewItem(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		id       int
+		itemName string
+		level    int
+		wantName string
+		wantErr  bool
+	}{
+		{name: "Valid", id: 49623, itemName: " Shadowmourne ", level: 284, wantName: "shadowmourne"},
+		{name: "Without item level", id: 49623, itemName: "shadowmourne", wantName: "shadowmourne"},
+		{name: "Invalid id", itemName: "shadowmourne", wantErr: true},
+		{name: "Empty name", id: 49623, itemName: " ", wantErr: true},
+		{name: "Negative item level", id: 49623, itemName: "shadowmourne", level: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			item, err := entity.NewItem(test.id, test.itemName, "Two-Hand", test.level, "")
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewItem() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err == nil && (item.Name != test.wantName || item.Slot != "two-hand") {
+				t.Errorf("NewItem() = %v", item)
+			}
+		})
+	}
+}
+
+func TestItem_String(t *testing.T) {
+	t.Parallel()
+
+	item := entity.Item{ID: 49623, Name: "shadowmourne"}
+	if got := item.String(); got != "shadowmourne (#49623)" {
+		t.Errorf("String() = %v", got)
+	}
+	item = entity.Item{ID: 50605, Name: "snowstorm helm", Slot: "head", ItemLevel: 264, ArmorType: "mail"}
+	if got := item.String(); got != "snowstorm helm (#50605, head, mail, ilvl 264)" {
+		t.Errorf("String() = %v", got)
+	}
+}
+
+func TestClosestItem(t *testing.T) {
+	t.Parallel()
+
+	items := []entity.Item{
+		{ID: 49623, Name: "shadowmourne"},
+		{ID: 50605, Name: "snowstorm helm"},
+		{ID: 50606, Name: "snowstorm helmet"},
+	}
+	tests := []struct {
+		name   string
+		query  string
+		wantID int
+		wantOK bool
+	}{
+		{name: "Exact name", query: "shadowmourne", wantID: 49623, wantOK: true},
+		{name: "Typos", query: "Shadowmorne", wantID: 49623, wantOK: true},
+		{name: "Too far", query: "frostmourne", wantOK: false},
+		{name: "Exact name among close ones", query: "snowstorm helm", wantID: 50605, wantOK: true},
+		{name: "As close to several items", query: "snowstorm helme", wantOK: false},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			item, ok := entity.ClosestItem(items, test.query)
+			if ok != test.wantOK || item.ID != test.wantID {
+				t.Errorf("ClosestItem() = %v, %v, want %v, %v", item.ID, ok, test.wantID, test.wantOK)
+			}
+		})
+	}
+}
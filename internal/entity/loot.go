@@ -10,6 +10,8 @@ type Loot struct {
 	Name   string
 	Player *Player
 	Raid   *Raid
+	// Item is the item of the catalogue the loot is, nil when the loot is not in the catalogue.
+	Item *Item
 }
 
 func NewLoot(index int, name string, player *Player, raid *Raid) (Loot, error) {
@@ -17,8 +19,8 @@ func NewLoot(index int, name string, player *Player, raid *Raid) (Loot, error) {
 		return Loot{}, fmt.Errorf("name cannot be empty")
 	}
 	name = strings.ToLower(name)
-	if len(name) < 1 || len(name) > 30 {
-		return Loot{}, fmt.Errorf("name must be between 1 and 30 characters")
+	if len(name) < 1 || len(name) > 100 {
+		return Loot{}, fmt.Errorf("name must be between 1 and 100 characters")
 	}
 
 	if player == nil {
@@ -36,3 +38,74 @@ func NewLoot(index int, name string, player *Player, raid *Raid) (Loot, error) {
 		Raid:   raid,
 	}, nil
 }
+
+// LootStrategy is the way a loot is given among several players.
+type LootStrategy string
+
+const (
+	// LootStrategyLowestCount picks the player with the fewest loots in the difficulty.
+	LootStrategyLowestCount LootStrategy = "lowest-count"
+	// LootStrategyAttendance picks the player with the best attendance for each loot received.
+	LootStrategyAttendance LootStrategy = "attendance"
+	// LootStrategyLastLoot picks the player who waited the longest since their last loot in the difficulty.
+	LootStrategyLastLoot LootStrategy = "last-loot"
+	// LootStrategyPoints picks the player with the most points in the points ledger.
+	LootStrategyPoints LootStrategy = "points"
+)
+
+// LootStrategies lists every loot strategy.
+var LootStrategies = []LootStrategy{
+	LootStrategyLowestCount, LootStrategyAttendance, LootStrategyLastLoot, LootStrategyPoints,
+}
+
+// ParseLootStrategy returns the loot strategy named name.
+func ParseLootStrategy(name string) (LootStrategy, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, strategy := range LootStrategies {
+		if string(strategy) == name {
+			return strategy, nil
+		}
+	}
+	names := make([]string, 0, len(LootStrategies))
+	for _, strategy := range LootStrategies {
+		names = append(names, string(strategy))
+	}
+	return "", fmt.Errorf("loot strategy %s not valid. Must be one of %s", name, strings.Join(names, ", "))
+}
+
+// LootCandidate is a player who may receive a loot, with what a strategy scored them on.
+// Players who wished the loot come first, by priority, then higher scores come first.
+type LootCandidate struct {
+	Player Player
+	Score  float64
+	Reason string
+	// Wish is the wish of the player on the loot, nil if they did not wish it.
+	Wish *Wish
+}
+
+// Before tells if the candidate comes before other for a loot.
+func (c LootCandidate) Before(other LootCandidate) bool {
+	if c.wishRank() != other.wishRank() {
+		return c.wishRank() < other.wishRank()
+	}
+	return c.Score > other.Score
+}
+
+// wishRank is the priority of the wish of the candidate, after every priority when they did not wish the loot.
+func (c LootCandidate) wishRank() int {
+	if c.Wish == nil {
+		return WishLowestPriority + 1
+	}
+	return c.Wish.Priority
+}
+
+// LootSelection is the player chosen to receive a loot and why they were chosen.
+// Candidates are sorted by score, the winner first.
+type LootSelection struct {
+	Strategy   LootStrategy
+	Winner     Player
+	Candidates []LootCandidate
+	Tied       int
+	// Excluded are the players left out of the selection, with the reason why.
+	Excluded []LootCandidate
+}
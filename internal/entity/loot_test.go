@@ -2,6 +2,7 @@ package entity_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/antony-ramos/guildops/internal/entity"
@@ -53,7 +54,7 @@ func TestNewLoot(t *testing.T) {
 			name: "Invalid Loot - Name Length",
 			args: args{
 				id:     1,
-				name:   "lootnameadzqdqzdklqzdkqzdlqzkdqlzdkqzmdkqzdlqzdkqzd",
+				name:   strings.Repeat("lootname", 13),
 				player: &entity.Player{},
 				raid:   &entity.Raid{},
 			},
@@ -0,0 +1,17 @@
+package entity
+
+// Page returns the part of list a search with limit and offset returns:
+// at most limit elements after the first offset ones, all of them when limit is 0.
+func Page[T any](list []T, limit, offset int) []T {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(list) {
+		return nil
+	}
+	list = list[offset:]
+	if limit > 0 && limit < len(list) {
+		list = list[:limit]
+	}
+	return list
+}
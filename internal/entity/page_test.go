@@ -0,0 +1,37 @@
+package entity_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestPage(t *testing.T) {
+	t.Parallel()
+
+	list := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		name   string
+		limit  int
+		offset int
+		want   []int
+	}{
+		{name: "No limit", limit: 0, offset: 0, want: []int{1, 2, 3, 4, 5}},
+		{name: "First page", limit: 2, offset: 0, want: []int{1, 2}},
+		{name: "Middle page", limit: 2, offset: 2, want: []int{3, 4}},
+		{name: "Last page is shorter", limit: 2, offset: 4, want: []int{5}},
+		{name: "Offset without limit", limit: 0, offset: 3, want: []int{4, 5}},
+		{name: "After the end", limit: 2, offset: 5, want: nil},
+		{name: "Negative offset", limit: 2, offset: -1, want: []int{1, 2}},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if got := entity.Page(list, test.limit, test.offset); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Page() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParticipantStatus tells how a player took part in a raid.
+type ParticipantStatus string
+
+const (
+	ParticipantPresent ParticipantStatus = "present"
+	ParticipantBench   ParticipantStatus = "bench"
+	ParticipantLate    ParticipantStatus = "late"
+	ParticipantAbsent  ParticipantStatus = "absent"
+)
+
+// ParticipantStatuses lists every status in the order a roster is displayed.
+var ParticipantStatuses = []ParticipantStatus{
+	ParticipantPresent, ParticipantLate, ParticipantBench, ParticipantAbsent,
+}
+
+// NewParticipantStatus returns the status matching the given string. Case is ignored.
+func NewParticipantStatus(status string) (ParticipantStatus, error) {
+	status = strings.ToLower(strings.TrimSpace(status))
+	for _, s := range ParticipantStatuses {
+		if string(s) == status {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("status must be present, bench, late or absent")
+}
+
+// Participant is a player on the roster of a raid.
+type Participant struct {
+	Player *Player
+	Raid   *Raid
+	Status ParticipantStatus
+}
+
+func NewParticipant(player *Player, raid *Raid, status string) (Participant, error) {
+	if player == nil {
+		return Participant{}, fmt.Errorf("player cannot be nil")
+	}
+	if raid == nil {
+		return Participant{}, fmt.Errorf("raid cannot be nil")
+	}
+
+	participantStatus, err := NewParticipantStatus(status)
+	if err != nil {
+		return Participant{}, err
+	}
+
+	return Participant{
+		Player: player,
+		Raid:   raid,
+		Status: participantStatus,
+	}, nil
+}
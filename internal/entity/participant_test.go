@@ -0,0 +1,111 @@
+package entity_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestNewParticipant(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		player *entity.Player
+		raid   *entity.Raid
+		status string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    entity.Participant
+		wantErr bool
+	}{
+		{
+			name: "Valid Participant",
+			args: args{
+				player: &entity.Player{},
+				raid:   &entity.Raid{},
+				status: "Bench",
+			},
+			want: entity.Participant{
+				Player: &entity.Player{},
+				Raid:   &entity.Raid{},
+				Status: entity.ParticipantBench,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid Participant - Unknown Status",
+			args: args{
+				player: &entity.Player{},
+				raid:   &entity.Raid{},
+				status: "tank",
+			},
+			want:    entity.Participant{},
+			wantErr: true,
+		},
+		{
+			name: "Invalid Participant - Player Nil",
+			args: args{
+				player: nil,
+				raid:   &entity.Raid{},
+				status: "present",
+			},
+			want:    entity.Participant{},
+			wantErr: true,
+		},
+		{
+			name: "Invalid Participant - Raid Nil",
+			args: args{
+				player: &entity.Player{},
+				raid:   nil,
+				status: "present",
+			},
+			want:    entity.Participant{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := entity.NewParticipant(test.args.player, test.args.raid, test.args.status)
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewParticipant() error = %v, wantErr %v", err, test.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("NewParticipant() got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRaid_SetRoster(t *testing.T) {
+	t.Parallel()
+
+	arthas := &entity.Player{ID: 1, Name: "arthas"}
+	jaina := &entity.Player{ID: 2, Name: "jaina"}
+	thrall := &entity.Player{ID: 3, Name: "thrall"}
+	raid := entity.Raid{Bench: []*entity.Player{thrall}}
+
+	raid.SetRoster([]entity.Participant{
+		{Player: arthas, Status: entity.ParticipantPresent},
+		{Player: jaina, Status: entity.ParticipantLate},
+		{Player: thrall, Status: entity.ParticipantAbsent},
+	})
+
+	if !reflect.DeepEqual(raid.Players, []*entity.Player{arthas}) {
+		t.Errorf("SetRoster() Players = %v", raid.Players)
+	}
+	if !reflect.DeepEqual(raid.Late, []*entity.Player{jaina}) {
+		t.Errorf("SetRoster() Late = %v", raid.Late)
+	}
+	if raid.Bench != nil {
+		t.Errorf("SetRoster() Bench = %v, want nil", raid.Bench)
+	}
+	if !reflect.DeepEqual(raid.Absences, []*entity.Player{thrall}) {
+		t.Errorf("SetRoster() Absences = %v", raid.Absences)
+	}
+}
@@ -3,13 +3,30 @@ package entity
 import (
 	"fmt"
 	"strings"
+	"time"
 	"unicode"
 )
 
 type Player struct {
-	ID          int
-	Name        string
+	ID   int
+	Name string
+	// DiscordID is the ID of the discord user linked to the player, DiscordName is its username,
+	// only kept to be shown. Links are made on the ID, a username can change and be taken by someone else.
+	DiscordID   string
 	DiscordName string
+	CreatedAt   time.Time
+
+	// Class, MainSpec and OffSpec are names of Classes and of their specs, empty when not set.
+	Class    string
+	MainSpec string
+	OffSpec  string
+	Role     Role
+
+	// MainID is the ID of the main character of the player when the player is an alt, 0 when it is a main.
+	MainID int
+	// Characters are every character of the person the player belongs to, main first then alts by name.
+	// It is empty when the person has no alt.
+	Characters []Player
 
 	Strikes     []Strike
 	Loots       []Loot
@@ -40,3 +57,143 @@ func NewPlayer(index int, name, discordName string) (Player, error) {
 		DiscordName: discordName,
 	}, nil
 }
+
+// ExpectedOn tells if the player was already created on the day of the raid,
+// so the raid counts in the attendance of the player.
+func (p Player) ExpectedOn(raid Raid) bool {
+	createdOn := time.Date(p.CreatedAt.Year(), p.CreatedAt.Month(), p.CreatedAt.Day(), 0, 0, 0, 0, time.UTC)
+	return !raid.Date.Before(createdOn)
+}
+
+// IsDiscordID tells if id is the ID of a discord user, a snowflake made of digits.
+// Players were linked by discord username before, such links are not IDs.
+func IsDiscordID(id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, char := range id {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// HasUsernameLink tells if the player is still linked by discord username, not by discord ID.
+func (p Player) HasUsernameLink() bool {
+	return p.DiscordID != "" && !IsDiscordID(p.DiscordID)
+}
+
+// SetClass sets the class, specs and role of the player. Specs must be specs of the class
+// and the class must be able to play the role. The role defaults to the role of the main spec.
+// Empty values are cleared.
+func (p *Player) SetClass(class, mainSpec, offSpec, role string) error {
+	var r Role
+	if strings.TrimSpace(role) != "" {
+		var err error
+		r, err = NewRole(role)
+		if err != nil {
+			return err
+		}
+	}
+
+	if strings.TrimSpace(class) == "" {
+		if strings.TrimSpace(mainSpec) != "" || strings.TrimSpace(offSpec) != "" {
+			return fmt.Errorf("class is required to set a spec")
+		}
+		p.Class, p.MainSpec, p.OffSpec, p.Role = "", "", "", r
+		return nil
+	}
+
+	c, err := NewClass(class)
+	if err != nil {
+		return err
+	}
+	var main, off string
+	var mainRole Role
+	if strings.TrimSpace(mainSpec) != "" {
+		main, mainRole, err = c.Spec(mainSpec)
+		if err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(offSpec) != "" {
+		off, _, err = c.Spec(offSpec)
+		if err != nil {
+			return err
+		}
+	}
+	if off != "" && off == main {
+		return fmt.Errorf("off spec must be different from main spec")
+	}
+	if r == "" {
+		r = mainRole
+	} else if !c.CanPlay(r) {
+		return fmt.Errorf("%s can't play %s", c.Name, r)
+	}
+
+	p.Class, p.MainSpec, p.OffSpec, p.Role = c.Name, main, off, r
+	return nil
+}
+
+// ArmorType returns the armor type worn by the class of the player, empty when the class is not set.
+func (p Player) ArmorType() string {
+	return Classes[p.Class].ArmorType
+}
+
+// ClassString returns the class, specs and role of the player as shown to users, empty when none is set.
+func (p Player) ClassString() string {
+	s := p.Class
+	if p.MainSpec != "" {
+		s += " " + p.MainSpec
+	}
+	if p.OffSpec != "" {
+		s += " (off spec " + p.OffSpec + ")"
+	}
+	if p.Role != "" {
+		if s != "" {
+			s += ", "
+		}
+		s += string(p.Role)
+	}
+	return s
+}
+
+// IsAlt tells if the player is the alt of another player.
+func (p Player) IsAlt() bool {
+	return p.MainID != 0
+}
+
+// SetMain makes the player an alt of main. An alt is linked to the discord account of its main,
+// so its own discord account is unlinked.
+func (p *Player) SetMain(main Player) error {
+	if main.ID == p.ID {
+		return fmt.Errorf("a player can't be its own alt")
+	}
+	if main.IsAlt() {
+		return fmt.Errorf("%s is an alt, an alt must be linked to a main", main.Name)
+	}
+	p.MainID = main.ID
+	p.DiscordID, p.DiscordName = "", ""
+	return nil
+}
+
+// Main returns the main character of the person the player belongs to, the player itself without characters.
+func (p Player) Main() Player {
+	if len(p.Characters) > 0 {
+		return p.Characters[0]
+	}
+	return p
+}
+
+// CharacterIDs returns the IDs of every character of the person the player belongs to.
+func (p Player) CharacterIDs() []int {
+	if len(p.Characters) == 0 {
+		return []int{p.ID}
+	}
+	ids := make([]int, 0, len(p.Characters))
+	for _, character := range p.Characters {
+		ids = append(ids, character.ID)
+	}
+	return ids
+}
@@ -81,3 +81,100 @@ func TestNewPlayer(t *testing.T) {
 		})
 	}
 }
+
+func TestPlayer_SetClass(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		class    string
+		mainSpec string
+		offSpec  string
+		role     string
+		want     string
+		wantErr  bool
+	}{
+		{name: "Role from main spec", class: "Paladin", mainSpec: "holy", offSpec: "protection",
+			want: "paladin holy (off spec protection), healer"},
+		{name: "Role given", class: "paladin", mainSpec: "holy", role: "tank", want: "paladin holy, tank"},
+		{name: "Class only", class: "mage", want: "mage"},
+		{name: "Nothing", want: ""},
+		{name: "Unknown class", class: "bard", wantErr: true},
+		{name: "Spec of another class", class: "mage", mainSpec: "holy", wantErr: true},
+		{name: "Same main and off spec", class: "mage", mainSpec: "frost", offSpec: "frost", wantErr: true},
+		{name: "Role the class can't play", class: "mage", role: "healer", wantErr: true},
+		{name: "Spec without class", mainSpec: "frost", wantErr: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			player := entity.Player{Name: "uther"}
+			err := player.SetClass(test.class, test.mainSpec, test.offSpec, test.role)
+			if (err != nil) != test.wantErr {
+				t.Errorf("SetClass() error = %v, wantErr %v", err, test.wantErr)
+				return
+			}
+			if got := player.ClassString(); err == nil && got != test.want {
+				t.Errorf("ClassString() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPlayer_SetMain(t *testing.T) {
+	t.Parallel()
+
+	arthas := entity.Player{ID: 1, Name: "arthas", DiscordID: "100000000000000001", DiscordName: "arthas"}
+	lichking := entity.Player{ID: 2, Name: "lichking", DiscordID: "100000000000000002", DiscordName: "lichking"}
+
+	if err := arthas.SetMain(arthas); err == nil {
+		t.Errorf("SetMain() expected an error when a player is its own main")
+	}
+	if err := lichking.SetMain(arthas); err != nil {
+		t.Fatalf("SetMain() error = %v", err)
+	}
+	if !lichking.IsAlt() || lichking.MainID != 1 || lichking.DiscordID != "" || lichking.DiscordName != "" {
+		t.Errorf("SetMain() player = %v", lichking)
+	}
+	jaina := entity.Player{ID: 3, Name: "jaina"}
+	if err := jaina.SetMain(lichking); err == nil {
+		t.Errorf("SetMain() expected an error when the main is an alt")
+	}
+
+	if got := arthas.CharacterIDs(); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("CharacterIDs() = %v", got)
+	}
+	lichking.Characters = []entity.Player{arthas, lichking}
+	if got := lichking.CharacterIDs(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("CharacterIDs() = %v", got)
+	}
+	if got := lichking.Main().Name; got != "arthas" {
+		t.Errorf("Main() = %v", got)
+	}
+}
+
+func TestPlayer_HasUsernameLink(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		discordID string
+		want      bool
+	}{
+		{name: "Not linked", discordID: "", want: false},
+		{name: "Linked by ID", discordID: "180976252397830144", want: false},
+		{name: "Linked by username", discordID: "arthas", want: true},
+		{name: "Username with digits", discordID: "arthas42", want: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			player := entity.Player{Name: "arthas", DiscordID: test.discordID}
+			if got := player.HasUsernameLink(); got != test.want {
+				t.Errorf("HasUsernameLink() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PointsKind tells why points were earned or spent.
+type PointsKind string
+
+const (
+	PointsAttendance PointsKind = "attendance"
+	PointsAward      PointsKind = "award"
+	PointsLoot       PointsKind = "loot"
+	PointsDecay      PointsKind = "decay"
+	PointsReversal   PointsKind = "reversal"
+)
+
+// PointsKinds lists every kind of ledger entry.
+var PointsKinds = []PointsKind{PointsAttendance, PointsAward, PointsLoot, PointsDecay, PointsReversal}
+
+// PointsSystemAuthor is the author of entries written by guildops itself, like attendance and loot entries.
+const PointsSystemAuthor = "guildops"
+
+// PointsEntry is a line of the points ledger: points earned (positive amount) or spent (negative amount)
+// by a player. Entries are never updated nor deleted, a wrong entry is undone by a reversal entry.
+type PointsEntry struct {
+	ID     int
+	Player *Player
+	Amount int
+	Kind   PointsKind
+	Reason string
+	Author string
+	Date   time.Time
+
+	// RaidID is the raid the points were earned on, 0 if none.
+	RaidID int
+	// LootID is the loot the points were spent on, 0 if none.
+	LootID int
+	// Reverts is the entry a reversal undoes, 0 for other kinds.
+	Reverts int
+}
+
+func NewPointsEntry(player *Player, amount int, kind PointsKind, reason, author string) (PointsEntry, error) {
+	if player == nil {
+		return PointsEntry{}, fmt.Errorf("player cannot be nil")
+	}
+	if amount == 0 {
+		return PointsEntry{}, fmt.Errorf("amount cannot be 0")
+	}
+
+	valid := false
+	for _, k := range PointsKinds {
+		if k == kind {
+			valid = true
+		}
+	}
+	if !valid {
+		return PointsEntry{}, fmt.Errorf("kind %s not valid", kind)
+	}
+
+	reason = strings.TrimSpace(reason)
+	if len(reason) == 0 {
+		return PointsEntry{}, fmt.Errorf("reason cannot be empty")
+	}
+	if len(reason) > 255 {
+		return PointsEntry{}, fmt.Errorf("reason must be less than 255 characters")
+	}
+	if author == "" {
+		author = PointsSystemAuthor
+	}
+
+	return PointsEntry{
+		Player: player,
+		Amount: amount,
+		Kind:   kind,
+		Reason: reason,
+		Author: author,
+		Date:   time.Now().UTC(),
+	}, nil
+}
+
+// Reversed returns the IDs of entries undone by a reversal among entries.
+func Reversed(entries []PointsEntry) map[int]bool {
+	reversed := make(map[int]bool)
+	for _, entry := range entries {
+		if entry.Kind == PointsReversal {
+			reversed[entry.Reverts] = true
+		}
+	}
+	return reversed
+}
+
+// PointsBalance is the ledger of a player, oldest entry first, and the points it sums up to.
+type PointsBalance struct {
+	Player  *Player
+	Points  int
+	Entries []PointsEntry
+}
+
+// NewPointsBalance sums up the entries of a player.
+func NewPointsBalance(player *Player, entries []PointsEntry) PointsBalance {
+	balance := PointsBalance{Player: player, Entries: entries}
+	for _, entry := range entries {
+		balance.Points += entry.Amount
+	}
+	return balance
+}
+
+// PointsPolicy is how points are earned, spent and decayed.
+// Zero values disable automatic entries and decay.
+type PointsPolicy struct {
+	// Attendance is earned by every player who attended a raid, benched players included.
+	Attendance int
+	// LootCost is spent by a player receiving a loot.
+	LootCost int
+	// DecayPercent is the share of positive balances removed by a decay.
+	DecayPercent int
+}
+
+// DecayPoints returns the amount a decay of percent removes from a balance of points, rounded,
+// 0 when there is nothing to remove.
+func DecayPoints(points, percent int) int {
+	if points <= 0 || percent <= 0 {
+		return 0
+	}
+	return -(points*percent + 50) / 100
+}
@@ -0,0 +1,78 @@
+package entity_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestPointsEntry_NewPointsEntry(t *testing.T) {
+	t.Parallel()
+
+	player := &entity.Player{ID: 1, Name: "arthas"}
+	tests := []struct {
+		name    string
+		player  *entity.Player
+		amount  int
+		kind    entity.PointsKind
+		reason  string
+		wantErr bool
+	}{
+		{name: "Valid award", player: player, amount: 10, kind: entity.PointsAward, reason: "first kill"},
+		{name: "Valid spend", player: player, amount: -20, kind: entity.PointsLoot, reason: "loot"},
+		{name: "Nil player", amount: 10, kind: entity.PointsAward, reason: "first kill", wantErr: true},
+		{name: "Zero amount", player: player, kind: entity.PointsAward, reason: "first kill", wantErr: true},
+		{name: "Unknown kind", player: player, amount: 10, kind: "bonus", reason: "first kill", wantErr: true},
+		{name: "Empty reason", player: player, amount: 10, kind: entity.PointsAward, reason: " ", wantErr: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			entry, err := entity.NewPointsEntry(test.player, test.amount, test.kind, test.reason, "")
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewPointsEntry() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err == nil && entry.Author != entity.PointsSystemAuthor {
+				t.Errorf("NewPointsEntry() author = %v, want %v", entry.Author, entity.PointsSystemAuthor)
+			}
+		})
+	}
+}
+
+func TestPointsBalance(t *testing.T) {
+	t.Parallel()
+
+	player := &entity.Player{ID: 1, Name: "arthas"}
+	entries := []entity.PointsEntry{
+		{ID: 1, Player: player, Amount: 10, Kind: entity.PointsAttendance},
+		{ID: 2, Player: player, Amount: -20, Kind: entity.PointsLoot},
+		{ID: 3, Player: player, Amount: 20, Kind: entity.PointsReversal, Reverts: 2},
+	}
+
+	if balance := entity.NewPointsBalance(player, entries); balance.Points != 10 {
+		t.Errorf("NewPointsBalance() points = %v, want 10", balance.Points)
+	}
+	if reversed := entity.Reversed(entries); !reflect.DeepEqual(reversed, map[int]bool{2: true}) {
+		t.Errorf("Reversed() = %v, want map[2:true]", reversed)
+	}
+}
+
+func TestDecayPoints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		points, percent, want int
+	}{
+		{points: 100, percent: 10, want: -10},
+		{points: 15, percent: 10, want: -2},
+		{points: -50, percent: 10, want: 0},
+		{points: 100, percent: 0, want: 0},
+	}
+	for _, test := range tests {
+		if got := entity.DecayPoints(test.points, test.percent); got != test.want {
+			t.Errorf("DecayPoints(%d, %d) = %v, want %v", test.points, test.percent, got, test.want)
+		}
+	}
+}
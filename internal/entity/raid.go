@@ -8,16 +8,25 @@ import (
 )
 
 type Raid struct {
-	ID         int
-	Name       string
+	ID   int
+	Name string
+	// Date is the day of the raid in the guild time zone, at midnight UTC.
 	Date       time.Time
 	Difficulty string
+	// StartTime is the time of day the raid starts in the guild time zone, since midnight.
+	StartTime time.Duration
+	// StartTimeSet tells whether StartTime is set, a raid starting at midnight has a zero StartTime.
+	StartTimeSet bool
 
 	Absences []*Player
 	Players  []*Player
+	Late     []*Player
 	Bench    []*Player
 
 	Loots []Loot
+
+	// Signups are the answers of players before the raid, the roster is who really came.
+	Signups []Signup
 }
 
 func NewRaid(name, difficulty string, date time.Time) (Raid, error) {
@@ -48,3 +57,90 @@ func NewRaid(name, difficulty string, date time.Time) (Raid, error) {
 		Date:       date,
 	}, nil
 }
+
+// StartIn returns when the raid starts, its day and start time being in the guild time zone location.
+func (r Raid) StartIn(location *time.Location) time.Time {
+	if location == nil {
+		location = time.UTC
+	}
+	hour := int(r.StartTime / time.Hour)
+	minute := int(r.StartTime % time.Hour / time.Minute)
+	return time.Date(r.Date.Year(), r.Date.Month(), r.Date.Day(), hour, minute, 0, 0, location)
+}
+
+// Day returns the day of t at midnight UTC, like raid dates.
+func Day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Today returns the current day in location at midnight UTC, like raid dates.
+func Today(location *time.Location) time.Time {
+	if location == nil {
+		location = time.UTC
+	}
+	return Day(time.Now().In(location))
+}
+
+// SetRoster replaces Players, Late, Bench and Absences with the players of the given participants.
+func (r *Raid) SetRoster(participants []Participant) {
+	r.Players, r.Late, r.Bench, r.Absences = nil, nil, nil, nil
+	for _, participant := range participants {
+		switch participant.Status {
+		case ParticipantPresent:
+			r.Players = append(r.Players, participant.Player)
+		case ParticipantLate:
+			r.Late = append(r.Late, participant.Player)
+		case ParticipantBench:
+			r.Bench = append(r.Bench, participant.Player)
+		case ParticipantAbsent:
+			r.Absences = append(r.Absences, participant.Player)
+		}
+	}
+}
+
+// FilterRoster keeps on the roster only the players selected by filter.
+func (r *Raid) FilterRoster(filter PlayerFilter) {
+	keep := func(players []*Player) []*Player {
+		var kept []*Player
+		for _, player := range players {
+			if filter.Matches(*player) {
+				kept = append(kept, player)
+			}
+		}
+		return kept
+	}
+	r.Players, r.Late, r.Bench, r.Absences = keep(r.Players), keep(r.Late), keep(r.Bench), keep(r.Absences)
+}
+
+// Composition counts the players who raided, late ones included, by role.
+// Players without a role are counted with an empty role.
+func (r Raid) Composition() map[Role]int {
+	composition := make(map[Role]int)
+	for _, players := range [][]*Player{r.Players, r.Late} {
+		for _, player := range players {
+			composition[player.Role]++
+		}
+	}
+	return composition
+}
+
+// SignedUp returns the players who signed up to the raid with this status.
+func (r Raid) SignedUp(status SignupStatus) []*Player {
+	var players []*Player
+	for _, signup := range r.Signups {
+		if signup.Status == status {
+			players = append(players, signup.Player)
+		}
+	}
+	return players
+}
+
+// SignupComposition counts the players who signed up to the raid with this status, by role.
+// Players without a role are counted with an empty role.
+func (r Raid) SignupComposition(status SignupStatus) map[Role]int {
+	composition := make(map[Role]int)
+	for _, player := range r.SignedUp(status) {
+		composition[player.Role]++
+	}
+	return composition
+}
@@ -76,3 +76,28 @@ func TestRaid_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestRaid_FilterRoster(t *testing.T) {
+	t.Parallel()
+
+	uther := &entity.Player{Name: "uther", Class: "paladin", MainSpec: "holy", Role: entity.RoleHealer}
+	arthas := &entity.Player{Name: "arthas", Class: "death knight", MainSpec: "blood", Role: entity.RoleTank}
+	jaina := &entity.Player{Name: "jaina", Class: "mage", MainSpec: "frost", Role: entity.RoleDPS}
+	thrall := &entity.Player{Name: "thrall"}
+	raid := entity.Raid{
+		Players:  []*entity.Player{uther, jaina, thrall},
+		Late:     []*entity.Player{arthas},
+		Absences: []*entity.Player{{Name: "sylvanas", Class: "hunter", Role: entity.RoleDPS}},
+	}
+
+	composition := raid.Composition()
+	if composition[entity.RoleTank] != 1 || composition[entity.RoleHealer] != 1 ||
+		composition[entity.RoleDPS] != 1 || composition[""] != 1 {
+		t.Errorf("Composition() = %v", composition)
+	}
+
+	raid.FilterRoster(entity.PlayerFilter{Role: entity.RoleDPS})
+	if len(raid.Players) != 1 || raid.Players[0] != jaina || len(raid.Late) != 0 || len(raid.Absences) != 1 {
+		t.Errorf("FilterRoster() = %v, %v, %v", raid.Players, raid.Late, raid.Absences)
+	}
+}
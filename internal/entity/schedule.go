@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// SchedulePolicy tells when raids start, how far ahead they are announced and when players are reminded.
+type SchedulePolicy struct {
+	// RaidStart is the time of day raids created without a start time start, since midnight.
+	RaidStart time.Duration
+	// AnnounceDays is the number of days of upcoming raids announced, today included.
+	AnnounceDays int
+	// ReminderHours is how many hours before a raid players who didn't sign up are reminded, 0 to disable.
+	ReminderHours int
+	// Location is the guild time zone, where raid days and start times are read. UTC when nil.
+	Location *time.Location
+}
+
+// StartOf returns when raid starts.
+func (p SchedulePolicy) StartOf(raid Raid) time.Time {
+	return raid.StartIn(p.Location)
+}
+
+// ReminderAt returns when players who didn't sign up to raid are reminded.
+func (p SchedulePolicy) ReminderAt(raid Raid) time.Time {
+	return p.StartOf(raid).Add(-time.Duration(p.ReminderHours) * time.Hour)
+}
@@ -0,0 +1,32 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestSchedulePolicy_StartOf(t *testing.T) {
+	t.Parallel()
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("no time zone database: %v", err)
+	}
+	policy := entity.SchedulePolicy{ReminderHours: 4, Location: paris}
+	// The raid is on the day the clocks go back, 21:30 is still 21:30
+	raid := entity.Raid{
+		Date:      time.Date(2023, time.October, 29, 0, 0, 0, 0, time.UTC),
+		StartTime: 21*time.Hour + 30*time.Minute,
+	}
+
+	want := time.Date(2023, time.October, 29, 21, 30, 0, 0, paris)
+	if got := policy.StartOf(raid); !got.Equal(want) {
+		t.Errorf("StartOf() = %v, want %v", got, want)
+	}
+	want = time.Date(2023, time.October, 29, 17, 30, 0, 0, paris)
+	if got := policy.ReminderAt(raid); !got.Equal(want) {
+		t.Errorf("ReminderAt() = %v, want %v", got, want)
+	}
+}
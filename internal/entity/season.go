@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Season is a period of the game, from its first day to its last day.
+type Season struct {
+	ID    int
+	Name  string
+	Start time.Time
+	End   time.Time
+}
+
+func NewSeason(name string, start, end time.Time) (Season, error) {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return Season{}, fmt.Errorf("name cannot be empty")
+	}
+	if len(name) > 50 {
+		return Season{}, fmt.Errorf("name must not be longer than 50 characters")
+	}
+	if end.Before(start) {
+		return Season{}, fmt.Errorf("season must end after it starts")
+	}
+
+	return Season{
+		Name:  name,
+		Start: start,
+		End:   end,
+	}, nil
+}
+
+// Contains tells if date is during the season. The last day is included.
+func (s Season) Contains(date time.Time) bool {
+	return !date.Before(s.Start) && date.Before(s.End.AddDate(0, 0, 1))
+}
+
+// Overlaps tells if both seasons share at least one day.
+func (s Season) Overlaps(other Season) bool {
+	return !s.End.Before(other.Start) && !other.End.Before(s.Start)
+}
@@ -0,0 +1,113 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestNewSeason(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		season  string
+		start   time.Time
+		end     time.Time
+		wantErr bool
+	}{
+		{name: "Valid season", season: " DF/S2 ", start: start, end: end, wantErr: false},
+		{name: "Single day season", season: "DF/S2", start: start, end: start, wantErr: false},
+		{name: "Empty name", season: "", start: start, end: end, wantErr: true},
+		{name: "Name too long", season: string(make([]byte, 51)), start: start, end: end, wantErr: true},
+		{name: "End before start", season: "DF/S2", start: end, end: start, wantErr: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			season, err := entity.NewSeason(test.season, test.start, test.end)
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewSeason() error = %v, wantErr %v", err, test.wantErr)
+				return
+			}
+			if !test.wantErr && season.Name != "DF/S2" {
+				t.Errorf("NewSeason() name = %v, want DF/S2", season.Name)
+			}
+		})
+	}
+}
+
+func TestSeason_Contains(t *testing.T) {
+	t.Parallel()
+
+	season := entity.Season{
+		Name:  "DF/S2",
+		Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name string
+		date time.Time
+		want bool
+	}{
+		{name: "First day", date: season.Start, want: true},
+		{name: "Last day evening", date: season.End.Add(20 * time.Hour), want: true},
+		{name: "Before", date: season.Start.AddDate(0, 0, -1), want: false},
+		{name: "After", date: season.End.AddDate(0, 0, 1), want: false},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if got := season.Contains(test.date); got != test.want {
+				t.Errorf("Contains() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSeason_Overlaps(t *testing.T) {
+	t.Parallel()
+
+	season := entity.Season{
+		Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name  string
+		other entity.Season
+		want  bool
+	}{
+		{
+			name:  "Next season",
+			other: entity.Season{Start: season.End.AddDate(0, 0, 1), End: season.End.AddDate(0, 6, 0)},
+			want:  false,
+		},
+		{
+			name:  "Shares last day",
+			other: entity.Season{Start: season.End, End: season.End.AddDate(0, 6, 0)},
+			want:  true,
+		},
+		{
+			name:  "Inside",
+			other: entity.Season{Start: season.Start.AddDate(0, 1, 0), End: season.Start.AddDate(0, 2, 0)},
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if got := season.Overlaps(test.other); got != test.want {
+				t.Errorf("Overlaps() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SignupStatus tells if a player plans to come to a raid.
+type SignupStatus string
+
+const (
+	SignupAccepted  SignupStatus = "accepted"
+	SignupTentative SignupStatus = "tentative"
+	SignupLate      SignupStatus = "late"
+	SignupDeclined  SignupStatus = "declined"
+)
+
+// SignupStatuses lists every status in the order signups are displayed.
+var SignupStatuses = []SignupStatus{
+	SignupAccepted, SignupTentative, SignupLate, SignupDeclined,
+}
+
+// NewSignupStatus returns the status matching the given string. Case is ignored.
+func NewSignupStatus(status string) (SignupStatus, error) {
+	status = strings.ToLower(strings.TrimSpace(status))
+	for _, s := range SignupStatuses {
+		if string(s) == status {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("status must be accepted, tentative, late or declined")
+}
+
+// Signup is the answer of a player to a raid before it happens.
+type Signup struct {
+	Player *Player
+	Raid   *Raid
+	Status SignupStatus
+}
+
+func NewSignup(player *Player, raid *Raid, status string) (Signup, error) {
+	if player == nil {
+		return Signup{}, fmt.Errorf("player cannot be nil")
+	}
+	if raid == nil {
+		return Signup{}, fmt.Errorf("raid cannot be nil")
+	}
+
+	signupStatus, err := NewSignupStatus(status)
+	if err != nil {
+		return Signup{}, err
+	}
+
+	return Signup{
+		Player: player,
+		Raid:   raid,
+		Status: signupStatus,
+	}, nil
+}
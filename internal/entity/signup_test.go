@@ -0,0 +1,62 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestNewSignup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		player  *entity.Player
+		raid    *entity.Raid
+		status  string
+		want    entity.SignupStatus
+		wantErr bool
+	}{
+		{name: "Valid Signup", player: &entity.Player{}, raid: &entity.Raid{}, status: " Tentative ",
+			want: entity.SignupTentative},
+		{name: "Unknown status", player: &entity.Player{}, raid: &entity.Raid{}, status: "bench", wantErr: true},
+		{name: "Player nil", raid: &entity.Raid{}, status: "accepted", wantErr: true},
+		{name: "Raid nil", player: &entity.Player{}, status: "accepted", wantErr: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := entity.NewSignup(test.player, test.raid, test.status)
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewSignup() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if got.Status != test.want {
+				t.Errorf("NewSignup() status = %v, want %v", got.Status, test.want)
+			}
+		})
+	}
+}
+
+func TestRaid_SignupComposition(t *testing.T) {
+	t.Parallel()
+
+	raid := entity.Raid{Signups: []entity.Signup{
+		{Player: &entity.Player{Name: "arthas", Role: entity.RoleTank}, Status: entity.SignupAccepted},
+		{Player: &entity.Player{Name: "jaina", Role: entity.RoleDPS}, Status: entity.SignupAccepted},
+		{Player: &entity.Player{Name: "thrall"}, Status: entity.SignupAccepted},
+		{Player: &entity.Player{Name: "uther", Role: entity.RoleHealer}, Status: entity.SignupDeclined},
+	}}
+
+	if got := len(raid.SignedUp(entity.SignupAccepted)); got != 3 {
+		t.Errorf("SignedUp(accepted) = %v players, want 3", got)
+	}
+	if got := raid.SignedUp(entity.SignupLate); got != nil {
+		t.Errorf("SignedUp(late) = %v, want nil", got)
+	}
+	composition := raid.SignupComposition(entity.SignupAccepted)
+	if composition[entity.RoleTank] != 1 || composition[entity.RoleDPS] != 1 || composition[""] != 1 ||
+		composition[entity.RoleHealer] != 0 {
+		t.Errorf("SignupComposition(accepted) = %v", composition)
+	}
+}
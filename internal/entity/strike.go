@@ -10,18 +10,14 @@ type Strike struct {
 	ID     int
 	Season string
 	Reason string
+	// ExpiresAt is when the strike stops counting, zero if it never expires.
+	ExpiresAt time.Time
 
 	Player *Player
 }
 
-func SeasonCalculator(date time.Time) string {
-	if date.After(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)) &&
-		date.Before(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
-		return "DF/S2"
-	} else {
-		return "Unknown"
-	}
-}
+// UnknownSeason is the season of a strike given out of every season.
+const UnknownSeason = "Unknown"
 
 func NewStrike(reason string) (Strike, error) {
 	if len(reason) == 0 {
@@ -34,6 +30,44 @@ func NewStrike(reason string) (Strike, error) {
 	return Strike{
 		Reason: reason,
 		Date:   time.Now(),
-		Season: SeasonCalculator(time.Now()),
+		Season: UnknownSeason,
 	}, nil
 }
+
+// Expired tells if the strike no longer counts on date.
+func (s Strike) Expired(date time.Time) bool {
+	return !s.ExpiresAt.IsZero() && !date.Before(s.ExpiresAt)
+}
+
+// StrikePolicy tells when strikes expire and when officers are alerted.
+type StrikePolicy struct {
+	// ExpiryWeeks is the number of weeks a strike counts, 0 to keep them.
+	ExpiryWeeks int
+	// ExpireOnSeasonEnd expires strikes at the end of the season they were given in.
+	ExpireOnSeasonEnd bool
+	// AlertThreshold is the number of active strikes which alerts officers, 0 to disable alerts.
+	AlertThreshold int
+}
+
+// ExpiresAt returns when strike expires, the earliest of both rules,
+// or zero time if the strike never expires.
+func (p StrikePolicy) ExpiresAt(strike Strike, seasons []Season) time.Time {
+	var expiresAt time.Time
+	if p.ExpiryWeeks > 0 {
+		expiresAt = strike.Date.AddDate(0, 0, 7*p.ExpiryWeeks)
+	}
+	if !p.ExpireOnSeasonEnd {
+		return expiresAt
+	}
+	for _, season := range seasons {
+		if !season.Contains(strike.Date) {
+			continue
+		}
+		seasonEnd := season.End.AddDate(0, 0, 1)
+		if expiresAt.IsZero() || seasonEnd.Before(expiresAt) {
+			expiresAt = seasonEnd
+		}
+		break
+	}
+	return expiresAt
+}
@@ -52,39 +52,81 @@ func TestStrike_NewStrike(t *testing.T) {
 	}
 }
 
-func TestSeasonCalculator(t *testing.T) {
+func TestStrikePolicy_ExpiresAt(t *testing.T) {
 	t.Parallel()
-	type args struct {
-		date time.Time
-	}
+
+	seasons := []entity.Season{{
+		Name:  "DF/S2",
+		Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, 11, 13, 0, 0, 0, 0, time.UTC),
+	}}
+	strike := entity.Strike{Date: time.Date(2023, 11, 1, 20, 0, 0, 0, time.UTC)}
+
 	tests := []struct {
-		name string
-		args args
-		want string
+		name   string
+		policy entity.StrikePolicy
+		strike entity.Strike
+		want   time.Time
 	}{
 		{
-			name: "Season 2",
-			args: args{
-				date: time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC),
-			},
-			want: "DF/S2",
+			name:   "Never expires",
+			policy: entity.StrikePolicy{},
+			strike: strike,
+			want:   time.Time{},
 		},
 		{
-			name: "Unknown Season",
-			args: args{
-				date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1),
-			},
-			want: "Unknown",
+			name:   "Expires after weeks",
+			policy: entity.StrikePolicy{ExpiryWeeks: 4},
+			strike: strike,
+			want:   time.Date(2023, 11, 29, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Expires at season end",
+			policy: entity.StrikePolicy{ExpireOnSeasonEnd: true},
+			strike: strike,
+			want:   time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Season ends before weeks",
+			policy: entity.StrikePolicy{ExpiryWeeks: 4, ExpireOnSeasonEnd: true},
+			strike: strike,
+			want:   time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Weeks end before season",
+			policy: entity.StrikePolicy{ExpiryWeeks: 1, ExpireOnSeasonEnd: true},
+			strike: strike,
+			want:   time.Date(2023, 11, 8, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Out of every season",
+			policy: entity.StrikePolicy{ExpireOnSeasonEnd: true},
+			strike: entity.Strike{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			want:   time.Time{},
 		},
 	}
-
 	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
-			if got := entity.SeasonCalculator(tt.args.date); got != tt.want {
-				t.Errorf("SeasonCalculator() = %v, want %v", got, tt.want)
+			if got := test.policy.ExpiresAt(test.strike, seasons); !got.Equal(test.want) {
+				t.Errorf("ExpiresAt() = %v, want %v", got, test.want)
 			}
 		})
 	}
 }
+
+func TestStrike_Expired(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC)
+	if (entity.Strike{}).Expired(expiresAt) {
+		t.Errorf("Expired() = true for a strike without expiry date")
+	}
+	if (entity.Strike{ExpiresAt: expiresAt}).Expired(expiresAt.Add(-time.Second)) {
+		t.Errorf("Expired() = true before expiry date")
+	}
+	if !(entity.Strike{ExpiresAt: expiresAt}).Expired(expiresAt) {
+		t.Errorf("Expired() = false on expiry date")
+	}
+}
@@ -0,0 +1,223 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RaidTemplate creates the same raid every week on its weekdays.
+type RaidTemplate struct {
+	ID         int
+	Name       string
+	Difficulty string
+	Weekdays   []time.Weekday
+	// StartTime is the time of day the raids start, since midnight.
+	StartTime time.Duration
+	// Timezone is the IANA name of the time zone of StartTime, the guild time zone when empty.
+	Timezone string
+}
+
+// StartTimeLayout is the layout of the start time of templates.
+const StartTimeLayout = "15:04"
+
+// NewRaidTemplate returns a template of the raid, checked like the raids it creates.
+func NewRaidTemplate(name, difficulty, weekdays, startTime, timezone string) (RaidTemplate, error) {
+	// Templates create raids, they follow the same rules
+	raid, err := NewRaid(name, difficulty, time.Time{})
+	if err != nil {
+		return RaidTemplate{}, err
+	}
+
+	days, err := ParseWeekdays(weekdays)
+	if err != nil {
+		return RaidTemplate{}, err
+	}
+
+	start, err := ParseStartTime(startTime)
+	if err != nil {
+		return RaidTemplate{}, err
+	}
+
+	timezone = strings.TrimSpace(timezone)
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return RaidTemplate{}, fmt.Errorf("timezone must be an IANA time zone like Europe/Paris")
+		}
+	}
+
+	return RaidTemplate{
+		Name:       raid.Name,
+		Difficulty: raid.Difficulty,
+		Weekdays:   days,
+		StartTime:  start,
+		Timezone:   timezone,
+	}, nil
+}
+
+// ParseWeekdays returns the comma separated weekdays of days, sorted from Monday.
+// Weekdays are full English names or their first three letters, case is ignored.
+func ParseWeekdays(days string) ([]time.Weekday, error) {
+	seen := make(map[time.Weekday]bool)
+	var weekdays []time.Weekday
+	for _, day := range strings.Split(days, ",") {
+		day = strings.ToLower(strings.TrimSpace(day))
+		if day == "" {
+			continue
+		}
+		weekday, ok := weekdayNames[day]
+		if !ok {
+			return nil, fmt.Errorf("week days must be one of: Monday, Tuesday, Wednesday, " +
+				"Thursday, Friday, Saturday, Sunday")
+		}
+		if !seen[weekday] {
+			seen[weekday] = true
+			weekdays = append(weekdays, weekday)
+		}
+	}
+	if len(weekdays) == 0 {
+		return nil, fmt.Errorf("at least one week day is required")
+	}
+	sort.Slice(weekdays, func(i, j int) bool {
+		return (weekdays[i]+6)%7 < (weekdays[j]+6)%7
+	})
+	return weekdays, nil
+}
+
+// weekdayNames are the names of weekdays read by ParseWeekdays.
+var weekdayNames = func() map[string]time.Weekday {
+	names := make(map[string]time.Weekday)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		names[name] = day
+		names[name[:3]] = day
+	}
+	return names
+}()
+
+// FormatWeekdays returns weekdays as read by ParseWeekdays.
+func FormatWeekdays(weekdays []time.Weekday) string {
+	names := make([]string, 0, len(weekdays))
+	for _, day := range weekdays {
+		names = append(names, strings.ToLower(day.String()))
+	}
+	return strings.Join(names, ",")
+}
+
+// ParseStartTime returns the time since midnight of a time of day like 21:00.
+func ParseStartTime(startTime string) (time.Duration, error) {
+	start, err := time.Parse(StartTimeLayout, strings.TrimSpace(startTime))
+	if err != nil {
+		return 0, fmt.Errorf("start time must be a time of day like 21:00")
+	}
+	return TimeOfDay(start), nil
+}
+
+// TimeOfDay returns the time of day of t since midnight, as read on its clock.
+func TimeOfDay(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+}
+
+// FormatStartTime returns a start time as read by NewRaidTemplate.
+func FormatStartTime(start time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(start/time.Hour), int(start%time.Hour/time.Minute))
+}
+
+// Dates returns the days of the template in the weeks starting on the day of from, from included.
+// Days are at midnight UTC, like raid dates.
+func (t RaidTemplate) Dates(from time.Time, weeks int) []time.Time {
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	var dates []time.Time
+	for i := 0; i < weeks*7; i++ {
+		for _, weekday := range t.Weekdays {
+			if day.Weekday() == weekday {
+				dates = append(dates, day)
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return dates
+}
+
+// String returns the template as shown to users.
+func (t RaidTemplate) String() string {
+	days := make([]string, 0, len(t.Weekdays))
+	for _, day := range t.Weekdays {
+		days = append(days, day.String())
+	}
+	msg := t.Name + " " + t.Difficulty + " on " + strings.Join(days, ", ") + " at " + FormatStartTime(t.StartTime)
+	if t.Timezone != "" {
+		msg += " " + t.Timezone
+	}
+	return msg
+}
+
+// Holiday is a period without raids, both days included.
+type Holiday struct {
+	Name  string
+	Start time.Time
+	End   time.Time
+}
+
+// Contains tells if the day of date is in the holiday.
+func (h Holiday) Contains(date time.Time) bool {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	start := time.Date(h.Start.Year(), h.Start.Month(), h.Start.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(h.End.Year(), h.End.Month(), h.End.Day(), 0, 0, 0, 0, time.UTC)
+	return !day.Before(start) && !day.After(end)
+}
+
+// FailedRaid is a raid which couldn't be created.
+type FailedRaid struct {
+	Raid   Raid
+	Reason string
+}
+
+// RaidGeneration reports what happened to each raid templates were asked to create.
+type RaidGeneration struct {
+	Created  []Raid
+	Existing []Raid
+	// Skipped raids are on holidays.
+	Skipped []Raid
+	// Deleted raids were deleted by officers, they are not created again until they are purged.
+	Deleted []Raid
+	Failed  []FailedRaid
+}
+
+// IsEmpty tells if no raid was asked.
+func (g RaidGeneration) IsEmpty() bool {
+	return len(g.Created) == 0 && len(g.Existing) == 0 && len(g.Skipped) == 0 && len(g.Deleted) == 0 &&
+		len(g.Failed) == 0
+}
+
+// String returns the report of the generation as shown to users, empty sections are left out.
+func (g RaidGeneration) String() string {
+	var msg string
+	section := func(title string, raids []Raid, reasons []string) {
+		if len(raids) == 0 {
+			return
+		}
+		msg += "**" + title + " (" + strconv.Itoa(len(raids)) + ")**\n"
+		for i, raid := range raids {
+			msg += "* " + raid.Name + " " + raid.Difficulty + " " + raid.Date.Format("Mon 02/01/06")
+			if reasons != nil {
+				msg += " : " + reasons[i]
+			}
+			msg += "\n"
+		}
+	}
+	section("Created", g.Created, nil)
+	section("Already there", g.Existing, nil)
+	section("Skipped on holidays", g.Skipped, nil)
+	section("Deleted, not created again", g.Deleted, nil)
+	failed := make([]Raid, 0, len(g.Failed))
+	reasons := make([]string, 0, len(g.Failed))
+	for _, fail := range g.Failed {
+		failed = append(failed, fail.Raid)
+		reasons = append(reasons, fail.Reason)
+	}
+	section("Failed", failed, reasons)
+	return msg
+}
@@ -0,0 +1,119 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestNewRaidTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		raidName   string
+		difficulty string
+		weekdays   string
+		startTime  string
+		timezone   string
+		wantErr    bool
+	}{
+		{name: "Success", raidName: "Nighthold", difficulty: "Heroic", weekdays: "Wednesday, mon", startTime: "21:00"},
+		{name: "Timezone", raidName: "nighthold", difficulty: "mythic", weekdays: "sunday", startTime: "20:30",
+			timezone: "Europe/Paris"},
+		{name: "Bad difficulty", raidName: "nighthold", difficulty: "lfr", weekdays: "monday", startTime: "21:00",
+			wantErr: true},
+		{name: "Bad weekday", raidName: "nighthold", difficulty: "heroic", weekdays: "someday", startTime: "21:00",
+			wantErr: true},
+		{name: "No weekday", raidName: "nighthold", difficulty: "heroic", weekdays: " , ", startTime: "21:00",
+			wantErr: true},
+		{name: "Bad start time", raidName: "nighthold", difficulty: "heroic", weekdays: "monday", startTime: "9pm",
+			wantErr: true},
+		{name: "Bad timezone", raidName: "nighthold", difficulty: "heroic", weekdays: "monday", startTime: "21:00",
+			timezone: "Azeroth/Orgrimmar", wantErr: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := entity.NewRaidTemplate(test.raidName, test.difficulty, test.weekdays, test.startTime, test.timezone)
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewRaidTemplate() error = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestRaidTemplate_Dates(t *testing.T) {
+	t.Parallel()
+
+	template, err := entity.NewRaidTemplate("nighthold", "heroic", "wed, monday, Wednesday", "21:30", "")
+	if err != nil {
+		t.Fatalf("NewRaidTemplate() error = %v", err)
+	}
+	if got := entity.FormatWeekdays(template.Weekdays); got != "monday,wednesday" {
+		t.Errorf("FormatWeekdays() = %v, want monday,wednesday", got)
+	}
+	if got := template.String(); got != "nighthold heroic on Monday, Wednesday at 21:30" {
+		t.Errorf("String() = %v", got)
+	}
+
+	// Tuesday 03/10/23
+	dates := template.Dates(time.Date(2023, time.October, 3, 18, 0, 0, 0, time.UTC), 2)
+	want := []time.Time{
+		time.Date(2023, time.October, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.October, 9, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.October, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.October, 16, 0, 0, 0, 0, time.UTC),
+	}
+	if len(dates) != len(want) {
+		t.Fatalf("Dates() = %v, want %v", dates, want)
+	}
+	for i := range want {
+		if !dates[i].Equal(want[i]) {
+			t.Errorf("Dates()[%d] = %v, want %v", i, dates[i], want[i])
+		}
+	}
+}
+
+func TestHoliday_Contains(t *testing.T) {
+	t.Parallel()
+
+	holiday := entity.Holiday{
+		Name:  "winter veil",
+		Start: time.Date(2023, time.December, 24, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC),
+	}
+	if !holiday.Contains(time.Date(2023, time.December, 31, 21, 0, 0, 0, time.UTC)) {
+		t.Errorf("Contains() = false on the last day")
+	}
+	if holiday.Contains(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Contains() = true after the holiday")
+	}
+}
+
+func TestRaidGeneration_String(t *testing.T) {
+	t.Parallel()
+
+	monday := entity.Raid{
+		Name:       "nighthold",
+		Difficulty: "heroic",
+		Date:       time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC),
+	}
+	wednesday := monday
+	wednesday.Date = monday.Date.AddDate(0, 0, 2)
+	generation := entity.RaidGeneration{
+		Created: []entity.Raid{monday},
+		Failed:  []entity.FailedRaid{{Raid: wednesday, Reason: "raid already exists"}},
+	}
+
+	want := "**Created (1)**\n* nighthold heroic Mon 02/10/23\n" +
+		"**Failed (1)**\n* nighthold heroic Wed 04/10/23 : raid already exists\n"
+	if got := generation.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if generation.IsEmpty() {
+		t.Errorf("IsEmpty() = true with raids")
+	}
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WishHighestPriority and WishLowestPriority bound the priority of a wish, 1 being the item wanted the most.
+const (
+	WishHighestPriority = 1
+	WishLowestPriority  = 5
+)
+
+// Wish is an item a player wants before it drops.
+type Wish struct {
+	ID       int
+	Player   *Player
+	Item     string
+	Priority int
+	// Spec is the specialization the item is wanted for, empty if any.
+	Spec string
+	Note string
+}
+
+func NewWish(player *Player, item string, priority int, spec, note string) (Wish, error) {
+	if player == nil {
+		return Wish{}, fmt.Errorf("player cannot be nil")
+	}
+
+	// Items are named like loots, so a wish matches the loot it is fulfilled by
+	item = strings.ToLower(strings.TrimSpace(item))
+	if len(item) < 1 || len(item) > 100 {
+		return Wish{}, fmt.Errorf("item must be between 1 and 100 characters")
+	}
+
+	if priority < WishHighestPriority || priority > WishLowestPriority {
+		return Wish{}, fmt.Errorf("priority must be between %d and %d", WishHighestPriority, WishLowestPriority)
+	}
+
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	if len(spec) > 20 {
+		return Wish{}, fmt.Errorf("spec must be less than 20 characters")
+	}
+
+	note = strings.TrimSpace(note)
+	if len(note) > 255 {
+		return Wish{}, fmt.Errorf("note must be less than 255 characters")
+	}
+
+	return Wish{
+		Player:   player,
+		Item:     item,
+		Priority: priority,
+		Spec:     spec,
+		Note:     note,
+	}, nil
+}
+
+// String returns the wish as shown to users, without its player.
+func (w Wish) String() string {
+	s := fmt.Sprintf("%s, priority %d", w.Item, w.Priority)
+	if w.Spec != "" {
+		s += ", " + w.Spec
+	}
+	if w.Note != "" {
+		s += " (" + w.Note + ")"
+	}
+	return s
+}
@@ -0,0 +1,60 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+func TestWish_NewWish(t *testing.T) {
+	t.Parallel()
+
+	player := &entity.Player{ID: 1, Name: "arthas"}
+	tests := []struct {
+		name     string
+		player   *entity.Player
+		item     string
+		priority int
+		spec     string
+		wantItem string
+		wantErr  bool
+	}{
+		{name: "Valid", player: player, item: " Frostmourne ", priority: 1, spec: "Frost", wantItem: "frostmourne"},
+		{name: "Without spec", player: player, item: "frostmourne", priority: 5, wantItem: "frostmourne"},
+		{name: "Nil player", item: "frostmourne", priority: 1, wantErr: true},
+		{name: "Empty item", player: player, item: " ", priority: 1, wantErr: true},
+		{name: "Item too long", player: player, item: strings.Repeat("frostmourne ", 9), priority: 1, wantErr: true},
+		{name: "Priority too high", player: player, item: "frostmourne", priority: 0, wantErr: true},
+		{name: "Priority too low", player: player, item: "frostmourne", priority: 6, wantErr: true},
+		{name: "Spec too long", player: player, item: "frostmourne", priority: 1, spec: "frost for the lich king",
+			wantErr: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			wish, err := entity.NewWish(test.player, test.item, test.priority, test.spec, "")
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewWish() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err == nil && wish.Item != test.wantItem {
+				t.Errorf("NewWish() item = %v, want %v", wish.Item, test.wantItem)
+			}
+		})
+	}
+}
+
+func TestWish_String(t *testing.T) {
+	t.Parallel()
+
+	wish := entity.Wish{Item: "frostmourne", Priority: 1}
+	if got := wish.String(); got != "frostmourne, priority 1" {
+		t.Errorf("String() = %v", got)
+	}
+	wish.Spec = "frost"
+	wish.Note = "best in slot"
+	if got := wish.String(); got != "frostmourne, priority 1, frost (best in slot)" {
+		t.Errorf("String() = %v", got)
+	}
+}
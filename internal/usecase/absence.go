@@ -14,12 +14,13 @@ import (
 
 // AbsenceUseCase is the use case for absences.
 type AbsenceUseCase struct {
-	backend Backend
+	backend  Backend
+	location *time.Location
 }
 
-// NewAbsenceUseCase returns a new AbsenceUseCase.
-func NewAbsenceUseCase(bk Backend) *AbsenceUseCase {
-	return &AbsenceUseCase{backend: bk}
+// NewAbsenceUseCase returns a new AbsenceUseCase. Past days are read in the guild time zone location.
+func NewAbsenceUseCase(bk Backend, location *time.Location) *AbsenceUseCase {
+	return &AbsenceUseCase{backend: bk, location: location}
 }
 
 // CreateAbsence creates an absence for a given player and date.
@@ -35,8 +36,7 @@ func (a AbsenceUseCase) CreateAbsence(ctx context.Context, playerName string, da
 	case <-ctx.Done():
 		return fmt.Errorf("AbsenceUseCase - CreateAbsence:  ctx.Done: request took too much time to be proceed")
 	default:
-		if date.Before(
-			time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 0, 0, 0, 0, time.Now().Location())) {
+		if date.Before(entity.Today(a.location)) {
 			return errors.New("can't create a absence in the past")
 		}
 
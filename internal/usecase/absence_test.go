@@ -21,7 +21,7 @@ func TestCreateAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		player := entity.Player{
 			ID:   1,
@@ -50,7 +50,7 @@ func TestCreateAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Player{}, errors.New("error while searching player"))
@@ -69,7 +69,7 @@ func TestCreateAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		// Call the function you want to test
 		err := absenceUseCase.CreateAbsence(context.Background(), "", time.Now())
@@ -84,7 +84,7 @@ func TestCreateAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Player{}, nil)
@@ -104,7 +104,7 @@ func TestCreateAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		player := entity.Player{
 			ID:   1,
@@ -138,7 +138,7 @@ func TestCreateAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		player := entity.Player{
 			ID:   1,
@@ -164,7 +164,7 @@ func TestCreateAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		player := entity.Player{
 			ID:   1,
@@ -189,7 +189,7 @@ func TestCreateAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		date := time.Now()
 		ctx, cancel := context.WithCancel(context.Background())
@@ -209,7 +209,7 @@ func TestDeleteAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		player := entity.Player{
 			ID:   1,
@@ -245,7 +245,7 @@ func TestDeleteAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Player{}, errors.New("error while searching player"))
@@ -265,7 +265,7 @@ func TestDeleteAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		player := entity.Player{
 			ID:   1,
@@ -299,7 +299,7 @@ func TestDeleteAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Player{}, nil)
@@ -318,7 +318,7 @@ func TestDeleteAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		player := entity.Player{
 			ID:   1,
@@ -349,7 +349,7 @@ func TestDeleteAbsence(t *testing.T) {
 		mockBackend := mocks.NewBackend(t)
 
 		// Delete an instance of your AbsenceUseCase with the mock backend
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		player := entity.Player{
 			ID:   1,
@@ -385,7 +385,7 @@ func TestDeleteAbsence(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend)
+		absenceUseCase := usecase.NewAbsenceUseCase(mockBackend, time.UTC)
 
 		date := time.Now()
 		ctx, cancel := context.WithCancel(context.Background())
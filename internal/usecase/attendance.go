@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// maxAttendanceDays is the longest range attendance can be computed on.
+const maxAttendanceDays = 366
+
+// AttendanceUseCase is the use case for attendance statistics.
+type AttendanceUseCase struct {
+	backend  Backend
+	location *time.Location
+}
+
+// NewAttendanceUseCase returns a new AttendanceUseCase. Today is read in the guild time zone location.
+func NewAttendanceUseCase(bk Backend, location *time.Location) *AttendanceUseCase {
+	return &AttendanceUseCase{backend: bk, location: location}
+}
+
+// raidsOnRange returns raids from a date to another, both included, sorted by date,
+// with the status of players on each raid. A declared absence counts as absent,
+// unless the roster of the raid says otherwise.
+// Raids after today are left out: they have no roster yet and would count as attended.
+func (a AttendanceUseCase) raidsOnRange(
+	ctx context.Context, from, to time.Time,
+) ([]entity.Raid, map[int]map[int]entity.ParticipantStatus, error) {
+	if to.Before(from) {
+		return nil, nil, fmt.Errorf("check date range: end date is before start date")
+	}
+	if to.Sub(from) > maxAttendanceDays*24*time.Hour {
+		return nil, nil, fmt.Errorf("check date range: range must not be longer than %d days", maxAttendanceDays)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, nil, fmt.Errorf("search raids on range: %w", ctx.Err())
+	default:
+	}
+
+	if today := entity.Today(a.location); to.After(today) {
+		to = today
+	}
+	if to.Before(from) {
+		return nil, map[int]map[int]entity.ParticipantStatus{}, nil
+	}
+
+	raids, err := a.backend.SearchRaidOnRange(ctx, from, to, 0, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("search raids on range: %w", err)
+	}
+	statuses := make(map[int]map[int]entity.ParticipantStatus)
+	for _, raid := range raids {
+		statuses[raid.ID] = make(map[int]entity.ParticipantStatus)
+	}
+
+	// Absences are declared on a day, they are searched once for each day with raids
+	searched := make(map[time.Time]bool)
+	for _, raid := range raids {
+		if searched[raid.Date] {
+			continue
+		}
+		searched[raid.Date] = true
+
+		absences, err := a.backend.SearchAbsence(ctx, "", -1, raid.Date)
+		if err != nil {
+			return nil, nil, fmt.Errorf("search absences on %s: %w", raid.Date.Format("02/01/06"), err)
+		}
+		for _, absence := range absences {
+			if status, ok := statuses[absence.Raid.ID]; ok {
+				status[absence.Player.ID] = entity.ParticipantAbsent
+			}
+		}
+	}
+
+	for _, raid := range raids {
+		participants, err := a.backend.SearchParticipant(ctx, raid.ID, -1)
+		if err != nil {
+			return nil, nil, fmt.Errorf("search roster of raid %d: %w", raid.ID, err)
+		}
+		for _, participant := range participants {
+			statuses[raid.ID][participant.Player.ID] = participant.Status
+		}
+	}
+	return raids, statuses, nil
+}
+
+// statusRank orders statuses from the best to the worst attendance.
+var statusRank = map[entity.ParticipantStatus]int{
+	entity.ParticipantPresent: 0, entity.ParticipantLate: 1, entity.ParticipantBench: 2, entity.ParticipantAbsent: 3,
+}
+
+// personStatus returns the best status of the characters of a person on a raid, empty when none has a status.
+func personStatus(statuses map[int]entity.ParticipantStatus, characterIDs []int) entity.ParticipantStatus {
+	var best entity.ParticipantStatus
+	for _, id := range characterIDs {
+		status, ok := statuses[id]
+		if ok && (best == "" || statusRank[status] < statusRank[best]) {
+			best = status
+		}
+	}
+	return best
+}
+
+// attendance returns the attendance of a player on raids. Characters of the player are rolled up:
+// the player attended a raid when one of their characters did.
+func attendance(
+	player entity.Player, raids []entity.Raid, statuses map[int]map[int]entity.ParticipantStatus,
+) entity.Attendance {
+	result := entity.Attendance{Player: &player}
+	for _, raid := range raids {
+		if !player.ExpectedOn(raid) {
+			continue
+		}
+		result.Raids = append(result.Raids, raid)
+		switch personStatus(statuses[raid.ID], player.CharacterIDs()) {
+		case entity.ParticipantAbsent:
+			result.Absent = append(result.Absent, raid)
+		case entity.ParticipantBench:
+			result.Bench = append(result.Bench, raid)
+		case entity.ParticipantLate:
+			result.Late = append(result.Late, raid)
+		case entity.ParticipantPresent:
+		}
+	}
+	return result
+}
+
+// ListAttendance returns the attendance of every main on raids from a date to another, both included,
+// with their alts rolled up.
+// It is sorted by attendance rate, best first, then by player name.
+func (a AttendanceUseCase) ListAttendance(ctx context.Context, from, to time.Time) ([]entity.Attendance, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Attendance/ListAttendance")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("from", from.Format("02/01/06")),
+		attribute.String("to", to.Format("02/01/06")),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("AttendanceUseCase - ListAttendance - ctx.Done: request took too much time to be proceed")
+	default:
+		players, err := a.backend.SearchPlayer(ctx, -1, "", "")
+		if err != nil {
+			return nil, fmt.Errorf("search players: %w", err)
+		}
+		raids, statuses, err := a.raidsOnRange(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		attendances := make([]entity.Attendance, 0, len(players))
+		for _, player := range players {
+			if player.IsAlt() {
+				continue
+			}
+			attendances = append(attendances, attendance(person(players, player), raids, statuses))
+		}
+		sort.SliceStable(attendances, func(i, j int) bool {
+			if attendances[i].Rate() != attendances[j].Rate() {
+				return attendances[i].Rate() > attendances[j].Rate()
+			}
+			return attendances[i].Player.Name < attendances[j].Player.Name
+		})
+		return attendances, nil
+	}
+}
+
+// ReadAttendance returns the attendance of the person a player belongs to on raids from a date to another,
+// both included.
+func (a AttendanceUseCase) ReadAttendance(
+	ctx context.Context, playerName string, from, to time.Time,
+) (entity.Attendance, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Attendance/ReadAttendance")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+		attribute.String("from", from.Format("02/01/06")),
+		attribute.String("to", to.Format("02/01/06")),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Attendance{}, fmt.Errorf(
+			"AttendanceUseCase - ReadAttendance - ctx.Done: request took too much time to be proceed")
+	default:
+		playerName = strings.ToLower(playerName)
+		players, err := a.backend.SearchPlayer(ctx, -1, playerName, "")
+		if err != nil {
+			return entity.Attendance{}, fmt.Errorf("search player %s: %w", playerName, err)
+		}
+		if len(players) == 0 {
+			return entity.Attendance{}, fmt.Errorf("check player exists: player %s not found", playerName)
+		}
+		all, err := a.backend.SearchPlayer(ctx, -1, "", "")
+		if err != nil {
+			return entity.Attendance{}, fmt.Errorf("search characters of player %s: %w", playerName, err)
+		}
+		raids, statuses, err := a.raidsOnRange(ctx, from, to)
+		if err != nil {
+			return entity.Attendance{}, err
+		}
+		return attendance(person(all, players[0]), raids, statuses), nil
+	}
+}
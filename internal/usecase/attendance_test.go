@@ -0,0 +1,231 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/mocks"
+)
+
+func TestAttendanceUseCase_ListAttendance(t *testing.T) {
+	t.Parallel()
+
+	first := time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)
+	second := first.AddDate(0, 0, 1)
+	third := first.AddDate(0, 0, 2)
+	firstRaid := entity.Raid{ID: 1, Name: "raid", Difficulty: "heroic", Date: first}
+	thirdRaid := entity.Raid{ID: 2, Name: "raid", Difficulty: "heroic", Date: third}
+
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+	jaina := entity.Player{ID: 2, Name: "jaina"}
+	// thrall joined after the first raid
+	thrall := entity.Player{ID: 3, Name: "thrall", CreatedAt: second.Add(20 * time.Hour)}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		attendanceUseCase := usecase.NewAttendanceUseCase(mockBackend, time.UTC)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").
+			Return([]entity.Player{arthas, jaina, thrall}, nil)
+		mockBackend.On("SearchRaidOnRange", mock.Anything, first, third, 0, 0).
+			Return([]entity.Raid{firstRaid, thirdRaid}, nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", -1, first).
+			Return([]entity.Absence{{Player: &arthas, Raid: &firstRaid}, {Player: &jaina, Raid: &firstRaid}}, nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", -1, third).Return(nil, nil)
+		// jaina declared an absence but came anyway
+		mockBackend.On("SearchParticipant", mock.Anything, 1, -1).
+			Return([]entity.Participant{{Player: &jaina, Raid: &firstRaid, Status: entity.ParticipantLate}}, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 2, -1).
+			Return([]entity.Participant{{Player: &thrall, Raid: &thirdRaid, Status: entity.ParticipantBench}}, nil)
+
+		attendances, err := attendanceUseCase.ListAttendance(context.Background(), first, third)
+
+		assert.NoError(t, err)
+		if assert.Len(t, attendances, 3) {
+			assert.Equal(t, "jaina", attendances[0].Player.Name)
+			assert.Len(t, attendances[0].Raids, 2)
+			assert.Len(t, attendances[0].Late, 1)
+			assert.Equal(t, "thrall", attendances[1].Player.Name)
+			assert.Len(t, attendances[1].Raids, 1)
+			assert.Len(t, attendances[1].Bench, 1)
+			assert.Equal(t, "arthas", attendances[2].Player.Name)
+			assert.Len(t, attendances[2].Raids, 2)
+			assert.Equal(t, float64(50), attendances[2].Rate())
+		}
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Upcoming raids do not count", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		attendanceUseCase := usecase.NewAttendanceUseCase(mockBackend, time.UTC)
+
+		// The season is going on, its raid of next week has no roster yet
+		today := entity.Today(time.UTC)
+		start, end := today.AddDate(0, 0, -14), today.AddDate(0, 0, 14)
+		pastRaid := entity.Raid{ID: 1, Name: "raid", Difficulty: "heroic", Date: today.AddDate(0, 0, -7)}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{jaina}, nil)
+		mockBackend.On("SearchRaidOnRange", mock.Anything, start, today, 0, 0).Return([]entity.Raid{pastRaid}, nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", -1, pastRaid.Date).
+			Return([]entity.Absence{{Player: &jaina, Raid: &pastRaid}}, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, -1).Return(nil, nil)
+
+		attendances, err := attendanceUseCase.ListAttendance(context.Background(), start, end)
+
+		assert.NoError(t, err)
+		if assert.Len(t, attendances, 1) {
+			assert.Len(t, attendances[0].Raids, 1)
+			assert.Equal(t, float64(0), attendances[0].Rate())
+		}
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Range after today", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		attendanceUseCase := usecase.NewAttendanceUseCase(mockBackend, time.UTC)
+
+		tomorrow := entity.Today(time.UTC).AddDate(0, 0, 1)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{jaina}, nil)
+
+		attendances, err := attendanceUseCase.ListAttendance(context.Background(), tomorrow, tomorrow.AddDate(0, 0, 7))
+
+		assert.NoError(t, err)
+		if assert.Len(t, attendances, 1) {
+			assert.Empty(t, attendances[0].Raids)
+		}
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Range too long", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		attendanceUseCase := usecase.NewAttendanceUseCase(mockBackend, time.UTC)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return(nil, nil)
+
+		_, err := attendanceUseCase.ListAttendance(context.Background(), first, first.AddDate(2, 0, 0))
+
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("ctx have been canceled", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		attendanceUseCase := usecase.NewAttendanceUseCase(mockBackend, time.UTC)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := attendanceUseCase.ListAttendance(ctx, first, third)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAttendanceUseCase_ReadAttendance(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		attendanceUseCase := usecase.NewAttendanceUseCase(mockBackend, time.UTC)
+
+		raid := entity.Raid{ID: 1, Date: date}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").
+			Return([]entity.Player{{ID: 1, Name: "arthas"}}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").
+			Return([]entity.Player{{ID: 1, Name: "arthas"}}, nil)
+		mockBackend.On("SearchRaidOnRange", mock.Anything, date, date, 0, 0).Return([]entity.Raid{raid}, nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", -1, date).Return(nil, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, -1).Return(nil, nil)
+
+		attendance, err := attendanceUseCase.ReadAttendance(context.Background(), "Arthas", date, date)
+
+		assert.NoError(t, err)
+		assert.Len(t, attendance.Raids, 1)
+		assert.Equal(t, float64(100), attendance.Rate())
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Alts are rolled up", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		attendanceUseCase := usecase.NewAttendanceUseCase(mockBackend, time.UTC)
+
+		raid := entity.Raid{ID: 1, Date: date}
+		arthas := entity.Player{ID: 1, Name: "arthas"}
+		lichking := entity.Player{ID: 2, Name: "lichking", MainID: 1}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "lichking", "").Return([]entity.Player{lichking}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{arthas, lichking}, nil)
+		mockBackend.On("SearchRaidOnRange", mock.Anything, date, date, 0, 0).Return([]entity.Raid{raid}, nil)
+		// arthas declared an absence and came with lichking
+		mockBackend.On("SearchAbsence", mock.Anything, "", -1, date).
+			Return([]entity.Absence{{Player: &arthas, Raid: &raid}}, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, -1).
+			Return([]entity.Participant{{Player: &lichking, Raid: &raid, Status: entity.ParticipantLate}}, nil)
+
+		attendance, err := attendanceUseCase.ReadAttendance(context.Background(), "lichking", date, date)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "arthas", attendance.Player.Name)
+		assert.Len(t, attendance.Late, 1)
+		assert.Equal(t, float64(100), attendance.Rate())
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Backend Error", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		attendanceUseCase := usecase.NewAttendanceUseCase(mockBackend, time.UTC)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").
+			Return(nil, errors.New("Backend Error"))
+
+		_, err := attendanceUseCase.ReadAttendance(context.Background(), "arthas", date, date)
+
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		attendanceUseCase := usecase.NewAttendanceUseCase(mockBackend, time.UTC)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return(nil, nil)
+
+		_, err := attendanceUseCase.ReadAttendance(context.Background(), "arthas", date, date)
+
+		assert.ErrorContains(t, err, "player arthas not found")
+		mockBackend.AssertExpectations(t)
+	})
+}
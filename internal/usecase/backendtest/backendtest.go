@@ -0,0 +1,1238 @@
+// Package backendtest is a conformance suite for usecase.Backend implementations.
+// Every backend must pass it, so use cases behave the same whatever the storage is.
+package backendtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+)
+
+// NewBackend returns an empty backend. It is called once per test.
+type NewBackend func(t *testing.T) usecase.Backend
+
+var raidDate = time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+// Run runs the conformance suite against backends returned by newBackend.
+func Run(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, newBackend NewBackend)
+	}{
+		{name: "Player", run: testPlayer},
+		{name: "Raid", run: testRaid},
+		{name: "Strike", run: testStrike},
+		{name: "Loot", run: testLoot},
+		{name: "Absence", run: testAbsence},
+		{name: "Fail", run: testFail},
+		{name: "Participant", run: testParticipant},
+		{name: "Signup", run: testSignup},
+		{name: "Season", run: testSeason},
+		{name: "Points", run: testPoints},
+		{name: "Wishlist", run: testWishlist},
+		{name: "Item", run: testItem},
+		{name: "RaidTemplate", run: testRaidTemplate},
+		{name: "Cascade", run: testCascade},
+		{name: "Trash", run: testTrash},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			test.run(t, newBackend)
+		})
+	}
+}
+
+func createPlayer(ctx context.Context, t *testing.T, backend usecase.Backend, name string) entity.Player {
+	t.Helper()
+	player, err := backend.CreatePlayer(ctx, entity.Player{Name: name, DiscordID: "id-" + name, DiscordName: name})
+	require.NoError(t, err)
+	require.NotZero(t, player.ID)
+	return player
+}
+
+func createRaid(ctx context.Context, t *testing.T, backend usecase.Backend, date time.Time) entity.Raid {
+	t.Helper()
+	raid, err := backend.CreateRaid(ctx, entity.Raid{Name: "raid", Date: date, Difficulty: "heroic"})
+	require.NoError(t, err)
+	require.NotZero(t, raid.ID)
+	return raid
+}
+
+func testPlayer(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create and read", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+
+		read, err := backend.ReadPlayer(ctx, player.ID)
+		require.NoError(t, err)
+		assert.Equal(t, player.ID, read.ID)
+		assert.Equal(t, "arthas", read.Name)
+
+		_, err = backend.ReadPlayer(ctx, player.ID+1)
+		assert.Error(t, err)
+	})
+
+	t.Run("Unique name and discord ID", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		createPlayer(ctx, t, backend, "arthas")
+
+		_, err := backend.CreatePlayer(ctx, entity.Player{Name: "arthas", DiscordID: "id-other"})
+		assert.ErrorContains(t, err, "player already exists")
+		_, err = backend.CreatePlayer(ctx, entity.Player{Name: "jaina", DiscordID: "id-arthas"})
+		assert.ErrorContains(t, err, "player already exists")
+		// Usernames are only shown, several players can have the same one
+		_, err = backend.CreatePlayer(ctx, entity.Player{Name: "jaina", DiscordID: "id-jaina", DiscordName: "arthas"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		arthas := createPlayer(ctx, t, backend, "arthas")
+		createPlayer(ctx, t, backend, "jaina")
+
+		players, err := backend.SearchPlayer(ctx, -1, "arthas", "")
+		require.NoError(t, err)
+		require.Len(t, players, 1)
+		assert.Equal(t, arthas.ID, players[0].ID)
+		assert.Equal(t, "id-arthas", players[0].DiscordID)
+		assert.Equal(t, "arthas", players[0].DiscordName)
+		assert.False(t, players[0].CreatedAt.IsZero())
+		assert.True(t, arthas.CreatedAt.Equal(players[0].CreatedAt))
+
+		players, err = backend.SearchPlayer(ctx, -1, "", "id-jaina")
+		require.NoError(t, err)
+		require.Len(t, players, 1)
+		assert.Equal(t, "jaina", players[0].Name)
+
+		players, err = backend.SearchPlayer(ctx, arthas.ID, "jaina", "")
+		require.NoError(t, err)
+		assert.Empty(t, players)
+	})
+
+	t.Run("Search on prefix", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		createPlayer(ctx, t, backend, "jaina")
+		createPlayer(ctx, t, backend, "arthas")
+		createPlayer(ctx, t, backend, "anduin")
+
+		players, err := backend.SearchPlayerOnPrefix(ctx, "a", 0)
+		require.NoError(t, err)
+		require.Len(t, players, 2)
+		assert.Equal(t, "anduin", players[0].Name)
+		assert.Equal(t, "arthas", players[1].Name)
+		assert.Equal(t, "id-anduin", players[0].DiscordID)
+
+		players, err = backend.SearchPlayerOnPrefix(ctx, "", 2)
+		require.NoError(t, err)
+		require.Len(t, players, 2)
+		assert.Equal(t, "anduin", players[0].Name)
+
+		players, err = backend.SearchPlayerOnPrefix(ctx, "thrall", 0)
+		require.NoError(t, err)
+		assert.Empty(t, players)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+
+		player.Name = "lichking"
+		require.NoError(t, player.SetClass("death knight", "blood", "frost", ""))
+		require.NoError(t, backend.UpdatePlayer(ctx, player))
+
+		read, err := backend.ReadPlayer(ctx, player.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "lichking", read.Name)
+		assert.Equal(t, "death knight", read.Class)
+		assert.Equal(t, "blood", read.MainSpec)
+		assert.Equal(t, "frost", read.OffSpec)
+		assert.Equal(t, entity.RoleTank, read.Role)
+
+		players, err := backend.SearchPlayer(ctx, -1, "lichking", "")
+		require.NoError(t, err)
+		require.Len(t, players, 1)
+		assert.Equal(t, read.ClassString(), players[0].ClassString())
+
+		// A player can be unlinked from discord, several players can have no discord ID
+		player.DiscordID, player.DiscordName = "", ""
+		require.NoError(t, backend.UpdatePlayer(ctx, player))
+		jaina := createPlayer(ctx, t, backend, "jaina")
+		jaina.DiscordID, jaina.DiscordName = "", ""
+		require.NoError(t, backend.UpdatePlayer(ctx, jaina))
+		players, err = backend.SearchPlayer(ctx, player.ID, "", "")
+		require.NoError(t, err)
+		require.Len(t, players, 1)
+		assert.Empty(t, players[0].DiscordID)
+		assert.Empty(t, players[0].DiscordName)
+	})
+
+	t.Run("Alts", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		arthas := createPlayer(ctx, t, backend, "arthas")
+		lichking := createPlayer(ctx, t, backend, "lichking")
+
+		lichking.MainID = arthas.ID
+		require.NoError(t, backend.UpdatePlayer(ctx, lichking))
+		read, err := backend.ReadPlayer(ctx, lichking.ID)
+		require.NoError(t, err)
+		assert.Equal(t, arthas.ID, read.MainID)
+		players, err := backend.SearchPlayer(ctx, arthas.ID, "", "")
+		require.NoError(t, err)
+		require.Len(t, players, 1)
+		assert.False(t, players[0].IsAlt())
+
+		lichking.MainID = arthas.ID + lichking.ID
+		assert.Error(t, backend.UpdatePlayer(ctx, lichking))
+
+		// Alts become mains when their main is deleted
+		require.NoError(t, backend.DeletePlayer(ctx, entity.Player{ID: arthas.ID}))
+		read, err = backend.ReadPlayer(ctx, lichking.ID)
+		require.NoError(t, err)
+		assert.False(t, read.IsAlt())
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+
+		require.NoError(t, backend.DeletePlayer(ctx, entity.Player{ID: player.ID}))
+		_, err := backend.ReadPlayer(ctx, player.ID)
+		assert.Error(t, err)
+		assert.Error(t, backend.DeletePlayer(ctx, entity.Player{ID: player.ID}))
+	})
+}
+
+func testRaid(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create and read", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		raid := createRaid(ctx, t, backend, raidDate)
+
+		read, err := backend.ReadRaid(ctx, raid.ID)
+		require.NoError(t, err)
+		assert.Equal(t, raid.ID, read.ID)
+		assert.Equal(t, "raid", read.Name)
+		assert.Equal(t, "heroic", read.Difficulty)
+		assert.True(t, raidDate.Equal(read.Date))
+	})
+
+	t.Run("Start time", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		raid, err := backend.CreateRaid(ctx,
+			entity.Raid{Name: "raid", Date: raidDate, Difficulty: "heroic", StartTime: 20*time.Hour + 30*time.Minute})
+		require.NoError(t, err)
+
+		raids, err := backend.SearchRaid(ctx, "", raidDate, "")
+		require.NoError(t, err)
+		require.Len(t, raids, 1)
+		assert.Equal(t, 20*time.Hour+30*time.Minute, raids[0].StartTime)
+
+		require.NoError(t, backend.UpdateRaid(ctx, entity.Raid{ID: raid.ID, StartTime: 21 * time.Hour, StartTimeSet: true}))
+		read, err := backend.ReadRaid(ctx, raid.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 21*time.Hour, read.StartTime)
+
+		// A raid can start at midnight, the start time is only kept when unset
+		require.NoError(t, backend.UpdateRaid(ctx, entity.Raid{ID: raid.ID, Difficulty: "mythic"}))
+		read, err = backend.ReadRaid(ctx, raid.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 21*time.Hour, read.StartTime)
+		require.NoError(t, backend.UpdateRaid(ctx, entity.Raid{ID: raid.ID, StartTimeSet: true}))
+		read, err = backend.ReadRaid(ctx, raid.ID)
+		require.NoError(t, err)
+		assert.Equal(t, time.Duration(0), read.StartTime)
+		assert.True(t, read.StartTimeSet)
+	})
+
+	t.Run("Unique date and difficulty", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		createRaid(ctx, t, backend, raidDate)
+
+		_, err := backend.CreateRaid(ctx, entity.Raid{Name: "other", Date: raidDate, Difficulty: "heroic"})
+		assert.ErrorContains(t, err, "raid already exists")
+		_, err = backend.CreateRaid(ctx, entity.Raid{Name: "raid", Date: raidDate, Difficulty: "mythic"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		raid := createRaid(ctx, t, backend, raidDate)
+		createRaid(ctx, t, backend, raidDate.AddDate(0, 0, 1))
+
+		raids, err := backend.SearchRaid(ctx, "", raidDate, "")
+		require.NoError(t, err)
+		require.Len(t, raids, 1)
+		assert.Equal(t, raid.ID, raids[0].ID)
+
+		raids, err = backend.SearchRaid(ctx, "raid", time.Time{}, "")
+		require.NoError(t, err)
+		assert.Len(t, raids, 2)
+	})
+
+	t.Run("Search on range", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		third := createRaid(ctx, t, backend, raidDate.AddDate(0, 0, 2))
+		first := createRaid(ctx, t, backend, raidDate)
+		second := createRaid(ctx, t, backend, raidDate.AddDate(0, 0, 1))
+		createRaid(ctx, t, backend, raidDate.AddDate(0, 0, 3))
+
+		raids, err := backend.SearchRaidOnRange(ctx, raidDate, raidDate.AddDate(0, 0, 2), 0, 0)
+		require.NoError(t, err)
+		require.Len(t, raids, 3)
+		assert.Equal(t, []int{first.ID, second.ID, third.ID}, []int{raids[0].ID, raids[1].ID, raids[2].ID})
+
+		raids, err = backend.SearchRaidOnRange(ctx, raidDate, raidDate.AddDate(0, 0, 2), 2, 2)
+		require.NoError(t, err)
+		require.Len(t, raids, 1)
+		assert.Equal(t, third.ID, raids[0].ID)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		raid := createRaid(ctx, t, backend, raidDate)
+
+		require.NoError(t, backend.UpdateRaid(ctx, entity.Raid{ID: raid.ID, Difficulty: "mythic"}))
+		read, err := backend.ReadRaid(ctx, raid.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "raid", read.Name)
+		assert.Equal(t, "mythic", read.Difficulty)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		raid := createRaid(ctx, t, backend, raidDate)
+
+		require.NoError(t, backend.DeleteRaid(ctx, raid.ID))
+		raids, err := backend.SearchRaid(ctx, "", raidDate, "")
+		require.NoError(t, err)
+		assert.Empty(t, raids)
+		assert.Error(t, backend.DeleteRaid(ctx, raid.ID))
+	})
+}
+
+func testStrike(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create, search, update and delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+
+		require.NoError(t, backend.CreateStrike(ctx, entity.Strike{Season: "DF/S2", Reason: "late"}, player.ID))
+
+		strikes, err := backend.SearchStrike(ctx, player.ID, time.Time{}, "", "")
+		require.NoError(t, err)
+		require.Len(t, strikes, 1)
+		assert.Equal(t, "DF/S2", strikes[0].Season)
+		assert.Equal(t, "late", strikes[0].Reason)
+		assert.False(t, strikes[0].Date.IsZero())
+
+		strike := strikes[0]
+		strike.Reason = "very late"
+		require.NoError(t, backend.UpdateStrike(ctx, strike))
+		read, err := backend.ReadStrike(ctx, strike.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "very late", read.Reason)
+
+		require.NoError(t, backend.DeleteStrike(ctx, strike.ID))
+		assert.ErrorContains(t, backend.DeleteStrike(ctx, strike.ID), "strike not found")
+	})
+
+	t.Run("Unknown player", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		assert.Error(t, backend.CreateStrike(ctx, entity.Strike{Season: "DF/S2", Reason: "late"}, 42))
+	})
+}
+
+func testLoot(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create, search, read and delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+
+		_, err := backend.CreateLoot(ctx, entity.Loot{Name: "frostmourne", Raid: &raid, Player: &player})
+		require.NoError(t, err)
+		_, err = backend.CreateLoot(ctx, entity.Loot{Name: "frostmourne", Raid: &raid, Player: &player})
+		assert.Error(t, err)
+
+		loots, err := backend.SearchLoot(ctx, "", time.Time{}, "", "arthas", 0, 0)
+		require.NoError(t, err)
+		require.Len(t, loots, 1)
+		assert.Equal(t, "frostmourne", loots[0].Name)
+		assert.Equal(t, raid.ID, loots[0].Raid.ID)
+		assert.Equal(t, "heroic", loots[0].Raid.Difficulty)
+		assert.Equal(t, player.ID, loots[0].Player.ID)
+
+		loots, err = backend.SearchLoot(ctx, "frostmourne", raidDate, "mythic", "", 0, 0)
+		require.NoError(t, err)
+		assert.Empty(t, loots)
+
+		read, err := backend.ReadLoot(ctx, firstLootID(t, backend))
+		require.NoError(t, err)
+		assert.Equal(t, "frostmourne", read.Name)
+		assert.Equal(t, "arthas", read.Player.Name)
+		assert.Equal(t, raid.ID, read.Raid.ID)
+
+		require.NoError(t, backend.DeleteLoot(ctx, read.ID))
+		assert.Error(t, backend.DeleteLoot(ctx, read.ID))
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+		_, err := backend.CreateLoot(ctx, entity.Loot{Name: "frostmourne", Raid: &raid, Player: &player})
+		require.NoError(t, err)
+
+		loot, err := backend.ReadLoot(ctx, firstLootID(t, backend))
+		require.NoError(t, err)
+		loot.Name = "ashbringer"
+		require.NoError(t, backend.UpdateLoot(ctx, loot))
+
+		read, err := backend.ReadLoot(ctx, loot.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "ashbringer", read.Name)
+	})
+
+	t.Run("Item", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+		frostmourne := entity.Item{ID: 19019, Name: "frostmourne", Slot: "two-hand", ItemLevel: 284}
+		require.NoError(t, backend.SaveItem(ctx, frostmourne))
+
+		loot, err := backend.CreateLoot(ctx, entity.Loot{Name: "frostmourne", Raid: &raid, Player: &player,
+			Item: &frostmourne})
+		require.NoError(t, err)
+		_, err = backend.CreateLoot(ctx, entity.Loot{Name: "ashbringer", Raid: &raid, Player: &player,
+			Item: &entity.Item{ID: 1, Name: "ashbringer"}})
+		assert.Error(t, err)
+
+		loots, err := backend.SearchLoot(ctx, "", time.Time{}, "", "arthas", 0, 0)
+		require.NoError(t, err)
+		require.Len(t, loots, 1)
+		assert.Equal(t, &frostmourne, loots[0].Item)
+
+		read, err := backend.ReadLoot(ctx, loot.ID)
+		require.NoError(t, err)
+		assert.Equal(t, &frostmourne, read.Item)
+
+		read.Item = nil
+		require.NoError(t, backend.UpdateLoot(ctx, read))
+		read, err = backend.ReadLoot(ctx, loot.ID)
+		require.NoError(t, err)
+		assert.Nil(t, read.Item)
+	})
+
+	t.Run("Search a page", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+		for _, name := range []string{"frostmourne", "ashbringer", "thunderfury"} {
+			_, err := backend.CreateLoot(ctx, entity.Loot{Name: name, Raid: &raid, Player: &player})
+			require.NoError(t, err)
+		}
+
+		loots, err := backend.SearchLoot(ctx, "", time.Time{}, "", "arthas", 2, 0)
+		require.NoError(t, err)
+		require.Len(t, loots, 2)
+		assert.Equal(t, "frostmourne", loots[0].Name)
+		assert.Equal(t, "ashbringer", loots[1].Name)
+
+		loots, err = backend.SearchLoot(ctx, "", time.Time{}, "", "arthas", 2, 2)
+		require.NoError(t, err)
+		require.Len(t, loots, 1)
+		assert.Equal(t, "thunderfury", loots[0].Name)
+	})
+
+	t.Run("Search names", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		arthas := createPlayer(ctx, t, backend, "arthas")
+		jaina := createPlayer(ctx, t, backend, "jaina")
+		raid := createRaid(ctx, t, backend, raidDate)
+		for _, loot := range []entity.Loot{
+			{Name: "frostmourne", Raid: &raid, Player: &arthas},
+			{Name: "frostmourne", Raid: &raid, Player: &jaina},
+			{Name: "frostbolt staff", Raid: &raid, Player: &jaina},
+			{Name: "ashbringer", Raid: &raid, Player: &arthas},
+		} {
+			_, err := backend.CreateLoot(ctx, loot)
+			require.NoError(t, err)
+		}
+
+		names, err := backend.SearchLootName(ctx, "frost", 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"frostbolt staff", "frostmourne"}, names)
+
+		names, err = backend.SearchLootName(ctx, "", 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ashbringer", "frostbolt staff"}, names)
+
+		names, err = backend.SearchLootName(ctx, "thunderfury", 0)
+		require.NoError(t, err)
+		assert.Empty(t, names)
+	})
+}
+
+// firstLootID returns the ID of the first loot of arthas, as CreateLoot doesn't always return it.
+func firstLootID(t *testing.T, backend usecase.Backend) int {
+	t.Helper()
+	loots, err := backend.SearchLoot(context.Background(), "", time.Time{}, "", "arthas", 0, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, loots)
+	return loots[0].ID
+}
+
+func testAbsence(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create, search, read and delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+
+		_, err := backend.CreateAbsence(ctx, entity.Absence{Player: &player, Raid: &raid})
+		require.NoError(t, err)
+		_, err = backend.CreateAbsence(ctx, entity.Absence{Player: &player, Raid: &raid})
+		assert.ErrorContains(t, err, "absence already exists")
+
+		absences, err := backend.SearchAbsence(ctx, "", -1, raidDate)
+		require.NoError(t, err)
+		require.Len(t, absences, 1)
+		assert.Equal(t, player.ID, absences[0].Player.ID)
+		assert.Equal(t, "arthas", absences[0].Player.Name)
+		assert.Equal(t, raid.ID, absences[0].Raid.ID)
+
+		absences, err = backend.SearchAbsence(ctx, "", player.ID, raidDate.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Empty(t, absences)
+
+		absences, err = backend.SearchAbsence(ctx, "arthas", -1, time.Time{})
+		require.NoError(t, err)
+		require.Len(t, absences, 1)
+
+		read, err := backend.ReadAbsence(ctx, absences[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, player.ID, read.Player.ID)
+		assert.Equal(t, raid.ID, read.Raid.ID)
+
+		require.NoError(t, backend.DeleteAbsence(ctx, read.ID))
+		assert.ErrorContains(t, backend.DeleteAbsence(ctx, read.ID), "absence not found")
+		_, err = backend.ReadAbsence(ctx, read.ID)
+		assert.ErrorContains(t, err, "absence not found")
+	})
+
+	t.Run("No param", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		_, err := backend.SearchAbsence(ctx, "", -1, time.Time{})
+		assert.Error(t, err)
+	})
+}
+
+func testFail(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create, search, update and delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+
+		_, err := backend.CreateFail(ctx, entity.Fail{Reason: "stood in fire", Player: &player, Raid: &raid})
+		require.NoError(t, err)
+
+		fails, err := backend.SearchFail(ctx, "", player.ID, -1, "", 0, 0)
+		require.NoError(t, err)
+		require.Len(t, fails, 1)
+		assert.Equal(t, "stood in fire", fails[0].Reason)
+		assert.Equal(t, player.ID, fails[0].Player.ID)
+		assert.Equal(t, raid.ID, fails[0].Raid.ID)
+
+		fail := fails[0]
+		fail.Reason = "stood in void"
+		require.NoError(t, backend.UpdateFail(ctx, fail))
+		read, err := backend.ReadFail(ctx, fail.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fail.ID, read.ID)
+		assert.Equal(t, "stood in void", read.Reason)
+
+		require.NoError(t, backend.DeleteFail(ctx, fail.ID))
+		assert.Error(t, backend.DeleteFail(ctx, fail.ID))
+	})
+
+	t.Run("Search a page", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+		for _, reason := range []string{"stood in fire", "stood in void", "pulled the boss"} {
+			_, err := backend.CreateFail(ctx, entity.Fail{Reason: reason, Player: &player, Raid: &raid})
+			require.NoError(t, err)
+		}
+
+		fails, err := backend.SearchFail(ctx, "", player.ID, -1, "", 2, 1)
+		require.NoError(t, err)
+		require.Len(t, fails, 2)
+		assert.Equal(t, "stood in void", fails[0].Reason)
+		assert.Equal(t, "pulled the boss", fails[1].Reason)
+
+		// fails matching several criteria are appended then paged
+		fails, err = backend.SearchFail(ctx, "", player.ID, raid.ID, "", 2, 2)
+		require.NoError(t, err)
+		require.Len(t, fails, 2)
+		assert.Equal(t, "pulled the boss", fails[0].Reason)
+		assert.Equal(t, "stood in fire", fails[1].Reason)
+	})
+}
+
+func testParticipant(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create, search, update and delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		thrall := createPlayer(ctx, t, backend, "thrall")
+		arthas := createPlayer(ctx, t, backend, "arthas")
+		require.NoError(t, arthas.SetClass("paladin", "protection", "", ""))
+		require.NoError(t, backend.UpdatePlayer(ctx, arthas))
+		raid := createRaid(ctx, t, backend, raidDate)
+		nextRaid := createRaid(ctx, t, backend, raidDate.AddDate(0, 0, 7))
+
+		require.NoError(t, backend.CreateParticipant(ctx,
+			entity.Participant{Player: &thrall, Raid: &raid, Status: entity.ParticipantBench}))
+		require.NoError(t, backend.CreateParticipant(ctx,
+			entity.Participant{Player: &arthas, Raid: &raid, Status: entity.ParticipantPresent}))
+		require.NoError(t, backend.CreateParticipant(ctx,
+			entity.Participant{Player: &arthas, Raid: &nextRaid, Status: entity.ParticipantLate}))
+		err := backend.CreateParticipant(ctx,
+			entity.Participant{Player: &arthas, Raid: &raid, Status: entity.ParticipantAbsent})
+		assert.ErrorContains(t, err, "participant already exists")
+
+		participants, err := backend.SearchParticipant(ctx, raid.ID, -1)
+		require.NoError(t, err)
+		require.Len(t, participants, 2)
+		assert.Equal(t, "arthas", participants[0].Player.Name)
+		assert.Equal(t, "paladin", participants[0].Player.Class)
+		assert.Equal(t, entity.RoleTank, participants[0].Player.Role)
+		assert.Equal(t, entity.ParticipantPresent, participants[0].Status)
+		assert.Equal(t, raid.ID, participants[0].Raid.ID)
+		assert.True(t, raidDate.Equal(participants[0].Raid.Date))
+		assert.Equal(t, "thrall", participants[1].Player.Name)
+		assert.Equal(t, entity.ParticipantBench, participants[1].Status)
+
+		participants, err = backend.SearchParticipant(ctx, -1, arthas.ID)
+		require.NoError(t, err)
+		require.Len(t, participants, 2)
+		assert.Equal(t, raid.ID, participants[0].Raid.ID)
+		assert.Equal(t, nextRaid.ID, participants[1].Raid.ID)
+
+		require.NoError(t, backend.UpdateParticipant(ctx,
+			entity.Participant{Player: &thrall, Raid: &raid, Status: entity.ParticipantAbsent}))
+		participants, err = backend.SearchParticipant(ctx, raid.ID, thrall.ID)
+		require.NoError(t, err)
+		require.Len(t, participants, 1)
+		assert.Equal(t, entity.ParticipantAbsent, participants[0].Status)
+		err = backend.UpdateParticipant(ctx,
+			entity.Participant{Player: &thrall, Raid: &nextRaid, Status: entity.ParticipantAbsent})
+		assert.ErrorContains(t, err, "participant not found")
+
+		require.NoError(t, backend.DeleteParticipant(ctx, raid.ID, thrall.ID))
+		assert.ErrorContains(t, backend.DeleteParticipant(ctx, raid.ID, thrall.ID), "participant not found")
+		participants, err = backend.SearchParticipant(ctx, raid.ID, -1)
+		require.NoError(t, err)
+		assert.Len(t, participants, 1)
+	})
+}
+
+func testSignup(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create, search, update and delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		thrall := createPlayer(ctx, t, backend, "thrall")
+		arthas := createPlayer(ctx, t, backend, "arthas")
+		require.NoError(t, arthas.SetClass("paladin", "holy", "", ""))
+		require.NoError(t, backend.UpdatePlayer(ctx, arthas))
+		raid := createRaid(ctx, t, backend, raidDate)
+
+		require.NoError(t, backend.CreateSignup(ctx,
+			entity.Signup{Player: &thrall, Raid: &raid, Status: entity.SignupTentative}))
+		require.NoError(t, backend.CreateSignup(ctx,
+			entity.Signup{Player: &arthas, Raid: &raid, Status: entity.SignupAccepted}))
+		err := backend.CreateSignup(ctx,
+			entity.Signup{Player: &arthas, Raid: &raid, Status: entity.SignupDeclined})
+		assert.ErrorContains(t, err, "signup already exists")
+
+		signups, err := backend.SearchSignup(ctx, raid.ID, -1)
+		require.NoError(t, err)
+		require.Len(t, signups, 2)
+		assert.Equal(t, "arthas", signups[0].Player.Name)
+		assert.Equal(t, entity.RoleHealer, signups[0].Player.Role)
+		assert.Equal(t, entity.SignupAccepted, signups[0].Status)
+		assert.Equal(t, "thrall", signups[1].Player.Name)
+		assert.Equal(t, entity.SignupTentative, signups[1].Status)
+
+		require.NoError(t, backend.UpdateSignup(ctx,
+			entity.Signup{Player: &thrall, Raid: &raid, Status: entity.SignupDeclined}))
+		signups, err = backend.SearchSignup(ctx, -1, thrall.ID)
+		require.NoError(t, err)
+		require.Len(t, signups, 1)
+		assert.Equal(t, entity.SignupDeclined, signups[0].Status)
+
+		require.NoError(t, backend.DeleteSignup(ctx, raid.ID, thrall.ID))
+		assert.ErrorContains(t, backend.DeleteSignup(ctx, raid.ID, thrall.ID), "signup not found")
+		err = backend.UpdateSignup(ctx,
+			entity.Signup{Player: &thrall, Raid: &raid, Status: entity.SignupLate})
+		assert.ErrorContains(t, err, "signup not found")
+	})
+}
+
+func testSeason(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create, search, update and delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		s3 := entity.Season{
+			Name:  "DF/S3",
+			Start: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, time.April, 30, 0, 0, 0, 0, time.UTC),
+		}
+		s2 := entity.Season{
+			Name:  "DF/S2",
+			Start: time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC),
+		}
+		s3, err := backend.CreateSeason(ctx, s3)
+		require.NoError(t, err)
+		assert.NotZero(t, s3.ID)
+		s2, err = backend.CreateSeason(ctx, s2)
+		require.NoError(t, err)
+		_, err = backend.CreateSeason(ctx, s2)
+		assert.ErrorContains(t, err, "season already exists")
+
+		seasons, err := backend.SearchSeason(ctx, "")
+		require.NoError(t, err)
+		require.Len(t, seasons, 2)
+		assert.Equal(t, "DF/S2", seasons[0].Name)
+		assert.True(t, s2.Start.Equal(seasons[0].Start))
+		assert.True(t, s2.End.Equal(seasons[0].End))
+		assert.Equal(t, "DF/S3", seasons[1].Name)
+
+		s3.End = time.Date(2024, time.May, 5, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, backend.UpdateSeason(ctx, s3))
+		seasons, err = backend.SearchSeason(ctx, "DF/S3")
+		require.NoError(t, err)
+		require.Len(t, seasons, 1)
+		assert.True(t, s3.End.Equal(seasons[0].End))
+		s3.Name = "DF/S2"
+		assert.ErrorContains(t, backend.UpdateSeason(ctx, s3), "season already exists")
+		assert.ErrorContains(t, backend.UpdateSeason(ctx, entity.Season{ID: 42, Name: "DF/S4"}), "season not found")
+
+		require.NoError(t, backend.DeleteSeason(ctx, s2.ID))
+		assert.ErrorContains(t, backend.DeleteSeason(ctx, s2.ID), "season not found")
+		seasons, err = backend.SearchSeason(ctx, "DF/S2")
+		require.NoError(t, err)
+		assert.Empty(t, seasons)
+	})
+}
+
+func testPoints(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create, search, read and revert", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		arthas := createPlayer(ctx, t, backend, "arthas")
+		jaina := createPlayer(ctx, t, backend, "jaina")
+		raid := createRaid(ctx, t, backend, raidDate)
+
+		attendance, err := backend.CreatePointsEntry(ctx, entity.PointsEntry{Player: &arthas, Amount: 10,
+			Kind: entity.PointsAttendance, Reason: "raid", Author: "guildops", Date: raidDate, RaidID: raid.ID})
+		require.NoError(t, err)
+		assert.NotZero(t, attendance.ID)
+		award, err := backend.CreatePointsEntry(ctx, entity.PointsEntry{Player: &jaina, Amount: 5,
+			Kind: entity.PointsAward, Reason: "first kill", Author: "thrall", Date: raidDate.Add(time.Hour)})
+		require.NoError(t, err)
+
+		entries, err := backend.SearchPointsEntry(ctx, -1, -1, -1)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, attendance.ID, entries[0].ID)
+		assert.Equal(t, "arthas", entries[0].Player.Name)
+		assert.Equal(t, 10, entries[0].Amount)
+		assert.Equal(t, entity.PointsAttendance, entries[0].Kind)
+		assert.Equal(t, raid.ID, entries[0].RaidID)
+		assert.True(t, raidDate.Equal(entries[0].Date))
+		assert.Equal(t, "thrall", entries[1].Author)
+		assert.Zero(t, entries[1].RaidID)
+
+		entries, err = backend.SearchPointsEntry(ctx, -1, raid.ID, -1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, arthas.ID, entries[0].Player.ID)
+
+		reversal := entity.PointsEntry{Player: &jaina, Amount: -5, Kind: entity.PointsReversal,
+			Reason: "wrong player", Author: "thrall", Date: raidDate.Add(2 * time.Hour), Reverts: award.ID}
+		reversal, err = backend.CreatePointsEntry(ctx, reversal)
+		require.NoError(t, err)
+		_, err = backend.CreatePointsEntry(ctx, reversal)
+		assert.ErrorContains(t, err, "points entry already reverted")
+
+		read, err := backend.ReadPointsEntry(ctx, reversal.ID)
+		require.NoError(t, err)
+		assert.Equal(t, award.ID, read.Reverts)
+		assert.Equal(t, "jaina", read.Player.Name)
+		_, err = backend.ReadPointsEntry(ctx, 42)
+		assert.ErrorContains(t, err, "points entry not found")
+	})
+}
+
+func testWishlist(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create, search and delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		arthas := createPlayer(ctx, t, backend, "arthas")
+		jaina := createPlayer(ctx, t, backend, "jaina")
+
+		frostmourne, err := backend.CreateWish(ctx,
+			entity.Wish{Player: &arthas, Item: "frostmourne", Priority: 2, Spec: "frost", Note: "best in slot"})
+		require.NoError(t, err)
+		assert.NotZero(t, frostmourne.ID)
+		_, err = backend.CreateWish(ctx, entity.Wish{Player: &arthas, Item: "frostmourne", Priority: 1})
+		assert.ErrorContains(t, err, "wish already exists")
+		_, err = backend.CreateWish(ctx, entity.Wish{Player: &jaina, Item: "frostmourne", Priority: 1})
+		require.NoError(t, err)
+		_, err = backend.CreateWish(ctx, entity.Wish{Player: &arthas, Item: "ashbringer", Priority: 3})
+		require.NoError(t, err)
+
+		wishes, err := backend.SearchWish(ctx, -1, "frostmourne")
+		require.NoError(t, err)
+		require.Len(t, wishes, 2)
+		assert.Equal(t, "jaina", wishes[0].Player.Name)
+		assert.Equal(t, "arthas", wishes[1].Player.Name)
+		assert.Equal(t, "frost", wishes[1].Spec)
+		assert.Equal(t, "best in slot", wishes[1].Note)
+
+		wishes, err = backend.SearchWish(ctx, arthas.ID, "")
+		require.NoError(t, err)
+		require.Len(t, wishes, 2)
+		assert.Equal(t, "frostmourne", wishes[0].Item)
+		assert.Equal(t, "ashbringer", wishes[1].Item)
+
+		require.NoError(t, backend.DeleteWish(ctx, frostmourne.ID))
+		assert.ErrorContains(t, backend.DeleteWish(ctx, frostmourne.ID), "wish not found")
+		wishes, err = backend.SearchWish(ctx, arthas.ID, "frostmourne")
+		require.NoError(t, err)
+		assert.Empty(t, wishes)
+	})
+}
+
+func testItem(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Save, search and read", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		frostmourne := entity.Item{ID: 19019, Name: "frostmourne", Slot: "two-hand", ItemLevel: 284}
+		require.NoError(t, backend.SaveItem(ctx, frostmourne))
+		require.NoError(t, backend.SaveItem(ctx, entity.Item{ID: 19020, Name: "frostguard", Slot: "chest",
+			ItemLevel: 270, ArmorType: "plate"}))
+		require.NoError(t, backend.SaveItem(ctx, entity.Item{ID: 22691, Name: "corrupted ashbringer"}))
+
+		items, err := backend.SearchItem(ctx, "frost")
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, "frostguard", items[0].Name)
+		assert.Equal(t, "plate", items[0].ArmorType)
+		assert.Equal(t, frostmourne, items[1])
+
+		items, err = backend.SearchItem(ctx, "")
+		require.NoError(t, err)
+		assert.Len(t, items, 3)
+
+		frostmourne.ItemLevel = 300
+		require.NoError(t, backend.SaveItem(ctx, frostmourne))
+		read, err := backend.ReadItem(ctx, frostmourne.ID)
+		require.NoError(t, err)
+		assert.Equal(t, frostmourne, read)
+
+		_, err = backend.ReadItem(ctx, 1)
+		assert.ErrorContains(t, err, "item 1 not found")
+	})
+}
+
+func testRaidTemplate(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Create, search and delete", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+
+		heroic, err := entity.NewRaidTemplate("nighthold", "heroic", "wed,mon", "21:30", "Europe/Paris")
+		require.NoError(t, err)
+		heroic, err = backend.CreateRaidTemplate(ctx, heroic)
+		require.NoError(t, err)
+		assert.NotZero(t, heroic.ID)
+		_, err = backend.CreateRaidTemplate(ctx, heroic)
+		assert.ErrorContains(t, err, "raid template already exists")
+		mythic, err := entity.NewRaidTemplate("nighthold", "mythic", "thursday", "20:00", "")
+		require.NoError(t, err)
+		mythic, err = backend.CreateRaidTemplate(ctx, mythic)
+		require.NoError(t, err)
+
+		templates, err := backend.SearchRaidTemplate(ctx, "nighthold")
+		require.NoError(t, err)
+		assert.Equal(t, []entity.RaidTemplate{heroic, mythic}, templates)
+
+		require.NoError(t, backend.DeleteRaidTemplate(ctx, heroic.ID))
+		assert.ErrorContains(t, backend.DeleteRaidTemplate(ctx, heroic.ID), "raid template not found")
+		templates, err = backend.SearchRaidTemplate(ctx, "")
+		require.NoError(t, err)
+		assert.Equal(t, []entity.RaidTemplate{mythic}, templates)
+	})
+}
+
+func testCascade(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	populate := func(t *testing.T) (usecase.Backend, entity.Player, entity.Raid) {
+		t.Helper()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+		require.NoError(t, backend.CreateStrike(ctx, entity.Strike{Season: "DF/S2", Reason: "late"}, player.ID))
+		loot, err := backend.CreateLoot(ctx, entity.Loot{Name: "frostmourne", Raid: &raid, Player: &player})
+		require.NoError(t, err)
+		_, err = backend.CreatePointsEntry(ctx, entity.PointsEntry{Player: &player, Amount: 10,
+			Kind: entity.PointsAttendance, Reason: "raid", Author: "guildops", Date: raidDate, RaidID: raid.ID})
+		require.NoError(t, err)
+		_, err = backend.CreatePointsEntry(ctx, entity.PointsEntry{Player: &player, Amount: -20,
+			Kind: entity.PointsLoot, Reason: "frostmourne", Author: "guildops", Date: raidDate, LootID: loot.ID})
+		require.NoError(t, err)
+		_, err = backend.CreateWish(ctx, entity.Wish{Player: &player, Item: "frostmourne", Priority: 1})
+		require.NoError(t, err)
+		_, err = backend.CreateAbsence(ctx, entity.Absence{Player: &player, Raid: &raid})
+		require.NoError(t, err)
+		_, err = backend.CreateFail(ctx, entity.Fail{Reason: "stood in fire", Player: &player, Raid: &raid})
+		require.NoError(t, err)
+		require.NoError(t, backend.CreateParticipant(ctx,
+			entity.Participant{Player: &player, Raid: &raid, Status: entity.ParticipantPresent}))
+		require.NoError(t, backend.CreateSignup(ctx,
+			entity.Signup{Player: &player, Raid: &raid, Status: entity.SignupAccepted}))
+		return backend, player, raid
+	}
+
+	t.Run("Delete player", func(t *testing.T) {
+		t.Parallel()
+		backend, player, raid := populate(t)
+		require.NoError(t, backend.DeletePlayer(ctx, entity.Player{ID: player.ID}))
+
+		strikes, err := backend.SearchStrike(ctx, player.ID, time.Time{}, "", "")
+		require.NoError(t, err)
+		assert.Empty(t, strikes)
+		loots, err := backend.SearchLoot(ctx, "frostmourne", time.Time{}, "", "", 0, 0)
+		require.NoError(t, err)
+		assert.Empty(t, loots)
+		absences, err := backend.SearchAbsence(ctx, "", -1, raidDate)
+		require.NoError(t, err)
+		assert.Empty(t, absences)
+		fails, err := backend.SearchFail(ctx, "", -1, raid.ID, "", 0, 0)
+		require.NoError(t, err)
+		assert.Empty(t, fails)
+		participants, err := backend.SearchParticipant(ctx, raid.ID, -1)
+		require.NoError(t, err)
+		assert.Empty(t, participants)
+		signups, err := backend.SearchSignup(ctx, raid.ID, -1)
+		require.NoError(t, err)
+		assert.Empty(t, signups)
+		entries, err := backend.SearchPointsEntry(ctx, player.ID, -1, -1)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+		wishes, err := backend.SearchWish(ctx, player.ID, "")
+		require.NoError(t, err)
+		assert.Empty(t, wishes)
+	})
+
+	t.Run("Delete raid", func(t *testing.T) {
+		t.Parallel()
+		backend, player, raid := populate(t)
+		require.NoError(t, backend.DeleteRaid(ctx, raid.ID))
+
+		strikes, err := backend.SearchStrike(ctx, player.ID, time.Time{}, "", "")
+		require.NoError(t, err)
+		assert.Len(t, strikes, 1)
+		loots, err := backend.SearchLoot(ctx, "", time.Time{}, "", "arthas", 0, 0)
+		require.NoError(t, err)
+		assert.Empty(t, loots)
+		absences, err := backend.SearchAbsence(ctx, "", player.ID, time.Time{})
+		require.NoError(t, err)
+		assert.Empty(t, absences)
+		fails, err := backend.SearchFail(ctx, "", player.ID, -1, "", 0, 0)
+		require.NoError(t, err)
+		assert.Empty(t, fails)
+		participants, err := backend.SearchParticipant(ctx, -1, player.ID)
+		require.NoError(t, err)
+		assert.Empty(t, participants)
+		signups, err := backend.SearchSignup(ctx, -1, player.ID)
+		require.NoError(t, err)
+		assert.Empty(t, signups)
+		// The ledger keeps its history, entries keep their raid and loot to be restored with them
+		entries, err := backend.SearchPointsEntry(ctx, player.ID, -1, -1)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, raid.ID, entries[0].RaidID)
+		assert.NotZero(t, entries[1].LootID)
+	})
+}
+
+func testTrash(t *testing.T, newBackend NewBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Delete and restore", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		alt := createPlayer(ctx, t, backend, "lichking")
+		alt.MainID = player.ID
+		require.NoError(t, backend.UpdatePlayer(ctx, alt))
+		raid := createRaid(ctx, t, backend, raidDate)
+		require.NoError(t, backend.CreateStrike(ctx, entity.Strike{Season: "DF/S2", Reason: "late"}, player.ID))
+		loot, err := backend.CreateLoot(ctx, entity.Loot{Name: "frostmourne", Raid: &raid, Player: &player})
+		require.NoError(t, err)
+
+		require.NoError(t, backend.DeleteLoot(ctx, loot.ID))
+		_, err = backend.ReadLoot(ctx, loot.ID)
+		assert.Error(t, err)
+		names, err := backend.SearchLootName(ctx, "frost", 0)
+		require.NoError(t, err)
+		assert.Empty(t, names)
+
+		require.NoError(t, backend.DeleteRaid(ctx, raid.ID))
+		read, err := backend.ReadRaid(ctx, raid.ID)
+		require.NoError(t, err)
+		assert.Zero(t, read.ID)
+		raids, err := backend.SearchRaidOnRange(ctx, raidDate, raidDate, 0, 0)
+		require.NoError(t, err)
+		assert.Empty(t, raids)
+
+		require.NoError(t, backend.DeletePlayer(ctx, entity.Player{ID: player.ID}))
+		assert.Error(t, backend.DeletePlayer(ctx, entity.Player{ID: player.ID}))
+		_, err = backend.ReadPlayer(ctx, player.ID)
+		assert.Error(t, err)
+		strikes, err := backend.SearchStrike(ctx, player.ID, time.Time{}, "", "")
+		require.NoError(t, err)
+		assert.Empty(t, strikes)
+
+		// the last deleted comes first
+		deleted, err := backend.SearchDeleted(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, deleted, 3)
+		assert.Equal(t, entity.DeletedPlayer, deleted[0].Kind)
+		assert.Equal(t, player.ID, deleted[0].ID)
+		assert.Equal(t, "arthas", deleted[0].Name)
+		assert.False(t, deleted[0].DeletedAt.IsZero())
+		assert.Equal(t, entity.Deleted{Kind: entity.DeletedRaid, ID: raid.ID, Name: "raid"},
+			entity.Deleted{Kind: deleted[1].Kind, ID: deleted[1].ID, Name: deleted[1].Name})
+		assert.Equal(t, entity.DeletedLoot, deleted[2].Kind)
+
+		for _, object := range deleted {
+			require.NoError(t, backend.RestoreDeleted(ctx, object))
+		}
+		assert.Error(t, backend.RestoreDeleted(ctx, deleted[0]))
+		deleted, err = backend.SearchDeleted(ctx, 1)
+		require.NoError(t, err)
+		assert.Empty(t, deleted)
+
+		readAlt, err := backend.ReadPlayer(ctx, alt.ID)
+		require.NoError(t, err)
+		assert.Equal(t, player.ID, readAlt.MainID)
+		strikes, err = backend.SearchStrike(ctx, player.ID, time.Time{}, "", "")
+		require.NoError(t, err)
+		assert.Len(t, strikes, 1)
+		loots, err := backend.SearchLoot(ctx, "frostmourne", time.Time{}, "", "", 0, 0)
+		require.NoError(t, err)
+		require.Len(t, loots, 1)
+		assert.Equal(t, loot.ID, loots[0].ID)
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+		_, err := backend.CreateLoot(ctx, entity.Loot{Name: "frostmourne", Raid: &raid, Player: &player})
+		require.NoError(t, err)
+		require.NoError(t, backend.DeleteRaid(ctx, raid.ID))
+
+		purged, err := backend.PurgeDeleted(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, purged)
+
+		purged, err = backend.PurgeDeleted(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, purged)
+		deleted, err := backend.SearchDeleted(ctx, 0)
+		require.NoError(t, err)
+		assert.Empty(t, deleted)
+		assert.Error(t, backend.RestoreDeleted(ctx, entity.Deleted{Kind: entity.DeletedRaid, ID: raid.ID}))
+
+		// the raid is gone for good, nothing can be attached to it anymore
+		_, err = backend.CreateLoot(ctx, entity.Loot{Name: "frostmourne", Raid: &raid, Player: &player})
+		assert.Error(t, err)
+	})
+
+	t.Run("Create again", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+		loot, err := backend.CreateLoot(ctx, entity.Loot{Name: "frostmourne", Raid: &raid, Player: &player})
+		require.NoError(t, err)
+
+		require.NoError(t, backend.DeleteLoot(ctx, loot.ID))
+		_, err = backend.CreateLoot(ctx, entity.Loot{Name: "frostmourne", Raid: &raid, Player: &player})
+		require.NoError(t, err)
+
+		require.NoError(t, backend.DeleteRaid(ctx, raid.ID))
+		createRaid(ctx, t, backend, raidDate)
+		deletedRaids, err := backend.SearchDeletedRaid(ctx, raidDate, "heroic")
+		require.NoError(t, err)
+		require.Len(t, deletedRaids, 1)
+		assert.Equal(t, raid.ID, deletedRaids[0].ID)
+
+		require.NoError(t, backend.DeletePlayer(ctx, entity.Player{ID: player.ID}))
+		createPlayer(ctx, t, backend, "arthas")
+
+		// the deleted objects are kept until they are purged, but can't come back over their replacement
+		deleted, err := backend.SearchDeleted(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, deleted, 3)
+		for _, object := range deleted {
+			assert.ErrorContains(t, backend.RestoreDeleted(ctx, object), "already exists")
+		}
+	})
+
+	t.Run("Discord link", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		require.NoError(t, backend.DeletePlayer(ctx, entity.Player{ID: player.ID}))
+
+		// the discord user of a deleted player can link another player
+		jaina, err := backend.CreatePlayer(ctx, entity.Player{Name: "jaina", DiscordID: "id-arthas", DiscordName: "arthas"})
+		require.NoError(t, err)
+
+		// the restored player is unlinked, the discord user stays on its new player
+		require.NoError(t, backend.RestoreDeleted(ctx, entity.Deleted{Kind: entity.DeletedPlayer, ID: player.ID}))
+		read, err := backend.ReadPlayer(ctx, player.ID)
+		require.NoError(t, err)
+		assert.Empty(t, read.DiscordID)
+		assert.Empty(t, read.DiscordName)
+		players, err := backend.SearchPlayer(ctx, -1, "", "id-arthas")
+		require.NoError(t, err)
+		require.Len(t, players, 1)
+		assert.Equal(t, jaina.ID, players[0].ID)
+	})
+
+	t.Run("Hidden with their player or raid", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+		player := createPlayer(ctx, t, backend, "arthas")
+		raid := createRaid(ctx, t, backend, raidDate)
+		require.NoError(t, backend.CreateStrike(ctx, entity.Strike{Season: "DF/S2", Reason: "late"}, player.ID))
+		strikes, err := backend.SearchStrike(ctx, player.ID, time.Time{}, "", "")
+		require.NoError(t, err)
+		require.Len(t, strikes, 1)
+		fail, err := backend.CreateFail(ctx, entity.Fail{Player: &player, Raid: &raid, Reason: "wipe"})
+		require.NoError(t, err)
+		absence, err := backend.CreateAbsence(ctx, entity.Absence{Player: &player, Raid: &raid})
+		require.NoError(t, err)
+
+		require.NoError(t, backend.DeleteRaid(ctx, raid.ID))
+		readFail, err := backend.ReadFail(ctx, fail.ID)
+		require.NoError(t, err)
+		assert.Zero(t, readFail.ID)
+		_, err = backend.ReadAbsence(ctx, absence.ID)
+		assert.Error(t, err)
+		strike, err := backend.ReadStrike(ctx, strikes[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, strikes[0].ID, strike.ID)
+
+		require.NoError(t, backend.RestoreDeleted(ctx, entity.Deleted{Kind: entity.DeletedRaid, ID: raid.ID}))
+		require.NoError(t, backend.DeletePlayer(ctx, entity.Player{ID: player.ID}))
+		strike, err = backend.ReadStrike(ctx, strikes[0].ID)
+		require.NoError(t, err)
+		assert.Zero(t, strike.ID)
+		readFail, err = backend.ReadFail(ctx, fail.ID)
+		require.NoError(t, err)
+		assert.Zero(t, readFail.ID)
+		_, err = backend.ReadAbsence(ctx, absence.ID)
+		assert.Error(t, err)
+
+		require.NoError(t, backend.RestoreDeleted(ctx, entity.Deleted{Kind: entity.DeletedPlayer, ID: player.ID}))
+		strike, err = backend.ReadStrike(ctx, strikes[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, strikes[0].ID, strike.ID)
+		readFail, err = backend.ReadFail(ctx, fail.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fail.ID, readFail.ID)
+		_, err = backend.ReadAbsence(ctx, absence.ID)
+		require.NoError(t, err)
+	})
+}
@@ -63,9 +63,14 @@ func (fuc FailUseCase) CreateFail(ctx context.Context, failReason string, date t
 	}
 }
 
-func (fuc FailUseCase) ListFailOnPLayer(ctx context.Context, playerName string) ([]entity.Fail, error) {
+// ListFailOnPLayer returns the fails of a player, only the ones of season when it is not empty.
+// At most limit fails after the first offset ones are returned, all of them when limit is 0.
+func (fuc FailUseCase) ListFailOnPLayer(
+	ctx context.Context, playerName, season string, limit, offset int,
+) ([]entity.Fail, error) {
 	ctx, span := otel.Tracer("Usecase").Start(ctx, "Fail/ListFailOnPLayer")
-	span.SetAttributes(attribute.String("playerName", playerName))
+	span.SetAttributes(attribute.String("playerName", playerName), attribute.String("season", season),
+		attribute.Int("limit", limit), attribute.Int("offset", offset))
 	defer span.End()
 	logger.FromContext(ctx).Debug("create fail use case")
 
@@ -81,19 +86,36 @@ func (fuc FailUseCase) ListFailOnPLayer(ctx context.Context, playerName string)
 			return nil, errors.New("player not found")
 		}
 
-		fails, err := fuc.backend.SearchFail(ctx, "", player[0].ID, -1, "")
+		// fails of a season are filtered on the date of their raid, so they are paged once filtered
+		var s entity.Season
+		searchLimit, searchOffset := limit, offset
+		if season != "" {
+			s, err = readSeason(ctx, fuc.backend, season)
+			if err != nil {
+				return nil, errors.Wrap(err, "list fail on player read season")
+			}
+			searchLimit, searchOffset = 0, 0
+		}
+
+		fails, err := fuc.backend.SearchFail(ctx, "", player[0].ID, -1, "", searchLimit, searchOffset)
 		if err != nil {
 			return nil, errors.Wrap(err, "list fail on player search fail")
 		}
-		for k, fail := range fails {
+		onSeason := make([]entity.Fail, 0, len(fails))
+		for _, fail := range fails {
 			r, err := fuc.backend.ReadRaid(ctx, fail.Raid.ID)
 			if err != nil {
 				return nil, errors.Wrap(err, "list fail on player read raid")
 			}
-			fails[k].Raid = &r
+			fail.Raid = &r
+			if season == "" || s.Contains(r.Date) {
+				onSeason = append(onSeason, fail)
+			}
 		}
-
-		return fails, nil
+		if season != "" {
+			return entity.Page(onSeason, limit, offset), nil
+		}
+		return onSeason, nil
 	}
 }
 
@@ -115,7 +137,7 @@ func (fuc FailUseCase) ListFailOnRaid(ctx context.Context, date time.Time) ([]en
 			return nil, errors.New("raid not found")
 		}
 
-		fails, err := fuc.backend.SearchFail(ctx, "", -1, raid[0].ID, "")
+		fails, err := fuc.backend.SearchFail(ctx, "", -1, raid[0].ID, "", 0, 0)
 		for k, fail := range fails {
 			p, err := fuc.backend.ReadPlayer(ctx, fail.Player.ID)
 			if err != nil {
@@ -159,7 +181,7 @@ func (fuc FailUseCase) ListFailOnRaidAndPlayer(
 			return nil, errors.New("raid not found")
 		}
 
-		fails, err := fuc.backend.SearchFail(ctx, "", player[0].ID, raid[0].ID, "")
+		fails, err := fuc.backend.SearchFail(ctx, "", player[0].ID, raid[0].ID, "", 0, 0)
 		if err != nil {
 			return nil, errors.Wrap(err, "list fail on raid search fail")
 		}
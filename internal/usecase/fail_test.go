@@ -188,7 +188,7 @@ func TestFailUseCase_ListFailOnPLayer(t *testing.T) {
 
 			ctx, cancel := context.WithCancel(context.Background())
 			cancel()
-			_, err := FailUseCase.ListFailOnPLayer(ctx, "playerone")
+			_, err := FailUseCase.ListFailOnPLayer(ctx, "playerone", "", 0, 0)
 			assert.Error(t, err)
 			mockBackend.AssertExpectations(t)
 		})
@@ -205,15 +205,73 @@ func TestFailUseCase_ListFailOnPLayer(t *testing.T) {
 			On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Player{{ID: 1}}, nil)
 		mockBackend.
-			On("SearchFail", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			On("SearchFail", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, 0, 0).
 			Return([]entity.Fail{{ID: 1, Player: &entity.Player{ID: 1}, Raid: &entity.Raid{ID: 1}}}, nil)
 		mockBackend.
 			On("ReadRaid", mock.Anything, mock.Anything).
 			Return(entity.Raid{ID: 1}, nil)
-		_, err := FailUseCase.ListFailOnPLayer(context.Background(), "playerone")
+		_, err := FailUseCase.ListFailOnPLayer(context.Background(), "playerone", "", 0, 0)
 		assert.NoError(t, err)
 		mockBackend.AssertExpectations(t)
 	})
+
+	t.Run("Page is searched by the backend", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		FailUseCase := usecase.NewFailUseCase(mockBackend)
+
+		mockBackend.
+			On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]entity.Player{{ID: 1}}, nil)
+		mockBackend.
+			On("SearchFail", mock.Anything, "", 1, -1, "", 10, 20).
+			Return([]entity.Fail{{ID: 21, Player: &entity.Player{ID: 1}, Raid: &entity.Raid{ID: 1}}}, nil)
+		mockBackend.
+			On("ReadRaid", mock.Anything, 1).
+			Return(entity.Raid{ID: 1}, nil)
+		fails, err := FailUseCase.ListFailOnPLayer(context.Background(), "playerone", "", 10, 20)
+		assert.NoError(t, err)
+		assert.Len(t, fails, 1)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Filter on season", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		FailUseCase := usecase.NewFailUseCase(mockBackend)
+
+		mockBackend.
+			On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]entity.Player{{ID: 1}}, nil)
+		mockBackend.
+			On("SearchSeason", mock.Anything, "DF/S2").
+			Return([]entity.Season{{
+				Name:  "DF/S2",
+				Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+			}}, nil)
+		mockBackend.
+			On("SearchFail", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, 0, 0).
+			Return([]entity.Fail{
+				{ID: 1, Player: &entity.Player{ID: 1}, Raid: &entity.Raid{ID: 1}},
+				{ID: 2, Player: &entity.Player{ID: 1}, Raid: &entity.Raid{ID: 2}},
+			}, nil)
+		mockBackend.
+			On("ReadRaid", mock.Anything, 1).
+			Return(entity.Raid{ID: 1, Date: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)}, nil)
+		mockBackend.
+			On("ReadRaid", mock.Anything, 2).
+			Return(entity.Raid{ID: 2, Date: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}, nil)
+		fails, err := FailUseCase.ListFailOnPLayer(context.Background(), "playerone", "DF/S2", 0, 0)
+		assert.NoError(t, err)
+		assert.Len(t, fails, 1)
+		assert.Equal(t, 1, fails[0].ID)
+		mockBackend.AssertExpectations(t)
+	})
 }
 
 func TestFailUseCase_ListFailOnRaid(t *testing.T) {
@@ -245,7 +303,7 @@ func TestFailUseCase_ListFailOnRaid(t *testing.T) {
 			On("SearchRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Raid{{ID: 1}}, nil)
 		mockBackend.
-			On("SearchFail", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			On("SearchFail", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, 0, 0).
 			Return([]entity.Fail{{ID: 1, Player: &entity.Player{ID: 1}}}, nil)
 		mockBackend.
 			On("ReadPlayer", mock.Anything, mock.Anything).
@@ -289,7 +347,7 @@ func TestFailUseCase_ListFailOnRaidAndPlayer(t *testing.T) {
 			On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Player{{ID: 1}}, nil)
 		mockBackend.
-			On("SearchFail", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			On("SearchFail", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, 0, 0).
 			Return([]entity.Fail{{ID: 1}}, nil)
 
 		_, err := FailUseCase.ListFailOnRaidAndPlayer(context.Background(), "raidone", "playerone")
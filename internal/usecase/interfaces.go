@@ -14,10 +14,19 @@ type Backend interface {
 	Loot
 	Absence
 	Fail
+	Participant
+	Signup
+	Season
+	Points
+	Wishlist
+	Item
+	RaidTemplate
+	Trash
 }
 
 type Player interface {
-	SearchPlayer(ctx context.Context, id int, name, discordName string) ([]entity.Player, error)
+	SearchPlayer(ctx context.Context, id int, name, discordID string) ([]entity.Player, error)
+	SearchPlayerOnPrefix(ctx context.Context, prefix string, limit int) ([]entity.Player, error)
 	CreatePlayer(ctx context.Context, player entity.Player) (entity.Player, error)
 	ReadPlayer(ctx context.Context, playerID int) (entity.Player, error)
 	UpdatePlayer(ctx context.Context, player entity.Player) error
@@ -34,6 +43,7 @@ type Strike interface {
 
 type Raid interface {
 	SearchRaid(ctx context.Context, raidName string, date time.Time, difficulty string) ([]entity.Raid, error)
+	SearchRaidOnRange(ctx context.Context, from, to time.Time, limit, offset int) ([]entity.Raid, error)
 	CreateRaid(ctx context.Context, raid entity.Raid) (entity.Raid, error)
 	ReadRaid(ctx context.Context, raidID int) (entity.Raid, error)
 	UpdateRaid(ctx context.Context, raid entity.Raid) error
@@ -41,7 +51,10 @@ type Raid interface {
 }
 
 type Loot interface {
-	SearchLoot(ctx context.Context, name string, date time.Time, difficulty, playerName string) ([]entity.Loot, error)
+	SearchLoot(
+		ctx context.Context, name string, date time.Time, difficulty, playerName string, limit, offset int,
+	) ([]entity.Loot, error)
+	SearchLootName(ctx context.Context, prefix string, limit int) ([]string, error)
 	CreateLoot(ctx context.Context, loot entity.Loot) (entity.Loot, error)
 	ReadLoot(ctx context.Context, lootID int) (entity.Loot, error)
 	UpdateLoot(ctx context.Context, loot entity.Loot) error
@@ -57,9 +70,94 @@ type Absence interface {
 }
 
 type Fail interface {
-	SearchFail(ctx context.Context, playerName string, playerID int, raidID int, reason string) ([]entity.Fail, error)
+	SearchFail(
+		ctx context.Context, playerName string, playerID int, raidID int, reason string, limit, offset int,
+	) ([]entity.Fail, error)
 	CreateFail(ctx context.Context, fail entity.Fail) (entity.Fail, error)
 	ReadFail(ctx context.Context, failID int) (entity.Fail, error)
 	UpdateFail(ctx context.Context, fail entity.Fail) error
 	DeleteFail(ctx context.Context, failID int) error
 }
+
+type Participant interface {
+	SearchParticipant(ctx context.Context, raidID, playerID int) ([]entity.Participant, error)
+	CreateParticipant(ctx context.Context, participant entity.Participant) error
+	UpdateParticipant(ctx context.Context, participant entity.Participant) error
+	DeleteParticipant(ctx context.Context, raidID, playerID int) error
+}
+
+// Signup is the answers of players to raids before they happen. A player signs up once to a raid.
+type Signup interface {
+	SearchSignup(ctx context.Context, raidID, playerID int) ([]entity.Signup, error)
+	CreateSignup(ctx context.Context, signup entity.Signup) error
+	UpdateSignup(ctx context.Context, signup entity.Signup) error
+	DeleteSignup(ctx context.Context, raidID, playerID int) error
+}
+
+type Season interface {
+	SearchSeason(ctx context.Context, name string) ([]entity.Season, error)
+	CreateSeason(ctx context.Context, season entity.Season) (entity.Season, error)
+	UpdateSeason(ctx context.Context, season entity.Season) error
+	DeleteSeason(ctx context.Context, seasonID int) error
+}
+
+// Points is the points ledger. Entries are never updated nor deleted.
+type Points interface {
+	SearchPointsEntry(ctx context.Context, playerID, raidID, lootID int) ([]entity.PointsEntry, error)
+	CreatePointsEntry(ctx context.Context, entry entity.PointsEntry) (entity.PointsEntry, error)
+	ReadPointsEntry(ctx context.Context, entryID int) (entity.PointsEntry, error)
+}
+
+// Wishlist is the items players want. A player wishes an item once.
+type Wishlist interface {
+	SearchWish(ctx context.Context, playerID int, item string) ([]entity.Wish, error)
+	CreateWish(ctx context.Context, wish entity.Wish) (entity.Wish, error)
+	DeleteWish(ctx context.Context, wishID int) error
+}
+
+// Item is the item catalogue. Items are identified by their game ID and never deleted.
+type Item interface {
+	SearchItem(ctx context.Context, name string) ([]entity.Item, error)
+	ReadItem(ctx context.Context, itemID int) (entity.Item, error)
+	SaveItem(ctx context.Context, item entity.Item) error
+}
+
+// RaidTemplate is a raid created every week. A template is unique by name and difficulty.
+type RaidTemplate interface {
+	SearchRaidTemplate(ctx context.Context, name string) ([]entity.RaidTemplate, error)
+	CreateRaidTemplate(ctx context.Context, template entity.RaidTemplate) (entity.RaidTemplate, error)
+	DeleteRaidTemplate(ctx context.Context, templateID int) error
+}
+
+// Trash is the players, raids and loots deleted. Deleting them only marks them deleted:
+// every other search and read hides them, with the loots, absences, rosters, signups and wishes
+// of deleted players and raids, until they are restored or purged for good with what belongs to them.
+// Creating a player, a raid or a loot which takes the place of a deleted one purges the deleted one.
+type Trash interface {
+	SearchDeleted(ctx context.Context, limit int) ([]entity.Deleted, error)
+	SearchDeletedRaid(ctx context.Context, date time.Time, difficulty string) ([]entity.Raid, error)
+	RestoreDeleted(ctx context.Context, deleted entity.Deleted) error
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+}
+
+// Notifier sends messages to officers, out of a command response.
+type Notifier interface {
+	NotifyOfficers(ctx context.Context, msg string) error
+}
+
+// DiscordLookup resolves the ID of a discord user from its username.
+// found is false when no user has this username.
+type DiscordLookup interface {
+	DiscordUserID(ctx context.Context, username string) (id string, found bool, err error)
+}
+
+// AbsenceRecorder creates and deletes the absences of a player on the raids of a date.
+type AbsenceRecorder interface {
+	CreateAbsence(ctx context.Context, playerName string, date time.Time) error
+	DeleteAbsence(ctx context.Context, playerName string, date time.Time) error
+}
+
+// Announcer posts messages to the guild members, out of a command response.
+type Announcer interface {
+	Announce(ctx context.Context, msg string) error
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// ItemUseCase is the use case for the item catalogue.
+type ItemUseCase struct {
+	backend Backend
+}
+
+func NewItemUseCase(bk Backend) *ItemUseCase {
+	return &ItemUseCase{backend: bk}
+}
+
+// SearchItems returns the items of the catalogue matching query, an item ID or a part of a name.
+func (i ItemUseCase) SearchItems(ctx context.Context, query string) ([]entity.Item, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Item/SearchItems")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("query", query),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("ItemUseCase - SearchItems - ctx.Done: request took too much time to be proceed")
+	default:
+		query = strings.ToLower(strings.TrimSpace(query))
+		if id, err := strconv.Atoi(query); err == nil {
+			item, err := i.backend.ReadItem(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("read item: %w", err)
+			}
+			return []entity.Item{item}, nil
+		}
+		items, err := i.backend.SearchItem(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("search items: %w", err)
+		}
+		return items, nil
+	}
+}
+
+// ImportItems adds items to the catalogue, updating the ones already there.
+func (i ItemUseCase) ImportItems(ctx context.Context, items []entity.Item) error {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Item/ImportItems")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("items", len(items)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("ItemUseCase - ImportItems - ctx.Done: request took too much time to be proceed")
+	default:
+		for _, item := range items {
+			err := i.backend.SaveItem(ctx, item)
+			if err != nil {
+				return fmt.Errorf("save item %d: %w", item.ID, err)
+			}
+		}
+		return nil
+	}
+}
+
+// catalogueItem is an item of a JSON catalogue file.
+type catalogueItem struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Slot      string `json:"slot"`
+	ItemLevel int    `json:"item_level"`
+	ArmorType string `json:"armor_type"`
+}
+
+// ReadItemCatalogue reads an item catalogue in format json or csv.
+// A JSON catalogue is an array of objects with id, name, slot, item_level and armor_type fields.
+// A CSV catalogue has a header line with the same columns, in any order; only id and name are required.
+func ReadItemCatalogue(r io.Reader, format string) ([]entity.Item, error) {
+	var records []catalogueItem
+	switch strings.ToLower(format) {
+	case "json":
+		err := json.NewDecoder(r).Decode(&records)
+		if err != nil {
+			return nil, fmt.Errorf("decode json catalogue: %w", err)
+		}
+	case "csv":
+		var err error
+		records, err = readCSVCatalogue(r)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("catalogue format %s not supported. Must be json or csv", format)
+	}
+
+	items := make([]entity.Item, 0, len(records))
+	for n, record := range records {
+		item, err := entity.NewItem(record.ID, record.Name, record.Slot, record.ItemLevel, record.ArmorType)
+		if err != nil {
+			return nil, fmt.Errorf("item %d of catalogue: %w", n+1, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func readCSVCatalogue(r io.Reader) ([]catalogueItem, error) {
+	lines, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read csv catalogue: %w", err)
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int)
+	for n, column := range lines[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = n
+	}
+	for _, column := range []string{"id", "name"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("csv catalogue has no %s column", column)
+		}
+	}
+	field := func(line []string, column string) string {
+		if n, ok := columns[column]; ok && n < len(line) {
+			return strings.TrimSpace(line[n])
+		}
+		return ""
+	}
+
+	records := make([]catalogueItem, 0, len(lines)-1)
+	for n, line := range lines[1:] {
+		record := catalogueItem{Name: field(line, "name"), Slot: field(line, "slot"), ArmorType: field(line, "armor_type")}
+		record.ID, err = strconv.Atoi(field(line, "id"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d of csv catalogue: id is not a number", n+2)
+		}
+		if itemLevel := field(line, "item_level"); itemLevel != "" {
+			record.ItemLevel, err = strconv.Atoi(itemLevel)
+			if err != nil {
+				return nil, fmt.Errorf("line %d of csv catalogue: item_level is not a number", n+2)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// resolveItem returns the item of the catalogue an item ID or name refers to.
+// A name matches an item with exactly this name, the only item containing it,
+// or else the item with the closest name.
+// It returns false when the name matches no item, so items missing from the catalogue keep their name.
+func resolveItem(ctx context.Context, backend Backend, query string) (entity.Item, bool, error) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if id, err := strconv.Atoi(query); err == nil {
+		item, err := backend.ReadItem(ctx, id)
+		if err != nil {
+			return entity.Item{}, false, fmt.Errorf("item %d not found", id)
+		}
+		return item, true, nil
+	}
+
+	items, err := backend.SearchItem(ctx, query)
+	if err != nil {
+		return entity.Item{}, false, fmt.Errorf("search item %s: %w", query, err)
+	}
+	for _, item := range items {
+		if item.Name == query {
+			return item, true, nil
+		}
+	}
+	switch {
+	case len(items) == 1:
+		return items[0], true, nil
+	case len(items) > 1:
+		names := make([]string, 0, 6)
+		for _, item := range items[:min(len(items), 5)] {
+			names = append(names, fmt.Sprintf("%s (#%d)", item.Name, item.ID))
+		}
+		if len(items) > 5 {
+			names = append(names, "...")
+		}
+		return entity.Item{}, false,
+			fmt.Errorf("several items match %s, use one of their IDs: %s", query, strings.Join(names, ", "))
+	}
+
+	catalogue, err := backend.SearchItem(ctx, "")
+	if err != nil {
+		return entity.Item{}, false, fmt.Errorf("search item catalogue: %w", err)
+	}
+	item, ok := entity.ClosestItem(catalogue, query)
+	return item, ok, nil
+}
+
+// itemName returns the name of the item of the catalogue query refers to, or query itself
+// when the item is not in the catalogue.
+func itemName(ctx context.Context, backend Backend, query string) (string, error) {
+	item, ok, err := resolveItem(ctx, backend, query)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return strings.ToLower(strings.TrimSpace(query)), nil
+	}
+	return item.Name, nil
+}
@@ -0,0 +1,138 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/mocks"
+)
+
+var frostmourne = entity.Item{ID: 19019, Name: "frostmourne", Slot: "two-hand", ItemLevel: 284}
+
+func TestItemUseCase_SearchItems(t *testing.T) {
+	t.Parallel()
+
+	t.Run("By ID", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		itemUseCase := usecase.NewItemUseCase(mockBackend)
+
+		mockBackend.On("ReadItem", mock.Anything, 19019).Return(frostmourne, nil)
+
+		items, err := itemUseCase.SearchItems(context.Background(), "19019")
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Item{frostmourne}, items)
+	})
+
+	t.Run("By name", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		itemUseCase := usecase.NewItemUseCase(mockBackend)
+
+		mockBackend.On("SearchItem", mock.Anything, "frost").Return([]entity.Item{frostmourne}, nil)
+
+		items, err := itemUseCase.SearchItems(context.Background(), " Frost ")
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Item{frostmourne}, items)
+	})
+}
+
+func TestItemUseCase_ImportItems(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		itemUseCase := usecase.NewItemUseCase(mockBackend)
+
+		mockBackend.On("SaveItem", mock.Anything, frostmourne).Return(nil)
+
+		err := itemUseCase.ImportItems(context.Background(), []entity.Item{frostmourne})
+		assert.NoError(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Backend Error", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		itemUseCase := usecase.NewItemUseCase(mockBackend)
+
+		mockBackend.On("SaveItem", mock.Anything, frostmourne).Return(errors.New("Backend Error"))
+
+		err := itemUseCase.ImportItems(context.Background(), []entity.Item{frostmourne})
+		assert.ErrorContains(t, err, "save item 19019")
+	})
+}
+
+func TestReadItemCatalogue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		format  string
+		want    []entity.Item
+		wantErr string
+	}{
+		{
+			name:    "JSON",
+			content: `[{"id": 19019, "name": "Frostmourne", "slot": "two-hand", "item_level": 284}]`,
+			format:  "json",
+			want:    []entity.Item{frostmourne},
+		},
+		{
+			name:    "CSV",
+			content: "name,id,slot,item_level,armor_type\nFrostmourne,19019,two-hand,284,\n",
+			format:  "csv",
+			want:    []entity.Item{frostmourne},
+		},
+		{
+			name:    "CSV without metadata",
+			content: "id,name\n19019,frostmourne\n",
+			format:  "CSV",
+			want:    []entity.Item{{ID: 19019, Name: "frostmourne"}},
+		},
+		{
+			name:    "CSV without id",
+			content: "name\nfrostmourne\n",
+			format:  "csv",
+			wantErr: "csv catalogue has no id column",
+		},
+		{
+			name:    "Invalid item",
+			content: `[{"id": 0, "name": "frostmourne"}]`,
+			format:  "json",
+			wantErr: "item 1 of catalogue: id must be a positive number",
+		},
+		{
+			name:    "Unknown format",
+			content: "",
+			format:  "xml",
+			wantErr: "catalogue format xml not supported",
+		},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			items, err := usecase.ReadItemCatalogue(strings.NewReader(test.content), test.format)
+			if test.wantErr != "" {
+				assert.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, items)
+		})
+	}
+}
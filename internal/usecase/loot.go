@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,11 +16,19 @@ import (
 )
 
 type LootUseCase struct {
-	backend Backend
+	backend  Backend
+	strategy entity.LootStrategy
+	points   entity.PointsPolicy
 }
 
-func NewLootUseCase(bk Backend) *LootUseCase {
-	return &LootUseCase{backend: bk}
+// NewLootUseCase returns a new LootUseCase giving loots with strategy by default,
+// and charging them with the points policy.
+// The lowest count strategy is used when strategy is empty.
+func NewLootUseCase(bk Backend, strategy entity.LootStrategy, points entity.PointsPolicy) *LootUseCase {
+	if strategy == "" {
+		strategy = entity.LootStrategyLowestCount
+	}
+	return &LootUseCase{backend: bk, strategy: strategy, points: points}
 }
 
 func (puc LootUseCase) CreateLoot(ctx context.Context, lootName string, raidDate time.Time, playerName string) error {
@@ -55,38 +64,107 @@ func (puc LootUseCase) CreateLoot(ctx context.Context, lootName string, raidDate
 			return fmt.Errorf("no player found")
 		}
 
+		item, inCatalogue, err := resolveItem(ctx, puc.backend, lootName)
+		if err != nil {
+			return fmt.Errorf("find item: %w", err)
+		}
+		if inCatalogue {
+			lootName = item.Name
+		}
+
 		loot, err := entity.NewLoot(-1, lootName, &player[0], &raid)
 		if err != nil {
 			return fmt.Errorf("create a loot object: %w", err)
 		}
+		if inCatalogue {
+			loot.Item = &item
+		}
 
-		_, err = puc.backend.CreateLoot(ctx, loot)
+		created, err := puc.backend.CreateLoot(ctx, loot)
 		if err != nil {
 			return fmt.Errorf("CreateLoot - backend.CreateLoot: %w", err)
 		}
 
+		err = spendOnLoot(ctx, puc.backend, puc.points, created)
 		if err != nil {
-			return fmt.Errorf("CreateLoot - backend.CreateLoot: %w", err)
+			return fmt.Errorf("CreateLoot - spend points: %w", err)
+		}
+
+		// The player got the item they wished, it leaves their wishlist
+		wishes, err := puc.backend.SearchWish(ctx, player[0].ID, loot.Name)
+		if err != nil {
+			return fmt.Errorf("CreateLoot - backend.SearchWish: %w", err)
+		}
+		for _, wish := range wishes {
+			err = puc.backend.DeleteWish(ctx, wish.ID)
+			if err != nil {
+				return fmt.Errorf("CreateLoot - backend.DeleteWish: %w", err)
+			}
 		}
 		return nil
 	}
 }
 
-func (puc LootUseCase) ListLootOnPLayer(ctx context.Context, playerName string) ([]entity.Loot, error) {
+// ListLootOnPLayer returns the loots of a player, only the ones of season when it is not empty.
+// At most limit loots after the first offset ones are returned, all of them when limit is 0.
+func (puc LootUseCase) ListLootOnPLayer(
+	ctx context.Context, playerName, season string, limit, offset int,
+) ([]entity.Loot, error) {
 	ctx, span := otel.Tracer("Usecase").Start(ctx, "Loot/ListLootOnPLayer")
 	defer span.End()
 	span.SetAttributes(
 		attribute.String("playerName", playerName),
+		attribute.String("season", season),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
 	)
 	select {
 	case <-ctx.Done():
 		return nil, fmt.Errorf("LootUseCase - ListLootOnPLayer - ctx.Done: request took too much time to be proceed")
 	default:
-		loots, err := puc.backend.SearchLoot(ctx, "", time.Time{}, "", playerName)
+		if season == "" {
+			loots, err := puc.backend.SearchLoot(ctx, "", time.Time{}, "", playerName, limit, offset)
+			if err != nil {
+				return nil, fmt.Errorf("list loots on player: %w", err)
+			}
+			return loots, nil
+		}
+		loots, err := puc.backend.SearchLoot(ctx, "", time.Time{}, "", playerName, 0, 0)
 		if err != nil {
 			return nil, fmt.Errorf("list loots on player: %w", err)
 		}
-		return loots, nil
+		s, err := readSeason(ctx, puc.backend, season)
+		if err != nil {
+			return nil, fmt.Errorf("read season: %w", err)
+		}
+		var onSeason []entity.Loot
+		for _, loot := range loots {
+			if s.Contains(loot.Raid.Date) {
+				onSeason = append(onSeason, loot)
+			}
+		}
+		return entity.Page(onSeason, limit, offset), nil
+	}
+}
+
+// SearchLootNames returns the names given to loots before starting with prefix, ordered.
+// At most limit names are returned, all of them when limit is 0.
+func (puc LootUseCase) SearchLootNames(ctx context.Context, prefix string, limit int) ([]string, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Loot/SearchLootNames")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("prefix", prefix),
+		attribute.Int("limit", limit),
+	)
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("LootUseCase - SearchLootNames - ctx.Done: request took too much time to be proceed")
+	default:
+		names, err := puc.backend.SearchLootName(ctx, strings.ToLower(strings.TrimSpace(prefix)), limit)
+		if err != nil {
+			return nil, fmt.Errorf("search loot names: %w", err)
+		}
+		return names, nil
 	}
 }
 
@@ -109,7 +187,7 @@ func (puc LootUseCase) ListLootOnRaid(ctx context.Context, date time.Time) ([]en
 			return nil, fmt.Errorf("raid not found")
 		}
 
-		loots, err := puc.backend.SearchLoot(ctx, "", raids[0].Date, raids[0].Difficulty, "")
+		loots, err := puc.backend.SearchLoot(ctx, "", raids[0].Date, raids[0].Difficulty, "", 0, 0)
 		if err != nil {
 			return nil, fmt.Errorf("ListLootOnPLayer - backend.SearchLoot: %w", err)
 		}
@@ -119,75 +197,193 @@ func (puc LootUseCase) ListLootOnRaid(ctx context.Context, date time.Time) ([]en
 	}
 }
 
+// SelectPlayerToAssign chooses which player among playerNames should receive a loot in a difficulty.
+// Strategy is the name of the loot strategy to use; the default strategy of the use case is used when empty.
+// When item, an item ID or name, is not empty, players who wished it come first, by priority of their wish.
+// Players not matching filter, or whose class doesn't wear the armor type of the item, are excluded.
+// The winner is drawn at random among the players sharing the best wish priority and score.
 func (puc LootUseCase) SelectPlayerToAssign(
-	ctx context.Context, playerNames []string, difficulty string,
-) (entity.Player, error) {
+	ctx context.Context, playerNames []string, difficulty, strategy, item string, filter entity.PlayerFilter,
+) (entity.LootSelection, error) {
 	ctx, span := otel.Tracer("Usecase").Start(ctx, "Loot/SelectPlayerToAssign")
 	defer span.End()
 	span.SetAttributes(
 		attribute.String("playerNames", fmt.Sprintf("%v", playerNames)),
 		attribute.String("difficulty", difficulty),
+		attribute.String("strategy", strategy),
+		attribute.String("item", item),
+		attribute.String("filter", filter.String()),
 	)
 
 	select {
 	case <-ctx.Done():
-		return entity.Player{}, fmt.Errorf("LootUseCase - SelectPlayerToAssign - " +
+		return entity.LootSelection{}, fmt.Errorf("LootUseCase - SelectPlayerToAssign - " +
 			"ctx.Done: request took too much time to be proceed")
 	default:
 		if len(playerNames) == 0 {
-			return entity.Player{}, fmt.Errorf("player list empty")
+			return entity.LootSelection{}, fmt.Errorf("player list empty")
 		}
 
 		difficulty = strings.ToLower(difficulty)
 		if difficulty != "normal" && difficulty != "heroic" && difficulty != "mythic" {
-			return entity.Player{}, fmt.Errorf("difficulty not valid. Must be normal, Heroic or mythic")
+			return entity.LootSelection{}, fmt.Errorf("difficulty not valid. Must be normal, Heroic or mythic")
+		}
+
+		selection := entity.LootSelection{Strategy: puc.strategy}
+		if strategy != "" {
+			var err error
+			selection.Strategy, err = entity.ParseLootStrategy(strategy)
+			if err != nil {
+				return entity.LootSelection{}, fmt.Errorf("parse strategy: %w", err)
+			}
+		}
+		scorer := lootStrategies[selection.Strategy]
+
+		var lootItem entity.Item
+		if item != "" {
+			resolved, inCatalogue, err := resolveItem(ctx, puc.backend, item)
+			if err != nil {
+				return entity.LootSelection{}, fmt.Errorf("find item: %w", err)
+			}
+			lootItem = entity.Item{Name: strings.ToLower(strings.TrimSpace(item))}
+			if inCatalogue {
+				lootItem = resolved
+			}
 		}
 
 		playerList := make([]entity.Player, 0)
 		for _, playerName := range playerNames {
 			player, err := puc.backend.SearchPlayer(ctx, -1, playerName, "")
 			if len(player) == 0 || err != nil {
-				return entity.Player{}, fmt.Errorf("player %s not found", playerName)
+				return entity.LootSelection{}, fmt.Errorf("player %s not found", playerName)
+			}
+			if reason := excludedFromLoot(player[0], filter, lootItem); reason != "" {
+				selection.Excluded = append(selection.Excluded, entity.LootCandidate{Player: player[0], Reason: reason})
+				continue
 			}
 
-			loots, err := puc.backend.SearchLoot(ctx, "", time.Time{}, difficulty, playerName)
+			loots, err := puc.backend.SearchLoot(ctx, "", time.Time{}, difficulty, playerName, 0, 0)
 			if err != nil {
-				return entity.Player{},
+				return entity.LootSelection{},
 					fmt.Errorf("in a loot to check if each players given by parameters exists in database: %w", err)
 			}
 			player[0].Loots = loots
 			playerList = append(playerList, player[0])
 		}
+		if len(playerList) == 0 {
+			return entity.LootSelection{}, fmt.Errorf("no player left once excluded players are removed")
+		}
 
-		counter := make(map[string]int)
-		for _, player := range playerList {
-			counter[player.Name] = 0
-			for _, loot := range player.Loots {
-				if loot.Raid.Difficulty == difficulty {
-					counter[player.Name]++
-				}
+		var points map[int]int
+		if scorer.needsPoints {
+			var err error
+			points, err = pointsByPlayer(ctx, puc.backend)
+			if err != nil {
+				return entity.LootSelection{}, fmt.Errorf("get points: %w", err)
 			}
 		}
 
-		minimum := 1000
-		for _, value := range counter {
-			if value < minimum {
-				minimum = value
+		var wishes map[int]entity.Wish
+		if item != "" {
+			var err error
+			wishes, err = wishesByPlayer(ctx, puc.backend, lootItem.Name)
+			if err != nil {
+				return entity.LootSelection{}, fmt.Errorf("get wishes: %w", err)
 			}
 		}
-		winningPlayers := make([]entity.Player, 0)
+
+		var raids []entity.Raid
+		var statuses map[int]map[int]entity.ParticipantStatus
+		var players []entity.Player
+		if scorer.needsAttendance {
+			// Attendance is the one of the person, loots the ones of the character
+			var err error
+			players, err = puc.backend.SearchPlayer(ctx, -1, "", "")
+			if err != nil {
+				return entity.LootSelection{}, fmt.Errorf("search characters of players: %w", err)
+			}
+			from, to, err := lootAttendanceRange(ctx, puc.backend, time.Now())
+			if err != nil {
+				return entity.LootSelection{}, fmt.Errorf("get attendance range: %w", err)
+			}
+			raids, statuses, err = AttendanceUseCase{backend: puc.backend}.raidsOnRange(ctx, from, to)
+			if err != nil {
+				return entity.LootSelection{}, fmt.Errorf("get attendance: %w", err)
+			}
+		}
+
 		for _, player := range playerList {
-			if counter[player.Name] == minimum {
-				winningPlayers = append(winningPlayers, player)
+			stats := lootStats{points: points[player.ID]}
+			if scorer.needsAttendance {
+				stats.attendance = attendance(person(players, player), raids, statuses)
+			}
+			for _, loot := range player.Loots {
+				if loot.Raid.Difficulty != difficulty {
+					continue
+				}
+				stats.loots++
+				if loot.Raid.Date.After(stats.lastLoot) {
+					stats.lastLoot = loot.Raid.Date
+				}
+			}
+			score, reason := scorer.score(stats, difficulty)
+			candidate := entity.LootCandidate{Player: player, Score: score, Reason: reason}
+			if wish, ok := wishes[player.ID]; ok {
+				candidate.Wish = &wish
+				candidate.Reason = fmt.Sprintf("wished with priority %d", wish.Priority)
+				if wish.Spec != "" {
+					candidate.Reason += " for " + wish.Spec
+				}
+				candidate.Reason += ", " + reason
 			}
+			selection.Candidates = append(selection.Candidates, candidate)
 		}
-		if len(winningPlayers) > 0 {
-			n, _ := rand.Int(rand.Reader, big.NewInt(int64(len(winningPlayers))))
-			winner := n.Int64()
-			return winningPlayers[winner], nil
+
+		sort.SliceStable(selection.Candidates, func(i, j int) bool {
+			return selection.Candidates[i].Before(selection.Candidates[j])
+		})
+		for _, candidate := range selection.Candidates {
+			if !selection.Candidates[0].Before(candidate) {
+				selection.Tied++
+			}
 		}
+		n, _ := rand.Int(rand.Reader, big.NewInt(int64(selection.Tied)))
+		winner := n.Int64()
+		selection.Candidates[0], selection.Candidates[winner] = selection.Candidates[winner], selection.Candidates[0]
+		selection.Winner = selection.Candidates[0].Player
 
-		return entity.Player{}, fmt.Errorf("no player found")
+		return selection, nil
+	}
+}
+
+// excludedFromLoot returns why a player can't receive the item, empty when they can.
+// Players not matching filter are excluded, and so are players whose class doesn't wear the armor type of the item.
+func excludedFromLoot(player entity.Player, filter entity.PlayerFilter, item entity.Item) string {
+	if !filter.Matches(player) {
+		return "not " + filter.String()
+	}
+	if item.ArmorType != "" && player.ArmorType() != "" && player.ArmorType() != item.ArmorType {
+		return "wears " + player.ArmorType() + ", not " + item.ArmorType
+	}
+	return ""
+}
+
+// ReadLoot returns the loot with this ID, with its player and its raid.
+func (puc LootUseCase) ReadLoot(ctx context.Context, lootID int) (entity.Loot, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Loot/ReadLoot")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("lootID", lootID),
+	)
+	select {
+	case <-ctx.Done():
+		return entity.Loot{}, fmt.Errorf("LootUseCase - ReadLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		loot, err := puc.backend.ReadLoot(ctx, lootID)
+		if err != nil {
+			return entity.Loot{}, fmt.Errorf("ReadLoot - backend.ReadLoot: %w", err)
+		}
+		return loot, nil
 	}
 }
 
@@ -201,7 +397,16 @@ func (puc LootUseCase) DeleteLoot(ctx context.Context, lootID int) error {
 	case <-ctx.Done():
 		return fmt.Errorf("LootUseCase - DeleteLoot - ctx.Done: request took too much time to be proceed")
 	default:
-		err := puc.backend.DeleteLoot(ctx, lootID)
+		entries, err := puc.backend.SearchPointsEntry(ctx, -1, -1, lootID)
+		if err != nil {
+			return fmt.Errorf("DeleteLoot - backend.SearchPointsEntry: %w", err)
+		}
+		err = revertAll(ctx, puc.backend, entries, lootDeletedReason)
+		if err != nil {
+			return fmt.Errorf("DeleteLoot - refund points: %w", err)
+		}
+
+		err = puc.backend.DeleteLoot(ctx, lootID)
 		if err != nil {
 			return fmt.Errorf("DeleteLoot - backend.DeleteLoot: %w", err)
 		}
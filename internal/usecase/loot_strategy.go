@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// lootAttendanceDays is how far back attendance is looked at when no season is running.
+const lootAttendanceDays = 90
+
+// lootStats is what is known about a player when a loot strategy scores them.
+type lootStats struct {
+	loots      int
+	lastLoot   time.Time
+	attendance entity.Attendance
+	points     int
+}
+
+// lootStrategy scores a player for a loot in a difficulty, higher is better, and tells why.
+type lootStrategy struct {
+	needsAttendance bool
+	needsPoints     bool
+	score           func(stats lootStats, difficulty string) (float64, string)
+}
+
+var lootStrategies = map[entity.LootStrategy]lootStrategy{
+	entity.LootStrategyLowestCount: {
+		score: func(stats lootStats, difficulty string) (float64, string) {
+			return -float64(stats.loots), fmt.Sprintf("%d loots in %s", stats.loots, difficulty)
+		},
+	},
+	entity.LootStrategyAttendance: {
+		needsAttendance: true,
+		score: func(stats lootStats, difficulty string) (float64, string) {
+			rate := stats.attendance.Rate()
+			return rate / float64(stats.loots+1),
+				fmt.Sprintf("%.0f%% attendance, %d loots in %s", rate, stats.loots, difficulty)
+		},
+	},
+	entity.LootStrategyLastLoot: {
+		score: func(stats lootStats, difficulty string) (float64, string) {
+			if stats.lastLoot.IsZero() {
+				return math.Inf(1), "never looted in " + difficulty
+			}
+			return -float64(stats.lastLoot.Unix()),
+				fmt.Sprintf("last loot in %s on %s", difficulty, stats.lastLoot.Format("02/01/06"))
+		},
+	},
+	entity.LootStrategyPoints: {
+		needsPoints: true,
+		score: func(stats lootStats, difficulty string) (float64, string) {
+			return float64(stats.points), fmt.Sprintf("%d points", stats.points)
+		},
+	},
+}
+
+// lootAttendanceRange returns the days attendance is looked at for a loot given on a date:
+// the running season up to the date, or the last lootAttendanceDays days out of season.
+func lootAttendanceRange(ctx context.Context, backend Backend, date time.Time) (time.Time, time.Time, error) {
+	to := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, 0, -lootAttendanceDays)
+
+	seasons, err := backend.SearchSeason(ctx, "")
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("search seasons: %w", err)
+	}
+	for _, season := range seasons {
+		if season.Contains(to) {
+			from = season.Start
+			break
+		}
+	}
+	if oldest := to.AddDate(0, 0, -maxAttendanceDays); from.Before(oldest) {
+		from = oldest
+	}
+	return from, to, nil
+}
@@ -21,11 +21,12 @@ func TestLootUseCase_SelectPlayerToAssign(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		LootUseCase := usecase.NewLootUseCase(mockBackend)
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
-		_, err := LootUseCase.SelectPlayerToAssign(ctx, []string{"playerone", "playertwo"}, "mythic")
+		_, err := LootUseCase.SelectPlayerToAssign(
+			ctx, []string{"playerone", "playertwo"}, "mythic", "", "", entity.PlayerFilter{})
 		assert.Error(t, err)
 		mockBackend.AssertExpectations(t)
 	})
@@ -35,7 +36,7 @@ func TestLootUseCase_SelectPlayerToAssign(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		LootUseCase := usecase.NewLootUseCase(mockBackend)
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
 
 		players := []entity.Player{
 			{
@@ -83,13 +84,14 @@ func TestLootUseCase_SelectPlayerToAssign(t *testing.T) {
 		for _, player := range players {
 			mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, player.Name, mock.Anything).
 				Return([]entity.Player{player}, nil)
-			mockBackend.On("SearchLoot", mock.Anything, mock.Anything, mock.Anything, mock.Anything, player.Name).
+			mockBackend.On("SearchLoot", mock.Anything, mock.Anything, mock.Anything, mock.Anything, player.Name, 0, 0).
 				Return(player.Loots, nil)
 		}
 
-		p, err := LootUseCase.SelectPlayerToAssign(context.Background(), playersNames, "mythic")
+		p, err := LootUseCase.SelectPlayerToAssign(
+			context.Background(), playersNames, "mythic", "", "", entity.PlayerFilter{})
 		assert.NoError(t, err)
-		assert.Equal(t, players[1], p)
+		assert.Equal(t, players[1], p.Winner)
 	})
 
 	t.Run("Player List empty", func(t *testing.T) {
@@ -97,11 +99,11 @@ func TestLootUseCase_SelectPlayerToAssign(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		LootUseCase := usecase.NewLootUseCase(mockBackend)
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
 
-		p, err := LootUseCase.SelectPlayerToAssign(context.Background(), []string{}, "mythic")
+		p, err := LootUseCase.SelectPlayerToAssign(context.Background(), []string{}, "mythic", "", "", entity.PlayerFilter{})
 		assert.Error(t, err)
-		assert.Equal(t, entity.Player{}, p)
+		assert.Equal(t, entity.LootSelection{}, p)
 	})
 
 	t.Run("Players doesnt exists", func(t *testing.T) {
@@ -109,16 +111,251 @@ func TestLootUseCase_SelectPlayerToAssign(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		LootUseCase := usecase.NewLootUseCase(mockBackend)
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
 
 		playersNames := []string{"playerone", "playertwo"}
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, playersNames[0], mock.Anything).
 			Return([]entity.Player{}, nil)
 
-		p, err := LootUseCase.SelectPlayerToAssign(context.Background(), playersNames, "mythic")
+		p, err := LootUseCase.SelectPlayerToAssign(
+			context.Background(), playersNames, "mythic", "", "", entity.PlayerFilter{})
 		assert.Error(t, err)
-		assert.Equal(t, entity.Player{}, p)
+		assert.Equal(t, entity.LootSelection{}, p)
+	})
+
+	t.Run("Unknown strategy", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		p, err := LootUseCase.SelectPlayerToAssign(
+			context.Background(), []string{"playerone"}, "mythic", "random", "", entity.PlayerFilter{})
+		assert.Error(t, err)
+		assert.Equal(t, entity.LootSelection{}, p)
+	})
+
+	t.Run("Last loot strategy", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		oldRaid := &entity.Raid{ID: 1, Name: "castle nathria", Difficulty: "mythic",
+			Date: time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)}
+		newRaid := &entity.Raid{ID: 2, Name: "castle nathria", Difficulty: "mythic",
+			Date: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)}
+		playerOne := entity.Player{ID: 1, Name: "playerone", Loots: []entity.Loot{
+			{ID: 1, Name: "lootone", Raid: oldRaid},
+			{ID: 2, Name: "loottwo", Raid: oldRaid},
+		}}
+		playerTwo := entity.Player{ID: 2, Name: "playertwo", Loots: []entity.Loot{
+			{ID: 3, Name: "lootthree", Raid: newRaid},
+		}}
+
+		for _, player := range []entity.Player{playerOne, playerTwo} {
+			mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, player.Name, mock.Anything).
+				Return([]entity.Player{player}, nil)
+			mockBackend.On("SearchLoot", mock.Anything, mock.Anything, mock.Anything, mock.Anything, player.Name, 0, 0).
+				Return(player.Loots, nil)
+		}
+
+		p, err := LootUseCase.SelectPlayerToAssign(
+			context.Background(), []string{"playerone", "playertwo"}, "mythic", "last-loot", "", entity.PlayerFilter{})
+		assert.NoError(t, err)
+		assert.Equal(t, entity.LootStrategyLastLoot, p.Strategy)
+		assert.Equal(t, playerOne, p.Winner)
+		assert.Equal(t, 1, p.Tied)
+		assert.Equal(t, "last loot in mythic on 01/09/23", p.Candidates[0].Reason)
+		assert.Equal(t, "last loot in mythic on 01/10/23", p.Candidates[1].Reason)
+	})
+
+	t.Run("Points strategy", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		playerOne := entity.Player{ID: 1, Name: "playerone"}
+		playerTwo := entity.Player{ID: 2, Name: "playertwo"}
+		for _, player := range []entity.Player{playerOne, playerTwo} {
+			mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, player.Name, mock.Anything).
+				Return([]entity.Player{player}, nil)
+			mockBackend.On("SearchLoot", mock.Anything, mock.Anything, mock.Anything, mock.Anything, player.Name, 0, 0).
+				Return(nil, nil)
+		}
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, -1, -1).Return([]entity.PointsEntry{
+			{ID: 1, Player: &playerOne, Amount: 30, Kind: entity.PointsAttendance},
+			{ID: 2, Player: &playerTwo, Amount: 50, Kind: entity.PointsAward},
+			{ID: 3, Player: &playerTwo, Amount: -40, Kind: entity.PointsLoot},
+		}, nil)
+
+		p, err := LootUseCase.SelectPlayerToAssign(
+			context.Background(), []string{"playerone", "playertwo"}, "mythic", "points", "", entity.PlayerFilter{})
+		assert.NoError(t, err)
+		assert.Equal(t, playerOne, p.Winner)
+		assert.Equal(t, "30 points", p.Candidates[0].Reason)
+		assert.Equal(t, "10 points", p.Candidates[1].Reason)
+	})
+
+	t.Run("Wishes come first", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		raid := &entity.Raid{ID: 1, Name: "castle nathria", Difficulty: "mythic"}
+		playerOne := entity.Player{ID: 1, Name: "playerone"}
+		playerTwo := entity.Player{ID: 2, Name: "playertwo", Loots: []entity.Loot{
+			{ID: 1, Name: "lootone", Raid: raid},
+		}}
+		playerThree := entity.Player{ID: 3, Name: "playerthree", Loots: []entity.Loot{
+			{ID: 2, Name: "loottwo", Raid: raid},
+			{ID: 3, Name: "lootthree", Raid: raid},
+		}}
+		for _, player := range []entity.Player{playerOne, playerTwo, playerThree} {
+			mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, player.Name, mock.Anything).
+				Return([]entity.Player{player}, nil)
+			mockBackend.On("SearchLoot", mock.Anything, mock.Anything, mock.Anything, mock.Anything, player.Name, 0, 0).
+				Return(player.Loots, nil)
+		}
+		mockBackend.On("SearchItem", mock.Anything, "frostmourne").
+			Return([]entity.Item{{ID: 1, Name: "frostmourne"}, {ID: 2, Name: "frostmourne hilt"}}, nil)
+		mockBackend.On("SearchWish", mock.Anything, -1, "frostmourne").Return([]entity.Wish{
+			{ID: 1, Player: &playerThree, Item: "frostmourne", Priority: 1, Spec: "frost"},
+			{ID: 2, Player: &playerTwo, Item: "frostmourne", Priority: 2},
+		}, nil)
+
+		p, err := LootUseCase.SelectPlayerToAssign(context.Background(),
+			[]string{"playerone", "playertwo", "playerthree"}, "mythic", "", " Frostmourne", entity.PlayerFilter{})
+		assert.NoError(t, err)
+		assert.Equal(t, playerThree, p.Winner)
+		assert.Equal(t, 1, p.Tied)
+		assert.Equal(t, "wished with priority 1 for frost, 2 loots in mythic", p.Candidates[0].Reason)
+		assert.Equal(t, "wished with priority 2, 1 loots in mythic", p.Candidates[1].Reason)
+		assert.Equal(t, playerOne, p.Candidates[2].Player)
+		assert.Nil(t, p.Candidates[2].Wish)
+	})
+
+	t.Run("Excluded players", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		uther := entity.Player{ID: 1, Name: "uther", Class: "paladin", MainSpec: "holy", Role: entity.RoleHealer}
+		anduin := entity.Player{ID: 2, Name: "anduin", Class: "priest", MainSpec: "holy", Role: entity.RoleHealer}
+		arthas := entity.Player{ID: 3, Name: "arthas", Class: "death knight", MainSpec: "blood", Role: entity.RoleTank}
+		for _, player := range []entity.Player{uther, anduin, arthas} {
+			mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, player.Name, mock.Anything).
+				Return([]entity.Player{player}, nil)
+		}
+		mockBackend.On("SearchLoot", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "uther", 0, 0).
+			Return(nil, nil)
+		mockBackend.On("SearchItem", mock.Anything, "lightbringer gauntlets").
+			Return([]entity.Item{{ID: 1, Name: "lightbringer gauntlets", ArmorType: "plate"}}, nil)
+		mockBackend.On("SearchWish", mock.Anything, -1, "lightbringer gauntlets").Return(nil, nil)
+
+		p, err := LootUseCase.SelectPlayerToAssign(context.Background(),
+			[]string{"uther", "anduin", "arthas"}, "mythic", "", "lightbringer gauntlets",
+			entity.PlayerFilter{Role: entity.RoleHealer})
+		assert.NoError(t, err)
+		assert.Equal(t, uther, p.Winner)
+		assert.Len(t, p.Candidates, 1)
+		assert.Len(t, p.Excluded, 2)
+		assert.Equal(t, "wears cloth, not plate", p.Excluded[0].Reason)
+		assert.Equal(t, "not healer", p.Excluded[1].Reason)
+
+		_, err = LootUseCase.SelectPlayerToAssign(context.Background(),
+			[]string{"anduin", "arthas"}, "mythic", "", "lightbringer gauntlets", entity.PlayerFilter{Role: entity.RoleHealer})
+		assert.ErrorContains(t, err, "no player left")
+	})
+
+	t.Run("Attendance strategy", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now().UTC()
+		raid := entity.Raid{ID: 1, Name: "castle nathria", Difficulty: "mythic",
+			Date: time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -3)}
+		// playerone missed the only raid, playertwo came and got a loot
+		playerOne := entity.Player{ID: 1, Name: "playerone"}
+		playerTwo := entity.Player{ID: 2, Name: "playertwo", Loots: []entity.Loot{
+			{ID: 1, Name: "lootone", Raid: &raid},
+		}}
+
+		tests := []struct {
+			strategy entity.LootStrategy
+			reasons  []string
+		}{
+			{
+				strategy: entity.LootStrategyAttendance,
+				reasons:  []string{"100% attendance, 1 loots in mythic", "0% attendance, 0 loots in mythic"},
+			},
+		}
+		for _, tt := range tests {
+			mockBackend := mocks.NewBackend(t)
+
+			LootUseCase := usecase.NewLootUseCase(mockBackend, tt.strategy, entity.PointsPolicy{})
+
+			for _, player := range []entity.Player{playerOne, playerTwo} {
+				mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, player.Name, mock.Anything).
+					Return([]entity.Player{player}, nil)
+				mockBackend.On("SearchLoot", mock.Anything, mock.Anything, mock.Anything, mock.Anything, player.Name, 0, 0).
+					Return(player.Loots, nil)
+			}
+			mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{playerOne, playerTwo}, nil)
+			mockBackend.On("SearchSeason", mock.Anything, "").Return(nil, nil)
+			mockBackend.On("SearchRaidOnRange", mock.Anything, mock.Anything, mock.Anything, 0, 0).
+				Return([]entity.Raid{raid}, nil)
+			mockBackend.On("SearchAbsence", mock.Anything, "", -1, raid.Date).
+				Return([]entity.Absence{{ID: 1, Player: &playerOne, Raid: &raid}}, nil)
+			mockBackend.On("SearchParticipant", mock.Anything, raid.ID, -1).Return(nil, nil)
+
+			p, err := LootUseCase.SelectPlayerToAssign(
+				context.Background(), []string{"playerone", "playertwo"}, "mythic", "", "", entity.PlayerFilter{})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.strategy, p.Strategy)
+			assert.Equal(t, playerTwo, p.Winner)
+			assert.Equal(t, tt.reasons[0], p.Candidates[0].Reason)
+			assert.Equal(t, tt.reasons[1], p.Candidates[1].Reason)
+		}
+	})
+}
+
+func TestLootUseCase_ReadLoot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		mockBackend.On("ReadLoot", mock.Anything, 1).Return(entity.Loot{ID: 1, Name: "sword"}, nil)
+
+		loot, err := LootUseCase.ReadLoot(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "sword", loot.Name)
+	})
+
+	t.Run("Backend Error", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		mockBackend.On("ReadLoot", mock.Anything, 1).Return(entity.Loot{}, errors.New("loot not found"))
+
+		_, err := LootUseCase.ReadLoot(context.Background(), 1)
+		assert.ErrorContains(t, err, "loot not found")
 	})
 }
 
@@ -130,7 +367,7 @@ func TestLootUseCase_DeleteLoot(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		LootUseCase := usecase.NewLootUseCase(mockBackend)
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -144,8 +381,9 @@ func TestLootUseCase_DeleteLoot(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		LootUseCase := usecase.NewLootUseCase(mockBackend)
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
 
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, -1, 1).Return(nil, nil)
 		mockBackend.On("DeleteLoot", mock.Anything, mock.Anything).Return(nil)
 
 		err := LootUseCase.DeleteLoot(context.Background(), 1)
@@ -153,13 +391,37 @@ func TestLootUseCase_DeleteLoot(t *testing.T) {
 		mockBackend.AssertExpectations(t)
 	})
 
+	t.Run("Points spent are refunded", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		player := &entity.Player{ID: 1, Name: "arthas"}
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, -1, 1).Return([]entity.PointsEntry{
+			{ID: 1, Player: player, Amount: -20, Kind: entity.PointsLoot, LootID: 1},
+			{ID: 2, Player: player, Amount: 20, Kind: entity.PointsReversal, LootID: 1, Reverts: 1},
+			{ID: 3, Player: player, Amount: -30, Kind: entity.PointsLoot, LootID: 1},
+		}, nil)
+		mockBackend.On("CreatePointsEntry", mock.Anything, mock.MatchedBy(func(entry entity.PointsEntry) bool {
+			return entry.Kind == entity.PointsReversal && entry.Reverts == 3 && entry.Amount == 30 && entry.LootID == 1
+		})).Return(entity.PointsEntry{ID: 4}, nil).Once()
+		mockBackend.On("DeleteLoot", mock.Anything, 1).Return(nil)
+
+		err := LootUseCase.DeleteLoot(context.Background(), 1)
+		assert.NoError(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+
 	t.Run("Backend Error", func(t *testing.T) {
 		t.Parallel()
 
 		mockBackend := mocks.NewBackend(t)
 
-		LootUseCase := usecase.NewLootUseCase(mockBackend)
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
 
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, -1, 1).Return(nil, nil)
 		mockBackend.On("DeleteLoot", mock.Anything, mock.Anything).Return(errors.New("Backend Error"))
 
 		err := LootUseCase.DeleteLoot(context.Background(), 1)
@@ -176,7 +438,7 @@ func TestLootUseCase_CreateLoot(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		LootUseCase := usecase.NewLootUseCase(mockBackend)
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -190,32 +452,59 @@ func TestLootUseCase_CreateLoot(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		LootUseCase := usecase.NewLootUseCase(mockBackend)
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Player{{ID: 1}}, nil)
 		mockBackend.On("SearchRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Raid{{ID: 1}}, nil)
 
+		mockBackend.On("SearchItem", mock.Anything, mock.Anything).Return(nil, nil)
 		mockBackend.On("CreateLoot", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(entity.Loot{}, nil)
+		mockBackend.On("SearchWish", mock.Anything, 1, "lootone").Return(nil, nil)
 
 		err := LootUseCase.CreateLoot(context.Background(), "lootone", time.Now(), "gilbert")
 		assert.NoError(t, err)
 		mockBackend.AssertExpectations(t)
 	})
 
+	t.Run("Wish is fulfilled", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]entity.Player{{ID: 1}}, nil)
+		mockBackend.On("SearchRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]entity.Raid{{ID: 1}}, nil)
+		mockBackend.On("ReadItem", mock.Anything, 1234).Return(entity.Item{ID: 1234, Name: "lootone"}, nil)
+		mockBackend.On("CreateLoot", mock.Anything, mock.MatchedBy(func(loot entity.Loot) bool {
+			return loot.Name == "lootone" && loot.Item != nil && loot.Item.ID == 1234
+		})).Return(entity.Loot{}, nil)
+		mockBackend.On("SearchWish", mock.Anything, 1, "lootone").
+			Return([]entity.Wish{{ID: 4, Player: &entity.Player{ID: 1}, Item: "lootone", Priority: 1}}, nil)
+		mockBackend.On("DeleteWish", mock.Anything, 4).Return(nil)
+
+		err := LootUseCase.CreateLoot(context.Background(), "1234", time.Now(), "gilbert")
+		assert.NoError(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+
 	t.Run("Backend Error", func(t *testing.T) {
 		t.Parallel()
 
 		mockBackend := mocks.NewBackend(t)
 
-		LootUseCase := usecase.NewLootUseCase(mockBackend)
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Player{{ID: 1}}, nil)
 		mockBackend.On("SearchRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Raid{{ID: 1}}, nil)
+		mockBackend.On("SearchItem", mock.Anything, mock.Anything).Return(nil, nil)
 		mockBackend.On("CreateLoot", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(entity.Loot{}, errors.New("Backend Error"))
 
@@ -224,3 +513,131 @@ func TestLootUseCase_CreateLoot(t *testing.T) {
 		mockBackend.AssertExpectations(t)
 	})
 }
+
+func TestLootUseCase_ListLootOnPLayer(t *testing.T) {
+	t.Parallel()
+
+	loots := []entity.Loot{
+		{ID: 1, Name: "sword", Raid: &entity.Raid{Date: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)}},
+		{ID: 2, Name: "shield", Raid: &entity.Raid{Date: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	t.Run("Every season", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		mockBackend.On("SearchLoot", mock.Anything, "", time.Time{}, "", "playerone", 0, 0).Return(loots, nil)
+
+		got, err := LootUseCase.ListLootOnPLayer(context.Background(), "playerone", "", 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, loots, got)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Filter on season", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		mockBackend.On("SearchLoot", mock.Anything, "", time.Time{}, "", "playerone", 0, 0).Return(loots, nil)
+		mockBackend.On("SearchSeason", mock.Anything, "DF/S2").Return([]entity.Season{{
+			Name:  "DF/S2",
+			Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+		}}, nil)
+
+		got, err := LootUseCase.ListLootOnPLayer(context.Background(), "playerone", "DF/S2", 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, loots[:1], got)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Page is searched by the backend", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		mockBackend.On("SearchLoot", mock.Anything, "", time.Time{}, "", "playerone", 10, 20).Return(loots, nil)
+
+		got, err := LootUseCase.ListLootOnPLayer(context.Background(), "playerone", "", 10, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, loots, got)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Page of a season", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		mockBackend.On("SearchLoot", mock.Anything, "", time.Time{}, "", "playerone", 0, 0).Return(loots, nil)
+		mockBackend.On("SearchSeason", mock.Anything, "DF/S2").Return([]entity.Season{{
+			Name:  "DF/S2",
+			Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		}}, nil)
+
+		got, err := LootUseCase.ListLootOnPLayer(context.Background(), "playerone", "DF/S2", 1, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, loots[1:], got)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Season not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		mockBackend.On("SearchLoot", mock.Anything, "", time.Time{}, "", "playerone", 0, 0).Return(loots, nil)
+		mockBackend.On("SearchSeason", mock.Anything, "DF/S9").Return(nil, nil)
+
+		_, err := LootUseCase.ListLootOnPLayer(context.Background(), "playerone", "DF/S9", 0, 0)
+		assert.ErrorContains(t, err, "season DF/S9 not found")
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestLootUseCase_SearchLootNames(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		names := []string{"frostbolt staff", "frostmourne"}
+		mockBackend.On("SearchLootName", mock.Anything, "frost", 25).Return(names, nil)
+
+		got, err := LootUseCase.SearchLootNames(context.Background(), " Frost", 25)
+		assert.NoError(t, err)
+		assert.Equal(t, names, got)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Backend Error", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		LootUseCase := usecase.NewLootUseCase(mockBackend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+
+		mockBackend.On("SearchLootName", mock.Anything, "frost", 25).Return(nil, errors.New("Backend Error"))
+
+		_, err := LootUseCase.SearchLootNames(context.Background(), "frost", 25)
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+}
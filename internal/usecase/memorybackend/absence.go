@@ -0,0 +1,198 @@
+package memorybackend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// absenceEntity returns an absence with its raid and player. Caller must hold the lock.
+func (m *Memory) absenceEntity(record absenceRecord) entity.Absence {
+	raid := m.raids[record.raidID]
+	player := m.players[record.playerID]
+	return entity.Absence{
+		ID:     record.id,
+		Raid:   &entity.Raid{ID: raid.ID, Name: raid.Name, Date: raid.Date, Difficulty: raid.Difficulty},
+		Player: &entity.Player{ID: player.ID, Name: player.Name},
+	}
+}
+
+// filterAbsences returns absences matching keep, except the ones of deleted players and raids.
+// Caller must hold the lock.
+func (m *Memory) filterAbsences(keep func(absence entity.Absence) bool) []entity.Absence {
+	var absences []entity.Absence
+	for _, id := range sortedIDs(m.absences) {
+		record := m.absences[id]
+		if m.isDeleted(entity.DeletedPlayer, record.playerID) || m.isDeleted(entity.DeletedRaid, record.raidID) {
+			continue
+		}
+		absence := m.absenceEntity(record)
+		if keep(absence) {
+			absences = append(absences, absence)
+		}
+	}
+	return absences
+}
+
+// SearchAbsence returns absences of a player on a raid date, of a player, or on a raid date.
+// playerID is ignored when -1. At least one parameter is required.
+func (m *Memory) SearchAbsence(
+	ctx context.Context, playerName string, playerID int, date time.Time,
+) ([]entity.Absence, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Absence/SearchAbsence")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+		attribute.Int("playerID", playerID),
+		attribute.String("date", date.Format("02/01/2006")),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchAbsence - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		switch {
+		case playerID != -1 && !date.IsZero():
+			return m.filterAbsences(func(absence entity.Absence) bool {
+				return absence.Player.ID == playerID && absence.Raid.Date.Equal(date)
+			}), nil
+		case playerID != -1 && playerName == "":
+			return m.filterAbsences(func(absence entity.Absence) bool {
+				return absence.Player.ID == playerID
+			}), nil
+		case playerID == -1 && playerName != "":
+			return m.filterAbsences(func(absence entity.Absence) bool {
+				return absence.Player.Name == playerName
+			}), nil
+		case playerID == -1 && playerName == "" && !date.IsZero():
+			return m.filterAbsences(func(absence entity.Absence) bool {
+				return absence.Raid.Date.Equal(date)
+			}), nil
+		}
+		return nil, errors.New("memory - SearchAbsence: no param given")
+	}
+}
+
+// CreateAbsence stores an absence of a player on a raid and returns it with its ID.
+func (m *Memory) CreateAbsence(ctx context.Context, absence entity.Absence) (entity.Absence, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Absence/CreateAbsence")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("absenceID", absence.ID),
+		attribute.Int("playerID", absence.Player.ID),
+		attribute.Int("raidID", absence.Raid.ID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Absence{}, fmt.Errorf("memory - CreateAbsence - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		err := m.checkAbsence(0, absence.Player.ID, absence.Raid.ID)
+		if err != nil {
+			return absence, fmt.Errorf("memory - CreateAbsence - %w", err)
+		}
+		absence.ID = m.nextID("absences")
+		m.absences[absence.ID] = absenceRecord{id: absence.ID, playerID: absence.Player.ID, raidID: absence.Raid.ID}
+		return absence, nil
+	}
+}
+
+// checkAbsence checks the raid and the player of an absence exist and that the player
+// has no other absence on this raid. Caller must hold the lock.
+func (m *Memory) checkAbsence(absenceID, playerID, raidID int) error {
+	if _, ok := m.players[playerID]; !ok {
+		return fmt.Errorf("player not found")
+	}
+	if _, ok := m.raids[raidID]; !ok {
+		return fmt.Errorf("raid not found")
+	}
+	for _, absence := range m.absences {
+		if absence.id != absenceID && absence.playerID == playerID && absence.raidID == raidID {
+			return fmt.Errorf("absence already exists")
+		}
+	}
+	return nil
+}
+
+// ReadAbsence returns the absence with the given ID with its raid and player.
+func (m *Memory) ReadAbsence(ctx context.Context, absenceID int) (entity.Absence, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Absence/ReadAbsence")
+	defer span.End()
+	span.SetAttributes(attribute.Int("absenceID", absenceID))
+
+	select {
+	case <-ctx.Done():
+		return entity.Absence{}, fmt.Errorf("memory - ReadAbsence - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		record, ok := m.absences[absenceID]
+		if !ok || m.isDeleted(entity.DeletedPlayer, record.playerID) || m.isDeleted(entity.DeletedRaid, record.raidID) {
+			return entity.Absence{}, fmt.Errorf("absence not found")
+		}
+		return m.absenceEntity(record), nil
+	}
+}
+
+// UpdateAbsence updates player and raid of an absence.
+func (m *Memory) UpdateAbsence(ctx context.Context, absence entity.Absence) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Absence/UpdateAbsence")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("absenceID", absence.ID),
+		attribute.Int("playerID", absence.Player.ID),
+		attribute.Int("raidID", absence.Raid.ID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - UpdateAbsence - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.absences[absence.ID]; !ok {
+			return fmt.Errorf("memory - UpdateAbsence - absence not found")
+		}
+		err := m.checkAbsence(absence.ID, absence.Player.ID, absence.Raid.ID)
+		if err != nil {
+			return fmt.Errorf("memory - UpdateAbsence - %w", err)
+		}
+		m.absences[absence.ID] = absenceRecord{id: absence.ID, playerID: absence.Player.ID, raidID: absence.Raid.ID}
+		return nil
+	}
+}
+
+// DeleteAbsence deletes the absence with the given ID.
+func (m *Memory) DeleteAbsence(ctx context.Context, absenceID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Absence/DeleteAbsence")
+	defer span.End()
+	span.SetAttributes(attribute.Int("absenceID", absenceID))
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - DeleteAbsence - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.absences[absenceID]; !ok {
+			return fmt.Errorf("memory - DeleteAbsence - absence not found")
+		}
+		delete(m.absences, absenceID)
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/pkg/errors"
+)
+
+// failEntity returns a fail with the IDs of its player and raid.
+func failEntity(record failRecord) entity.Fail {
+	return entity.Fail{
+		ID:     record.id,
+		Reason: record.reason,
+		Player: &entity.Player{ID: record.playerID},
+		Raid:   &entity.Raid{ID: record.raidID},
+	}
+}
+
+// filterFails returns fails matching keep, except the ones of deleted players and raids. Caller must hold the lock.
+func (m *Memory) filterFails(keep func(fail failRecord) bool) []entity.Fail {
+	var fails []entity.Fail
+	for _, id := range sortedIDs(m.fails) {
+		if keep(m.fails[id]) && !m.isDeleted(entity.DeletedPlayer, m.fails[id].playerID) &&
+			!m.isDeleted(entity.DeletedRaid, m.fails[id].raidID) {
+			fails = append(fails, failEntity(m.fails[id]))
+		}
+	}
+	return fails
+}
+
+// SearchFail returns fails matching each of playerID, raidID and reason, not combined,
+// like the postgres backend does. IDs are ignored when -1 and reason when empty.
+// At most limit fails after the first offset ones are returned, all of them when limit is 0.
+func (m *Memory) SearchFail(
+	ctx context.Context, playerName string, playerID int, raidID int, reason string, limit, offset int,
+) ([]entity.Fail, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Fail/SearchFail")
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+		attribute.Int("playerID", playerID),
+		attribute.Int("raidID", raidID),
+		attribute.String("reason", reason),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "search fail from memory")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var fails []entity.Fail
+		if playerID != -1 {
+			fails = append(fails, m.filterFails(func(fail failRecord) bool { return fail.playerID == playerID })...)
+		}
+		if raidID != -1 {
+			fails = append(fails, m.filterFails(func(fail failRecord) bool { return fail.raidID == raidID })...)
+		}
+		if len(reason) != 0 {
+			fails = append(fails, m.filterFails(func(fail failRecord) bool { return fail.reason == reason })...)
+		}
+		return entity.Page(fails, limit, offset), nil
+	}
+}
+
+// CreateFail stores a fail of a player on a raid and returns it with its ID.
+func (m *Memory) CreateFail(ctx context.Context, fail entity.Fail) (entity.Fail, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Fail/CreateFail")
+	span.SetAttributes(
+		attribute.String("failReason", fail.Reason),
+		attribute.String("playerName", fail.Player.Name),
+		attribute.String("date", fail.Raid.Date.String()))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Fail{}, errors.Wrap(ctx.Err(), "create fail from memory")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.players[fail.Player.ID]; !ok {
+			return entity.Fail{}, errors.New("create fail: player not found")
+		}
+		if _, ok := m.raids[fail.Raid.ID]; !ok {
+			return entity.Fail{}, errors.New("create fail: raid not found")
+		}
+		fail.ID = m.nextID("fails")
+		m.fails[fail.ID] = failRecord{id: fail.ID, playerID: fail.Player.ID, raidID: fail.Raid.ID, reason: fail.Reason}
+		return fail, nil
+	}
+}
+
+// ReadFail returns the fail with the given ID.
+// Like the postgres backend, an empty fail is returned if it doesn't exist.
+func (m *Memory) ReadFail(ctx context.Context, failID int) (entity.Fail, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Fail/ReadFail")
+	span.SetAttributes(attribute.Int("failID", failID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Fail{}, errors.Wrap(ctx.Err(), "read fail from memory")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		record, ok := m.fails[failID]
+		if !ok || m.isDeleted(entity.DeletedPlayer, record.playerID) || m.isDeleted(entity.DeletedRaid, record.raidID) {
+			return entity.Fail{}, nil
+		}
+		return failEntity(record), nil
+	}
+}
+
+// UpdateFail updates the reason of a fail.
+func (m *Memory) UpdateFail(ctx context.Context, fail entity.Fail) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Fail/UpdateFail")
+	span.SetAttributes(attribute.Int("failID", fail.ID), attribute.String("failReason", fail.Reason))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "update fail from memory")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		record, ok := m.fails[fail.ID]
+		if !ok {
+			return fmt.Errorf("fail not found from memory")
+		}
+		record.reason = fail.Reason
+		m.fails[fail.ID] = record
+		return nil
+	}
+}
+
+// DeleteFail deletes the fail with the given ID.
+func (m *Memory) DeleteFail(ctx context.Context, failID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Fail/DeleteFail")
+	span.SetAttributes(attribute.Int("failID", failID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "delete fail from memory")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.fails[failID]; !ok {
+			return fmt.Errorf("fail not found from memory")
+		}
+		delete(m.fails, failID)
+		return nil
+	}
+}
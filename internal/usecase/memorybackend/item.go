@@ -0,0 +1,87 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchItem returns the items of the catalogue whose name contains name, ordered by name.
+// Every item is returned when name is empty.
+func (m *Memory) SearchItem(ctx context.Context, name string) ([]entity.Item, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Item/SearchItem")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchItem - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		name = strings.ToLower(name)
+		var items []entity.Item
+		for _, id := range sortedIDs(m.items) {
+			if strings.Contains(m.items[id].Name, name) {
+				items = append(items, m.items[id])
+			}
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].Name < items[j].Name
+		})
+		return items, nil
+	}
+}
+
+// ReadItem returns the item of the catalogue with this game ID.
+func (m *Memory) ReadItem(ctx context.Context, itemID int) (entity.Item, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Item/ReadItem")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", itemID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Item{}, fmt.Errorf("memory - ReadItem - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		item, ok := m.items[itemID]
+		if !ok {
+			return entity.Item{}, fmt.Errorf("item %d not found", itemID)
+		}
+		return item, nil
+	}
+}
+
+// SaveItem adds an item to the catalogue, or updates it when its game ID is already there.
+func (m *Memory) SaveItem(ctx context.Context, item entity.Item) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Item/SaveItem")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", item.ID),
+		attribute.String("name", item.Name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - SaveItem - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.items[item.ID] = item
+		return nil
+	}
+}
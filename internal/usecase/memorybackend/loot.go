@@ -0,0 +1,235 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// lootEntity returns a loot with its raid and player. Caller must hold the lock.
+func (m *Memory) lootEntity(record lootRecord) entity.Loot {
+	raid := m.raids[record.raidID]
+	player := m.players[record.playerID]
+	loot := entity.Loot{
+		ID:     record.id,
+		Name:   record.name,
+		Raid:   &entity.Raid{ID: raid.ID, Name: raid.Name, Date: raid.Date, Difficulty: raid.Difficulty},
+		Player: &entity.Player{ID: player.ID, Name: player.Name},
+	}
+	if item, ok := m.items[record.itemID]; ok {
+		loot.Item = &item
+	}
+	return loot
+}
+
+// lootHidden tells if a loot, its raid or its player is deleted. Caller must hold the lock.
+func (m *Memory) lootHidden(record lootRecord) bool {
+	return m.isDeleted(entity.DeletedLoot, record.id) || m.isDeleted(entity.DeletedRaid, record.raidID) ||
+		m.isDeleted(entity.DeletedPlayer, record.playerID)
+}
+
+// SearchLoot returns loots matching every given criteria, ordered by ID. Empty criteria are ignored.
+// At most limit loots after the first offset ones are returned, all of them when limit is 0.
+func (m *Memory) SearchLoot(
+	ctx context.Context, name string, date time.Time, difficulty, playerName string, limit, offset int,
+) ([]entity.Loot, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Loot/SearchLoot")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("date", date.String()),
+		attribute.String("difficulty", difficulty),
+		attribute.String("playerName", playerName),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var loots []entity.Loot
+		for _, id := range sortedIDs(m.loots) {
+			if m.lootHidden(m.loots[id]) {
+				continue
+			}
+			loot := m.lootEntity(m.loots[id])
+			if name != "" && loot.Name != name {
+				continue
+			}
+			if !date.IsZero() && !loot.Raid.Date.Equal(date) {
+				continue
+			}
+			if difficulty != "" && loot.Raid.Difficulty != difficulty {
+				continue
+			}
+			if playerName != "" && loot.Player.Name != playerName {
+				continue
+			}
+			loots = append(loots, loot)
+		}
+		return entity.Page(loots, limit, offset), nil
+	}
+}
+
+// SearchLootName returns the names of the loots starting with prefix, once each and ordered.
+// At most limit names are returned, all of them when limit is 0.
+func (m *Memory) SearchLootName(ctx context.Context, prefix string, limit int) ([]string, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Loot/SearchLootName")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("prefix", prefix),
+		attribute.Int("limit", limit),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchLootName - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		seen := make(map[string]bool)
+		var names []string
+		for _, loot := range m.loots {
+			if strings.HasPrefix(loot.name, prefix) && !seen[loot.name] && !m.isDeleted(entity.DeletedLoot, loot.id) {
+				seen[loot.name] = true
+				names = append(names, loot.name)
+			}
+		}
+		sort.Strings(names)
+		return entity.Page(names, limit, 0), nil
+	}
+}
+
+// checkLoot checks the raid, the player and the item of a loot exist and that the player
+// didn't already get this item on this raid, deleted loots left out. Caller must hold the lock.
+func (m *Memory) checkLoot(lootID int, name string, raidID, playerID, itemID int) error {
+	if _, ok := m.raids[raidID]; !ok {
+		return fmt.Errorf("raid not found")
+	}
+	if _, ok := m.players[playerID]; !ok {
+		return fmt.Errorf("player not found")
+	}
+	if _, ok := m.items[itemID]; itemID != 0 && !ok {
+		return fmt.Errorf("item not found")
+	}
+	for _, loot := range m.loots {
+		if loot.id != lootID && loot.name == name && loot.raidID == raidID && loot.playerID == playerID &&
+			!m.isDeleted(entity.DeletedLoot, loot.id) {
+			return fmt.Errorf("loot already exists")
+		}
+	}
+	return nil
+}
+
+// CreateLoot stores a loot and returns it with its ID.
+func (m *Memory) CreateLoot(ctx context.Context, loot entity.Loot) (entity.Loot, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Loot/CreateLoot")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("lootName", loot.Name),
+		attribute.String("raidName", loot.Raid.Date.Format("02/01/2006")),
+		attribute.String("playerName", loot.Player.Name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Loot{}, fmt.Errorf("memory - CreateLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		record := newLootRecord(loot)
+		err := m.checkLoot(0, record.name, record.raidID, record.playerID, record.itemID)
+		if err != nil {
+			return entity.Loot{}, fmt.Errorf("memory - CreateLoot - %w", err)
+		}
+		loot.ID = m.nextID("loots")
+		record.id = loot.ID
+		m.loots[loot.ID] = record
+		return loot, nil
+	}
+}
+
+// ReadLoot returns the loot with the given ID with its raid and player.
+func (m *Memory) ReadLoot(ctx context.Context, lootID int) (entity.Loot, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Loot/ReadLoot")
+	defer span.End()
+	span.SetAttributes(attribute.Int("lootID", lootID))
+
+	select {
+	case <-ctx.Done():
+		return entity.Loot{}, fmt.Errorf("memory - ReadLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		record, ok := m.loots[lootID]
+		if !ok || m.lootHidden(record) {
+			return entity.Loot{}, fmt.Errorf("memory - ReadLoot - loot not found")
+		}
+		return m.lootEntity(record), nil
+	}
+}
+
+// UpdateLoot updates name, raid, player and item of a loot.
+func (m *Memory) UpdateLoot(ctx context.Context, loot entity.Loot) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Loot/UpdateLoot")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("lootID", loot.ID),
+		attribute.String("lootName", loot.Name),
+		attribute.Int("raidID", loot.Raid.ID),
+		attribute.Int("playerID", loot.Player.ID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - UpdateLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.loots[loot.ID]; !ok {
+			return fmt.Errorf("memory - UpdateLoot - loot not found")
+		}
+		record := newLootRecord(loot)
+		err := m.checkLoot(loot.ID, record.name, record.raidID, record.playerID, record.itemID)
+		if err != nil {
+			return fmt.Errorf("memory - UpdateLoot - %w", err)
+		}
+		m.loots[loot.ID] = record
+		return nil
+	}
+}
+
+// DeleteLoot marks the loot with the given ID deleted, until it is restored or purged.
+func (m *Memory) DeleteLoot(ctx context.Context, lootID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Loot/DeleteLoot")
+	defer span.End()
+	span.SetAttributes(attribute.Int("lootID", lootID))
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - DeleteLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.loots[lootID]; !ok || m.isDeleted(entity.DeletedLoot, lootID) {
+			return fmt.Errorf("memory - DeleteLoot - loot not found")
+		}
+		m.deleted[deletedKey{entity.DeletedLoot, lootID}] = m.now()
+		return nil
+	}
+}
@@ -0,0 +1,216 @@
+// Package memorybackend implements usecase.Backend in memory.
+// It follows the constraints of the SQL schema: unique raid date and difficulty,
+// unique player name and discord_id, unique season name, unique wish per player and item,
+// unique raid template name and difficulty,
+// unique absence, roster entry and signup per player and raid,
+// and purging a player or a raid deletes everything attached to it, except points ledger entries
+// which lose their raid or loot but are kept.
+// Deleted players, raids and loots are only marked deleted: they are hidden with what belongs to them
+// until they are restored or purged, and a replacement can be created meanwhile.
+// It is used by tests and by the demo mode, nothing is persisted.
+package memorybackend
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+type strikeRecord struct {
+	strike   entity.Strike
+	playerID int
+}
+
+type lootRecord struct {
+	id       int
+	name     string
+	raidID   int
+	playerID int
+	itemID   int
+}
+
+func newLootRecord(loot entity.Loot) lootRecord {
+	record := lootRecord{id: loot.ID, name: loot.Name, raidID: loot.Raid.ID, playerID: loot.Player.ID}
+	if loot.Item != nil {
+		record.itemID = loot.Item.ID
+	}
+	return record
+}
+
+type absenceRecord struct {
+	id       int
+	playerID int
+	raidID   int
+}
+
+type participantKey struct {
+	raidID   int
+	playerID int
+}
+
+type failRecord struct {
+	id       int
+	playerID int
+	raidID   int
+	reason   string
+}
+
+// Memory is a concurrency-safe in-memory backend.
+// Use New to create one.
+type Memory struct {
+	mu sync.RWMutex
+
+	sequences map[string]int
+	now       func() time.Time
+
+	players  map[int]entity.Player
+	raids    map[int]entity.Raid
+	strikes  map[int]strikeRecord
+	loots    map[int]lootRecord
+	absences map[int]absenceRecord
+	fails    map[int]failRecord
+
+	participants map[participantKey]entity.ParticipantStatus
+	signups      map[participantKey]entity.SignupStatus
+	seasons      map[int]entity.Season
+	points       map[int]entity.PointsEntry
+	wishes       map[int]entity.Wish
+	items        map[int]entity.Item
+	templates    map[int]entity.RaidTemplate
+	deleted      map[deletedKey]time.Time
+}
+
+// New returns an empty in-memory backend.
+func New() *Memory {
+	return &Memory{
+		sequences: make(map[string]int),
+		now:       func() time.Time { return time.Now().UTC() },
+		players:   make(map[int]entity.Player),
+		raids:     make(map[int]entity.Raid),
+		strikes:   make(map[int]strikeRecord),
+		loots:     make(map[int]lootRecord),
+		absences:  make(map[int]absenceRecord),
+		fails:     make(map[int]failRecord),
+
+		participants: make(map[participantKey]entity.ParticipantStatus),
+		signups:      make(map[participantKey]entity.SignupStatus),
+		seasons:      make(map[int]entity.Season),
+		points:       make(map[int]entity.PointsEntry),
+		wishes:       make(map[int]entity.Wish),
+		items:        make(map[int]entity.Item),
+		templates:    make(map[int]entity.RaidTemplate),
+		deleted:      make(map[deletedKey]time.Time),
+	}
+}
+
+// nextID returns a new identifier for a table, like a serial column.
+// IDs are never reused. Caller must hold the write lock.
+func (m *Memory) nextID(table string) int {
+	m.sequences[table]++
+	return m.sequences[table]
+}
+
+// sortedIDs returns the keys of records in insertion order.
+func sortedIDs[V any](records map[int]V) []int {
+	ids := make([]int, 0, len(records))
+	for id := range records {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// deletePlayerRecords deletes everything attached to a purged player. Caller must hold the write lock.
+func (m *Memory) deletePlayerRecords(playerID int) {
+	for id, strike := range m.strikes {
+		if strike.playerID == playerID {
+			delete(m.strikes, id)
+		}
+	}
+	for id, loot := range m.loots {
+		if loot.playerID == playerID {
+			delete(m.loots, id)
+			delete(m.deleted, deletedKey{entity.DeletedLoot, id})
+		}
+	}
+	for id, absence := range m.absences {
+		if absence.playerID == playerID {
+			delete(m.absences, id)
+		}
+	}
+	for id, fail := range m.fails {
+		if fail.playerID == playerID {
+			delete(m.fails, id)
+		}
+	}
+	for key := range m.participants {
+		if key.playerID == playerID {
+			delete(m.participants, key)
+		}
+	}
+	for key := range m.signups {
+		if key.playerID == playerID {
+			delete(m.signups, key)
+		}
+	}
+	for id, entry := range m.points {
+		if entry.Player.ID == playerID {
+			delete(m.points, id)
+		}
+	}
+	for id, wish := range m.wishes {
+		if wish.Player.ID == playerID {
+			delete(m.wishes, id)
+		}
+	}
+}
+
+// deleteRaidRecords deletes everything attached to a purged raid. Caller must hold the write lock.
+func (m *Memory) deleteRaidRecords(raidID int) {
+	for id, loot := range m.loots {
+		if loot.raidID == raidID {
+			m.unlinkLootPoints(id)
+			delete(m.loots, id)
+			delete(m.deleted, deletedKey{entity.DeletedLoot, id})
+		}
+	}
+	for id, entry := range m.points {
+		if entry.RaidID == raidID {
+			entry.RaidID = 0
+			m.points[id] = entry
+		}
+	}
+	for id, absence := range m.absences {
+		if absence.raidID == raidID {
+			delete(m.absences, id)
+		}
+	}
+	for id, fail := range m.fails {
+		if fail.raidID == raidID {
+			delete(m.fails, id)
+		}
+	}
+	for key := range m.participants {
+		if key.raidID == raidID {
+			delete(m.participants, key)
+		}
+	}
+	for key := range m.signups {
+		if key.raidID == raidID {
+			delete(m.signups, key)
+		}
+	}
+}
+
+// unlinkLootPoints keeps the ledger entries of a purged loot, without the loot.
+// Caller must hold the write lock.
+func (m *Memory) unlinkLootPoints(lootID int) {
+	for id, entry := range m.points {
+		if entry.LootID == lootID {
+			entry.LootID = 0
+			m.points[id] = entry
+		}
+	}
+}
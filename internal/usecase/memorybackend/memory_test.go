@@ -0,0 +1,65 @@
+package memorybackend_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/backendtest"
+	"github.com/antony-ramos/guildops/internal/usecase/memorybackend"
+)
+
+func TestMemory_Conformance(t *testing.T) {
+	t.Parallel()
+
+	backendtest.Run(t, func(t *testing.T) usecase.Backend {
+		t.Helper()
+		return memorybackend.New()
+	})
+}
+
+func TestMemory_Concurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	backend := memorybackend.New()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			player, err := backend.CreatePlayer(ctx, entity.Player{Name: "player" + strconv.Itoa(i)})
+			assert.NoError(t, err)
+			_, err = backend.SearchPlayer(ctx, player.ID, "", "")
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	players, err := backend.SearchPlayer(ctx, -1, "", "")
+	assert.NoError(t, err)
+	assert.Len(t, players, 50)
+	for i, player := range players {
+		assert.Equal(t, i+1, player.ID)
+	}
+}
+
+func TestMemory_Done(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	backend := memorybackend.New()
+	_, err := backend.CreatePlayer(ctx, entity.Player{Name: "arthas"})
+	assert.Error(t, err)
+	_, err = backend.SearchRaid(ctx, "raid", time.Time{}, "")
+	assert.Error(t, err)
+}
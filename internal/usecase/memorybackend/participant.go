@@ -0,0 +1,143 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchParticipant returns the roster entries of a raid, of a player, or of a player on a raid,
+// ordered by raid date and player name. raidID and playerID are ignored when -1.
+func (m *Memory) SearchParticipant(ctx context.Context, raidID, playerID int) ([]entity.Participant, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Participant/SearchParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var participants []entity.Participant
+		for key, status := range m.participants {
+			if raidID != -1 && key.raidID != raidID {
+				continue
+			}
+			if playerID != -1 && key.playerID != playerID {
+				continue
+			}
+			if m.isDeleted(entity.DeletedPlayer, key.playerID) || m.isDeleted(entity.DeletedRaid, key.raidID) {
+				continue
+			}
+			raid := m.raids[key.raidID]
+			record := m.players[key.playerID]
+			player := entity.Player{ID: record.ID, Name: record.Name,
+				Class: record.Class, MainSpec: record.MainSpec, OffSpec: record.OffSpec, Role: record.Role}
+			participants = append(participants, entity.Participant{
+				Raid:   &entity.Raid{ID: raid.ID, Name: raid.Name, Date: raid.Date, Difficulty: raid.Difficulty},
+				Player: &player,
+				Status: status,
+			})
+		}
+		sort.Slice(participants, func(i, j int) bool {
+			if !participants[i].Raid.Date.Equal(participants[j].Raid.Date) {
+				return participants[i].Raid.Date.Before(participants[j].Raid.Date)
+			}
+			return participants[i].Player.Name < participants[j].Player.Name
+		})
+		return participants, nil
+	}
+}
+
+// CreateParticipant adds a player to the roster of a raid.
+func (m *Memory) CreateParticipant(ctx context.Context, participant entity.Participant) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Participant/CreateParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", participant.Raid.ID),
+		attribute.Int("playerID", participant.Player.ID),
+		attribute.String("status", string(participant.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - CreateParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.players[participant.Player.ID]; !ok {
+			return fmt.Errorf("memory - CreateParticipant - player not found")
+		}
+		if _, ok := m.raids[participant.Raid.ID]; !ok {
+			return fmt.Errorf("memory - CreateParticipant - raid not found")
+		}
+		key := participantKey{raidID: participant.Raid.ID, playerID: participant.Player.ID}
+		if _, ok := m.participants[key]; ok {
+			return fmt.Errorf("participant already exists")
+		}
+		m.participants[key] = participant.Status
+		return nil
+	}
+}
+
+// UpdateParticipant updates the status of a player on the roster of a raid.
+func (m *Memory) UpdateParticipant(ctx context.Context, participant entity.Participant) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Participant/UpdateParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", participant.Raid.ID),
+		attribute.Int("playerID", participant.Player.ID),
+		attribute.String("status", string(participant.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - UpdateParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		key := participantKey{raidID: participant.Raid.ID, playerID: participant.Player.ID}
+		if _, ok := m.participants[key]; !ok {
+			return fmt.Errorf("memory - UpdateParticipant - participant not found")
+		}
+		m.participants[key] = participant.Status
+		return nil
+	}
+}
+
+// DeleteParticipant removes a player from the roster of a raid.
+func (m *Memory) DeleteParticipant(ctx context.Context, raidID, playerID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Participant/DeleteParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - DeleteParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		key := participantKey{raidID: raidID, playerID: playerID}
+		if _, ok := m.participants[key]; !ok {
+			return fmt.Errorf("memory - DeleteParticipant - participant not found")
+		}
+		delete(m.participants, key)
+		return nil
+	}
+}
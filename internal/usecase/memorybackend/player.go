@@ -0,0 +1,236 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchPlayer returns players matching every given criteria.
+// playerID is ignored when -1, name and discordID when empty.
+// players returned doesn't contain strikes, fails, missed raids and loots.
+func (m *Memory) SearchPlayer(ctx context.Context, playerID int, name, discordID string) ([]entity.Player, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Player/SearchPlayer")
+	span.SetAttributes(
+		attribute.String("playerName", name),
+		attribute.String("discordID", discordID),
+		attribute.Int("playerID", playerID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchPlayer - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var players []entity.Player
+		for _, id := range sortedIDs(m.players) {
+			player := m.playerEntity(m.players[id])
+			if m.isDeleted(entity.DeletedPlayer, id) {
+				continue
+			}
+			if playerID != -1 && player.ID != playerID {
+				continue
+			}
+			if name != "" && player.Name != name {
+				continue
+			}
+			if discordID != "" && player.DiscordID != discordID {
+				continue
+			}
+			players = append(players, player)
+		}
+		return players, nil
+	}
+}
+
+// SearchPlayerOnPrefix returns the players whose name starts with prefix, ordered by name.
+// At most limit players are returned, all of them when limit is 0.
+func (m *Memory) SearchPlayerOnPrefix(ctx context.Context, prefix string, limit int) ([]entity.Player, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Player/SearchPlayerOnPrefix")
+	span.SetAttributes(
+		attribute.String("prefix", prefix),
+		attribute.Int("limit", limit))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchPlayerOnPrefix - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var players []entity.Player
+		for _, id := range sortedIDs(m.players) {
+			if strings.HasPrefix(m.players[id].Name, prefix) && !m.isDeleted(entity.DeletedPlayer, id) {
+				players = append(players, m.playerEntity(m.players[id]))
+			}
+		}
+		sort.SliceStable(players, func(i, j int) bool {
+			return players[i].Name < players[j].Name
+		})
+		return entity.Page(players, limit, 0), nil
+	}
+}
+
+// playerExists checks name and discordID are not used by another player than playerID.
+// Deleted players are left out, like the partial unique indexes of the SQL schema.
+// Caller must hold the lock.
+func (m *Memory) playerExists(playerID int, name, discordID string) bool {
+	for _, player := range m.players {
+		if player.ID == playerID || m.isDeleted(entity.DeletedPlayer, player.ID) {
+			continue
+		}
+		if player.Name == name || (discordID != "" && player.DiscordID == discordID) {
+			return true
+		}
+	}
+	return false
+}
+
+// CreatePlayer stores a player and returns it with its ID.
+func (m *Memory) CreatePlayer(ctx context.Context, player entity.Player) (entity.Player, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Player/CreatePlayer")
+	span.SetAttributes(attribute.String("playerName", player.Name))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Player{}, fmt.Errorf("memory - CreatePlayer - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if m.playerExists(0, player.Name, player.DiscordID) {
+			return entity.Player{}, fmt.Errorf("player already exists")
+		}
+		player = entity.Player{
+			ID: m.nextID("players"), Name: player.Name, CreatedAt: m.now(),
+			DiscordID: player.DiscordID, DiscordName: player.DiscordName,
+			Class: player.Class, MainSpec: player.MainSpec, OffSpec: player.OffSpec, Role: player.Role,
+		}
+		m.players[player.ID] = player
+		return player, nil
+	}
+}
+
+// ReadPlayer returns the player with the given ID.
+func (m *Memory) ReadPlayer(ctx context.Context, playerID int) (entity.Player, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Player/ReadPlayer")
+	span.SetAttributes(attribute.Int("playerID", playerID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Player{}, fmt.Errorf("memory - ReadPlayer - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		player, ok := m.players[playerID]
+		if !ok || m.isDeleted(entity.DeletedPlayer, playerID) {
+			return entity.Player{}, fmt.Errorf("memory - ReadPlayer - player not found")
+		}
+		return m.playerEntity(player), nil
+	}
+}
+
+// UpdatePlayer updates name, discord account, class, specs, role and main of a player.
+func (m *Memory) UpdatePlayer(ctx context.Context, player entity.Player) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Player/UpdatePlayer")
+	span.SetAttributes(
+		attribute.String("playerName", player.Name),
+		attribute.String("discordID", player.DiscordID),
+		attribute.Int("playerID", player.ID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - UpdatePlayer - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.players[player.ID]; !ok {
+			return fmt.Errorf("memory - UpdatePlayer - player not found")
+		}
+		if m.playerExists(player.ID, player.Name, player.DiscordID) {
+			return fmt.Errorf("memory - UpdatePlayer - player already exists")
+		}
+		if _, ok := m.players[player.MainID]; player.IsAlt() && !ok {
+			return fmt.Errorf("memory - UpdatePlayer - main not found")
+		}
+		record := m.players[player.ID]
+		record.Name, record.DiscordID, record.DiscordName = player.Name, player.DiscordID, player.DiscordName
+		record.Class, record.MainSpec, record.OffSpec = player.Class, player.MainSpec, player.OffSpec
+		record.Role, record.MainID = player.Role, player.MainID
+		m.players[player.ID] = record
+		return nil
+	}
+}
+
+// DeletePlayer marks deleted the players matching every non-empty field of player,
+// with their strikes, loots, absences and fails hidden, until they are restored or purged.
+func (m *Memory) DeletePlayer(ctx context.Context, player entity.Player) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Player/DeletePlayer")
+	span.SetAttributes(
+		attribute.String("playerName", player.Name),
+		attribute.String("discordID", player.DiscordID),
+		attribute.Int("playerID", player.ID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - DeletePlayer - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		deleted := false
+		for id, p := range m.players {
+			if m.isDeleted(entity.DeletedPlayer, id) {
+				continue
+			}
+			if player.ID != 0 && p.ID != player.ID {
+				continue
+			}
+			if player.Name != "" && p.Name != player.Name {
+				continue
+			}
+			if player.DiscordID != "" && p.DiscordID != player.DiscordID {
+				continue
+			}
+			m.deleted[deletedKey{entity.DeletedPlayer, id}] = m.now()
+			deleted = true
+		}
+		if !deleted {
+			return fmt.Errorf("memory - DeletePlayer - player not found")
+		}
+		return nil
+	}
+}
+
+// unlinkAlts makes the alts of a purged player mains. Caller must hold the lock.
+func (m *Memory) unlinkAlts(mainID int) {
+	for id, player := range m.players {
+		if player.MainID == mainID {
+			player.MainID = 0
+			m.players[id] = player
+		}
+	}
+}
+
+// playerEntity returns a player, as a main while its main is deleted. Caller must hold the lock.
+func (m *Memory) playerEntity(player entity.Player) entity.Player {
+	if player.IsAlt() && m.isDeleted(entity.DeletedPlayer, player.MainID) {
+		player.MainID = 0
+	}
+	return player
+}
@@ -0,0 +1,131 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchPointsEntry returns the ledger entries of a player, of a raid or of a loot.
+// playerID, raidID and lootID are ignored when -1. Entries are ordered by date.
+func (m *Memory) SearchPointsEntry(ctx context.Context, playerID, raidID, lootID int) ([]entity.PointsEntry, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Points/SearchPointsEntry")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", playerID),
+		attribute.Int("raidID", raidID),
+		attribute.Int("lootID", lootID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchPointsEntry - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var entries []entity.PointsEntry
+		for _, id := range sortedIDs(m.points) {
+			entry := m.points[id]
+			if playerID != -1 && entry.Player.ID != playerID {
+				continue
+			}
+			if raidID != -1 && entry.RaidID != raidID {
+				continue
+			}
+			if lootID != -1 && entry.LootID != lootID {
+				continue
+			}
+			// entries of deleted players are hidden with them, entries of deleted raids and loots are kept
+			if m.isDeleted(entity.DeletedPlayer, entry.Player.ID) {
+				continue
+			}
+			entries = append(entries, m.pointsEntry(entry))
+		}
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].Date.Before(entries[j].Date)
+		})
+		return entries, nil
+	}
+}
+
+// CreatePointsEntry adds an entry to the ledger.
+func (m *Memory) CreatePointsEntry(ctx context.Context, entry entity.PointsEntry) (entity.PointsEntry, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Points/CreatePointsEntry")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", entry.Player.ID),
+		attribute.Int("amount", entry.Amount),
+		attribute.String("kind", string(entry.Kind)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.PointsEntry{}, fmt.Errorf(
+			"memory - CreatePointsEntry - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.players[entry.Player.ID]; !ok {
+			return entity.PointsEntry{}, fmt.Errorf("memory - CreatePointsEntry - player not found")
+		}
+		if _, ok := m.raids[entry.RaidID]; entry.RaidID != 0 && !ok {
+			return entity.PointsEntry{}, fmt.Errorf("memory - CreatePointsEntry - raid not found")
+		}
+		if _, ok := m.loots[entry.LootID]; entry.LootID != 0 && !ok {
+			return entity.PointsEntry{}, fmt.Errorf("memory - CreatePointsEntry - loot not found")
+		}
+		if entry.Reverts != 0 {
+			if _, ok := m.points[entry.Reverts]; !ok {
+				return entity.PointsEntry{}, fmt.Errorf("memory - CreatePointsEntry - points entry not found")
+			}
+			for _, other := range m.points {
+				if other.Reverts == entry.Reverts {
+					return entity.PointsEntry{}, fmt.Errorf("points entry already reverted")
+				}
+			}
+		}
+		entry.ID = m.nextID("points")
+		entry.Player = &entity.Player{ID: entry.Player.ID}
+		m.points[entry.ID] = entry
+		return m.pointsEntry(entry), nil
+	}
+}
+
+// ReadPointsEntry returns an entry of the ledger.
+func (m *Memory) ReadPointsEntry(ctx context.Context, entryID int) (entity.PointsEntry, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Points/ReadPointsEntry")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", entryID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.PointsEntry{}, fmt.Errorf(
+			"memory - ReadPointsEntry - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		entry, ok := m.points[entryID]
+		if !ok || m.isDeleted(entity.DeletedPlayer, entry.Player.ID) {
+			return entity.PointsEntry{}, fmt.Errorf("points entry not found")
+		}
+		return m.pointsEntry(entry), nil
+	}
+}
+
+// pointsEntry returns a copy of a stored entry with the name of its player, like the SQL join does.
+// Caller must hold the lock.
+func (m *Memory) pointsEntry(entry entity.PointsEntry) entity.PointsEntry {
+	player := m.players[entry.Player.ID]
+	entry.Player = &entity.Player{ID: player.ID, Name: player.Name}
+	return entry
+}
@@ -0,0 +1,231 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchRaid searches raids like the postgres backend does: each criteria is looked up
+// on its own (name, date and difficulty, difficulty, date) and results are appended.
+func (m *Memory) SearchRaid(
+	ctx context.Context, raidName string, date time.Time, difficulty string,
+) ([]entity.Raid, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Raid/SearchRaid")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("raidName", raidName),
+		attribute.String("date", date.String()),
+		attribute.String("difficulty", difficulty),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var raids []entity.Raid
+		if raidName != "" {
+			raids = append(raids, m.filterRaids(func(raid entity.Raid) bool {
+				return raid.Name == raidName
+			})...)
+		}
+		if difficulty != "" && !date.IsZero() {
+			raids = append(raids, m.filterRaids(func(raid entity.Raid) bool {
+				return raid.Difficulty == difficulty && raid.Date.Equal(date)
+			})...)
+		}
+		if difficulty != "" {
+			raids = append(raids, m.filterRaids(func(raid entity.Raid) bool {
+				return raid.Difficulty == difficulty
+			})...)
+		}
+		if !date.IsZero() {
+			raids = append(raids, m.filterRaids(func(raid entity.Raid) bool {
+				return raid.Date.Equal(date)
+			})...)
+		}
+		return raids, nil
+	}
+}
+
+// SearchRaidOnRange returns the raids from one date to another, both included, ordered by date.
+// At most limit raids after the first offset ones are returned, all of them when limit is 0.
+func (m *Memory) SearchRaidOnRange(
+	ctx context.Context, from, to time.Time, limit, offset int,
+) ([]entity.Raid, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Raid/SearchRaidOnRange")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("from", from.String()),
+		attribute.String("to", to.String()),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchRaidOnRange - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		raids := m.filterRaids(func(raid entity.Raid) bool {
+			return !raid.Date.Before(from) && !raid.Date.After(to)
+		})
+		sort.SliceStable(raids, func(i, j int) bool {
+			return raids[i].Date.Before(raids[j].Date)
+		})
+		return entity.Page(raids, limit, offset), nil
+	}
+}
+
+// filterRaids returns raids matching keep, except deleted ones. Caller must hold the lock.
+func (m *Memory) filterRaids(keep func(raid entity.Raid) bool) []entity.Raid {
+	var raids []entity.Raid
+	for _, id := range sortedIDs(m.raids) {
+		if keep(m.raids[id]) && !m.isDeleted(entity.DeletedRaid, id) {
+			raids = append(raids, m.raids[id])
+		}
+	}
+	return raids
+}
+
+// raidExists checks no other raid than raidID is on the same date and difficulty.
+// Deleted raids are left out, like the partial unique indexes of the SQL schema.
+// Caller must hold the lock.
+func (m *Memory) raidExists(raidID int, date time.Time, difficulty string) bool {
+	for _, raid := range m.raids {
+		if raid.ID != raidID && raid.Date.Equal(date) && raid.Difficulty == difficulty &&
+			!m.isDeleted(entity.DeletedRaid, raid.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateRaid stores a raid and returns it with its ID.
+func (m *Memory) CreateRaid(ctx context.Context, raid entity.Raid) (entity.Raid, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Raid/CreateRaid")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("raidName", raid.Name),
+		attribute.String("difficulty", raid.Difficulty),
+		attribute.String("date", raid.Date.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Raid{}, fmt.Errorf("memory - CreateRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if m.raidExists(0, raid.Date, raid.Difficulty) {
+			return entity.Raid{}, fmt.Errorf("raid already exists")
+		}
+		raid = entity.Raid{
+			ID:         m.nextID("raids"),
+			Name:       raid.Name,
+			Date:       raid.Date,
+			Difficulty: raid.Difficulty,
+			StartTime:  raid.StartTime,
+			// Every stored raid has a start time, like the start_time column of databases
+			StartTimeSet: true,
+		}
+		m.raids[raid.ID] = raid
+		return raid, nil
+	}
+}
+
+// ReadRaid returns the raid with the given ID.
+// Like the postgres backend, an empty raid is returned if it doesn't exist.
+func (m *Memory) ReadRaid(ctx context.Context, raidID int) (entity.Raid, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Raid/ReadRaid")
+	defer span.End()
+	span.SetAttributes(attribute.Int("raidID", raidID))
+
+	select {
+	case <-ctx.Done():
+		return entity.Raid{}, fmt.Errorf("memory - ReadRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		if m.isDeleted(entity.DeletedRaid, raidID) {
+			return entity.Raid{}, nil
+		}
+		return m.raids[raidID], nil
+	}
+}
+
+// UpdateRaid updates non-empty fields of a raid, and its start time when set.
+func (m *Memory) UpdateRaid(ctx context.Context, raid entity.Raid) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Raid/UpdateRaid")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raid.ID),
+		attribute.String("raidName", raid.Name),
+		attribute.String("difficulty", raid.Difficulty),
+		attribute.String("date", raid.Date.Format("02/01/2006")),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - UpdateRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		oldRaid, ok := m.raids[raid.ID]
+		if !ok {
+			return fmt.Errorf("memory - UpdateRaid - raid not found")
+		}
+		if raid.Name != "" {
+			oldRaid.Name = raid.Name
+		}
+		if !raid.Date.IsZero() {
+			oldRaid.Date = raid.Date
+		}
+		if raid.Difficulty != "" {
+			oldRaid.Difficulty = raid.Difficulty
+		}
+		if raid.StartTimeSet {
+			oldRaid.StartTime = raid.StartTime
+		}
+		if m.raidExists(oldRaid.ID, oldRaid.Date, oldRaid.Difficulty) {
+			return fmt.Errorf("memory - UpdateRaid - raid already exists")
+		}
+		m.raids[oldRaid.ID] = oldRaid
+		return nil
+	}
+}
+
+// DeleteRaid marks a raid deleted, with its loots, absences and fails hidden, until it is restored or purged.
+func (m *Memory) DeleteRaid(ctx context.Context, raidID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Raid/DeleteRaid")
+	defer span.End()
+	span.SetAttributes(attribute.Int("raidID", raidID))
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - DeleteRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.raids[raidID]; !ok || m.isDeleted(entity.DeletedRaid, raidID) {
+			return fmt.Errorf("memory - DeleteRaid - raid not found")
+		}
+		m.deleted[deletedKey{entity.DeletedRaid, raidID}] = m.now()
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchSeason returns the season with the given name, or every season when name is empty.
+// Seasons are ordered by start date.
+func (m *Memory) SearchSeason(ctx context.Context, name string) ([]entity.Season, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Season/SearchSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var seasons []entity.Season
+		for _, id := range sortedIDs(m.seasons) {
+			if name == "" || m.seasons[id].Name == name {
+				seasons = append(seasons, m.seasons[id])
+			}
+		}
+		sort.SliceStable(seasons, func(i, j int) bool {
+			return seasons[i].Start.Before(seasons[j].Start)
+		})
+		return seasons, nil
+	}
+}
+
+// CreateSeason adds a season to the calendar.
+func (m *Memory) CreateSeason(ctx context.Context, season entity.Season) (entity.Season, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Season/CreateSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", season.Name),
+		attribute.String("start", season.Start.String()),
+		attribute.String("end", season.End.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Season{}, fmt.Errorf("memory - CreateSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if m.seasonExists(0, season.Name) {
+			return entity.Season{}, fmt.Errorf("season already exists")
+		}
+		season.ID = m.nextID("seasons")
+		m.seasons[season.ID] = season
+		return season, nil
+	}
+}
+
+// UpdateSeason updates name and dates of a season.
+func (m *Memory) UpdateSeason(ctx context.Context, season entity.Season) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Season/UpdateSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", season.ID),
+		attribute.String("name", season.Name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - UpdateSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.seasons[season.ID]; !ok {
+			return fmt.Errorf("memory - UpdateSeason - season not found")
+		}
+		if m.seasonExists(season.ID, season.Name) {
+			return fmt.Errorf("season already exists")
+		}
+		m.seasons[season.ID] = season
+		return nil
+	}
+}
+
+// DeleteSeason removes a season from the calendar.
+func (m *Memory) DeleteSeason(ctx context.Context, seasonID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Season/DeleteSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", seasonID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - DeleteSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.seasons[seasonID]; !ok {
+			return fmt.Errorf("memory - DeleteSeason - season not found")
+		}
+		delete(m.seasons, seasonID)
+		return nil
+	}
+}
+
+// seasonExists checks no other season than seasonID has the same name.
+// Caller must hold the lock.
+func (m *Memory) seasonExists(seasonID int, name string) bool {
+	for id, season := range m.seasons {
+		if id != seasonID && season.Name == name {
+			return true
+		}
+	}
+	return false
+}
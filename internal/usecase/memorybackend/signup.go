@@ -0,0 +1,143 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchSignup returns the signups to a raid, of a player, or of a player on a raid,
+// ordered by raid date and player name. raidID and playerID are ignored when -1.
+func (m *Memory) SearchSignup(ctx context.Context, raidID, playerID int) ([]entity.Signup, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Signup/SearchSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var signups []entity.Signup
+		for key, status := range m.signups {
+			if raidID != -1 && key.raidID != raidID {
+				continue
+			}
+			if playerID != -1 && key.playerID != playerID {
+				continue
+			}
+			if m.isDeleted(entity.DeletedPlayer, key.playerID) || m.isDeleted(entity.DeletedRaid, key.raidID) {
+				continue
+			}
+			raid := m.raids[key.raidID]
+			record := m.players[key.playerID]
+			player := entity.Player{ID: record.ID, Name: record.Name,
+				Class: record.Class, MainSpec: record.MainSpec, OffSpec: record.OffSpec, Role: record.Role}
+			signups = append(signups, entity.Signup{
+				Raid:   &entity.Raid{ID: raid.ID, Name: raid.Name, Date: raid.Date, Difficulty: raid.Difficulty},
+				Player: &player,
+				Status: status,
+			})
+		}
+		sort.Slice(signups, func(i, j int) bool {
+			if !signups[i].Raid.Date.Equal(signups[j].Raid.Date) {
+				return signups[i].Raid.Date.Before(signups[j].Raid.Date)
+			}
+			return signups[i].Player.Name < signups[j].Player.Name
+		})
+		return signups, nil
+	}
+}
+
+// CreateSignup signs a player up to a raid.
+func (m *Memory) CreateSignup(ctx context.Context, signup entity.Signup) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Signup/CreateSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", signup.Raid.ID),
+		attribute.Int("playerID", signup.Player.ID),
+		attribute.String("status", string(signup.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - CreateSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.players[signup.Player.ID]; !ok {
+			return fmt.Errorf("memory - CreateSignup - player not found")
+		}
+		if _, ok := m.raids[signup.Raid.ID]; !ok {
+			return fmt.Errorf("memory - CreateSignup - raid not found")
+		}
+		key := participantKey{raidID: signup.Raid.ID, playerID: signup.Player.ID}
+		if _, ok := m.signups[key]; ok {
+			return fmt.Errorf("signup already exists")
+		}
+		m.signups[key] = signup.Status
+		return nil
+	}
+}
+
+// UpdateSignup updates the status of a player signed up to a raid.
+func (m *Memory) UpdateSignup(ctx context.Context, signup entity.Signup) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Signup/UpdateSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", signup.Raid.ID),
+		attribute.Int("playerID", signup.Player.ID),
+		attribute.String("status", string(signup.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - UpdateSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		key := participantKey{raidID: signup.Raid.ID, playerID: signup.Player.ID}
+		if _, ok := m.signups[key]; !ok {
+			return fmt.Errorf("memory - UpdateSignup - signup not found")
+		}
+		m.signups[key] = signup.Status
+		return nil
+	}
+}
+
+// DeleteSignup removes the signup of a player to a raid.
+func (m *Memory) DeleteSignup(ctx context.Context, raidID, playerID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Signup/DeleteSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - DeleteSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		key := participantKey{raidID: raidID, playerID: playerID}
+		if _, ok := m.signups[key]; !ok {
+			return fmt.Errorf("memory - DeleteSignup - signup not found")
+		}
+		delete(m.signups, key)
+		return nil
+	}
+}
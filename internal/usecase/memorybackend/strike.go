@@ -0,0 +1,158 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchStrike returns strikes matching each of the given parameters, not combined,
+// like the postgres backend does. Strike date is its creation date.
+func (m *Memory) SearchStrike(
+	ctx context.Context, playerID int, date time.Time, season, reason string,
+) ([]entity.Strike, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Strike/SearchStrike")
+	span.SetAttributes(
+		attribute.Int("playerID", playerID),
+		attribute.String("season", season),
+		attribute.String("reason", reason),
+		attribute.String("date", date.String()))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchStrike - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var strikes []entity.Strike
+		if playerID != -1 {
+			strikes = append(strikes, m.filterStrikes(func(s strikeRecord) bool { return s.playerID == playerID })...)
+		}
+		if len(season) != 0 {
+			strikes = append(strikes, m.filterStrikes(func(s strikeRecord) bool { return s.strike.Season == season })...)
+		}
+		if len(reason) != 0 {
+			strikes = append(strikes, m.filterStrikes(func(s strikeRecord) bool { return s.strike.Reason == reason })...)
+		}
+		if !date.IsZero() {
+			strikes = append(strikes, m.filterStrikes(func(s strikeRecord) bool { return s.strike.Date.Equal(date) })...)
+		}
+		return strikes, nil
+	}
+}
+
+// filterStrikes returns strikes matching keep, except the ones of deleted players. Caller must hold the lock.
+func (m *Memory) filterStrikes(keep func(strike strikeRecord) bool) []entity.Strike {
+	var strikes []entity.Strike
+	for _, id := range sortedIDs(m.strikes) {
+		if keep(m.strikes[id]) && !m.isDeleted(entity.DeletedPlayer, m.strikes[id].playerID) {
+			strikes = append(strikes, m.strikes[id].strike)
+		}
+	}
+	return strikes
+}
+
+// CreateStrike stores a strike on a player. Strike date is set to now.
+func (m *Memory) CreateStrike(ctx context.Context, strike entity.Strike, playerID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Strike/CreateStrike")
+	span.SetAttributes(
+		attribute.String("season", strike.Season),
+		attribute.String("reason", strike.Reason),
+		attribute.Int("playerID", playerID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - CreateStrike - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.players[playerID]; !ok {
+			return fmt.Errorf("memory - CreateStrike - player not found")
+		}
+		id := m.nextID("strikes")
+		m.strikes[id] = strikeRecord{
+			strike:   entity.Strike{ID: id, Season: strike.Season, Reason: strike.Reason, Date: m.now()},
+			playerID: playerID,
+		}
+		return nil
+	}
+}
+
+// ReadStrike returns the strike with the given ID.
+// Like the postgres backend, an empty strike is returned if it doesn't exist.
+func (m *Memory) ReadStrike(ctx context.Context, strikeID int) (entity.Strike, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Strike/ReadStrike")
+	span.SetAttributes(attribute.Int("strikeID", strikeID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Strike{}, fmt.Errorf("memory - ReadStrike - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		record, ok := m.strikes[strikeID]
+		if !ok || m.isDeleted(entity.DeletedPlayer, record.playerID) {
+			return entity.Strike{}, nil
+		}
+		return record.strike, nil
+	}
+}
+
+// UpdateStrike updates season and reason of a strike.
+func (m *Memory) UpdateStrike(ctx context.Context, strike entity.Strike) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Strike/UpdateStrike")
+	span.SetAttributes(
+		attribute.Int("strikeID", strike.ID),
+		attribute.String("season", strike.Season),
+		attribute.String("reason", strike.Reason))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - UpdateStrike - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		record, ok := m.strikes[strike.ID]
+		if !ok {
+			return fmt.Errorf("memory - UpdateStrike - strike not found")
+		}
+		record.strike.Season = strike.Season
+		record.strike.Reason = strike.Reason
+		m.strikes[strike.ID] = record
+		return nil
+	}
+}
+
+// DeleteStrike deletes the strike with the given ID.
+func (m *Memory) DeleteStrike(ctx context.Context, strikeID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Strike/DeleteStrike")
+	span.SetAttributes(attribute.Int("strikeID", strikeID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - DeleteStrike - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.strikes[strikeID]; !ok {
+			return fmt.Errorf("memory - DeleteStrike - strike not found")
+		}
+		delete(m.strikes, strikeID)
+		return nil
+	}
+}
@@ -0,0 +1,92 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchRaidTemplate returns the raid templates with this name, all of them when name is empty.
+func (m *Memory) SearchRaidTemplate(ctx context.Context, name string) ([]entity.RaidTemplate, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "RaidTemplate/SearchRaidTemplate")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchRaidTemplate - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var templates []entity.RaidTemplate
+		for _, id := range sortedIDs(m.templates) {
+			template := m.templates[id]
+			if name != "" && template.Name != name {
+				continue
+			}
+			template.Weekdays = append([]time.Weekday(nil), template.Weekdays...)
+			templates = append(templates, template)
+		}
+		return templates, nil
+	}
+}
+
+// CreateRaidTemplate adds a raid template.
+func (m *Memory) CreateRaidTemplate(ctx context.Context, template entity.RaidTemplate) (entity.RaidTemplate, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "RaidTemplate/CreateRaidTemplate")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", template.Name),
+		attribute.String("difficulty", template.Difficulty),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.RaidTemplate{},
+			fmt.Errorf("memory - CreateRaidTemplate - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		for _, other := range m.templates {
+			if other.Name == template.Name && other.Difficulty == template.Difficulty {
+				return entity.RaidTemplate{}, fmt.Errorf("raid template already exists")
+			}
+		}
+		template.ID = m.nextID("raid_templates")
+		template.Weekdays = append([]time.Weekday(nil), template.Weekdays...)
+		m.templates[template.ID] = template
+		return template, nil
+	}
+}
+
+// DeleteRaidTemplate removes a raid template, raids it created are kept.
+func (m *Memory) DeleteRaidTemplate(ctx context.Context, templateID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "RaidTemplate/DeleteRaidTemplate")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", templateID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - DeleteRaidTemplate - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.templates[templateID]; !ok {
+			return fmt.Errorf("memory - DeleteRaidTemplate - raid template not found")
+		}
+		delete(m.templates, templateID)
+		return nil
+	}
+}
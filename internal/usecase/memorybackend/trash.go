@@ -0,0 +1,217 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// deletedKey identifies a player, a raid or a loot marked deleted.
+type deletedKey struct {
+	kind entity.DeletedKind
+	id   int
+}
+
+// isDeleted tells if the object of kind with the given ID is marked deleted. Caller must hold the lock.
+func (m *Memory) isDeleted(kind entity.DeletedKind, id int) bool {
+	_, ok := m.deleted[deletedKey{kind, id}]
+	return ok
+}
+
+// deletedName returns the name of a deleted object, false if it doesn't exist anymore.
+// Caller must hold the lock.
+func (m *Memory) deletedName(key deletedKey) (string, bool) {
+	switch key.kind {
+	case entity.DeletedPlayer:
+		player, ok := m.players[key.id]
+		return player.Name, ok
+	case entity.DeletedRaid:
+		raid, ok := m.raids[key.id]
+		return raid.Name, ok
+	case entity.DeletedLoot:
+		loot, ok := m.loots[key.id]
+		return loot.name, ok
+	}
+	return "", false
+}
+
+// purgePlayer removes a player for good with everything attached to it. Caller must hold the write lock.
+func (m *Memory) purgePlayer(playerID int) {
+	delete(m.players, playerID)
+	delete(m.deleted, deletedKey{entity.DeletedPlayer, playerID})
+	m.deletePlayerRecords(playerID)
+	m.unlinkAlts(playerID)
+}
+
+// purgeRaid removes a raid for good with everything attached to it. Caller must hold the write lock.
+func (m *Memory) purgeRaid(raidID int) {
+	delete(m.raids, raidID)
+	delete(m.deleted, deletedKey{entity.DeletedRaid, raidID})
+	m.deleteRaidRecords(raidID)
+}
+
+// purgeLoot removes a loot for good, its ledger entries are kept. Caller must hold the write lock.
+func (m *Memory) purgeLoot(lootID int) {
+	delete(m.loots, lootID)
+	delete(m.deleted, deletedKey{entity.DeletedLoot, lootID})
+	m.unlinkLootPoints(lootID)
+}
+
+// restoreConflicts tells if a deleted object was replaced by another one with the same name,
+// or the same date and difficulty for a raid. Caller must hold the lock.
+func (m *Memory) restoreConflicts(key deletedKey) bool {
+	switch key.kind {
+	case entity.DeletedPlayer:
+		return m.playerExists(key.id, m.players[key.id].Name, "")
+	case entity.DeletedRaid:
+		return m.raidExists(key.id, m.raids[key.id].Date, m.raids[key.id].Difficulty)
+	case entity.DeletedLoot:
+		loot := m.loots[key.id]
+		for _, other := range m.loots {
+			if other.id != loot.id && other.name == loot.name && other.raidID == loot.raidID &&
+				other.playerID == loot.playerID && !m.isDeleted(entity.DeletedLoot, other.id) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SearchDeleted returns the players, raids and loots deleted, the last one deleted first.
+// At most limit objects are returned, all of them when limit is 0.
+func (m *Memory) SearchDeleted(ctx context.Context, limit int) ([]entity.Deleted, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Trash/SearchDeleted")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("limit", limit),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchDeleted - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var deleted []entity.Deleted
+		for key, deletedAt := range m.deleted {
+			name, ok := m.deletedName(key)
+			if !ok {
+				continue
+			}
+			deleted = append(deleted, entity.Deleted{Kind: key.kind, ID: key.id, Name: name, DeletedAt: deletedAt})
+		}
+		sort.Slice(deleted, func(i, j int) bool {
+			if !deleted[i].DeletedAt.Equal(deleted[j].DeletedAt) {
+				return deleted[i].DeletedAt.After(deleted[j].DeletedAt)
+			}
+			return deleted[i].ID > deleted[j].ID
+		})
+		return entity.Page(deleted, limit, 0), nil
+	}
+}
+
+// SearchDeletedRaid returns the deleted raids on a date with a difficulty.
+func (m *Memory) SearchDeletedRaid(ctx context.Context, date time.Time, difficulty string) ([]entity.Raid, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Trash/SearchDeletedRaid")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("date", date.String()),
+		attribute.String("difficulty", difficulty),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchDeletedRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var raids []entity.Raid
+		for _, id := range sortedIDs(m.raids) {
+			raid := m.raids[id]
+			if raid.Date.Equal(date) && raid.Difficulty == difficulty && m.isDeleted(entity.DeletedRaid, id) {
+				raids = append(raids, raid)
+			}
+		}
+		return raids, nil
+	}
+}
+
+// RestoreDeleted brings back a deleted player, raid or loot, with what belongs to it.
+func (m *Memory) RestoreDeleted(ctx context.Context, deleted entity.Deleted) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Trash/RestoreDeleted")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("kind", string(deleted.Kind)),
+		attribute.Int("id", deleted.ID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - RestoreDeleted - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		key := deletedKey{deleted.Kind, deleted.ID}
+		if _, ok := m.deleted[key]; !ok {
+			return fmt.Errorf("memory - RestoreDeleted - deleted %s not found", deleted.Kind)
+		}
+		if m.restoreConflicts(key) {
+			return fmt.Errorf("memory - RestoreDeleted - %s already exists", deleted.Kind)
+		}
+		// The discord user of a deleted player may have been linked to another player since
+		if player, ok := m.players[key.id]; key.kind == entity.DeletedPlayer && ok &&
+			m.playerExists(player.ID, "", player.DiscordID) {
+			player.DiscordID, player.DiscordName = "", ""
+			m.players[player.ID] = player
+		}
+		delete(m.deleted, key)
+		return nil
+	}
+}
+
+// PurgeDeleted removes for good the players, raids and loots deleted before a time,
+// with everything which belongs to them. It returns the number of objects purged.
+func (m *Memory) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Trash/PurgeDeleted")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("before", before.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return 0, fmt.Errorf("memory - PurgeDeleted - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		// loots first, like the SQL backends, so loots purged with their raid or player are not counted
+		var purged int
+		for _, kind := range []entity.DeletedKind{entity.DeletedLoot, entity.DeletedRaid, entity.DeletedPlayer} {
+			for key, deletedAt := range m.deleted {
+				if key.kind != kind || !deletedAt.Before(before) {
+					continue
+				}
+				switch kind {
+				case entity.DeletedPlayer:
+					m.purgePlayer(key.id)
+				case entity.DeletedRaid:
+					m.purgeRaid(key.id)
+				case entity.DeletedLoot:
+					m.purgeLoot(key.id)
+				}
+				purged++
+			}
+		}
+		return purged, nil
+	}
+}
@@ -0,0 +1,109 @@
+package memorybackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchWish returns the wishes of a player or on an item, highest priority first.
+// playerID is ignored when -1 and item when empty.
+func (m *Memory) SearchWish(ctx context.Context, playerID int, item string) ([]entity.Wish, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Wish/SearchWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", playerID),
+		attribute.String("item", item),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("memory - SearchWish - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		var wishes []entity.Wish
+		for _, id := range sortedIDs(m.wishes) {
+			wish := m.wishes[id]
+			if playerID != -1 && wish.Player.ID != playerID {
+				continue
+			}
+			if item != "" && wish.Item != item {
+				continue
+			}
+			if m.isDeleted(entity.DeletedPlayer, wish.Player.ID) {
+				continue
+			}
+			player := m.players[wish.Player.ID]
+			wish.Player = &entity.Player{ID: player.ID, Name: player.Name}
+			wishes = append(wishes, wish)
+		}
+		sort.SliceStable(wishes, func(i, j int) bool {
+			return wishes[i].Priority < wishes[j].Priority
+		})
+		return wishes, nil
+	}
+}
+
+// CreateWish adds a wish to the wishlist of its player.
+func (m *Memory) CreateWish(ctx context.Context, wish entity.Wish) (entity.Wish, error) {
+	_, span := otel.Tracer("Backend").Start(ctx, "Wish/CreateWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", wish.Player.ID),
+		attribute.String("item", wish.Item),
+		attribute.Int("priority", wish.Priority),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Wish{}, fmt.Errorf("memory - CreateWish - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.players[wish.Player.ID]; !ok {
+			return entity.Wish{}, fmt.Errorf("memory - CreateWish - player not found")
+		}
+		for _, other := range m.wishes {
+			if other.Player.ID == wish.Player.ID && other.Item == wish.Item {
+				return entity.Wish{}, fmt.Errorf("wish already exists")
+			}
+		}
+		player := wish.Player
+		wish.ID = m.nextID("wishes")
+		wish.Player = &entity.Player{ID: player.ID}
+		m.wishes[wish.ID] = wish
+		wish.Player = player
+		return wish, nil
+	}
+}
+
+// DeleteWish removes a wish from the wishlist of its player.
+func (m *Memory) DeleteWish(ctx context.Context, wishID int) error {
+	_, span := otel.Tracer("Backend").Start(ctx, "Wish/DeleteWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", wishID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("memory - DeleteWish - ctx.Done: request took too much time to be proceed")
+	default:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.wishes[wishID]; !ok {
+			return fmt.Errorf("memory - DeleteWish - wish not found")
+		}
+		delete(m.wishes, wishID)
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AbsenceRecorder is an autogenerated mock type for the AbsenceRecorder type
+type AbsenceRecorder struct {
+	mock.Mock
+}
+
+// CreateAbsence provides a mock function with given fields: ctx, playerName, date
+func (_m *AbsenceRecorder) CreateAbsence(ctx context.Context, playerName string, date time.Time) error {
+	ret := _m.Called(ctx, playerName, date)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, playerName, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteAbsence provides a mock function with given fields: ctx, playerName, date
+func (_m *AbsenceRecorder) DeleteAbsence(ctx context.Context, playerName string, date time.Time) error {
+	ret := _m.Called(ctx, playerName, date)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, playerName, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAbsenceRecorder creates a new instance of AbsenceRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAbsenceRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AbsenceRecorder {
+	mock := &AbsenceRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
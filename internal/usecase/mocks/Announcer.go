@@ -0,0 +1,42 @@
+// Code generated by mockery v2.33.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Announcer is an autogenerated mock type for the Announcer type
+type Announcer struct {
+	mock.Mock
+}
+
+// Announce provides a mock function with given fields: ctx, msg
+func (_m *Announcer) Announce(ctx context.Context, msg string) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAnnouncer creates a new instance of Announcer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnnouncer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Announcer {
+	mock := &Announcer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
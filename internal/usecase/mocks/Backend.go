@@ -88,6 +88,20 @@ func (_m *Backend) CreateLoot(ctx context.Context, loot entity.Loot) (entity.Loo
 	return r0, r1
 }
 
+// CreateParticipant provides a mock function with given fields: ctx, participant
+func (_m *Backend) CreateParticipant(ctx context.Context, participant entity.Participant) error {
+	ret := _m.Called(ctx, participant)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Participant) error); ok {
+		r0 = rf(ctx, participant)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreatePlayer provides a mock function with given fields: ctx, player
 func (_m *Backend) CreatePlayer(ctx context.Context, player entity.Player) (entity.Player, error) {
 	ret := _m.Called(ctx, player)
@@ -112,6 +126,30 @@ func (_m *Backend) CreatePlayer(ctx context.Context, player entity.Player) (enti
 	return r0, r1
 }
 
+// CreatePointsEntry provides a mock function with given fields: ctx, entry
+func (_m *Backend) CreatePointsEntry(ctx context.Context, entry entity.PointsEntry) (entity.PointsEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	var r0 entity.PointsEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.PointsEntry) (entity.PointsEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.PointsEntry) entity.PointsEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(entity.PointsEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.PointsEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateRaid provides a mock function with given fields: ctx, raid
 func (_m *Backend) CreateRaid(ctx context.Context, raid entity.Raid) (entity.Raid, error) {
 	ret := _m.Called(ctx, raid)
@@ -136,6 +174,68 @@ func (_m *Backend) CreateRaid(ctx context.Context, raid entity.Raid) (entity.Rai
 	return r0, r1
 }
 
+// CreateRaidTemplate provides a mock function with given fields: ctx, template
+func (_m *Backend) CreateRaidTemplate(ctx context.Context, template entity.RaidTemplate) (entity.RaidTemplate, error) {
+	ret := _m.Called(ctx, template)
+
+	var r0 entity.RaidTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.RaidTemplate) (entity.RaidTemplate, error)); ok {
+		return rf(ctx, template)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.RaidTemplate) entity.RaidTemplate); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Get(0).(entity.RaidTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.RaidTemplate) error); ok {
+		r1 = rf(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSeason provides a mock function with given fields: ctx, season
+func (_m *Backend) CreateSeason(ctx context.Context, season entity.Season) (entity.Season, error) {
+	ret := _m.Called(ctx, season)
+
+	var r0 entity.Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Season) (entity.Season, error)); ok {
+		return rf(ctx, season)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Season) entity.Season); ok {
+		r0 = rf(ctx, season)
+	} else {
+		r0 = ret.Get(0).(entity.Season)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Season) error); ok {
+		r1 = rf(ctx, season)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSignup provides a mock function with given fields: ctx, signup
+func (_m *Backend) CreateSignup(ctx context.Context, signup entity.Signup) error {
+	ret := _m.Called(ctx, signup)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Signup) error); ok {
+		r0 = rf(ctx, signup)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateStrike provides a mock function with given fields: ctx, strike, playerID
 func (_m *Backend) CreateStrike(ctx context.Context, strike entity.Strike, playerID int) error {
 	ret := _m.Called(ctx, strike, playerID)
@@ -150,6 +250,30 @@ func (_m *Backend) CreateStrike(ctx context.Context, strike entity.Strike, playe
 	return r0
 }
 
+// CreateWish provides a mock function with given fields: ctx, wish
+func (_m *Backend) CreateWish(ctx context.Context, wish entity.Wish) (entity.Wish, error) {
+	ret := _m.Called(ctx, wish)
+
+	var r0 entity.Wish
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Wish) (entity.Wish, error)); ok {
+		return rf(ctx, wish)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Wish) entity.Wish); ok {
+		r0 = rf(ctx, wish)
+	} else {
+		r0 = ret.Get(0).(entity.Wish)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Wish) error); ok {
+		r1 = rf(ctx, wish)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAbsence provides a mock function with given fields: ctx, absenceID
 func (_m *Backend) DeleteAbsence(ctx context.Context, absenceID int) error {
 	ret := _m.Called(ctx, absenceID)
@@ -192,6 +316,20 @@ func (_m *Backend) DeleteLoot(ctx context.Context, lootID int) error {
 	return r0
 }
 
+// DeleteParticipant provides a mock function with given fields: ctx, raidID, playerID
+func (_m *Backend) DeleteParticipant(ctx context.Context, raidID int, playerID int) error {
+	ret := _m.Called(ctx, raidID, playerID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, raidID, playerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeletePlayer provides a mock function with given fields: ctx, player
 func (_m *Backend) DeletePlayer(ctx context.Context, player entity.Player) error {
 	ret := _m.Called(ctx, player)
@@ -220,6 +358,48 @@ func (_m *Backend) DeleteRaid(ctx context.Context, raidID int) error {
 	return r0
 }
 
+// DeleteRaidTemplate provides a mock function with given fields: ctx, templateID
+func (_m *Backend) DeleteRaidTemplate(ctx context.Context, templateID int) error {
+	ret := _m.Called(ctx, templateID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, templateID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSeason provides a mock function with given fields: ctx, seasonID
+func (_m *Backend) DeleteSeason(ctx context.Context, seasonID int) error {
+	ret := _m.Called(ctx, seasonID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, seasonID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSignup provides a mock function with given fields: ctx, raidID, playerID
+func (_m *Backend) DeleteSignup(ctx context.Context, raidID int, playerID int) error {
+	ret := _m.Called(ctx, raidID, playerID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, raidID, playerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteStrike provides a mock function with given fields: ctx, strikeID
 func (_m *Backend) DeleteStrike(ctx context.Context, strikeID int) error {
 	ret := _m.Called(ctx, strikeID)
@@ -234,6 +414,44 @@ func (_m *Backend) DeleteStrike(ctx context.Context, strikeID int) error {
 	return r0
 }
 
+// DeleteWish provides a mock function with given fields: ctx, wishID
+func (_m *Backend) DeleteWish(ctx context.Context, wishID int) error {
+	ret := _m.Called(ctx, wishID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, wishID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeDeleted provides a mock function with given fields: ctx, before
+func (_m *Backend) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReadAbsence provides a mock function with given fields: ctx, absenceID
 func (_m *Backend) ReadAbsence(ctx context.Context, absenceID int) (entity.Absence, error) {
 	ret := _m.Called(ctx, absenceID)
@@ -282,6 +500,30 @@ func (_m *Backend) ReadFail(ctx context.Context, failID int) (entity.Fail, error
 	return r0, r1
 }
 
+// ReadItem provides a mock function with given fields: ctx, itemID
+func (_m *Backend) ReadItem(ctx context.Context, itemID int) (entity.Item, error) {
+	ret := _m.Called(ctx, itemID)
+
+	var r0 entity.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (entity.Item, error)); ok {
+		return rf(ctx, itemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) entity.Item); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		r0 = ret.Get(0).(entity.Item)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReadLoot provides a mock function with given fields: ctx, lootID
 func (_m *Backend) ReadLoot(ctx context.Context, lootID int) (entity.Loot, error) {
 	ret := _m.Called(ctx, lootID)
@@ -330,6 +572,30 @@ func (_m *Backend) ReadPlayer(ctx context.Context, playerID int) (entity.Player,
 	return r0, r1
 }
 
+// ReadPointsEntry provides a mock function with given fields: ctx, entryID
+func (_m *Backend) ReadPointsEntry(ctx context.Context, entryID int) (entity.PointsEntry, error) {
+	ret := _m.Called(ctx, entryID)
+
+	var r0 entity.PointsEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (entity.PointsEntry, error)); ok {
+		return rf(ctx, entryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) entity.PointsEntry); ok {
+		r0 = rf(ctx, entryID)
+	} else {
+		r0 = ret.Get(0).(entity.PointsEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, entryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReadRaid provides a mock function with given fields: ctx, raidID
 func (_m *Backend) ReadRaid(ctx context.Context, raidID int) (entity.Raid, error) {
 	ret := _m.Called(ctx, raidID)
@@ -378,6 +644,34 @@ func (_m *Backend) ReadStrike(ctx context.Context, strikeID int) (entity.Strike,
 	return r0, r1
 }
 
+// RestoreDeleted provides a mock function with given fields: ctx, deleted
+func (_m *Backend) RestoreDeleted(ctx context.Context, deleted entity.Deleted) error {
+	ret := _m.Called(ctx, deleted)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Deleted) error); ok {
+		r0 = rf(ctx, deleted)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveItem provides a mock function with given fields: ctx, item
+func (_m *Backend) SaveItem(ctx context.Context, item entity.Item) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Item) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SearchAbsence provides a mock function with given fields: ctx, playerName, playerID, date
 func (_m *Backend) SearchAbsence(ctx context.Context, playerName string, playerID int, date time.Time) ([]entity.Absence, error) {
 	ret := _m.Called(ctx, playerName, playerID, date)
@@ -404,25 +698,77 @@ func (_m *Backend) SearchAbsence(ctx context.Context, playerName string, playerI
 	return r0, r1
 }
 
-// SearchFail provides a mock function with given fields: ctx, playerName, playerID, raidID, reason
-func (_m *Backend) SearchFail(ctx context.Context, playerName string, playerID int, raidID int, reason string) ([]entity.Fail, error) {
-	ret := _m.Called(ctx, playerName, playerID, raidID, reason)
+// SearchDeleted provides a mock function with given fields: ctx, limit
+func (_m *Backend) SearchDeleted(ctx context.Context, limit int) ([]entity.Deleted, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 []entity.Deleted
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]entity.Deleted, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []entity.Deleted); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Deleted)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchDeletedRaid provides a mock function with given fields: ctx, date, difficulty
+func (_m *Backend) SearchDeletedRaid(ctx context.Context, date time.Time, difficulty string) ([]entity.Raid, error) {
+	ret := _m.Called(ctx, date, difficulty)
+
+	var r0 []entity.Raid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string) ([]entity.Raid, error)); ok {
+		return rf(ctx, date, difficulty)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string) []entity.Raid); ok {
+		r0 = rf(ctx, date, difficulty)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Raid)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, string) error); ok {
+		r1 = rf(ctx, date, difficulty)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchFail provides a mock function with given fields: ctx, playerName, playerID, raidID, reason, limit, offset
+func (_m *Backend) SearchFail(ctx context.Context, playerName string, playerID int, raidID int, reason string, limit int, offset int) ([]entity.Fail, error) {
+	ret := _m.Called(ctx, playerName, playerID, raidID, reason, limit, offset)
 
 	var r0 []entity.Fail
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) ([]entity.Fail, error)); ok {
-		return rf(ctx, playerName, playerID, raidID, reason)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string, int, int) ([]entity.Fail, error)); ok {
+		return rf(ctx, playerName, playerID, raidID, reason, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) []entity.Fail); ok {
-		r0 = rf(ctx, playerName, playerID, raidID, reason)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string, int, int) []entity.Fail); ok {
+		r0 = rf(ctx, playerName, playerID, raidID, reason, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Fail)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string) error); ok {
-		r1 = rf(ctx, playerName, playerID, raidID, reason)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string, int, int) error); ok {
+		r1 = rf(ctx, playerName, playerID, raidID, reason, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -430,25 +776,103 @@ func (_m *Backend) SearchFail(ctx context.Context, playerName string, playerID i
 	return r0, r1
 }
 
-// SearchLoot provides a mock function with given fields: ctx, name, date, difficulty, playerName
-func (_m *Backend) SearchLoot(ctx context.Context, name string, date time.Time, difficulty string, playerName string) ([]entity.Loot, error) {
-	ret := _m.Called(ctx, name, date, difficulty, playerName)
+// SearchItem provides a mock function with given fields: ctx, name
+func (_m *Backend) SearchItem(ctx context.Context, name string) ([]entity.Item, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 []entity.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.Item, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Item); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchLoot provides a mock function with given fields: ctx, name, date, difficulty, playerName, limit, offset
+func (_m *Backend) SearchLoot(ctx context.Context, name string, date time.Time, difficulty string, playerName string, limit int, offset int) ([]entity.Loot, error) {
+	ret := _m.Called(ctx, name, date, difficulty, playerName, limit, offset)
 
 	var r0 []entity.Loot
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, string, string) ([]entity.Loot, error)); ok {
-		return rf(ctx, name, date, difficulty, playerName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, string, string, int, int) ([]entity.Loot, error)); ok {
+		return rf(ctx, name, date, difficulty, playerName, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, string, string) []entity.Loot); ok {
-		r0 = rf(ctx, name, date, difficulty, playerName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, string, string, int, int) []entity.Loot); ok {
+		r0 = rf(ctx, name, date, difficulty, playerName, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Loot)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, string, string) error); ok {
-		r1 = rf(ctx, name, date, difficulty, playerName)
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, string, string, int, int) error); ok {
+		r1 = rf(ctx, name, date, difficulty, playerName, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchLootName provides a mock function with given fields: ctx, prefix, limit
+func (_m *Backend) SearchLootName(ctx context.Context, prefix string, limit int) ([]string, error) {
+	ret := _m.Called(ctx, prefix, limit)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]string, error)); ok {
+		return rf(ctx, prefix, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []string); ok {
+		r0 = rf(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchParticipant provides a mock function with given fields: ctx, raidID, playerID
+func (_m *Backend) SearchParticipant(ctx context.Context, raidID int, playerID int) ([]entity.Participant, error) {
+	ret := _m.Called(ctx, raidID, playerID)
+
+	var r0 []entity.Participant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]entity.Participant, error)); ok {
+		return rf(ctx, raidID, playerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []entity.Participant); ok {
+		r0 = rf(ctx, raidID, playerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Participant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, raidID, playerID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -456,17 +880,17 @@ func (_m *Backend) SearchLoot(ctx context.Context, name string, date time.Time,
 	return r0, r1
 }
 
-// SearchPlayer provides a mock function with given fields: ctx, id, name, discordName
-func (_m *Backend) SearchPlayer(ctx context.Context, id int, name string, discordName string) ([]entity.Player, error) {
-	ret := _m.Called(ctx, id, name, discordName)
+// SearchPlayer provides a mock function with given fields: ctx, id, name, discordID
+func (_m *Backend) SearchPlayer(ctx context.Context, id int, name string, discordID string) ([]entity.Player, error) {
+	ret := _m.Called(ctx, id, name, discordID)
 
 	var r0 []entity.Player
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) ([]entity.Player, error)); ok {
-		return rf(ctx, id, name, discordName)
+		return rf(ctx, id, name, discordID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) []entity.Player); ok {
-		r0 = rf(ctx, id, name, discordName)
+		r0 = rf(ctx, id, name, discordID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Player)
@@ -474,7 +898,59 @@ func (_m *Backend) SearchPlayer(ctx context.Context, id int, name string, discor
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int, string, string) error); ok {
-		r1 = rf(ctx, id, name, discordName)
+		r1 = rf(ctx, id, name, discordID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchPlayerOnPrefix provides a mock function with given fields: ctx, prefix, limit
+func (_m *Backend) SearchPlayerOnPrefix(ctx context.Context, prefix string, limit int) ([]entity.Player, error) {
+	ret := _m.Called(ctx, prefix, limit)
+
+	var r0 []entity.Player
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]entity.Player, error)); ok {
+		return rf(ctx, prefix, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []entity.Player); ok {
+		r0 = rf(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Player)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchPointsEntry provides a mock function with given fields: ctx, playerID, raidID, lootID
+func (_m *Backend) SearchPointsEntry(ctx context.Context, playerID int, raidID int, lootID int) ([]entity.PointsEntry, error) {
+	ret := _m.Called(ctx, playerID, raidID, lootID)
+
+	var r0 []entity.PointsEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) ([]entity.PointsEntry, error)); ok {
+		return rf(ctx, playerID, raidID, lootID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) []entity.PointsEntry); ok {
+		r0 = rf(ctx, playerID, raidID, lootID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PointsEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = rf(ctx, playerID, raidID, lootID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -508,6 +984,110 @@ func (_m *Backend) SearchRaid(ctx context.Context, raidName string, date time.Ti
 	return r0, r1
 }
 
+// SearchRaidOnRange provides a mock function with given fields: ctx, from, to, limit, offset
+func (_m *Backend) SearchRaidOnRange(ctx context.Context, from time.Time, to time.Time, limit int, offset int) ([]entity.Raid, error) {
+	ret := _m.Called(ctx, from, to, limit, offset)
+
+	var r0 []entity.Raid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int, int) ([]entity.Raid, error)); ok {
+		return rf(ctx, from, to, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int, int) []entity.Raid); ok {
+		r0 = rf(ctx, from, to, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Raid)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int, int) error); ok {
+		r1 = rf(ctx, from, to, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRaidTemplate provides a mock function with given fields: ctx, name
+func (_m *Backend) SearchRaidTemplate(ctx context.Context, name string) ([]entity.RaidTemplate, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 []entity.RaidTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.RaidTemplate, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.RaidTemplate); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.RaidTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchSeason provides a mock function with given fields: ctx, name
+func (_m *Backend) SearchSeason(ctx context.Context, name string) ([]entity.Season, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 []entity.Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.Season, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Season); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Season)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchSignup provides a mock function with given fields: ctx, raidID, playerID
+func (_m *Backend) SearchSignup(ctx context.Context, raidID int, playerID int) ([]entity.Signup, error) {
+	ret := _m.Called(ctx, raidID, playerID)
+
+	var r0 []entity.Signup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]entity.Signup, error)); ok {
+		return rf(ctx, raidID, playerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []entity.Signup); ok {
+		r0 = rf(ctx, raidID, playerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Signup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, raidID, playerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SearchStrike provides a mock function with given fields: ctx, playerID, Date, Season, Reason
 func (_m *Backend) SearchStrike(ctx context.Context, playerID int, Date time.Time, Season string, Reason string) ([]entity.Strike, error) {
 	ret := _m.Called(ctx, playerID, Date, Season, Reason)
@@ -534,6 +1114,32 @@ func (_m *Backend) SearchStrike(ctx context.Context, playerID int, Date time.Tim
 	return r0, r1
 }
 
+// SearchWish provides a mock function with given fields: ctx, playerID, item
+func (_m *Backend) SearchWish(ctx context.Context, playerID int, item string) ([]entity.Wish, error) {
+	ret := _m.Called(ctx, playerID, item)
+
+	var r0 []entity.Wish
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]entity.Wish, error)); ok {
+		return rf(ctx, playerID, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []entity.Wish); ok {
+		r0 = rf(ctx, playerID, item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Wish)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, playerID, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateAbsence provides a mock function with given fields: ctx, absence
 func (_m *Backend) UpdateAbsence(ctx context.Context, absence entity.Absence) error {
 	ret := _m.Called(ctx, absence)
@@ -576,6 +1182,20 @@ func (_m *Backend) UpdateLoot(ctx context.Context, loot entity.Loot) error {
 	return r0
 }
 
+// UpdateParticipant provides a mock function with given fields: ctx, participant
+func (_m *Backend) UpdateParticipant(ctx context.Context, participant entity.Participant) error {
+	ret := _m.Called(ctx, participant)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Participant) error); ok {
+		r0 = rf(ctx, participant)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdatePlayer provides a mock function with given fields: ctx, player
 func (_m *Backend) UpdatePlayer(ctx context.Context, player entity.Player) error {
 	ret := _m.Called(ctx, player)
@@ -604,6 +1224,34 @@ func (_m *Backend) UpdateRaid(ctx context.Context, raid entity.Raid) error {
 	return r0
 }
 
+// UpdateSeason provides a mock function with given fields: ctx, season
+func (_m *Backend) UpdateSeason(ctx context.Context, season entity.Season) error {
+	ret := _m.Called(ctx, season)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Season) error); ok {
+		r0 = rf(ctx, season)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSignup provides a mock function with given fields: ctx, signup
+func (_m *Backend) UpdateSignup(ctx context.Context, signup entity.Signup) error {
+	ret := _m.Called(ctx, signup)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Signup) error); ok {
+		r0 = rf(ctx, signup)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateStrike provides a mock function with given fields: ctx, strike
 func (_m *Backend) UpdateStrike(ctx context.Context, strike entity.Strike) error {
 	ret := _m.Called(ctx, strike)
@@ -2,7 +2,10 @@ package usecase
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,10 +20,12 @@ import (
 
 type PlayerUseCase struct {
 	backend Backend
+	policy  entity.StrikePolicy
 }
 
-func NewPlayerUseCase(bk Backend) *PlayerUseCase {
-	return &PlayerUseCase{backend: bk}
+// NewPlayerUseCase returns a new PlayerUseCase. policy sets when strikes of players expire.
+func NewPlayerUseCase(bk Backend, policy entity.StrikePolicy) *PlayerUseCase {
+	return &PlayerUseCase{backend: bk, policy: policy}
 }
 
 func (puc PlayerUseCase) CreatePlayer(ctx context.Context, playerName string) (int, error) {
@@ -66,12 +71,76 @@ func (puc PlayerUseCase) DeletePlayer(ctx context.Context, playerName string) er
 	}
 }
 
-func (puc PlayerUseCase) ReadPlayer(ctx context.Context, playerName, playerLinkName string) (entity.Player, error) {
+// ReadPlayerCascade returns the player named playerName with what deleting it removes along with it.
+func (puc PlayerUseCase) ReadPlayerCascade(
+	ctx context.Context, playerName string,
+) (entity.Player, entity.Cascade, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Player/ReadPlayerCascade")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+	)
+	select {
+	case <-ctx.Done():
+		return entity.Player{}, entity.Cascade{},
+			fmt.Errorf("PlayerUseCase - ReadPlayerCascade - ctx.Done: request took too much time to be proceed")
+	default:
+		player, err := findPlayer(ctx, puc.backend, playerName)
+		if err != nil {
+			return entity.Player{}, entity.Cascade{}, err
+		}
+
+		var cascade entity.Cascade
+		strikes, err := puc.backend.SearchStrike(ctx, player.ID, time.Time{}, "", "")
+		if err != nil {
+			return entity.Player{}, entity.Cascade{}, fmt.Errorf("search strikes of player: %w", err)
+		}
+		cascade.Strikes = len(strikes)
+		loots, err := puc.backend.SearchLoot(ctx, "", time.Time{}, "", player.Name, 0, 0)
+		if err != nil {
+			return entity.Player{}, entity.Cascade{}, fmt.Errorf("search loots of player: %w", err)
+		}
+		cascade.Loots = len(loots)
+		absences, err := puc.backend.SearchAbsence(ctx, "", player.ID, time.Time{})
+		if err != nil {
+			return entity.Player{}, entity.Cascade{}, fmt.Errorf("search absences of player: %w", err)
+		}
+		cascade.Absences = len(absences)
+		fails, err := puc.backend.SearchFail(ctx, "", player.ID, -1, "", 0, 0)
+		if err != nil {
+			return entity.Player{}, entity.Cascade{}, fmt.Errorf("search fails of player: %w", err)
+		}
+		cascade.Fails = len(fails)
+		participants, err := puc.backend.SearchParticipant(ctx, -1, player.ID)
+		if err != nil {
+			return entity.Player{}, entity.Cascade{}, fmt.Errorf("search rosters of player: %w", err)
+		}
+		cascade.Roster = len(participants)
+		signups, err := puc.backend.SearchSignup(ctx, -1, player.ID)
+		if err != nil {
+			return entity.Player{}, entity.Cascade{}, fmt.Errorf("search signups of player: %w", err)
+		}
+		cascade.Signups = len(signups)
+		entries, err := puc.backend.SearchPointsEntry(ctx, player.ID, -1, -1)
+		if err != nil {
+			return entity.Player{}, entity.Cascade{}, fmt.Errorf("search points of player: %w", err)
+		}
+		cascade.Points = len(entries)
+		wishes, err := puc.backend.SearchWish(ctx, player.ID, "")
+		if err != nil {
+			return entity.Player{}, entity.Cascade{}, fmt.Errorf("search wishes of player: %w", err)
+		}
+		cascade.Wishes = len(wishes)
+		return player, cascade, nil
+	}
+}
+
+func (puc PlayerUseCase) ReadPlayer(ctx context.Context, playerName, discordID string) (entity.Player, error) {
 	ctx, span := otel.Tracer("Usecase").Start(ctx, "Player/ReadPlayer")
 	defer span.End()
 	span.SetAttributes(
 		attribute.String("playerName", playerName),
-		attribute.String("playerLinkName", playerLinkName),
+		attribute.String("discordID", discordID),
 	)
 	logger.FromContext(ctx).Debug("read player use case")
 
@@ -80,7 +149,6 @@ func (puc PlayerUseCase) ReadPlayer(ctx context.Context, playerName, playerLinkN
 		return entity.Player{}, fmt.Errorf("PlayerUseCase - ReadPlayer - ctx.Done: request took too much time to be proceed")
 	default:
 		playerName := strings.ToLower(playerName)
-		playerLinkName := strings.ToLower(playerLinkName)
 
 		player, err := func() (entity.Player, error) {
 			switch {
@@ -93,48 +161,75 @@ func (puc PlayerUseCase) ReadPlayer(ctx context.Context, playerName, playerLinkN
 					return entity.Player{}, fmt.Errorf("player %s not found", playerName)
 				}
 				return plrs[0], nil
-			case playerLinkName != "":
-				plrs, err := puc.backend.SearchPlayer(ctx, -1, "", playerLinkName)
+			case discordID != "":
+				plrs, err := puc.backend.SearchPlayer(ctx, -1, "", discordID)
 				if err != nil {
 					return entity.Player{}, fmt.Errorf("get basic info for player: %w", err)
 				}
 				if len(plrs) == 0 {
-					return entity.Player{}, fmt.Errorf("didn't find a player linked to this discord user named %s", playerLinkName)
+					return entity.Player{}, fmt.Errorf("didn't find a player linked to this discord user")
 				}
 				return plrs[0], nil
 			default:
-				return entity.Player{}, fmt.Errorf("playerName or discordName must be set")
+				return entity.Player{}, fmt.Errorf("playerName or discordID must be set")
 			}
 		}()
 		if err != nil {
 			return entity.Player{}, err
 		}
+		player, err = withCharacters(ctx, puc.backend, player)
+		if err != nil {
+			return entity.Player{}, err
+		}
 
-		strikes, err := puc.backend.SearchStrike(ctx, player.ID, time.Time{}, "", "")
+		// Strikes and absences are given to the person, loots to each character
+		strikes, err := personStrikes(ctx, puc.backend, player)
 		if err != nil {
 			return entity.Player{}, fmt.Errorf("database - ReadPlayer - r.SearchStrike: %w", err)
 		}
-		player.Strikes = strikes
+		player.Strikes, err = setExpiry(ctx, puc.backend, puc.policy, strikes)
+		if err != nil {
+			return entity.Player{}, fmt.Errorf("set expiry of strikes: %w", err)
+		}
 
-		fails, err := puc.backend.SearchFail(ctx, "", player.ID, -1, "")
+		fails, err := puc.backend.SearchFail(ctx, "", player.ID, -1, "", 0, 0)
 		if err != nil {
 			return entity.Player{}, fmt.Errorf("database - ReadPlayer - r.SearchFail: %w", err)
 		}
 		player.Fails = fails
 
-		loots, err := puc.backend.SearchLoot(ctx, "", time.Time{}, "", player.Name)
+		loots, err := puc.backend.SearchLoot(ctx, "", time.Time{}, "", player.Name, 0, 0)
 		if err != nil {
 			return entity.Player{}, fmt.Errorf("database - ReadPlayer - r.SearchLoot: %w", err)
 		}
 		player.Loots = loots
-
-		missedRaids, err := puc.backend.SearchAbsence(ctx, "", player.ID, time.Time{})
-		if err != nil {
-			return entity.Player{}, fmt.Errorf("database - ReadPlayer - r.SearchAbsence: %w", err)
+		for i, character := range player.Characters {
+			if character.ID == player.ID {
+				player.Characters[i].Loots = loots
+				continue
+			}
+			player.Characters[i].Loots, err = puc.backend.SearchLoot(ctx, "", time.Time{}, "", character.Name, 0, 0)
+			if err != nil {
+				return entity.Player{}, fmt.Errorf("database - ReadPlayer - r.SearchLoot: %w", err)
+			}
 		}
-		for _, missedRaid := range missedRaids {
-			player.MissedRaids = append(player.MissedRaids, *missedRaid.Raid)
+
+		missed := make(map[int]bool)
+		for _, id := range player.CharacterIDs() {
+			missedRaids, err := puc.backend.SearchAbsence(ctx, "", id, time.Time{})
+			if err != nil {
+				return entity.Player{}, fmt.Errorf("database - ReadPlayer - r.SearchAbsence: %w", err)
+			}
+			for _, missedRaid := range missedRaids {
+				if !missed[missedRaid.Raid.ID] {
+					missed[missedRaid.Raid.ID] = true
+					player.MissedRaids = append(player.MissedRaids, *missedRaid.Raid)
+				}
+			}
 		}
+		sort.SliceStable(player.MissedRaids, func(i, j int) bool {
+			return player.MissedRaids[i].Date.Before(player.MissedRaids[j].Date)
+		})
 
 		for k, fail := range fails {
 			r, err := puc.backend.ReadRaid(ctx, fail.Raid.ID)
@@ -148,41 +243,351 @@ func (puc PlayerUseCase) ReadPlayer(ctx context.Context, playerName, playerLinkN
 	}
 }
 
-func (puc PlayerUseCase) LinkPlayer(ctx context.Context, playerName string, discordID string) error {
+// SearchPlayerNames returns the names of the players starting with prefix, ordered.
+// At most limit names are returned, all of them when limit is 0.
+func (puc PlayerUseCase) SearchPlayerNames(ctx context.Context, prefix string, limit int) ([]string, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Player/SearchPlayerNames")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("prefix", prefix),
+		attribute.Int("limit", limit),
+	)
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("PlayerUseCase - SearchPlayerNames - ctx.Done: request took too much time to be proceed")
+	default:
+		players, err := puc.backend.SearchPlayerOnPrefix(ctx, strings.ToLower(strings.TrimSpace(prefix)), limit)
+		if err != nil {
+			return nil, fmt.Errorf("search players on prefix: %w", err)
+		}
+		names := make([]string, 0, len(players))
+		for _, player := range players {
+			names = append(names, player.Name)
+		}
+		return names, nil
+	}
+}
+
+// LinkPlayer links a discord account to a player. The account is identified by discordID,
+// discordName is its username, only kept to be shown. When the discord account is already linked to a main,
+// the player becomes an alt of this main. It returns the player with the characters of its person.
+func (puc PlayerUseCase) LinkPlayer(
+	ctx context.Context, playerName, discordID, discordName string,
+) (entity.Player, error) {
 	ctx, span := otel.Tracer("Usecase").Start(ctx, "Player/LinkPlayer")
 	defer span.End()
 	span.SetAttributes(
 		attribute.String("playerName", playerName),
 		attribute.String("discordID", discordID),
+		attribute.String("discordName", discordName),
 	)
 	select {
 	case <-ctx.Done():
-		return fmt.Errorf("PlayerUseCase - LinkPlayer - ctx.Done: request took too much time to be proceed")
+		return entity.Player{}, fmt.Errorf("PlayerUseCase - LinkPlayer - ctx.Done: request took too much time to be proceed")
 	default:
 		playerName := strings.ToLower(playerName)
-		discordID := strings.ToLower(discordID)
+		if !entity.IsDiscordID(discordID) {
+			return entity.Player{}, fmt.Errorf("discord ID %s is not valid", discordID)
+		}
 
 		alreadyLinked, err := puc.backend.SearchPlayer(ctx, -1, "", discordID)
 		if err != nil {
-			return fmt.Errorf("database - LinkPlayer - r.SearchPlayer: %w", err)
-		}
-		if len(alreadyLinked) > 0 {
-			return fmt.Errorf("discord account already linked to player name %s. Contact Staff for modification",
-				alreadyLinked[0].Name)
+			return entity.Player{}, fmt.Errorf("database - LinkPlayer - r.SearchPlayer: %w", err)
 		}
 
 		player, err := puc.backend.SearchPlayer(ctx, -1, playerName, "")
 		if err != nil {
-			return fmt.Errorf("database - LinkPlayer - r.SearchPlayer: %w", err)
+			return entity.Player{}, fmt.Errorf("database - LinkPlayer - r.SearchPlayer: %w", err)
 		}
 		if len(player) == 0 {
-			return fmt.Errorf("player %s not found", playerName)
+			return entity.Player{}, fmt.Errorf("player %s not found", playerName)
+		}
+
+		if len(alreadyLinked) > 0 {
+			return setMain(ctx, puc.backend, player[0], alreadyLinked[0])
+		}
+		if player[0].IsAlt() {
+			return entity.Player{}, fmt.Errorf("player %s is the alt of another player. Contact Staff for modification",
+				playerName)
 		}
-		player[0].DiscordName = discordID
+		player[0].DiscordID = discordID
+		player[0].DiscordName = strings.ToLower(discordName)
 		err = puc.backend.UpdatePlayer(ctx, player[0])
 		if err != nil {
-			return fmt.Errorf("database - LinkPlayer - r.UpdatePlayer: %w", err)
+			return entity.Player{}, fmt.Errorf("database - LinkPlayer - r.UpdatePlayer: %w", err)
 		}
-		return nil
+		return withCharacters(ctx, puc.backend, player[0])
+	}
+}
+
+// MigrateDiscordLinks links players still linked by discord username to the ID of their discord user,
+// resolved through lookup. The username is kept to be shown. Links which can't be resolved, or resolve to a
+// discord user already linked, match no discord user: they are kept to be resolved later,
+// or removed when removeUnresolved is set. Their players are returned with their username link.
+// It returns the number of migrated links.
+func (puc PlayerUseCase) MigrateDiscordLinks(
+	ctx context.Context, lookup DiscordLookup, removeUnresolved bool,
+) (int, []entity.Player, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Player/MigrateDiscordLinks")
+	defer span.End()
+	select {
+	case <-ctx.Done():
+		return 0, nil, fmt.Errorf("PlayerUseCase - MigrateDiscordLinks - " +
+			"ctx.Done: request took too much time to be proceed")
+	default:
+		players, err := puc.backend.SearchPlayer(ctx, -1, "", "")
+		if err != nil {
+			return 0, nil, fmt.Errorf("database - MigrateDiscordLinks - r.SearchPlayer: %w", err)
+		}
+
+		linked := make(map[string]bool)
+		for _, player := range players {
+			linked[player.DiscordID] = true
+		}
+
+		migrated := 0
+		var unresolved []entity.Player
+		for _, player := range players {
+			if !player.HasUsernameLink() {
+				continue
+			}
+			username := strings.ToLower(player.DiscordID)
+			discordID, found, err := lookup.DiscordUserID(ctx, username)
+			if err != nil {
+				return migrated, unresolved, fmt.Errorf("look up discord user %s: %w", username, err)
+			}
+			// A discord user is linked to one player only, the other characters are its alts
+			found = found && entity.IsDiscordID(discordID) && !linked[discordID]
+			if !found {
+				unresolved = append(unresolved, player)
+				if !removeUnresolved {
+					continue
+				}
+			}
+			player.DiscordID, player.DiscordName = "", username
+			if found {
+				player.DiscordID = discordID
+				linked[discordID] = true
+			}
+			err = puc.backend.UpdatePlayer(ctx, player)
+			if err != nil {
+				return migrated, unresolved, fmt.Errorf("database - MigrateDiscordLinks - r.UpdatePlayer: %w", err)
+			}
+			if found {
+				migrated++
+			}
+		}
+		span.SetAttributes(
+			attribute.Int("migrated", migrated),
+			attribute.Int("unresolved", len(unresolved)),
+		)
+		return migrated, unresolved, nil
+	}
+}
+
+// DiscordIDs is a DiscordLookup reading discord user IDs from a list of usernames and IDs.
+type DiscordIDs map[string]string
+
+// DiscordUserID returns the ID of the discord user with the given username.
+func (ids DiscordIDs) DiscordUserID(_ context.Context, username string) (string, bool, error) {
+	id, ok := ids[strings.ToLower(username)]
+	return id, ok, nil
+}
+
+// ReadDiscordIDs reads discord user IDs from a csv file with a username and an id column.
+func ReadDiscordIDs(r io.Reader) (DiscordIDs, error) {
+	lines, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read csv discord IDs: %w", err)
+	}
+	ids := make(DiscordIDs)
+	if len(lines) == 0 {
+		return ids, nil
+	}
+
+	columns := make(map[string]int)
+	for n, column := range lines[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = n
+	}
+	for _, column := range []string{"username", "id"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("csv discord IDs have no %s column", column)
+		}
+	}
+	for n, line := range lines[1:] {
+		if columns["username"] >= len(line) || columns["id"] >= len(line) {
+			return nil, fmt.Errorf("line %d of csv discord IDs: missing column", n+2)
+		}
+		username := strings.ToLower(strings.TrimSpace(line[columns["username"]]))
+		id := strings.TrimSpace(line[columns["id"]])
+		if !entity.IsDiscordID(id) {
+			return nil, fmt.Errorf("line %d of csv discord IDs: id %s is not a discord ID", n+2, id)
+		}
+		ids[username] = id
+	}
+	return ids, nil
+}
+
+// SetMain makes a player an alt of a main. When mainName is empty, the player becomes a main again.
+// It returns the player with the characters of its person.
+func (puc PlayerUseCase) SetMain(ctx context.Context, playerName, mainName string) (entity.Player, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Player/SetMain")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+		attribute.String("mainName", mainName),
+	)
+	select {
+	case <-ctx.Done():
+		return entity.Player{}, fmt.Errorf("PlayerUseCase - SetMain - ctx.Done: request took too much time to be proceed")
+	default:
+		player, err := findPlayer(ctx, puc.backend, playerName)
+		if err != nil {
+			return entity.Player{}, err
+		}
+		if strings.TrimSpace(mainName) == "" {
+			player.MainID = 0
+			err = puc.backend.UpdatePlayer(ctx, player)
+			if err != nil {
+				return entity.Player{}, fmt.Errorf("database - SetMain - r.UpdatePlayer: %w", err)
+			}
+			return withCharacters(ctx, puc.backend, player)
+		}
+		main, err := findPlayer(ctx, puc.backend, mainName)
+		if err != nil {
+			return entity.Player{}, err
+		}
+		return setMain(ctx, puc.backend, player, main)
+	}
+}
+
+// setMain makes player an alt of main and returns it with the characters of its person.
+// A player with alts can't become an alt.
+func setMain(ctx context.Context, backend Backend, player, main entity.Player) (entity.Player, error) {
+	if player.ID == main.ID || player.MainID == main.ID {
+		return entity.Player{}, fmt.Errorf("player %s is already linked to %s", player.Name, main.Name)
+	}
+	players, err := backend.SearchPlayer(ctx, -1, "", "")
+	if err != nil {
+		return entity.Player{}, fmt.Errorf("search alts of player %s: %w", player.Name, err)
+	}
+	for _, p := range players {
+		if p.MainID == player.ID {
+			return entity.Player{}, fmt.Errorf("player %s has alts, it can't become an alt", player.Name)
+		}
+	}
+	err = player.SetMain(main)
+	if err != nil {
+		return entity.Player{}, fmt.Errorf("set main of player: %w", err)
+	}
+	err = backend.UpdatePlayer(ctx, player)
+	if err != nil {
+		return entity.Player{}, fmt.Errorf("database - SetMain - r.UpdatePlayer: %w", err)
+	}
+	return withCharacters(ctx, backend, player)
+}
+
+// withCharacters returns player with every character of the person it belongs to.
+func withCharacters(ctx context.Context, backend Backend, player entity.Player) (entity.Player, error) {
+	players, err := backend.SearchPlayer(ctx, -1, "", "")
+	if err != nil {
+		return entity.Player{}, fmt.Errorf("search characters of player %s: %w", player.Name, err)
+	}
+	player.Characters = person(players, player).Characters
+	return player, nil
+}
+
+// person returns the main of the person player belongs to among players, with every character of the person.
+// Characters are not set when the person has no alt.
+func person(players []entity.Player, player entity.Player) entity.Player {
+	mainID := player.ID
+	if player.IsAlt() {
+		mainID = player.MainID
+	}
+	main := player
+	var alts []entity.Player
+	for _, p := range players {
+		switch {
+		case p.ID == mainID:
+			main = p
+		case p.MainID == mainID:
+			alts = append(alts, p)
+		}
+	}
+	if len(alts) == 0 {
+		return main
+	}
+	sort.SliceStable(alts, func(i, j int) bool {
+		return alts[i].Name < alts[j].Name
+	})
+	main.Characters = append([]entity.Player{main}, alts...)
+	return main
+}
+
+// personStrikes returns the strikes given to every character of the person player belongs to, sorted by date.
+// player must have its characters set.
+func personStrikes(ctx context.Context, backend Backend, player entity.Player) ([]entity.Strike, error) {
+	var strikes []entity.Strike
+	for _, id := range player.CharacterIDs() {
+		found, err := backend.SearchStrike(ctx, id, time.Time{}, "", "")
+		if err != nil {
+			return nil, fmt.Errorf("search strikes of player %d: %w", id, err)
+		}
+		strikes = append(strikes, found...)
+	}
+	sort.SliceStable(strikes, func(i, j int) bool {
+		return strikes[i].Date.Before(strikes[j].Date)
+	})
+	return strikes, nil
+}
+
+// UpdatePlayer sets the class, main spec, off spec and role of a player.
+// Empty values keep the current ones, except that changing the class clears the specs and the role,
+// and changing the main spec sets the role of the new spec unless a role is given.
+func (puc PlayerUseCase) UpdatePlayer(
+	ctx context.Context, playerName, class, mainSpec, offSpec, role string,
+) (entity.Player, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Player/UpdatePlayer")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+		attribute.String("class", class),
+		attribute.String("mainSpec", mainSpec),
+		attribute.String("offSpec", offSpec),
+		attribute.String("role", role),
+	)
+	select {
+	case <-ctx.Done():
+		return entity.Player{}, fmt.Errorf("PlayerUseCase - UpdatePlayer - " +
+			"ctx.Done: request took too much time to be proceed")
+	default:
+		player, err := findPlayer(ctx, puc.backend, playerName)
+		if err != nil {
+			return entity.Player{}, err
+		}
+
+		newClass, newMainSpec, newOffSpec, newRole := player.Class, player.MainSpec, player.OffSpec, string(player.Role)
+		if class != "" && strings.ToLower(strings.TrimSpace(class)) != player.Class {
+			newClass, newMainSpec, newOffSpec, newRole = class, "", "", ""
+		}
+		if mainSpec != "" {
+			newMainSpec, newRole = mainSpec, ""
+		}
+		if offSpec != "" {
+			newOffSpec = offSpec
+		}
+		if role != "" {
+			newRole = role
+		}
+		err = player.SetClass(newClass, newMainSpec, newOffSpec, newRole)
+		if err != nil {
+			return entity.Player{}, fmt.Errorf("set class of player: %w", err)
+		}
+
+		err = puc.backend.UpdatePlayer(ctx, player)
+		if err != nil {
+			return entity.Player{}, fmt.Errorf("database - UpdatePlayer - r.UpdatePlayer: %w", err)
+		}
+		return player, nil
 	}
 }
@@ -3,7 +3,9 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/antony-ramos/guildops/internal/entity"
 	"github.com/antony-ramos/guildops/internal/usecase"
@@ -20,7 +22,7 @@ func TestPlayerUseCase_CreatePlayer(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		playerUseCase := usecase.NewPlayerUseCase(mockBackend)
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
 
 		player := entity.Player{
 			ID:   1,
@@ -40,7 +42,7 @@ func TestPlayerUseCase_CreatePlayer(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		playerUseCase := usecase.NewPlayerUseCase(mockBackend)
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
 
 		_, err := playerUseCase.CreatePlayer(context.Background(), "")
 		assert.Error(t, err)
@@ -52,7 +54,7 @@ func TestPlayerUseCase_CreatePlayer(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		playerUseCase := usecase.NewPlayerUseCase(mockBackend)
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
 
 		mockBackend.On("CreatePlayer", mock.Anything, mock.Anything, mock.Anything).
 			Return(entity.Player{}, errors.New("Backend Error"))
@@ -69,7 +71,7 @@ func TestPlayerUseCase_CreatePlayer(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		playerUseCase := usecase.NewPlayerUseCase(mockBackend)
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -89,19 +91,152 @@ func TestPlayerUseCase_LinkPlayer(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		playerUseCase := usecase.NewPlayerUseCase(mockBackend)
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
 
-		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, "titi").
+		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, "100000000000000001").
 			Return(nil, nil)
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, "toto", mock.Anything).
 			Return([]entity.Player{{Name: "toto"}}, nil)
 
-		mockBackend.On("UpdatePlayer", mock.Anything, entity.Player{Name: "toto", DiscordName: "titi"}).
+		mockBackend.On("UpdatePlayer", mock.Anything,
+			entity.Player{Name: "toto", DiscordID: "100000000000000001", DiscordName: "titi"}).
 			Return(nil)
 
-		err := playerUseCase.LinkPlayer(context.Background(), "toto", "titi")
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").
+			Return([]entity.Player{{Name: "toto", DiscordID: "100000000000000001", DiscordName: "titi"}}, nil)
+
+		player, err := playerUseCase.LinkPlayer(context.Background(), "toto", "100000000000000001", "Titi")
+		assert.NoError(t, err)
+		assert.False(t, player.IsAlt())
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Second character becomes an alt", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		arthas := entity.Player{ID: 1, Name: "arthas", DiscordID: "100000000000000001", DiscordName: "titi"}
+		lichking := entity.Player{ID: 2, Name: "lichking"}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "100000000000000001").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "lichking", "").Return([]entity.Player{lichking}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").
+			Return([]entity.Player{arthas, lichking}, nil).Once()
+		mockBackend.On("UpdatePlayer", mock.Anything, entity.Player{ID: 2, Name: "lichking", MainID: 1}).Return(nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").
+			Return([]entity.Player{arthas, {ID: 2, Name: "lichking", MainID: 1}}, nil).Once()
+
+		player, err := playerUseCase.LinkPlayer(context.Background(), "lichking", "100000000000000001", "titi")
+		assert.NoError(t, err)
+		assert.True(t, player.IsAlt())
+		assert.Equal(t, "arthas", player.Main().Name)
+		assert.Equal(t, []int{1, 2}, player.CharacterIDs())
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Player with alts can't become an alt", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		arthas := entity.Player{ID: 1, Name: "arthas", DiscordID: "100000000000000001", DiscordName: "titi"}
+		jaina := entity.Player{ID: 2, Name: "jaina"}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "100000000000000001").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "jaina", "").Return([]entity.Player{jaina}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").
+			Return([]entity.Player{arthas, jaina, {ID: 3, Name: "proudmoore", MainID: 2}}, nil)
+
+		_, err := playerUseCase.LinkPlayer(context.Background(), "jaina", "100000000000000001", "titi")
+		assert.ErrorContains(t, err, "player jaina has alts, it can't become an alt")
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := playerUseCase.LinkPlayer(ctx, "toto", "100000000000000001", "titi")
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Username is not a discord ID", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		_, err := playerUseCase.LinkPlayer(context.Background(), "toto", "titi", "titi")
+		assert.ErrorContains(t, err, "discord ID titi is not valid")
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestPlayerUseCase_MigrateDiscordLinks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		thrall := entity.Player{ID: 3, Name: "thrall", DiscordID: "thrall"}
+		uther := entity.Player{ID: 4, Name: "uther", DiscordID: "uther"}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{
+			{ID: 1, Name: "arthas", DiscordID: "Arthas"},
+			{ID: 2, Name: "jaina", DiscordID: "100000000000000002", DiscordName: "jaina"},
+			{ID: 3, Name: "thrall", DiscordID: "thrall"},
+			{ID: 4, Name: "uther", DiscordID: "uther"},
+			{ID: 5, Name: "sylvanas"},
+		}, nil)
+		mockBackend.On("UpdatePlayer", mock.Anything,
+			entity.Player{ID: 1, Name: "arthas", DiscordID: "100000000000000001", DiscordName: "arthas"}).Return(nil)
+		mockBackend.On("UpdatePlayer", mock.Anything, entity.Player{ID: 3, Name: "thrall", DiscordName: "thrall"}).
+			Return(nil)
+		mockBackend.On("UpdatePlayer", mock.Anything, entity.Player{ID: 4, Name: "uther", DiscordName: "uther"}).
+			Return(nil)
+
+		lookup := usecase.DiscordIDs{"arthas": "100000000000000001", "uther": "100000000000000002"}
+		migrated, unresolved, err := playerUseCase.MigrateDiscordLinks(context.Background(), lookup, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, migrated)
+		assert.Equal(t, []entity.Player{thrall, uther}, unresolved)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Unresolved links are kept", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		thrall := entity.Player{ID: 3, Name: "thrall", DiscordID: "thrall"}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{
+			{ID: 1, Name: "arthas", DiscordID: "arthas"},
+			thrall,
+		}, nil)
+		mockBackend.On("UpdatePlayer", mock.Anything,
+			entity.Player{ID: 1, Name: "arthas", DiscordID: "100000000000000001", DiscordName: "arthas"}).Return(nil)
+
+		lookup := usecase.DiscordIDs{"arthas": "100000000000000001"}
+		migrated, unresolved, err := playerUseCase.MigrateDiscordLinks(context.Background(), lookup, false)
 		assert.NoError(t, err)
+		assert.Equal(t, 1, migrated)
+		assert.Equal(t, []entity.Player{thrall}, unresolved)
 		mockBackend.AssertExpectations(t)
 	})
 
@@ -110,16 +245,30 @@ func TestPlayerUseCase_LinkPlayer(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		playerUseCase := usecase.NewPlayerUseCase(mockBackend)
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
-		err := playerUseCase.LinkPlayer(ctx, "toto", "titi")
+		_, _, err := playerUseCase.MigrateDiscordLinks(ctx, usecase.DiscordIDs{}, false)
 		assert.Error(t, err)
 		mockBackend.AssertExpectations(t)
 	})
 }
 
+func TestReadDiscordIDs(t *testing.T) {
+	t.Parallel()
+
+	ids, err := usecase.ReadDiscordIDs(strings.NewReader("id,username\n100000000000000001, Arthas\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, usecase.DiscordIDs{"arthas": "100000000000000001"}, ids)
+
+	_, err = usecase.ReadDiscordIDs(strings.NewReader("name,id\narthas,100000000000000001\n"))
+	assert.ErrorContains(t, err, "no username column")
+
+	_, err = usecase.ReadDiscordIDs(strings.NewReader("username,id\narthas,arthas\n"))
+	assert.ErrorContains(t, err, "line 2 of csv discord IDs")
+}
+
 func TestPlayerUseCase_DeletePlayer(t *testing.T) {
 	t.Parallel()
 
@@ -128,7 +277,7 @@ func TestPlayerUseCase_DeletePlayer(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		playerUseCase := usecase.NewPlayerUseCase(mockBackend)
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
 
 		mockBackend.On("DeletePlayer", mock.Anything, mock.Anything).
 			Return(nil)
@@ -138,3 +287,216 @@ func TestPlayerUseCase_DeletePlayer(t *testing.T) {
 		mockBackend.AssertExpectations(t)
 	})
 }
+
+func TestPlayerUseCase_ReadPlayerCascade(t *testing.T) {
+	t.Parallel()
+
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchStrike", mock.Anything, 1, time.Time{}, "", "").
+			Return([]entity.Strike{{ID: 1}, {ID: 2}}, nil)
+		mockBackend.On("SearchLoot", mock.Anything, "", time.Time{}, "", "arthas", 0, 0).
+			Return([]entity.Loot{{ID: 1}}, nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", 1, time.Time{}).Return(nil, nil)
+		mockBackend.On("SearchFail", mock.Anything, "", 1, -1, "", 0, 0).Return([]entity.Fail{{ID: 1}}, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, -1, 1).
+			Return([]entity.Participant{{Player: &arthas}}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, -1, 1).Return(nil, nil)
+		mockBackend.On("SearchPointsEntry", mock.Anything, 1, -1, -1).
+			Return([]entity.PointsEntry{{ID: 1}, {ID: 2}, {ID: 3}}, nil)
+		mockBackend.On("SearchWish", mock.Anything, 1, "").Return([]entity.Wish{{ID: 1}}, nil)
+
+		player, cascade, err := playerUseCase.ReadPlayerCascade(context.Background(), "Arthas")
+		assert.NoError(t, err)
+		assert.Equal(t, arthas, player)
+		assert.Equal(t, entity.Cascade{Strikes: 2, Loots: 1, Fails: 1, Roster: 1, Points: 3, Wishes: 1}, cascade)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return(nil, nil)
+
+		_, _, err := playerUseCase.ReadPlayerCascade(context.Background(), "arthas")
+		assert.ErrorContains(t, err, "player arthas not found")
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestPlayerUseCase_SearchPlayerNames(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		mockBackend.On("SearchPlayerOnPrefix", mock.Anything, "ar", 25).
+			Return([]entity.Player{{ID: 1, Name: "arthas"}, {ID: 2, Name: "arugal"}}, nil)
+
+		names, err := playerUseCase.SearchPlayerNames(context.Background(), "Ar", 25)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"arthas", "arugal"}, names)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Backend Error", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		mockBackend.On("SearchPlayerOnPrefix", mock.Anything, "ar", 25).Return(nil, errors.New("Backend Error"))
+
+		_, err := playerUseCase.SearchPlayerNames(context.Background(), "ar", 25)
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestPlayerUseCase_UpdatePlayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "uther", "").
+			Return([]entity.Player{{ID: 1, Name: "uther", Class: "paladin", MainSpec: "holy", Role: entity.RoleHealer}}, nil)
+		want := entity.Player{
+			ID: 1, Name: "uther", Class: "paladin", MainSpec: "protection", OffSpec: "holy", Role: entity.RoleTank,
+		}
+		mockBackend.On("UpdatePlayer", mock.Anything, want).Return(nil)
+
+		player, err := playerUseCase.UpdatePlayer(context.Background(), "Uther", "", "protection", "holy", "")
+		assert.NoError(t, err)
+		assert.Equal(t, want, player)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Class change clears specs", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "uther", "").
+			Return([]entity.Player{{ID: 1, Name: "uther", Class: "paladin", MainSpec: "holy", Role: entity.RoleHealer}}, nil)
+		want := entity.Player{ID: 1, Name: "uther", Class: "priest"}
+		mockBackend.On("UpdatePlayer", mock.Anything, want).Return(nil)
+
+		player, err := playerUseCase.UpdatePlayer(context.Background(), "uther", "priest", "", "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, want, player)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Invalid spec", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "uther", "").
+			Return([]entity.Player{{ID: 1, Name: "uther", Class: "paladin"}}, nil)
+
+		_, err := playerUseCase.UpdatePlayer(context.Background(), "uther", "", "frost", "", "")
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "uther", "").Return(nil, nil)
+
+		_, err := playerUseCase.UpdatePlayer(context.Background(), "uther", "paladin", "", "", "")
+		assert.ErrorContains(t, err, "player uther not found")
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := playerUseCase.UpdatePlayer(ctx, "uther", "paladin", "", "", "")
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestPlayerUseCase_ReadPlayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Characters are rolled up", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		playerUseCase := usecase.NewPlayerUseCase(mockBackend, entity.StrikePolicy{})
+
+		arthas := entity.Player{ID: 1, Name: "arthas", DiscordID: "100000000000000001", DiscordName: "titi"}
+		lichking := entity.Player{ID: 2, Name: "lichking", MainID: 1}
+		first := entity.Raid{ID: 1, Date: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)}
+		second := entity.Raid{ID: 2, Date: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "100000000000000001").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{lichking, arthas}, nil)
+		mockBackend.On("SearchStrike", mock.Anything, 1, time.Time{}, "", "").
+			Return([]entity.Strike{{ID: 2, Date: second.Date}}, nil)
+		mockBackend.On("SearchStrike", mock.Anything, 2, time.Time{}, "", "").
+			Return([]entity.Strike{{ID: 1, Date: first.Date}}, nil)
+		mockBackend.On("SearchFail", mock.Anything, "", 1, -1, "", 0, 0).Return(nil, nil)
+		mockBackend.On("SearchLoot", mock.Anything, "", time.Time{}, "", "arthas", 0, 0).Return(nil, nil)
+		mockBackend.On("SearchLoot", mock.Anything, "", time.Time{}, "", "lichking", 0, 0).
+			Return([]entity.Loot{{ID: 1, Name: "frostmourne", Raid: &first}}, nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", 1, time.Time{}).
+			Return([]entity.Absence{{Raid: &second}}, nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", 2, time.Time{}).
+			Return([]entity.Absence{{Raid: &first}, {Raid: &second}}, nil)
+
+		player, err := playerUseCase.ReadPlayer(context.Background(), "", "100000000000000001")
+		assert.NoError(t, err)
+		assert.Empty(t, player.Loots)
+		if assert.Len(t, player.Characters, 2) {
+			assert.Equal(t, "arthas", player.Characters[0].Name)
+			assert.Len(t, player.Characters[1].Loots, 1)
+		}
+		if assert.Len(t, player.Strikes, 2) {
+			assert.Equal(t, 1, player.Strikes[0].ID)
+		}
+		if assert.Len(t, player.MissedRaids, 2) {
+			assert.Equal(t, 1, player.MissedRaids[0].ID)
+		}
+		mockBackend.AssertExpectations(t)
+	})
+}
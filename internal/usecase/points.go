@@ -0,0 +1,366 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// PointsUseCase is the use case for the points ledger.
+type PointsUseCase struct {
+	backend Backend
+	policy  entity.PointsPolicy
+}
+
+// NewPointsUseCase returns a new PointsUseCase decaying points with policy.
+func NewPointsUseCase(bk Backend, policy entity.PointsPolicy) *PointsUseCase {
+	return &PointsUseCase{backend: bk, policy: policy}
+}
+
+// ReadBalance returns the ledger of a player and the points it sums up to.
+func (p PointsUseCase) ReadBalance(ctx context.Context, playerName string) (entity.PointsBalance, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Points/ReadBalance")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.PointsBalance{}, fmt.Errorf(
+			"PointsUseCase - ReadBalance - ctx.Done: request took too much time to be proceed")
+	default:
+		player, err := findPlayer(ctx, p.backend, playerName)
+		if err != nil {
+			return entity.PointsBalance{}, err
+		}
+		entries, err := p.backend.SearchPointsEntry(ctx, player.ID, -1, -1)
+		if err != nil {
+			return entity.PointsBalance{}, fmt.Errorf("search points of %s: %w", player.Name, err)
+		}
+		return entity.NewPointsBalance(&player, entries), nil
+	}
+}
+
+// ListStandings returns the balance of every player, most points first, then by player name.
+// Entries of balances are not filled.
+func (p PointsUseCase) ListStandings(ctx context.Context) ([]entity.PointsBalance, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Points/ListStandings")
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("PointsUseCase - ListStandings - ctx.Done: request took too much time to be proceed")
+	default:
+		return standings(ctx, p.backend)
+	}
+}
+
+// AwardPoints gives points to a player, or takes them back when amount is negative.
+func (p PointsUseCase) AwardPoints(
+	ctx context.Context, playerName string, amount int, reason, author string,
+) (entity.PointsEntry, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Points/AwardPoints")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+		attribute.Int("amount", amount),
+		attribute.String("reason", reason),
+		attribute.String("author", author),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.PointsEntry{}, fmt.Errorf(
+			"PointsUseCase - AwardPoints - ctx.Done: request took too much time to be proceed")
+	default:
+		player, err := findPlayer(ctx, p.backend, playerName)
+		if err != nil {
+			return entity.PointsEntry{}, err
+		}
+		entry, err := entity.NewPointsEntry(&player, amount, entity.PointsAward, reason, author)
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("create entity points entry: %w", err)
+		}
+		entry, err = p.backend.CreatePointsEntry(ctx, entry)
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("save points entry: %w", err)
+		}
+		return entry, nil
+	}
+}
+
+// RevertPointsEntry undoes an entry of the ledger with a reversal entry giving the points back.
+// A reversal cannot be reverted, and an entry can only be reverted once.
+func (p PointsUseCase) RevertPointsEntry(
+	ctx context.Context, entryID int, reason, author string,
+) (entity.PointsEntry, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Points/RevertPointsEntry")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("entryID", entryID),
+		attribute.String("reason", reason),
+		attribute.String("author", author),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.PointsEntry{}, fmt.Errorf(
+			"PointsUseCase - RevertPointsEntry - ctx.Done: request took too much time to be proceed")
+	default:
+		entry, err := p.backend.ReadPointsEntry(ctx, entryID)
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("read points entry %d: %w", entryID, err)
+		}
+		return revertPoints(ctx, p.backend, entry, reason, author)
+	}
+}
+
+// PreviewDecay returns the decay entries DecayPoints would write, without writing them.
+func (p PointsUseCase) PreviewDecay(ctx context.Context, percent int, author string) ([]entity.PointsEntry, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Points/PreviewDecay")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("percent", percent),
+		attribute.String("author", author),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("PointsUseCase - PreviewDecay - ctx.Done: request took too much time to be proceed")
+	default:
+		return p.decayEntries(ctx, percent, author)
+	}
+}
+
+// DecayPoints removes percent of every positive balance, rounded. The decay of the policy is used
+// when percent is 0. It returns the decay entries written.
+func (p PointsUseCase) DecayPoints(ctx context.Context, percent int, author string) ([]entity.PointsEntry, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Points/DecayPoints")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("percent", percent),
+		attribute.String("author", author),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("PointsUseCase - DecayPoints - ctx.Done: request took too much time to be proceed")
+	default:
+		decays, err := p.decayEntries(ctx, percent, author)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]entity.PointsEntry, 0, len(decays))
+		for _, decay := range decays {
+			entry, err := p.backend.CreatePointsEntry(ctx, decay)
+			if err != nil {
+				return entries, fmt.Errorf("save decay of %s: %w", decay.Player.Name, err)
+			}
+			entries = append(entries, entry)
+		}
+		return entries, nil
+	}
+}
+
+// decayEntries returns the entries removing percent of every positive balance, or the decay of the policy
+// when percent is 0. Balances left unchanged by the rounding get no entry.
+func (p PointsUseCase) decayEntries(ctx context.Context, percent int, author string) ([]entity.PointsEntry, error) {
+	if percent == 0 {
+		percent = p.policy.DecayPercent
+	}
+	if percent <= 0 || percent > 100 {
+		return nil, fmt.Errorf("check decay: percent must be between 1 and 100")
+	}
+
+	balances, err := standings(ctx, p.backend)
+	if err != nil {
+		return nil, err
+	}
+	var entries []entity.PointsEntry
+	for _, balance := range balances {
+		amount := entity.DecayPoints(balance.Points, percent)
+		if amount == 0 {
+			continue
+		}
+		entry, err := entity.NewPointsEntry(balance.Player, amount, entity.PointsDecay,
+			fmt.Sprintf("decay of %d%%", percent), author)
+		if err != nil {
+			return nil, fmt.Errorf("create entity points entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// findPlayer returns the player with this name.
+func findPlayer(ctx context.Context, backend Backend, playerName string) (entity.Player, error) {
+	playerName = strings.ToLower(strings.TrimSpace(playerName))
+	players, err := backend.SearchPlayer(ctx, -1, playerName, "")
+	if err != nil {
+		return entity.Player{}, fmt.Errorf("search player %s: %w", playerName, err)
+	}
+	if len(players) == 0 {
+		return entity.Player{}, fmt.Errorf("check player exists: player %s not found", playerName)
+	}
+	return players[0], nil
+}
+
+// standings returns the balance of every player, most points first, then by player name.
+func standings(ctx context.Context, backend Backend) ([]entity.PointsBalance, error) {
+	players, err := backend.SearchPlayer(ctx, -1, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("search players: %w", err)
+	}
+	points, err := pointsByPlayer(ctx, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]entity.PointsBalance, 0, len(players))
+	for i := range players {
+		balances = append(balances, entity.PointsBalance{Player: &players[i], Points: points[players[i].ID]})
+	}
+	sort.SliceStable(balances, func(i, j int) bool {
+		if balances[i].Points != balances[j].Points {
+			return balances[i].Points > balances[j].Points
+		}
+		return balances[i].Player.Name < balances[j].Player.Name
+	})
+	return balances, nil
+}
+
+// pointsByPlayer returns the points of every player who has entries in the ledger, by player ID.
+func pointsByPlayer(ctx context.Context, backend Backend) (map[int]int, error) {
+	entries, err := backend.SearchPointsEntry(ctx, -1, -1, -1)
+	if err != nil {
+		return nil, fmt.Errorf("search points: %w", err)
+	}
+	points := make(map[int]int)
+	for _, entry := range entries {
+		points[entry.Player.ID] += entry.Amount
+	}
+	return points, nil
+}
+
+// revertPoints writes the reversal of an entry. The reversal keeps the raid and the loot of the entry,
+// so searching entries of a raid or a loot also returns their reversals.
+func revertPoints(
+	ctx context.Context, backend Backend, entry entity.PointsEntry, reason, author string,
+) (entity.PointsEntry, error) {
+	if entry.Kind == entity.PointsReversal {
+		return entity.PointsEntry{}, fmt.Errorf("check points entry: a reversal cannot be reverted")
+	}
+	if reason == "" {
+		reason = "revert of #" + fmt.Sprint(entry.ID)
+	}
+	reversal, err := entity.NewPointsEntry(entry.Player, -entry.Amount, entity.PointsReversal, reason, author)
+	if err != nil {
+		return entity.PointsEntry{}, fmt.Errorf("create entity points entry: %w", err)
+	}
+	reversal.Reverts = entry.ID
+	reversal.RaidID = entry.RaidID
+	reversal.LootID = entry.LootID
+	reversal, err = backend.CreatePointsEntry(ctx, reversal)
+	if err != nil {
+		return entity.PointsEntry{}, fmt.Errorf("save reversal of points entry %d: %w", entry.ID, err)
+	}
+	return reversal, nil
+}
+
+// Reasons of the reversals refunding the points of a deleted raid or loot.
+const (
+	raidDeletedReason = "raid deleted"
+	lootDeletedReason = "loot deleted"
+)
+
+// revertAll reverts every entry which is not a reversal and not reverted yet among entries.
+func revertAll(ctx context.Context, backend Backend, entries []entity.PointsEntry, reason string) error {
+	reversed := entity.Reversed(entries)
+	for _, entry := range entries {
+		if entry.Kind == entity.PointsReversal || reversed[entry.ID] {
+			continue
+		}
+		_, err := revertPoints(ctx, backend, entry, reason, entity.PointsSystemAuthor)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// spendOnLoot writes the points spent by the player receiving a loot, if loots cost points.
+func spendOnLoot(ctx context.Context, backend Backend, policy entity.PointsPolicy, loot entity.Loot) error {
+	if policy.LootCost == 0 {
+		return nil
+	}
+	entry, err := entity.NewPointsEntry(loot.Player, -policy.LootCost, entity.PointsLoot,
+		fmt.Sprintf("%s on %s %s", loot.Name, loot.Raid.Difficulty, loot.Raid.Date.Format("02/01/06")), "")
+	if err != nil {
+		return fmt.Errorf("create entity points entry: %w", err)
+	}
+	entry.RaidID = loot.Raid.ID
+	entry.LootID = loot.ID
+	_, err = backend.CreatePointsEntry(ctx, entry)
+	if err != nil {
+		return fmt.Errorf("save points spent on loot: %w", err)
+	}
+	return nil
+}
+
+// earnOnRaid writes the attendance points of the roster of a raid: players who attended the raid
+// earn points once, players who no longer attended it or were removed from the roster lose them.
+func earnOnRaid(
+	ctx context.Context, backend Backend, policy entity.PointsPolicy, raid entity.Raid,
+	participants []entity.Participant, removed []*entity.Player,
+) error {
+	if policy.Attendance == 0 {
+		return nil
+	}
+	entries, err := backend.SearchPointsEntry(ctx, -1, raid.ID, -1)
+	if err != nil {
+		return fmt.Errorf("search points of raid: %w", err)
+	}
+	reversed := entity.Reversed(entries)
+	earned := make(map[int][]entity.PointsEntry)
+	for _, entry := range entries {
+		if entry.Kind == entity.PointsAttendance && !reversed[entry.ID] {
+			earned[entry.Player.ID] = append(earned[entry.Player.ID], entry)
+		}
+	}
+
+	for _, participant := range participants {
+		attended := participant.Status != entity.ParticipantAbsent
+		switch {
+		case attended && len(earned[participant.Player.ID]) == 0:
+			entry, err := entity.NewPointsEntry(participant.Player, policy.Attendance, entity.PointsAttendance,
+				fmt.Sprintf("%s %s on %s", raid.Name, raid.Difficulty, raid.Date.Format("02/01/06")), "")
+			if err != nil {
+				return fmt.Errorf("create entity points entry: %w", err)
+			}
+			entry.RaidID = raid.ID
+			_, err = backend.CreatePointsEntry(ctx, entry)
+			if err != nil {
+				return fmt.Errorf("save attendance points of %s: %w", participant.Player.Name, err)
+			}
+		case !attended:
+			err := revertAll(ctx, backend, earned[participant.Player.ID], "absent from the raid")
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, player := range removed {
+		err := revertAll(ctx, backend, earned[player.ID], "removed from the roster")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
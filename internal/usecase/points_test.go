@@ -0,0 +1,222 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/mocks"
+)
+
+func TestPointsUseCase_ReadBalance(t *testing.T) {
+	t.Parallel()
+
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		pointsUseCase := usecase.NewPointsUseCase(mockBackend, entity.PointsPolicy{})
+
+		entries := []entity.PointsEntry{
+			{ID: 1, Player: &arthas, Amount: 10, Kind: entity.PointsAttendance},
+			{ID: 2, Player: &arthas, Amount: -20, Kind: entity.PointsLoot},
+		}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchPointsEntry", mock.Anything, 1, -1, -1).Return(entries, nil)
+
+		balance, err := pointsUseCase.ReadBalance(context.Background(), "Arthas")
+		assert.NoError(t, err)
+		assert.Equal(t, -10, balance.Points)
+		assert.Equal(t, entries, balance.Entries)
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		pointsUseCase := usecase.NewPointsUseCase(mockBackend, entity.PointsPolicy{})
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return(nil, nil)
+
+		_, err := pointsUseCase.ReadBalance(context.Background(), "arthas")
+		assert.ErrorContains(t, err, "player arthas not found")
+	})
+}
+
+func TestPointsUseCase_AwardPoints(t *testing.T) {
+	t.Parallel()
+
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		pointsUseCase := usecase.NewPointsUseCase(mockBackend, entity.PointsPolicy{})
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("CreatePointsEntry", mock.Anything, mock.MatchedBy(func(entry entity.PointsEntry) bool {
+			return entry.Player.ID == 1 && entry.Amount == 15 && entry.Kind == entity.PointsAward &&
+				entry.Reason == "first kill" && entry.Author == "thrall"
+		})).Return(entity.PointsEntry{ID: 3, Player: &arthas, Amount: 15}, nil)
+
+		entry, err := pointsUseCase.AwardPoints(context.Background(), "arthas", 15, "first kill", "thrall")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, entry.ID)
+	})
+
+	t.Run("Amount is 0", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		pointsUseCase := usecase.NewPointsUseCase(mockBackend, entity.PointsPolicy{})
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+
+		_, err := pointsUseCase.AwardPoints(context.Background(), "arthas", 0, "first kill", "thrall")
+		assert.ErrorContains(t, err, "amount cannot be 0")
+	})
+}
+
+func TestPointsUseCase_RevertPointsEntry(t *testing.T) {
+	t.Parallel()
+
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		pointsUseCase := usecase.NewPointsUseCase(mockBackend, entity.PointsPolicy{})
+
+		mockBackend.On("ReadPointsEntry", mock.Anything, 4).Return(entity.PointsEntry{
+			ID: 4, Player: &arthas, Amount: -20, Kind: entity.PointsLoot, RaidID: 2, LootID: 3,
+		}, nil)
+		mockBackend.On("CreatePointsEntry", mock.Anything, mock.MatchedBy(func(entry entity.PointsEntry) bool {
+			return entry.Amount == 20 && entry.Kind == entity.PointsReversal && entry.Reverts == 4 &&
+				entry.RaidID == 2 && entry.LootID == 3 && entry.Reason == "revert of #4" && entry.Author == "thrall"
+		})).Return(entity.PointsEntry{ID: 5, Player: &arthas, Amount: 20, Reverts: 4}, nil)
+
+		entry, err := pointsUseCase.RevertPointsEntry(context.Background(), 4, "", "thrall")
+		assert.NoError(t, err)
+		assert.Equal(t, 5, entry.ID)
+	})
+
+	t.Run("Reversal cannot be reverted", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		pointsUseCase := usecase.NewPointsUseCase(mockBackend, entity.PointsPolicy{})
+
+		mockBackend.On("ReadPointsEntry", mock.Anything, 5).Return(entity.PointsEntry{
+			ID: 5, Player: &arthas, Amount: 20, Kind: entity.PointsReversal, Reverts: 4,
+		}, nil)
+
+		_, err := pointsUseCase.RevertPointsEntry(context.Background(), 5, "", "thrall")
+		assert.ErrorContains(t, err, "a reversal cannot be reverted")
+	})
+}
+
+func TestPointsUseCase_DecayPoints(t *testing.T) {
+	t.Parallel()
+
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+	jaina := entity.Player{ID: 2, Name: "jaina"}
+	thrall := entity.Player{ID: 3, Name: "thrall"}
+
+	t.Run("Decay of the policy", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		pointsUseCase := usecase.NewPointsUseCase(mockBackend, entity.PointsPolicy{DecayPercent: 10})
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{arthas, jaina, thrall}, nil)
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, -1, -1).Return([]entity.PointsEntry{
+			{ID: 1, Player: &arthas, Amount: 100, Kind: entity.PointsAward},
+			{ID: 2, Player: &jaina, Amount: -20, Kind: entity.PointsLoot},
+		}, nil)
+		mockBackend.On("CreatePointsEntry", mock.Anything, mock.MatchedBy(func(entry entity.PointsEntry) bool {
+			return entry.Player.ID == 1 && entry.Amount == -10 && entry.Kind == entity.PointsDecay &&
+				entry.Reason == "decay of 10%"
+		})).Return(entity.PointsEntry{ID: 3, Player: &arthas, Amount: -10}, nil).Once()
+
+		entries, err := pointsUseCase.DecayPoints(context.Background(), 0, "thrall")
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("No decay set", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		pointsUseCase := usecase.NewPointsUseCase(mockBackend, entity.PointsPolicy{})
+
+		_, err := pointsUseCase.DecayPoints(context.Background(), 0, "thrall")
+		assert.ErrorContains(t, err, "percent must be between 1 and 100")
+	})
+}
+
+func TestPointsUseCase_PreviewDecay(t *testing.T) {
+	t.Parallel()
+
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+
+	mockBackend := mocks.NewBackend(t)
+
+	pointsUseCase := usecase.NewPointsUseCase(mockBackend, entity.PointsPolicy{DecayPercent: 10})
+
+	mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{arthas}, nil)
+	mockBackend.On("SearchPointsEntry", mock.Anything, -1, -1, -1).Return([]entity.PointsEntry{
+		{ID: 1, Player: &arthas, Amount: 100, Kind: entity.PointsAward},
+	}, nil)
+
+	entries, err := pointsUseCase.PreviewDecay(context.Background(), 20, "thrall")
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, -20, entries[0].Amount)
+		assert.Equal(t, "decay of 20%", entries[0].Reason)
+	}
+	mockBackend.AssertNotCalled(t, "CreatePointsEntry", mock.Anything, mock.Anything)
+}
+
+func TestPointsUseCase_ListStandings(t *testing.T) {
+	t.Parallel()
+
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+	jaina := entity.Player{ID: 2, Name: "jaina"}
+	thrall := entity.Player{ID: 3, Name: "thrall"}
+
+	mockBackend := mocks.NewBackend(t)
+
+	pointsUseCase := usecase.NewPointsUseCase(mockBackend, entity.PointsPolicy{})
+
+	mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{arthas, jaina, thrall}, nil)
+	mockBackend.On("SearchPointsEntry", mock.Anything, -1, -1, -1).Return([]entity.PointsEntry{
+		{ID: 1, Player: &thrall, Amount: 30, Kind: entity.PointsAward},
+		{ID: 2, Player: &jaina, Amount: -20, Kind: entity.PointsLoot},
+	}, nil)
+
+	balances, err := pointsUseCase.ListStandings(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, balances, 3)
+	assert.Equal(t, "thrall", balances[0].Player.Name)
+	assert.Equal(t, 30, balances[0].Points)
+	assert.Equal(t, "arthas", balances[1].Player.Name)
+	assert.Equal(t, 0, balances[1].Points)
+	assert.Equal(t, "jaina", balances[2].Player.Name)
+}
@@ -31,7 +31,8 @@ func (pg *PG) searchAbsenceOnParam(ctx context.Context, paramName string, param
 			From("absences").
 			Join("raids ON raids.id = absences.raid_id").
 			Join("players ON players.id = absences.player_id").
-			Where(fmt.Sprintf("%s = $1", paramName)).ToSql()
+			Where(fmt.Sprintf("%s = $1", paramName)).
+			Where("raids.deleted_at IS NULL AND players.deleted_at IS NULL").ToSql()
 		if err != nil {
 			return nil, fmt.Errorf("database - SearchAbsence - searchAbsenceOnParam - r.Builder: %w", err)
 		}
@@ -165,7 +166,9 @@ func (pg *PG) ReadAbsence(ctx context.Context, absenceID int) (entity.Absence, e
 	case <-ctx.Done():
 		return entity.Absence{}, fmt.Errorf("database - ReadAbsence - ctx.Done: request took too much time to be proceed")
 	default:
-		sql, _, err := pg.Builder.Select("id", "player_id", "raid_id").From("absences").Where("id = $1").ToSql()
+		sql, _, err := pg.Builder.Select("id", "player_id", "raid_id").From("absences").Where("id = $1").
+			Where("player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)").
+			Where("raid_id IN (SELECT id FROM raids WHERE deleted_at IS NULL)").ToSql()
 		if err != nil {
 			return entity.Absence{}, fmt.Errorf("database - ReadAbsence - r.Builder: %w", err)
 		}
@@ -174,7 +177,7 @@ func (pg *PG) ReadAbsence(ctx context.Context, absenceID int) (entity.Absence, e
 			return entity.Absence{}, fmt.Errorf("database - ReadAbsence - r.Pool.Query: %w", err)
 		}
 		defer rows.Close()
-		var absence entity.Absence
+		absence := entity.Absence{Player: &entity.Player{}, Raid: &entity.Raid{}}
 		if rows.Next() {
 			err := rows.Scan(&absence.ID, &absence.Player.ID, &absence.Raid.ID)
 			if err != nil {
@@ -202,7 +205,7 @@ func (pg *PG) UpdateAbsence(ctx context.Context, absence entity.Absence) error {
 			Update("absences").
 			Set("player_id", absence.Player.ID).
 			Set("raid_id", absence.Raid.ID).
-			Where("id = $1").ToSql()
+			Where("id = ?", absence.ID).ToSql()
 		if err != nil {
 			return fmt.Errorf("database - UpdateAbsence - r.Builder: %w", err)
 		}
@@ -41,7 +41,7 @@ func TestPG_SearchAbsence(t *testing.T) {
 				"FROM absences "+
 				"JOIN raids ON raids.id = absences.raid_id "+
 				"JOIN players ON players.id = absences.player_id "+
-				"WHERE players.name = $1", "test").
+				"WHERE players.name = $1 AND raids.deleted_at IS NULL AND players.deleted_at IS NULL", "test").
 			Return(pgxRows, nil)
 
 		absence, err := pgBackend.SearchAbsence(context.Background(), "test", -1, time.Now())
@@ -13,11 +13,16 @@ import (
 )
 
 // SearchFailOnParam is a function which call backend to Search an entity.Fail on a given parameter.
-func (pg *PG) SearchFailOnParam(ctx context.Context, paramName string, param interface{}) ([]entity.Fail, error) {
+// Fails are ordered by ID, at most limit after the first offset ones are returned, all of them when limit is 0.
+func (pg *PG) SearchFailOnParam(
+	ctx context.Context, paramName string, param interface{}, limit, offset int,
+) ([]entity.Fail, error) {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Fail/SearchFailOnParam")
 	span.SetAttributes(
 		attribute.String("paramName", paramName),
-		attribute.String("param", fmt.Sprintf("%v", param)))
+		attribute.String("param", fmt.Sprintf("%v", param)),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset))
 	defer span.End()
 
 	logger.FromContext(ctx).Debug("SearchFailOnParam",
@@ -30,9 +35,15 @@ func (pg *PG) SearchFailOnParam(ctx context.Context, paramName string, param int
 	default:
 		var fails []entity.Fail
 		condition := fmt.Sprintf("%s = $1", paramName)
-		sql, _, err := pg.Builder.
+		query := pg.Builder.
 			Select("id", "player_id", "raid_id", "reason").
-			From("fails").Where(condition).ToSql()
+			From("fails").Where(condition).
+			Where("player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)").
+			Where("raid_id IN (SELECT id FROM raids WHERE deleted_at IS NULL)").OrderBy("id")
+		if limit > 0 {
+			query = query.Limit(uint64(limit)).Offset(uint64(offset))
+		}
+		sql, _, err := query.ToSql()
 		if err != nil {
 			return nil, errors.Wrap(err, "create query to search fail with param")
 		}
@@ -56,15 +67,19 @@ func (pg *PG) SearchFailOnParam(ctx context.Context, paramName string, param int
 }
 
 // SearchFail is a function which call backend to Search an entity.Fail.
+// It returns fails matching each of playerID, raidID and reason, not combined.
+// At most limit fails after the first offset ones are returned, all of them when limit is 0.
 func (pg *PG) SearchFail(
-	ctx context.Context, playerName string, playerID int, raidID int, reason string,
+	ctx context.Context, playerName string, playerID int, raidID int, reason string, limit, offset int,
 ) ([]entity.Fail, error) {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Fail/SearchFail")
 	span.SetAttributes(
 		attribute.String("playerName", playerName),
 		attribute.Int("playerID", playerID),
 		attribute.Int("raidID", raidID),
-		attribute.String("reason", reason))
+		attribute.String("reason", reason),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset))
 	defer span.End()
 
 	logger.FromContext(ctx).Debug("SearchFail",
@@ -78,29 +93,34 @@ func (pg *PG) SearchFail(
 		return nil, errors.Wrap(ctx.Err(), "search fail from pg database")
 
 	default:
-		var fails []entity.Fail
+		type param struct {
+			name  string
+			value interface{}
+		}
+		var params []param
 		if playerID != -1 {
-			s, err := pg.SearchFailOnParam(ctx, "player_id", playerID)
-			if err != nil {
-				return nil, err
-			}
-			fails = append(fails, s...)
+			params = append(params, param{name: "player_id", value: playerID})
 		}
 		if raidID != -1 {
-			s, err := pg.SearchFailOnParam(ctx, "raid_ID", raidID)
-			if err != nil {
-				return nil, err
-			}
-			fails = append(fails, s...)
+			params = append(params, param{name: "raid_ID", value: raidID})
 		}
 		if len(reason) != 0 {
-			s, err := pg.SearchFailOnParam(ctx, "reason", reason)
+			params = append(params, param{name: "reason", value: reason})
+		}
+
+		// a single criteria is paged by the database, results of several ones are paged once appended
+		if len(params) == 1 {
+			return pg.SearchFailOnParam(ctx, params[0].name, params[0].value, limit, offset)
+		}
+		var fails []entity.Fail
+		for _, p := range params {
+			s, err := pg.SearchFailOnParam(ctx, p.name, p.value, 0, 0)
 			if err != nil {
 				return nil, err
 			}
 			fails = append(fails, s...)
 		}
-		return fails, nil
+		return entity.Page(fails, limit, offset), nil
 	}
 }
 
@@ -151,7 +171,9 @@ func (pg *PG) ReadFail(ctx context.Context, failID int) (entity.Fail, error) {
 		sql, _, err := pg.Builder.
 			Select("id", "player_id", "raid_id", "reason").
 			From("fails").
-			Where("id = $1").ToSql()
+			Where("id = $1").
+			Where("player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)").
+			Where("raid_id IN (SELECT id FROM raids WHERE deleted_at IS NULL)").ToSql()
 		if err != nil {
 			return entity.Fail{}, errors.Wrap(err, "create query to read fail")
 		}
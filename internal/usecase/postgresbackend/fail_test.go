@@ -33,10 +33,12 @@ func TestPG_searchFailOnParam(t *testing.T) {
 		columns := []string{"id", "player_id", "raid_id", "reason"}
 		pgxRows := pgxpoolmock.NewRows(columns).AddRow(0, 0, 0, "test").ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, player_id, raid_id, reason FROM fails WHERE player_id = $1", 1).
+			"SELECT id, player_id, raid_id, reason FROM fails WHERE player_id = $1 "+
+				"AND player_id IN (SELECT id FROM players WHERE deleted_at IS NULL) "+
+				"AND raid_id IN (SELECT id FROM raids WHERE deleted_at IS NULL) ORDER BY id", 1).
 			Return(pgxRows, nil)
 
-		fail, err := pgBackend.SearchFailOnParam(context.Background(), "player_id", 1)
+		fail, err := pgBackend.SearchFailOnParam(context.Background(), "player_id", 1, 0, 0)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(fail))
 	})
@@ -56,7 +58,7 @@ func TestPG_searchFailOnParam(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
 
-		_, err := pgBackend.SearchFailOnParam(ctx, "player_id", 1)
+		_, err := pgBackend.SearchFailOnParam(ctx, "player_id", 1, 0, 0)
 		assert.Error(t, err)
 	})
 
@@ -73,10 +75,12 @@ func TestPG_searchFailOnParam(t *testing.T) {
 		}}
 
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, player_id, raid_id, reason FROM fails WHERE player_id = $1", 1).
+			"SELECT id, player_id, raid_id, reason FROM fails WHERE player_id = $1 "+
+				"AND player_id IN (SELECT id FROM players WHERE deleted_at IS NULL) "+
+				"AND raid_id IN (SELECT id FROM raids WHERE deleted_at IS NULL) ORDER BY id", 1).
 			Return(nil, errors.New("query failed"))
 
-		_, err := pgBackend.SearchFailOnParam(context.Background(), "player_id", 1)
+		_, err := pgBackend.SearchFailOnParam(context.Background(), "player_id", 1, 0, 0)
 		assert.Error(t, err)
 	})
 
@@ -95,10 +99,12 @@ func TestPG_searchFailOnParam(t *testing.T) {
 		columns := []string{"id", "player_id", "raid_id", "reason", "toto"}
 		pgxRows := pgxpoolmock.NewRows(columns).AddRow(0, 0, "test", "test", time.Now()).ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, player_id, raid_id, reason FROM fails WHERE player_id = $1", 1).
+			"SELECT id, player_id, raid_id, reason FROM fails WHERE player_id = $1 "+
+				"AND player_id IN (SELECT id FROM players WHERE deleted_at IS NULL) "+
+				"AND raid_id IN (SELECT id FROM raids WHERE deleted_at IS NULL) ORDER BY id", 1).
 			Return(pgxRows, nil)
 
-		_, err := pgBackend.SearchFailOnParam(context.Background(), "player_id", 1)
+		_, err := pgBackend.SearchFailOnParam(context.Background(), "player_id", 1, 0, 0)
 		assert.Error(t, err)
 	})
 }
@@ -272,14 +278,17 @@ func TestPG_SearchFail(t *testing.T) {
 
 		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, player_id, raid_id, reason FROM fails WHERE player_id = $1", player.ID).
+			"SELECT id, player_id, raid_id, reason FROM fails WHERE player_id = $1 "+
+				"AND player_id IN (SELECT id FROM players WHERE deleted_at IS NULL) "+
+				"AND raid_id IN (SELECT id FROM raids WHERE deleted_at IS NULL) ORDER BY id LIMIT 10 OFFSET 10",
+			player.ID).
 			Return(pgxRows, nil)
 
 		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
 			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
 			Pool:    mockPool,
 		}}
-		fails, err := pgBackend.SearchFail(context.Background(), "", player.ID, -1, "")
+		fails, err := pgBackend.SearchFail(context.Background(), "", player.ID, -1, "", 10, 10)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(fails))
 	})
@@ -302,7 +311,7 @@ func TestPG_SearchFail(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
 
-		_, err := pgBackend.SearchFail(ctx, "", player.ID, -1, "")
+		_, err := pgBackend.SearchFail(ctx, "", player.ID, -1, "", 0, 0)
 		assert.Error(t, err)
 	})
 }
@@ -326,7 +335,9 @@ func TestPG_ReadFail(t *testing.T) {
 
 		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, player_id, raid_id, reason FROM fails WHERE id = $1", fail.ID).
+			"SELECT id, player_id, raid_id, reason FROM fails WHERE id = $1 "+
+				"AND player_id IN (SELECT id FROM players WHERE deleted_at IS NULL) "+
+				"AND raid_id IN (SELECT id FROM raids WHERE deleted_at IS NULL)", fail.ID).
 			Return(pgxRows, nil)
 
 		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
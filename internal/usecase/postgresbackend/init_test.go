@@ -24,16 +24,55 @@ func TestPG_Init(t *testing.T) {
 		if err != nil {
 			t.Fatalf("Error creating mock database: %v", err)
 		}
-		defer database.Close()
 
 		pgBackend := &postgresbackend.PG{nil}
 
-		mock.ExpectExec(".*CREATE TABLE IF NOT EXISTS players.*").WillReturnResult(sqlmock.NewResult(0, 0))
-		mock.ExpectExec(".*CREATE TABLE IF NOT EXISTS raids.*").WillReturnResult(sqlmock.NewResult(0, 0))
-		mock.ExpectExec(".*CREATE TABLE IF NOT EXISTS strikes.*").WillReturnResult(sqlmock.NewResult(0, 0))
-		mock.ExpectExec(".*CREATE TABLE IF NOT EXISTS loots.*").WillReturnResult(sqlmock.NewResult(0, 0))
-		mock.ExpectExec(".*CREATE TABLE IF NOT EXISTS absences.*").WillReturnResult(sqlmock.NewResult(0, 0))
-		mock.ExpectExec(".*CREATE TABLE IF NOT EXISTS fails.*").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT CURRENT_DATABASE()").
+			WillReturnRows(sqlmock.NewRows([]string{"current_database"}).AddRow("guildops"))
+		mock.ExpectQuery("SELECT CURRENT_SCHEMA()").
+			WillReturnRows(sqlmock.NewRows([]string{"current_schema"}).AddRow("public"))
+		mock.ExpectExec("SELECT pg_advisory_lock").WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT COUNT\\(1\\) FROM information_schema.tables").
+			WithArgs("public", "schema_migrations").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS \"public\".\"schema_migrations\"").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("SELECT pg_advisory_unlock").WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		mock.ExpectExec("SELECT pg_advisory_lock").WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT version, dirty FROM").WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}))
+		migrations := []string{
+			"(?s)CREATE TABLE IF NOT EXISTS players.*CREATE TABLE IF NOT EXISTS fails",
+			"CREATE TABLE IF NOT EXISTS raid_participants",
+			"ALTER TABLE players ADD COLUMN IF NOT EXISTS created_at",
+			"CREATE TABLE IF NOT EXISTS seasons",
+			"CREATE TABLE IF NOT EXISTS points",
+			"CREATE TABLE IF NOT EXISTS wishes",
+			"(?s)CREATE TABLE IF NOT EXISTS items.*ALTER TABLE loots ADD COLUMN IF NOT EXISTS item_id",
+			"(?s)ALTER TABLE players ADD COLUMN IF NOT EXISTS class.*ADD COLUMN IF NOT EXISTS role",
+			"ALTER TABLE players ADD COLUMN IF NOT EXISTS main_id",
+			"(?s)ALTER TABLE players ADD COLUMN IF NOT EXISTS discord_name.*UPDATE players SET discord_id = NULL",
+			"CREATE TABLE IF NOT EXISTS raid_signups",
+			"CREATE TABLE IF NOT EXISTS raid_templates",
+			"ALTER TABLE raids ADD COLUMN IF NOT EXISTS start_time",
+			"ALTER TABLE players ADD COLUMN IF NOT EXISTS deleted_at",
+		}
+		for index, migration := range migrations {
+			version := index + 1
+			mock.ExpectBegin()
+			mock.ExpectExec("TRUNCATE").WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec("INSERT INTO \"public\".\"schema_migrations\"").
+				WithArgs(version, true).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+			mock.ExpectExec(migration).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectBegin()
+			mock.ExpectExec("TRUNCATE").WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec("INSERT INTO \"public\".\"schema_migrations\"").
+				WithArgs(version, false).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+		}
+		mock.ExpectExec("SELECT pg_advisory_unlock").WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectClose()
 
 		err = pgBackend.Init(ctx, "mock_conn_string", database)
 		assert.NoError(t, err)
@@ -50,3 +89,11 @@ func TestPG_Init(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestLatestMigration(t *testing.T) {
+	t.Parallel()
+
+	version, err := postgresbackend.LatestMigration()
+	assert.NoError(t, err)
+	assert.Equal(t, uint(14), version)
+}
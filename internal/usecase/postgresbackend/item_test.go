@@ -0,0 +1,96 @@
+package postgresbackend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
+	"github.com/antony-ramos/guildops/pkg/postgres"
+)
+
+func TestPG_SearchItem(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Searching by name", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		columns := []string{"id", "name", "slot", "item_level", "armor_type"}
+		pgxRows := pgxpoolmock.NewRows(columns).AddRow(19019, "frostmourne", "two-hand", 284, "").ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT id, name, slot, item_level, armor_type FROM items WHERE name LIKE $1 ORDER BY name, id",
+			"%frost%").
+			Return(pgxRows, nil)
+
+		items, err := pgBackend.SearchItem(context.Background(), "Frost")
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Item{{ID: 19019, Name: "frostmourne", Slot: "two-hand", ItemLevel: 284}}, items)
+	})
+}
+
+func TestPG_ReadItem(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Item not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		columns := []string{"id", "name", "slot", "item_level", "armor_type"}
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT id, name, slot, item_level, armor_type FROM items WHERE id = $1", 12).
+			Return(pgxpoolmock.NewRows(columns).ToPgxRows(), nil)
+
+		_, err := pgBackend.ReadItem(context.Background(), 12)
+		assert.ErrorContains(t, err, "item 12 not found")
+	})
+}
+
+func TestPG_SaveItem(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		mockPool.EXPECT().Exec(gomock.Any(),
+			"INSERT INTO items (id,name,slot,item_level,armor_type) VALUES ($1,$2,$3,$4,$5) "+
+				"ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, slot = EXCLUDED.slot, "+
+				"item_level = EXCLUDED.item_level, armor_type = EXCLUDED.armor_type",
+			19019, "frostmourne", "two-hand", 284, "").
+			Return(nil, nil)
+
+		err := pgBackend.SaveItem(context.Background(),
+			entity.Item{ID: 19019, Name: "frostmourne", Slot: "two-hand", ItemLevel: 284})
+		assert.NoError(t, err)
+	})
+}
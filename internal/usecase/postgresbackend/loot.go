@@ -12,8 +12,10 @@ import (
 	"github.com/antony-ramos/guildops/internal/entity"
 )
 
+// SearchLoot returns loots matching every given criteria, ordered by ID. Empty criteria are ignored.
+// At most limit loots after the first offset ones are returned, all of them when limit is 0.
 func (pg *PG) SearchLoot(
-	ctx context.Context, name string, date time.Time, difficulty, playerName string,
+	ctx context.Context, name string, date time.Time, difficulty, playerName string, limit, offset int,
 ) ([]entity.Loot, error) {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Loot/SearchLoot")
 	defer span.End()
@@ -21,6 +23,8 @@ func (pg *PG) SearchLoot(
 		attribute.String("date", date.String()),
 		attribute.String("difficulty", difficulty),
 		attribute.String("playerName", playerName),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
 	)
 
 	select {
@@ -33,8 +37,11 @@ func (pg *PG) SearchLoot(
 			Select("loots.id", "loots.name", "loots.raid_id",
 				"raids.name", "raids.difficulty", "raids.date",
 				"loots.player_id", "players.name").
+			Columns(lootItemColumns...).
 			From("loots").
-			Join("raids ON raids.id = loots.raid_id").Join("players ON players.id = loots.player_id")
+			Join("raids ON raids.id = loots.raid_id").Join("players ON players.id = loots.player_id").
+			LeftJoin("items ON items.id = loots.item_id").
+			Where("loots.deleted_at IS NULL AND raids.deleted_at IS NULL AND players.deleted_at IS NULL")
 
 		count := 0
 		var args []any
@@ -58,6 +65,10 @@ func (pg *PG) SearchLoot(
 			selectSQL = selectSQL.Where("players.name = $" + strconv.Itoa(count))
 			args = append(args, playerName)
 		}
+		selectSQL = selectSQL.OrderBy("loots.id")
+		if limit > 0 {
+			selectSQL = selectSQL.Limit(uint64(limit)).Offset(uint64(offset))
+		}
 
 		request, _, err := selectSQL.ToSql()
 		if err != nil {
@@ -73,18 +84,63 @@ func (pg *PG) SearchLoot(
 			var loot entity.Loot
 			var raid entity.Raid
 			var player entity.Player
-			err := rows.Scan(&loot.ID, &loot.Name, &raid.ID, &raid.Name, &raid.Difficulty, &raid.Date, &player.ID, &player.Name)
+			var item entity.Item
+			err := rows.Scan(&loot.ID, &loot.Name, &raid.ID, &raid.Name, &raid.Difficulty, &raid.Date, &player.ID, &player.Name,
+				&item.ID, &item.Name, &item.Slot, &item.ItemLevel, &item.ArmorType)
 			if err != nil {
 				return nil, fmt.Errorf("populate loots table with row data: %w", err)
 			}
 			loot.Raid = &raid
 			loot.Player = &player
+			loot.Item = lootItem(item)
 			loots = append(loots, loot)
 		}
 		return loots, nil
 	}
 }
 
+// SearchLootName returns the names of the loots starting with prefix, once each and ordered.
+// At most limit names are returned, all of them when limit is 0.
+func (pg *PG) SearchLootName(ctx context.Context, prefix string, limit int) ([]string, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Loot/SearchLootName")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("prefix", prefix),
+		attribute.Int("limit", limit),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchLootName - ctx.Done: request took too much time to be proceed")
+	default:
+		query := pg.Builder.Select("DISTINCT name").From("loots").Where("name LIKE $1 AND deleted_at IS NULL").OrderBy("name")
+		if limit > 0 {
+			query = query.Limit(uint64(limit))
+		}
+		sql, _, err := query.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchLootName - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, prefix+"%")
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchLootName - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+
+		var names []string
+		for rows.Next() {
+			var name string
+			err := rows.Scan(&name)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchLootName - rows.Scan: %w", err)
+			}
+			names = append(names, name)
+		}
+		return names, nil
+	}
+}
+
+// CreateLoot creates a loot in the database.
 func (pg *PG) CreateLoot(ctx context.Context, loot entity.Loot) (entity.Loot, error) {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Loot/CreateLoot")
 	defer span.End()
@@ -103,7 +159,8 @@ func (pg *PG) CreateLoot(ctx context.Context, loot entity.Loot) (entity.Loot, er
 			From("loots").
 			Where("name = $1").
 			Where("raid_id = $2").
-			Where("player_id = $3").ToSql()
+			Where("player_id = $3").
+			Where("deleted_at IS NULL").ToSql()
 		if err != nil {
 			return entity.Loot{}, fmt.Errorf("database - CreateLoot - r.Builder: %w", err)
 		}
@@ -115,17 +172,21 @@ func (pg *PG) CreateLoot(ctx context.Context, loot entity.Loot) (entity.Loot, er
 		if rows.Next() {
 			return entity.Loot{}, fmt.Errorf("database - CreateLoot - loot already exists")
 		}
-
 		sql, args, errInsert := pg.Builder.
 			Insert("loots").
-			Columns("name", "raid_id", "player_id").
-			Values(loot.Name, loot.Raid.ID, loot.Player.ID).ToSql()
+			Columns("name", "raid_id", "player_id", "item_id").
+			Values(loot.Name, loot.Raid.ID, loot.Player.ID, itemID(loot.Item)).
+			Suffix("RETURNING \"id\"").ToSql()
 		if errInsert != nil {
 			return entity.Loot{}, fmt.Errorf("database - CreateLoot - r.Builder.Insert: %w", errInsert)
 		}
-		_, err = pg.Pool.Exec(ctx, sql, args...)
+		row := pg.Pool.QueryRow(ctx, sql, args...)
+		if row == nil {
+			return entity.Loot{}, fmt.Errorf("call insert loot, returned row is empty")
+		}
+		err = row.Scan(&loot.ID)
 		if err != nil {
-			return entity.Loot{}, fmt.Errorf("database - CreateLoot - r.Pool.Exec: %w", err)
+			return entity.Loot{}, fmt.Errorf("database - CreateLoot - row.Scan: %w", err)
 		}
 		return loot, nil
 	}
@@ -142,7 +203,10 @@ func (pg *PG) ReadLoot(ctx context.Context, lootID int) (entity.Loot, error) {
 	case <-ctx.Done():
 		return entity.Loot{}, fmt.Errorf("database - ReadLoot - ctx.Done: request took too much time to be proceed")
 	default:
-		sql, _, err := pg.Builder.Select("id", "name", "raid_id", "player_id").From("loots").Where("id = $1").ToSql()
+		sql, _, err := pg.Builder.Select("id", "name", "raid_id", "player_id", "COALESCE(item_id, 0)").
+			From("loots").Where("id = $1 AND deleted_at IS NULL").
+			Where("player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)").
+			Where("raid_id IN (SELECT id FROM raids WHERE deleted_at IS NULL)").ToSql()
 		if err != nil {
 			return entity.Loot{}, fmt.Errorf("database - ReadLoot - r.Builder: %w", err)
 		}
@@ -155,10 +219,18 @@ func (pg *PG) ReadLoot(ctx context.Context, lootID int) (entity.Loot, error) {
 			loot := entity.Loot{}
 			var raidID int
 			var playerID int
-			err := rows.Scan(&loot.ID, &loot.Name, &raidID, &playerID)
+			var itemID int
+			err := rows.Scan(&loot.ID, &loot.Name, &raidID, &playerID, &itemID)
 			if err != nil {
 				return entity.Loot{}, fmt.Errorf("database - ReadLoot - rows.Scan: %w", err)
 			}
+			if itemID != 0 {
+				item, err := pg.ReadItem(ctx, itemID)
+				if err != nil {
+					return entity.Loot{}, fmt.Errorf("database - ReadLoot - pg.ReadItem: %w", err)
+				}
+				loot.Item = &item
+			}
 			raid, err := pg.ReadRaid(ctx, raidID)
 			if err != nil {
 				return entity.Loot{}, fmt.Errorf("database - ReadLoot - pg.ReadRaid: %w", err)
@@ -196,11 +268,12 @@ func (pg *PG) UpdateLoot(ctx context.Context, loot entity.Loot) error {
 			Set("name", loot.Name).
 			Set("raid_id", loot.Raid.ID).
 			Set("player_id", loot.Player.ID).
-			Where("id = $4").ToSql()
+			Set("item_id", itemID(loot.Item)).
+			Where("id = ?", loot.ID).ToSql()
 		if err != nil {
 			return fmt.Errorf("database - UpdateLoot - r.Builder: %w", err)
 		}
-		_, err = pg.Pool.Exec(ctx, sql, args, loot.ID)
+		_, err = pg.Pool.Exec(ctx, sql, args...)
 		if err != nil {
 			return fmt.Errorf("database - UpdateLoot - r.Pool.Exec: %w", err)
 		}
@@ -208,6 +281,7 @@ func (pg *PG) UpdateLoot(ctx context.Context, loot entity.Loot) error {
 	}
 }
 
+// DeleteLoot marks a loot deleted, until it is restored or purged.
 func (pg *PG) DeleteLoot(ctx context.Context, lootID int) error {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Loot/DeleteLoot")
 	defer span.End()
@@ -218,15 +292,16 @@ func (pg *PG) DeleteLoot(ctx context.Context, lootID int) error {
 	case <-ctx.Done():
 		return fmt.Errorf("database - DeleteLoot - ctx.Done: request took too much time to be proceed")
 	default:
-		sql, _, errInsert := pg.Builder.Delete("loots").Where("id = $1").ToSql()
-		if errInsert != nil {
-			return fmt.Errorf("database - DeleteLoot - r.Builder: %w", errInsert)
+		sql, args, errUpdate := pg.Builder.Update("loots").Set("deleted_at", time.Now().UTC()).
+			Where("id = ? AND deleted_at IS NULL", lootID).ToSql()
+		if errUpdate != nil {
+			return fmt.Errorf("database - DeleteLoot - r.Builder: %w", errUpdate)
 		}
-		isDelete, err := pg.Pool.Exec(ctx, sql, lootID)
+		isDelete, err := pg.Pool.Exec(ctx, sql, args...)
 		if err != nil {
 			return fmt.Errorf("database - DeleteLoot - r.Pool.Exec: %w", err)
 		}
-		if isDelete.String() == isNotDeleted {
+		if isDelete.String() == isNotUpdated {
 			return fmt.Errorf("database - DeleteLoot - loot not found")
 		}
 		return nil
@@ -2,6 +2,7 @@ package postgresbackend_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -44,18 +45,21 @@ func TestPG_CreateLoot(t *testing.T) {
 		columns := []string{"name", "raid_id", "player_id"}
 		pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT name, raid_id, player_id FROM loots WHERE name = $1 AND raid_id = $2 AND player_id = $3",
+			"SELECT name, raid_id, player_id FROM loots "+
+				"WHERE name = $1 AND raid_id = $2 AND player_id = $3 AND deleted_at IS NULL",
 			loot.Name, loot.Raid.ID, loot.Player.ID).
 			Return(pgxRows, nil)
 
-		mockPool.EXPECT().Exec(gomock.Any(),
-			"INSERT INTO loots (name,raid_id,player_id) VALUES ($1,$2,$3)",
-			loot.Name, loot.Raid.ID, loot.Player.ID).
-			Return(nil, nil)
+		rows := pgxpoolmock.NewRows([]string{"id"}).AddRow(4).ToPgxRows()
+		rows.Next()
+		mockPool.EXPECT().QueryRow(gomock.Any(),
+			"INSERT INTO loots (name,raid_id,player_id,item_id) VALUES ($1,$2,$3,$4) RETURNING \"id\"",
+			loot.Name, loot.Raid.ID, loot.Player.ID, nil).
+			Return(rows)
 
-		loot, err := pgBackend.CreateLoot(context.Background(), loot)
+		created, err := pgBackend.CreateLoot(context.Background(), loot)
 		assert.NoError(t, err)
-		assert.Equal(t, loot, loot)
+		assert.Equal(t, 4, created.ID)
 	})
 }
 
@@ -90,25 +94,106 @@ func TestPG_SearchLoot(t *testing.T) {
 			"loots.id", "loots.name", "loots.raid_id",
 			"raids.name", "raids.difficulty", "raids.date",
 			"loots.player_id", "players.name",
+			"items.id", "items.name", "items.slot", "items.item_level", "items.armor_type",
 		}
 		pgxRows := pgxpoolmock.NewRows(columns).AddRow(loot.ID, loot.Name, loot.Raid.ID,
 			loot.Raid.Name, loot.Raid.Difficulty, loot.Raid.Date,
-			loot.Player.ID, loot.Player.Name).ToPgxRows()
+			loot.Player.ID, loot.Player.Name, 0, "", "", 0, "").ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
 			"SELECT loots.id, loots.name, loots.raid_id, "+
 				"raids.name, raids.difficulty, raids.date, loots.player_id, p"+
-				"layers.name FROM loots JOIN raids ON raids.id = loots.raid_id "+
+				"layers.name, COALESCE(items.id, 0), COALESCE(items.name, ''), COALESCE(items.slot, ''), "+
+				"COALESCE(items.item_level, 0), COALESCE(items.armor_type, '') "+
+				"FROM loots JOIN raids ON raids.id = loots.raid_id "+
 				"JOIN players ON players.id = loots.player_id "+
-				"WHERE loots.name = $1 AND raids.date = $2 AND raids.difficulty = $3"+
-				" AND players.name = $4",
+				"LEFT JOIN items ON items.id = loots.item_id "+
+				"WHERE loots.deleted_at IS NULL AND raids.deleted_at IS NULL AND players.deleted_at IS NULL "+
+				"AND loots.name = $1 AND raids.date = $2 AND raids.difficulty = $3"+
+				" AND players.name = $4 ORDER BY loots.id",
 			loot.Name, loot.Raid.Date, loot.Raid.Difficulty, loot.Player.Name).
 			Return(pgxRows, nil)
 
 		loots, err := pgBackend.SearchLoot(
-			context.Background(), loot.Name, loot.Raid.Date, loot.Raid.Difficulty, loot.Player.Name)
+			context.Background(), loot.Name, loot.Raid.Date, loot.Raid.Difficulty, loot.Player.Name, 0, 0)
 		assert.NoError(t, err)
 		assert.Equal(t, loots, []entity.Loot{loot})
 	})
+
+	t.Run("Page", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		columns := []string{
+			"loots.id", "loots.name", "loots.raid_id",
+			"raids.name", "raids.difficulty", "raids.date",
+			"loots.player_id", "players.name",
+			"items.id", "items.name", "items.slot", "items.item_level", "items.armor_type",
+		}
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT loots.id, loots.name, loots.raid_id, "+
+				"raids.name, raids.difficulty, raids.date, loots.player_id, p"+
+				"layers.name, COALESCE(items.id, 0), COALESCE(items.name, ''), COALESCE(items.slot, ''), "+
+				"COALESCE(items.item_level, 0), COALESCE(items.armor_type, '') "+
+				"FROM loots JOIN raids ON raids.id = loots.raid_id "+
+				"JOIN players ON players.id = loots.player_id "+
+				"LEFT JOIN items ON items.id = loots.item_id "+
+				"WHERE loots.deleted_at IS NULL AND raids.deleted_at IS NULL AND players.deleted_at IS NULL "+
+				"AND players.name = $1 ORDER BY loots.id LIMIT 10 OFFSET 20",
+			"playername").
+			Return(pgxpoolmock.NewRows(columns).ToPgxRows(), nil)
+
+		loots, err := pgBackend.SearchLoot(context.Background(), "", time.Time{}, "", "playername", 10, 20)
+		assert.NoError(t, err)
+		assert.Empty(t, loots)
+	})
+}
+
+func TestPG_SearchLootName(t *testing.T) {
+	t.Parallel()
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		pgxRows := pgxpoolmock.NewRows([]string{"name"}).
+			AddRow("frostbolt staff").AddRow("frostmourne").ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT DISTINCT name FROM loots WHERE name LIKE $1 AND deleted_at IS NULL ORDER BY name LIMIT 25", "frost%").
+			Return(pgxRows, nil)
+
+		names, err := pgBackend.SearchLootName(context.Background(), "frost", 25)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"frostbolt staff", "frostmourne"}, names)
+	})
+
+	t.Run("Query error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT DISTINCT name FROM loots WHERE name LIKE $1 AND deleted_at IS NULL ORDER BY name", "%").
+			Return(nil, errors.New("error"))
+
+		_, err := pgBackend.SearchLootName(context.Background(), "", 0)
+		assert.Error(t, err)
+	})
 }
 
 func TestPG_UpdateLoot(t *testing.T) {
@@ -136,11 +221,12 @@ func TestPG_UpdateLoot(t *testing.T) {
 				ID:   1,
 				Name: "playername",
 			},
+			Item: &entity.Item{ID: 19019, Name: "lootname"},
 		}
 
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"UPDATE loots SET name = $1, raid_id = $2, player_id = $3 WHERE id = $4",
-			gomock.Any(), gomock.Any()).
+			"UPDATE loots SET name = $1, raid_id = $2, player_id = $3, item_id = $4 WHERE id = $5",
+			"lootname", 1, 1, 19019, 1).
 			Return(nil, nil)
 
 		err := pgBackend.UpdateLoot(context.Background(), loot)
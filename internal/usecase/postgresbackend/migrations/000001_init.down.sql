@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS fails;
+DROP TABLE IF EXISTS absences;
+DROP TABLE IF EXISTS loots;
+DROP TABLE IF EXISTS strikes;
+DROP TABLE IF EXISTS raids;
+DROP TABLE IF EXISTS players;
@@ -0,0 +1,3 @@
+-- Players created before this migration are considered there since the beginning
+ALTER TABLE players ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
+ALTER TABLE players ALTER COLUMN created_at SET DEFAULT CURRENT_TIMESTAMP;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS points (
+    id SERIAL PRIMARY KEY,
+    player_id INTEGER NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    amount INTEGER NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('attendance', 'award', 'loot', 'decay', 'reversal')),
+    reason VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    date TIMESTAMP NOT NULL,
+    raid_id INTEGER REFERENCES raids(id) ON DELETE SET NULL,
+    loot_id INTEGER REFERENCES loots(id) ON DELETE SET NULL,
+    reverts INTEGER UNIQUE REFERENCES points(id) ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS wishes (
+    id SERIAL PRIMARY KEY,
+    player_id INTEGER NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    item VARCHAR(30) NOT NULL,
+    priority INTEGER NOT NULL CHECK (priority BETWEEN 1 AND 5),
+    spec VARCHAR(20) NOT NULL DEFAULT '',
+    note VARCHAR(255) NOT NULL DEFAULT '',
+    UNIQUE (player_id, item)
+);
@@ -0,0 +1,4 @@
+ALTER TABLE wishes ALTER COLUMN item TYPE VARCHAR(30);
+ALTER TABLE loots DROP COLUMN IF EXISTS item_id;
+ALTER TABLE loots ALTER COLUMN name TYPE VARCHAR(20);
+DROP TABLE IF EXISTS items;
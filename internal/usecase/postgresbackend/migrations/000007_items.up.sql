@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS items (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    slot VARCHAR(20) NOT NULL DEFAULT '',
+    item_level INTEGER NOT NULL DEFAULT 0,
+    armor_type VARCHAR(20) NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS items_name ON items (name);
+-- Loot names were shorter than the names the entity accepts
+ALTER TABLE loots ALTER COLUMN name TYPE VARCHAR(100);
+ALTER TABLE loots ADD COLUMN IF NOT EXISTS item_id INTEGER REFERENCES items(id) ON DELETE SET NULL;
+ALTER TABLE wishes ALTER COLUMN item TYPE VARCHAR(100);
@@ -0,0 +1,4 @@
+ALTER TABLE players DROP COLUMN IF EXISTS role;
+ALTER TABLE players DROP COLUMN IF EXISTS off_spec;
+ALTER TABLE players DROP COLUMN IF EXISTS main_spec;
+ALTER TABLE players DROP COLUMN IF EXISTS class;
@@ -0,0 +1,4 @@
+ALTER TABLE players ADD COLUMN IF NOT EXISTS class VARCHAR(20) NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN IF NOT EXISTS main_spec VARCHAR(20) NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN IF NOT EXISTS off_spec VARCHAR(20) NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN IF NOT EXISTS role VARCHAR(10) NOT NULL DEFAULT '';
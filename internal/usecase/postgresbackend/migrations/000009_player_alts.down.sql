@@ -0,0 +1 @@
+ALTER TABLE players DROP COLUMN IF EXISTS main_id;
@@ -0,0 +1,2 @@
+-- Alts are linked to their main, they become mains when their main is deleted
+ALTER TABLE players ADD COLUMN IF NOT EXISTS main_id INTEGER REFERENCES players(id) ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+UPDATE players SET discord_id = 'tmp_' || id WHERE discord_id IS NULL;
+ALTER TABLE players DROP COLUMN IF EXISTS discord_name;
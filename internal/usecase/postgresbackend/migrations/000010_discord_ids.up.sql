@@ -0,0 +1,4 @@
+-- Players are linked by discord user ID, the discord username is only kept to be shown
+ALTER TABLE players ADD COLUMN IF NOT EXISTS discord_name VARCHAR(255) NOT NULL DEFAULT '';
+-- Players without discord account had a placeholder ID, they have no ID now
+UPDATE players SET discord_id = NULL WHERE discord_id LIKE 'tmp\_%';
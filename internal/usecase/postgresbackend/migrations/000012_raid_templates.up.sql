@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS raid_templates (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(12) NOT NULL,
+    difficulty VARCHAR(50) NOT NULL,
+    weekdays VARCHAR(100) NOT NULL,
+    start_time VARCHAR(5) NOT NULL,
+    timezone VARCHAR(64) NOT NULL DEFAULT '',
+    UNIQUE (name, difficulty)
+);
@@ -0,0 +1 @@
+ALTER TABLE raids DROP COLUMN IF EXISTS start_time;
@@ -0,0 +1,3 @@
+-- Raids created before start times start at 21:00, the former default raid start.
+-- Migrations can't read the configuration: guilds with another raid_start update these raids by hand.
+ALTER TABLE raids ADD COLUMN IF NOT EXISTS start_time VARCHAR(5) NOT NULL DEFAULT '21:00';
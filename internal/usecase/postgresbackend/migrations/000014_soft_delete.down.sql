@@ -0,0 +1,15 @@
+-- Deleted rows are purged with what belongs to them before their column is dropped
+DELETE FROM loots WHERE deleted_at IS NOT NULL;
+DELETE FROM raids WHERE deleted_at IS NOT NULL;
+DELETE FROM players WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS unique_loot_entry;
+DROP INDEX IF EXISTS unique_raid_entry;
+DROP INDEX IF EXISTS players_discord_id_key;
+DROP INDEX IF EXISTS players_name_key;
+ALTER TABLE loots ADD CONSTRAINT unique_loot_entry UNIQUE (name, raid_id, player_id);
+ALTER TABLE raids ADD CONSTRAINT unique_raid_entry UNIQUE (date, difficulty);
+ALTER TABLE players ADD CONSTRAINT players_discord_id_key UNIQUE (discord_id);
+ALTER TABLE players ADD CONSTRAINT players_name_key UNIQUE (name);
+ALTER TABLE loots DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE raids DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE players DROP COLUMN IF EXISTS deleted_at;
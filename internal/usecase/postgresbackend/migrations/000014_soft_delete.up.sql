@@ -0,0 +1,13 @@
+-- Players, raids and loots are kept when deleted until they are purged, so they can be restored.
+ALTER TABLE players ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE raids ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE loots ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+-- Deleted rows are left out of unique constraints, so they can be replaced while they are kept
+ALTER TABLE players DROP CONSTRAINT IF EXISTS players_name_key;
+ALTER TABLE players DROP CONSTRAINT IF EXISTS players_discord_id_key;
+ALTER TABLE raids DROP CONSTRAINT IF EXISTS unique_raid_entry;
+ALTER TABLE loots DROP CONSTRAINT IF EXISTS unique_loot_entry;
+CREATE UNIQUE INDEX IF NOT EXISTS players_name_key ON players (name) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS players_discord_id_key ON players (discord_id) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS unique_raid_entry ON raids (date, difficulty) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS unique_loot_entry ON loots (name, raid_id, player_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,181 @@
+package postgresbackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+var isNotUpdated = "UPDATE 0"
+
+// SearchParticipant returns the roster entries of a raid, of a player, or of a player on a raid.
+// raidID and playerID are ignored when -1.
+func (pg *PG) SearchParticipant(ctx context.Context, raidID, playerID int) ([]entity.Participant, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Participant/SearchParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if raidID != -1 {
+			params["raid_participants.raid_id"] = raidID
+		}
+		if playerID != -1 {
+			params["raid_participants.player_id"] = playerID
+		}
+		// Roster entries of deleted players and raids are hidden with them
+		params["players.deleted_at"] = nil
+		params["raids.deleted_at"] = nil
+		sql, args, err := pg.Builder.Select("raid_participants.raid_id", "raids.name", "raids.difficulty", "raids.date",
+			"raid_participants.player_id", "players.name", "players.class", "players.main_spec",
+			"players.off_spec", "players.role", "raid_participants.status").
+			From("raid_participants").
+			Join("raids ON raids.id = raid_participants.raid_id").
+			Join("players ON players.id = raid_participants.player_id").
+			Where(params).
+			OrderBy("raids.date", "players.name").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchParticipant - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchParticipant - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+
+		var participants []entity.Participant
+		for rows.Next() {
+			var raid entity.Raid
+			var player entity.Player
+			var role, status string
+			err := rows.Scan(&raid.ID, &raid.Name, &raid.Difficulty, &raid.Date, &player.ID, &player.Name,
+				&player.Class, &player.MainSpec, &player.OffSpec, &role, &status)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchParticipant - rows.Scan: %w", err)
+			}
+			player.Role = entity.Role(role)
+			participants = append(participants, entity.Participant{
+				Player: &player,
+				Raid:   &raid,
+				Status: entity.ParticipantStatus(status),
+			})
+		}
+		return participants, nil
+	}
+}
+
+// CreateParticipant adds a player to the roster of a raid.
+func (pg *PG) CreateParticipant(ctx context.Context, participant entity.Participant) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Participant/CreateParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", participant.Raid.ID),
+		attribute.Int("playerID", participant.Player.ID),
+		attribute.String("status", string(participant.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - CreateParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, _, err := pg.Builder.
+			Select("raid_id", "player_id").
+			From("raid_participants").
+			Where("raid_id = $1 AND player_id = $2").ToSql()
+		if err != nil {
+			return fmt.Errorf("database - CreateParticipant - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, participant.Raid.ID, participant.Player.ID)
+		if err != nil {
+			return fmt.Errorf("database - CreateParticipant - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+		if rows.Next() {
+			return fmt.Errorf("participant already exists")
+		}
+
+		sql, args, err := pg.Builder.
+			Insert("raid_participants").
+			Columns("raid_id", "player_id", "status").
+			Values(participant.Raid.ID, participant.Player.ID, string(participant.Status)).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - CreateParticipant - r.Builder: %w", err)
+		}
+		_, err = pg.Pool.Exec(ctx, sql, args...)
+		if err != nil {
+			return fmt.Errorf("database - CreateParticipant - r.Pool.Exec: %w", err)
+		}
+		return nil
+	}
+}
+
+// UpdateParticipant updates the status of a player on the roster of a raid.
+func (pg *PG) UpdateParticipant(ctx context.Context, participant entity.Participant) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Participant/UpdateParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", participant.Raid.ID),
+		attribute.Int("playerID", participant.Player.ID),
+		attribute.String("status", string(participant.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdateParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, args, err := pg.Builder.
+			Update("raid_participants").
+			Set("status", string(participant.Status)).
+			Where("raid_id = ? AND player_id = ?", participant.Raid.ID, participant.Player.ID).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdateParticipant - r.Builder: %w", err)
+		}
+		isUpdated, err := pg.Pool.Exec(ctx, sql, args...)
+		if err != nil {
+			return fmt.Errorf("database - UpdateParticipant - r.Pool.Exec: %w", err)
+		}
+		if isUpdated.String() == isNotUpdated {
+			return fmt.Errorf("database - UpdateParticipant - participant not found")
+		}
+		return nil
+	}
+}
+
+// DeleteParticipant removes a player from the roster of a raid.
+func (pg *PG) DeleteParticipant(ctx context.Context, raidID, playerID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Participant/DeleteParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, _, err := pg.Builder.Delete("raid_participants").Where("raid_id = $1 AND player_id = $2").ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteParticipant - r.Builder: %w", err)
+		}
+		isDelete, err := pg.Pool.Exec(ctx, sql, raidID, playerID)
+		if err != nil {
+			return fmt.Errorf("database - DeleteParticipant - r.Pool.Exec: %w", err)
+		}
+		if isDelete.String() == isNotDeleted {
+			return fmt.Errorf("database - DeleteParticipant - participant not found")
+		}
+		return nil
+	}
+}
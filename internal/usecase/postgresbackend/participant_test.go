@@ -0,0 +1,177 @@
+package postgresbackend_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
+	"github.com/antony-ramos/guildops/pkg/postgres"
+)
+
+func TestPG_SearchParticipant(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Searching on raid", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		columns := []string{
+			"raid_id", "name", "difficulty", "date", "player_id", "name", "class", "main_spec", "off_spec", "role", "status",
+		}
+		pgxRows := pgxpoolmock.NewRows(columns).
+			AddRow(1, "raid", "heroic", time.Now(), 2, "arthas", "paladin", "holy", "", "healer", "bench").ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT raid_participants.raid_id, raids.name, raids.difficulty, raids.date, "+
+				"raid_participants.player_id, players.name, players.class, players.main_spec, players.off_spec, "+
+				"players.role, raid_participants.status "+
+				"FROM raid_participants "+
+				"JOIN raids ON raids.id = raid_participants.raid_id "+
+				"JOIN players ON players.id = raid_participants.player_id "+
+				"WHERE players.deleted_at IS NULL AND raid_participants.raid_id = $1 AND raids.deleted_at IS NULL "+
+				"ORDER BY raids.date, players.name", 1).
+			Return(pgxRows, nil)
+
+		participants, err := pgBackend.SearchParticipant(context.Background(), 1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(participants))
+		assert.Equal(t, "arthas", participants[0].Player.Name)
+		assert.Equal(t, entity.RoleHealer, participants[0].Player.Role)
+		assert.Equal(t, entity.ParticipantBench, participants[0].Status)
+	})
+}
+
+func TestPG_CreateParticipant(t *testing.T) {
+	t.Parallel()
+
+	participant := entity.Participant{
+		Player: &entity.Player{ID: 2},
+		Raid:   &entity.Raid{ID: 1},
+		Status: entity.ParticipantLate,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		pgxRows := pgxpoolmock.NewRows([]string{"raid_id", "player_id"}).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT raid_id, player_id FROM raid_participants WHERE raid_id = $1 AND player_id = $2", 1, 2).
+			Return(pgxRows, nil)
+		mockPool.EXPECT().Exec(gomock.Any(),
+			"INSERT INTO raid_participants (raid_id,player_id,status) VALUES ($1,$2,$3)", 1, 2, "late").
+			Return(nil, nil)
+
+		err := pgBackend.CreateParticipant(context.Background(), participant)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Participant already exists", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		pgxRows := pgxpoolmock.NewRows([]string{"raid_id", "player_id"}).AddRow(1, 2).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT raid_id, player_id FROM raid_participants WHERE raid_id = $1 AND player_id = $2", 1, 2).
+			Return(pgxRows, nil)
+
+		err := pgBackend.CreateParticipant(context.Background(), participant)
+		assert.Error(t, err)
+	})
+}
+
+func TestPG_UpdateParticipant(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Participant not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		mockPool.EXPECT().Exec(gomock.Any(),
+			"UPDATE raid_participants SET status = $1 WHERE raid_id = $2 AND player_id = $3", "present", 1, 2).
+			Return(pgconn.CommandTag("UPDATE 0"), nil)
+
+		err := pgBackend.UpdateParticipant(context.Background(), entity.Participant{
+			Player: &entity.Player{ID: 2},
+			Raid:   &entity.Raid{ID: 1},
+			Status: entity.ParticipantPresent,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestPG_DeleteParticipant(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		mockPool.EXPECT().Exec(gomock.Any(),
+			"DELETE FROM raid_participants WHERE raid_id = $1 AND player_id = $2", 1, 2).
+			Return(pgconn.CommandTag("DELETE 1"), nil)
+
+		err := pgBackend.DeleteParticipant(context.Background(), 1, 2)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := pgBackend.DeleteParticipant(ctx, 1, 2)
+		assert.Error(t, err)
+	})
+}
@@ -15,14 +15,19 @@ import (
 	"github.com/antony-ramos/guildops/internal/entity"
 )
 
+// mainIDColumn selects the ID of the main of a player, 0 when the player is a main
+// or while its main is deleted.
+const mainIDColumn = "COALESCE((SELECT main.id FROM players AS main " +
+	"WHERE main.id = players.main_id AND main.deleted_at IS NULL), 0)"
+
 // SearchPlayer is a function which call backend to Search a Player Object.
-// It can search by playerID, name or discordName.
+// It can search by playerID, name or discordID.
 // players returned doesn't contain strikes, fails, missed raids and loots.
-func (pg *PG) SearchPlayer(ctx context.Context, playerID int, name, discordName string) ([]entity.Player, error) {
+func (pg *PG) SearchPlayer(ctx context.Context, playerID int, name, discordID string) ([]entity.Player, error) {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/SearchPlayer")
 	span.SetAttributes(
 		attribute.String("playerName", name),
-		attribute.String("discordName", discordName),
+		attribute.String("discordID", discordID),
 		attribute.Int("playerID", playerID))
 	defer span.End()
 
@@ -31,7 +36,9 @@ func (pg *PG) SearchPlayer(ctx context.Context, playerID int, name, discordName
 		return nil, fmt.Errorf("database - SearchPlayer - ctx.Done: request took too much time to be proceed")
 	default:
 		var players []entity.Player
-		sqlQuery := pg.Builder.Select("id", "name", "discord_id").From("players")
+		sqlQuery := pg.Builder.Select("id", "name", "COALESCE(discord_id, '')", "discord_name", "created_at",
+			"class", "main_spec", "off_spec", "role", mainIDColumn).From("players").
+			Where("deleted_at IS NULL")
 		count := 0
 		args := make([]any, 0)
 		if playerID != -1 {
@@ -44,10 +51,10 @@ func (pg *PG) SearchPlayer(ctx context.Context, playerID int, name, discordName
 			sqlQuery = sqlQuery.Where("name = $" + strconv.Itoa(count))
 			args = append(args, name)
 		}
-		if discordName != "" {
+		if discordID != "" {
 			count++
 			sqlQuery = sqlQuery.Where("discord_id = $" + strconv.Itoa(count))
-			args = append(args, discordName)
+			args = append(args, discordID)
 		}
 
 		sql, _, err := sqlQuery.ToSql()
@@ -63,10 +70,13 @@ func (pg *PG) SearchPlayer(ctx context.Context, playerID int, name, discordName
 			defer rows.Close()
 			for rows.Next() {
 				var player entity.Player
-				err := rows.Scan(&player.ID, &player.Name, &player.DiscordName)
+				var role string
+				err := rows.Scan(&player.ID, &player.Name, &player.DiscordID, &player.DiscordName, &player.CreatedAt,
+					&player.Class, &player.MainSpec, &player.OffSpec, &role, &player.MainID)
 				if err != nil {
 					return nil, fmt.Errorf("database - SearchPlayer - rows.Scan: %w", err)
 				}
+				player.Role = entity.Role(role)
 				players = append(players, player)
 			}
 		}
@@ -74,6 +84,52 @@ func (pg *PG) SearchPlayer(ctx context.Context, playerID int, name, discordName
 	}
 }
 
+// SearchPlayerOnPrefix returns the players whose name starts with prefix, ordered by name.
+// At most limit players are returned, all of them when limit is 0.
+// players returned doesn't contain strikes, fails, missed raids and loots.
+func (pg *PG) SearchPlayerOnPrefix(ctx context.Context, prefix string, limit int) ([]entity.Player, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/SearchPlayerOnPrefix")
+	span.SetAttributes(
+		attribute.String("prefix", prefix),
+		attribute.Int("limit", limit))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchPlayerOnPrefix - ctx.Done: request took too much time to be proceed")
+	default:
+		sqlQuery := pg.Builder.Select("id", "name", "COALESCE(discord_id, '')", "discord_name", "created_at",
+			"class", "main_spec", "off_spec", "role", mainIDColumn).From("players").
+			Where("name LIKE $1 AND deleted_at IS NULL").OrderBy("name")
+		if limit > 0 {
+			sqlQuery = sqlQuery.Limit(uint64(limit))
+		}
+		sql, _, err := sqlQuery.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchPlayerOnPrefix - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, prefix+"%")
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchPlayerOnPrefix - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+
+		var players []entity.Player
+		for rows.Next() {
+			var player entity.Player
+			var role string
+			err := rows.Scan(&player.ID, &player.Name, &player.DiscordID, &player.DiscordName, &player.CreatedAt,
+				&player.Class, &player.MainSpec, &player.OffSpec, &role, &player.MainID)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchPlayerOnPrefix - rows.Scan: %w", err)
+			}
+			player.Role = entity.Role(role)
+			players = append(players, player)
+		}
+		return players, nil
+	}
+}
+
 // CreatePlayer is a function which call backend to Create a Player Object.
 func (pg *PG) CreatePlayer(ctx context.Context, player entity.Player) (entity.Player, error) {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/CreatePlayer")
@@ -83,15 +139,12 @@ func (pg *PG) CreatePlayer(ctx context.Context, player entity.Player) (entity.Pl
 	case <-ctx.Done():
 		return entity.Player{}, fmt.Errorf("database - CreatePlayer - ctx.Done: request took too much time to be proceed")
 	default:
-		if player.DiscordName == "" {
-			player.DiscordName = "tmp_" + strconv.FormatInt(time.Now().Unix(), 10)
-		}
-
 		req, args, errInsert := pg.Builder.
 			Insert("players").
-			Columns("name", "discord_id").
-			Values(player.Name, player.DiscordName).
-			Suffix("RETURNING \"id\"").
+			Columns("name", "discord_id", "discord_name", "class", "main_spec", "off_spec", "role").
+			Values(player.Name, nullString(player.DiscordID), player.DiscordName,
+				player.Class, player.MainSpec, player.OffSpec, string(player.Role)).
+			Suffix("RETURNING \"id\", \"created_at\"").
 			ToSql()
 		if errInsert != nil {
 			return entity.Player{}, fmt.Errorf("database - CreatePlayer - r.Builder.Insert: %w", errInsert)
@@ -100,7 +153,7 @@ func (pg *PG) CreatePlayer(ctx context.Context, player entity.Player) (entity.Pl
 		if row == nil {
 			return entity.Player{}, fmt.Errorf("call insert player, returned row is empty")
 		}
-		err := row.Scan(&player.ID)
+		err := row.Scan(&player.ID, &player.CreatedAt)
 		if err != nil {
 			var pgErr *pgconn.PgError
 			ok := errors.As(err, &pgErr)
@@ -124,7 +177,8 @@ func (pg *PG) ReadPlayer(ctx context.Context, playerID int) (entity.Player, erro
 	case <-ctx.Done():
 		return entity.Player{}, fmt.Errorf("database - ReadPlayer - ctx.Done: request took too much time to be proceed")
 	default:
-		sql, _, err := pg.Builder.Select("id", "name").From("players").Where("id = $1").ToSql()
+		sql, _, err := pg.Builder.Select("id", "name", "class", "main_spec", "off_spec", "role", mainIDColumn).
+			From("players").Where("id = $1 AND deleted_at IS NULL").ToSql()
 		if err != nil {
 			return entity.Player{}, fmt.Errorf("database - ReadPlayer - r.Builder.Select: %w", err)
 		}
@@ -135,10 +189,13 @@ func (pg *PG) ReadPlayer(ctx context.Context, playerID int) (entity.Player, erro
 		defer rows.Close()
 		var player entity.Player
 		if rows.Next() {
-			err := rows.Scan(&player.ID, &player.Name)
+			var role string
+			err := rows.Scan(&player.ID, &player.Name, &player.Class, &player.MainSpec, &player.OffSpec, &role,
+				&player.MainID)
 			if err != nil {
 				return entity.Player{}, fmt.Errorf("database - ReadPlayer - rows.Scan: %w", err)
 			}
+			player.Role = entity.Role(role)
 			return player, nil
 		}
 		return entity.Player{}, fmt.Errorf("database - ReadPlayer - player not found")
@@ -150,7 +207,7 @@ func (pg *PG) UpdatePlayer(ctx context.Context, player entity.Player) error {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/UpdatePlayer")
 	span.SetAttributes(
 		attribute.String("playerName", player.Name),
-		attribute.String("discordName", player.DiscordName),
+		attribute.String("discordID", player.DiscordID),
 		attribute.Int("playerID", player.ID))
 	defer span.End()
 
@@ -158,12 +215,15 @@ func (pg *PG) UpdatePlayer(ctx context.Context, player entity.Player) error {
 	case <-ctx.Done():
 		return fmt.Errorf("database - UpdatePlayer - ctx.Done: request took too much time to be proceed")
 	default:
-		if player.DiscordName == "" {
-			player.DiscordName = "tmp_" + strconv.FormatInt(time.Now().Unix(), 10)
-		}
 		sql, args, err := pg.Builder.Update("players").
 			Set("name", player.Name).
-			Set("discord_id", player.DiscordName).
+			Set("discord_id", nullString(player.DiscordID)).
+			Set("discord_name", player.DiscordName).
+			Set("class", player.Class).
+			Set("main_spec", player.MainSpec).
+			Set("off_spec", player.OffSpec).
+			Set("role", string(player.Role)).
+			Set("main_id", mainID(player)).
 			Where("id = ?", player.ID).ToSql()
 		if err != nil {
 			return fmt.Errorf("database - UpdatePlayer - r.Builder.Update: %w", err)
@@ -177,11 +237,12 @@ func (pg *PG) UpdatePlayer(ctx context.Context, player entity.Player) error {
 }
 
 // DeletePlayer is a function which call backend to Delete a Player Object.
+// The player is marked deleted, until it is restored or purged.
 func (pg *PG) DeletePlayer(ctx context.Context, player entity.Player) error {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/DeletePlayer")
 	span.SetAttributes(
 		attribute.String("playerName", player.Name),
-		attribute.String("discordName", player.DiscordName),
+		attribute.String("discordID", player.DiscordID),
 		attribute.Int("playerID", player.ID))
 	defer span.End()
 
@@ -189,31 +250,43 @@ func (pg *PG) DeletePlayer(ctx context.Context, player entity.Player) error {
 	case <-ctx.Done():
 		return fmt.Errorf("database - DeletePlayer - ctx.Done: request took too much time to be proceed")
 	default:
-		sqlQuery := pg.Builder.Delete("players")
-		args := make([]any, 0)
+		sqlQuery := pg.Builder.Update("players").Set("deleted_at", time.Now().UTC()).Where("deleted_at IS NULL")
 		if player.ID != 0 {
 			sqlQuery = sqlQuery.Where("id = ?", player.ID)
-			args = append(args, player.ID)
 		}
 		if player.Name != "" {
 			sqlQuery = sqlQuery.Where("name = ?", player.Name)
-			args = append(args, player.Name)
 		}
-		if player.DiscordName != "" {
-			sqlQuery = sqlQuery.Where("discord_id = ?", player.DiscordName)
-			args = append(args, player.DiscordName)
+		if player.DiscordID != "" {
+			sqlQuery = sqlQuery.Where("discord_id = ?", player.DiscordID)
 		}
-		sql, _, err := sqlQuery.ToSql()
+		sql, args, err := sqlQuery.ToSql()
 		if err != nil {
-			return fmt.Errorf("database - DeletePlayer - r.Builder.Delete: %w", err)
+			return fmt.Errorf("database - DeletePlayer - r.Builder.Update: %w", err)
 		}
 		isDelete, err := pg.Pool.Exec(ctx, sql, args...)
 		if err != nil {
 			return fmt.Errorf("database - DeletePlayer - r.Pool.Exec: %w", err)
 		}
-		if isDelete.String() == isNotDeleted {
+		if isDelete.String() == isNotUpdated {
 			return fmt.Errorf("database - DeletePlayer - player not found")
 		}
 		return nil
 	}
 }
+
+// nullString stores empty strings as NULL, so several players can have no discord_id.
+func nullString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// mainID returns the ID of the main of a player to store, nil when the player is a main.
+func mainID(player entity.Player) any {
+	if !player.IsAlt() {
+		return nil
+	}
+	return player.MainID
+}
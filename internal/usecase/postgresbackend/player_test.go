@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/antony-ramos/guildops/internal/entity"
@@ -17,6 +18,9 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+const mainIDColumn = "COALESCE((SELECT main.id FROM players AS main " +
+	"WHERE main.id = players.main_id AND main.deleted_at IS NULL), 0)"
+
 func TestPG_CreatePlayer(t *testing.T) {
 	t.Parallel()
 	t.Run("Success", func(t *testing.T) {
@@ -35,10 +39,11 @@ func TestPG_CreatePlayer(t *testing.T) {
 			Name: "playername",
 		}
 
-		rows := pgxpoolmock.NewRows([]string{"id"}).AddRow(1).ToPgxRows()
+		rows := pgxpoolmock.NewRows([]string{"id", "created_at"}).AddRow(1, time.Now()).ToPgxRows()
 		rows.Next()
 		mockPool.EXPECT().QueryRow(gomock.Any(),
-			"INSERT INTO players (name,discord_id) VALUES ($1,$2) RETURNING \"id\"", player.Name).
+			"INSERT INTO players (name,discord_id,discord_name,class,main_spec,off_spec,role) VALUES ($1,$2,$3,$4,$5,$6,$7) "+
+				"RETURNING \"id\", \"created_at\"", player.Name).
 			Return(rows)
 
 		p, err := pgBackend.CreatePlayer(context.Background(), player)
@@ -87,7 +92,8 @@ func TestPG_CreatePlayer(t *testing.T) {
 		}
 
 		mockPool.EXPECT().QueryRow(gomock.Any(),
-			"INSERT INTO players (name,discord_id) VALUES ($1,$2) RETURNING \"id\"", player.Name).
+			"INSERT INTO players (name,discord_id,discord_name,class,main_spec,off_spec,role) VALUES ($1,$2,$3,$4,$5,$6,$7) "+
+				"RETURNING \"id\", \"created_at\"", player.Name).
 			Return(pgx.Row(nil))
 
 		p, err := pgBackend.CreatePlayer(context.Background(), player)
@@ -112,10 +118,12 @@ func TestPG_CreatePlayer(t *testing.T) {
 			Name: "playername",
 		}
 
-		rows := pgxpoolmock.NewRows([]string{"id"}).RowError(0, &pgconn.PgError{Code: "23505"}).AddRow(1).ToPgxRows()
+		rows := pgxpoolmock.NewRows([]string{"id", "created_at"}).
+			RowError(0, &pgconn.PgError{Code: "23505"}).AddRow(1, time.Now()).ToPgxRows()
 		rows.Next()
 		mockPool.EXPECT().QueryRow(gomock.Any(),
-			"INSERT INTO players (name,discord_id) VALUES ($1,$2) RETURNING \"id\"", player.Name).
+			"INSERT INTO players (name,discord_id,discord_name,class,main_spec,off_spec,role) VALUES ($1,$2,$3,$4,$5,$6,$7) "+
+				"RETURNING \"id\", \"created_at\"", player.Name).
 			Return(rows)
 
 		_, err := pgBackend.CreatePlayer(context.Background(), player)
@@ -142,10 +150,12 @@ func TestPG_ReadPlayer(t *testing.T) {
 			Name: "playername",
 		}
 
-		columns := []string{"id", "name"}
-		pgxRows := pgxpoolmock.NewRows(columns).AddRow(player.ID, player.Name).ToPgxRows()
+		columns := []string{"id", "name", "class", "main_spec", "off_spec", "role", "main_id"}
+		pgxRows := pgxpoolmock.NewRows(columns).AddRow(player.ID, player.Name, "", "", "", "", 0).ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, name FROM players WHERE id = $1", strconv.FormatInt(int64(player.ID), 10)).
+			"SELECT id, name, class, main_spec, off_spec, role, "+mainIDColumn+
+				" FROM players WHERE id = $1 AND deleted_at IS NULL",
+			strconv.FormatInt(int64(player.ID), 10)).
 			Return(pgxRows, nil)
 
 		p, err := pgBackend.ReadPlayer(context.Background(), player.ID)
@@ -193,10 +203,12 @@ func TestPG_ReadPlayer(t *testing.T) {
 			Name: "playername",
 		}
 
-		columns := []string{"id", "name"}
-		pgxRows := pgxpoolmock.NewRows(columns).AddRow(player.ID, player.Name).ToPgxRows()
+		columns := []string{"id", "name", "class", "main_spec", "off_spec", "role", "main_id"}
+		pgxRows := pgxpoolmock.NewRows(columns).AddRow(player.ID, player.Name, "", "", "", "", 0).ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, name FROM players WHERE id = $1", strconv.FormatInt(int64(player.ID), 10)).
+			"SELECT id, name, class, main_spec, off_spec, role, "+mainIDColumn+
+				" FROM players WHERE id = $1 AND deleted_at IS NULL",
+			strconv.FormatInt(int64(player.ID), 10)).
 			Return(pgxRows, errors.New("error"))
 
 		_, err := pgBackend.ReadPlayer(context.Background(), player.ID)
@@ -230,10 +242,16 @@ func TestPG_SearchPlayer(t *testing.T) {
 			Name: "playername",
 		}
 
-		columns := []string{"id", "name", "discord_id"}
-		pgxRows := pgxpoolmock.NewRows(columns).AddRow(player.ID, player.Name, player.DiscordName).ToPgxRows()
+		columns := []string{
+			"id", "name", "discord_id", "discord_name", "created_at", "class", "main_spec", "off_spec", "role", "main_id",
+		}
+		pgxRows := pgxpoolmock.NewRows(columns).
+			AddRow(player.ID, player.Name, player.DiscordID, player.DiscordName, player.CreatedAt, "", "", "", "", 0).
+			ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, name, discord_id FROM players WHERE id = $1", playerID).
+			"SELECT id, name, COALESCE(discord_id, ''), discord_name, created_at, class, main_spec, off_spec, role, "+
+				mainIDColumn+" "+
+				"FROM players WHERE deleted_at IS NULL AND id = $1", playerID).
 			Return(pgxRows, nil)
 
 		p, err := pgBackend.SearchPlayer(context.Background(), playerID, name, discordName)
@@ -260,7 +278,9 @@ func TestPG_SearchPlayer(t *testing.T) {
 		}
 
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, name, discord_id FROM players WHERE id = $1", playerID).
+			"SELECT id, name, COALESCE(discord_id, ''), discord_name, created_at, class, main_spec, off_spec, role, "+
+				mainIDColumn+" "+
+				"FROM players WHERE deleted_at IS NULL AND id = $1", playerID).
 			Return(nil, errors.New("error"))
 
 		_, err := pgBackend.SearchPlayer(context.Background(), playerID, name, discordName)
@@ -290,10 +310,16 @@ func TestPG_SearchPlayer(t *testing.T) {
 			Name: "playername",
 		}
 
-		columns := []string{"id", "name", "discord_id"}
-		pgxRows := pgxpoolmock.NewRows(columns).AddRow(player.ID, player.Name, player.DiscordName).ToPgxRows()
+		columns := []string{
+			"id", "name", "discord_id", "discord_name", "created_at", "class", "main_spec", "off_spec", "role", "main_id",
+		}
+		pgxRows := pgxpoolmock.NewRows(columns).
+			AddRow(player.ID, player.Name, player.DiscordID, player.DiscordName, player.CreatedAt, "", "", "", "", 0).
+			ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, name, discord_id FROM players WHERE name = $1", name).
+			"SELECT id, name, COALESCE(discord_id, ''), discord_name, created_at, class, main_spec, off_spec, role, "+
+				mainIDColumn+" "+
+				"FROM players WHERE deleted_at IS NULL AND name = $1", name).
 			Return(pgxRows, nil)
 
 		p, err := pgBackend.SearchPlayer(context.Background(), playerID, name, discordName)
@@ -320,7 +346,9 @@ func TestPG_SearchPlayer(t *testing.T) {
 		}
 
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, name, discord_id FROM players WHERE name = $1", name).
+			"SELECT id, name, COALESCE(discord_id, ''), discord_name, created_at, class, main_spec, off_spec, role, "+
+				mainIDColumn+" "+
+				"FROM players WHERE deleted_at IS NULL AND name = $1", name).
 			Return(nil, errors.New("error"))
 
 		_, err := pgBackend.SearchPlayer(context.Background(), playerID, name, discordName)
@@ -350,10 +378,16 @@ func TestPG_SearchPlayer(t *testing.T) {
 			Name: "playername",
 		}
 
-		columns := []string{"id", "name", "discord_id"}
-		pgxRows := pgxpoolmock.NewRows(columns).AddRow(player.ID, player.Name, player.DiscordName).ToPgxRows()
+		columns := []string{
+			"id", "name", "discord_id", "discord_name", "created_at", "class", "main_spec", "off_spec", "role", "main_id",
+		}
+		pgxRows := pgxpoolmock.NewRows(columns).
+			AddRow(player.ID, player.Name, player.DiscordID, player.DiscordName, player.CreatedAt, "", "", "", "", 0).
+			ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, name, discord_id FROM players WHERE discord_id = $1", discordName).
+			"SELECT id, name, COALESCE(discord_id, ''), discord_name, created_at, class, main_spec, off_spec, role, "+
+				mainIDColumn+" "+
+				"FROM players WHERE deleted_at IS NULL AND discord_id = $1", discordName).
 			Return(pgxRows, nil)
 
 		p, err := pgBackend.SearchPlayer(context.Background(), playerID, name, discordName)
@@ -381,7 +415,9 @@ func TestPG_SearchPlayer(t *testing.T) {
 		}
 
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, name, discord_id FROM players WHERE discord_id = $1", discordName).
+			"SELECT id, name, COALESCE(discord_id, ''), discord_name, created_at, class, main_spec, off_spec, role, "+
+				mainIDColumn+" "+
+				"FROM players WHERE deleted_at IS NULL AND discord_id = $1", discordName).
 			Return(nil, errors.New("error"))
 
 		_, err := pgBackend.SearchPlayer(context.Background(), playerID, name, discordName)
@@ -414,7 +450,9 @@ func TestPG_SearchPlayer(t *testing.T) {
 		columns := []string{"id", "name"}
 		pgxRows := pgxpoolmock.NewRows(columns).AddRow(player.ID, player.Name).ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, name, discord_id FROM players WHERE discord_id = $1", discordName).
+			"SELECT id, name, COALESCE(discord_id, ''), discord_name, created_at, class, main_spec, off_spec, role, "+
+				mainIDColumn+" "+
+				"FROM players WHERE deleted_at IS NULL AND discord_id = $1", discordName).
 			Return(pgxRows, nil)
 
 		_, err := pgBackend.SearchPlayer(context.Background(), playerID, name, discordName)
@@ -446,6 +484,53 @@ func TestPG_SearchPlayer(t *testing.T) {
 	})
 }
 
+func TestPG_SearchPlayerOnPrefix(t *testing.T) {
+	t.Parallel()
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		player := entity.Player{ID: 1, Name: "arthas"}
+		columns := []string{
+			"id", "name", "discord_id", "discord_name", "created_at", "class", "main_spec", "off_spec", "role", "main_id",
+		}
+		pgxRows := pgxpoolmock.NewRows(columns).
+			AddRow(player.ID, player.Name, player.DiscordID, player.DiscordName, player.CreatedAt, "", "", "", "", 0).
+			ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT id, name, COALESCE(discord_id, ''), discord_name, created_at, class, main_spec, off_spec, role, "+
+				mainIDColumn+" "+
+				"FROM players WHERE name LIKE $1 AND deleted_at IS NULL ORDER BY name LIMIT 25", "ar%").
+			Return(pgxRows, nil)
+
+		players, err := pgBackend.SearchPlayerOnPrefix(context.Background(), "ar", 25)
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Player{player}, players)
+	})
+
+	t.Run("Context cancelled", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := pgBackend.SearchPlayerOnPrefix(ctx, "ar", 25)
+		assert.Error(t, err)
+	})
+}
+
 func TestPG_UpdatePlayer(t *testing.T) {
 	t.Parallel()
 	t.Run("Success", func(t *testing.T) {
@@ -464,11 +549,14 @@ func TestPG_UpdatePlayer(t *testing.T) {
 		player := entity.Player{
 			ID:          1,
 			Name:        "playername",
+			DiscordID:   "100000000000000001",
 			DiscordName: "toto",
 		}
 
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"UPDATE players SET name = $1, discord_id = $2 WHERE id = $3", player.Name, player.DiscordName, player.ID).
+			"UPDATE players SET name = $1, discord_id = $2, discord_name = $3, class = $4, main_spec = $5, off_spec = $6, "+
+				"role = $7, main_id = $8 WHERE id = $9", player.Name, player.DiscordID, player.DiscordName,
+			player.Class, player.MainSpec, player.OffSpec, string(player.Role), nil, player.ID).
 			Return(nil, nil)
 
 		err := pgBackend.UpdatePlayer(context.Background(), player)
@@ -510,11 +598,14 @@ func TestPG_UpdatePlayer(t *testing.T) {
 		player := entity.Player{
 			ID:          1,
 			Name:        "playername",
+			DiscordID:   "100000000000000001",
 			DiscordName: "toto",
 		}
 
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"UPDATE players SET name = $1, discord_id = $2 WHERE id = $3", player.Name, player.DiscordName, player.ID).
+			"UPDATE players SET name = $1, discord_id = $2, discord_name = $3, class = $4, main_spec = $5, off_spec = $6, "+
+				"role = $7, main_id = $8 WHERE id = $9", player.Name, player.DiscordID, player.DiscordName,
+			player.Class, player.MainSpec, player.OffSpec, string(player.Role), nil, player.ID).
 			Return(nil, errors.New("error"))
 
 		err := pgBackend.UpdatePlayer(context.Background(), player)
@@ -537,7 +628,7 @@ func TestPG_DeletePlayer(t *testing.T) {
 			},
 		}
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"DELETE FROM players WHERE id = $1", 1).
+			"UPDATE players SET deleted_at = $1 WHERE deleted_at IS NULL AND id = $2", gomock.Any(), 1).
 			Return(nil, nil)
 
 		err := pgBackend.DeletePlayer(context.Background(), entity.Player{ID: 1})
@@ -568,7 +659,7 @@ func TestPG_DeletePlayer(t *testing.T) {
 		defer ctrl.Finish()
 		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"DELETE FROM players WHERE id = $1", 1).
+			"UPDATE players SET deleted_at = $1 WHERE deleted_at IS NULL AND id = $2", gomock.Any(), 1).
 			Return(nil, errors.New("error"))
 
 		pgBackend := postgresbackend.PG{
@@ -588,9 +679,9 @@ func TestPG_DeletePlayer(t *testing.T) {
 		defer ctrl.Finish()
 		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
 
-		commandTag := pgconn.CommandTag("DELETE 0")
+		commandTag := pgconn.CommandTag("UPDATE 0")
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"DELETE FROM players WHERE id = $1", 1).
+			"UPDATE players SET deleted_at = $1 WHERE deleted_at IS NULL AND id = $2", gomock.Any(), 1).
 			Return(commandTag, nil)
 
 		pgBackend := postgresbackend.PG{
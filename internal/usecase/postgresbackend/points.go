@@ -0,0 +1,171 @@
+package postgresbackend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgconn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// pointsColumns are the columns of a points entry, with the name of its player.
+var pointsColumns = []string{
+	"points.id", "points.player_id", "players.name", "points.amount", "points.kind", "points.reason",
+	"points.author", "points.date", "COALESCE(points.raid_id, 0)", "COALESCE(points.loot_id, 0)",
+	"COALESCE(points.reverts, 0)",
+}
+
+// nullID returns nil for 0, so an optional reference is stored as NULL.
+func nullID(id int) any {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// SearchPointsEntry returns the ledger entries of a player, of a raid or of a loot.
+// playerID, raidID and lootID are ignored when -1. Entries are ordered by date.
+func (pg *PG) SearchPointsEntry(ctx context.Context, playerID, raidID, lootID int) ([]entity.PointsEntry, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Points/SearchPointsEntry")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", playerID),
+		attribute.Int("raidID", raidID),
+		attribute.Int("lootID", lootID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchPointsEntry - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if playerID != -1 {
+			params["points.player_id"] = playerID
+		}
+		if raidID != -1 {
+			params["points.raid_id"] = raidID
+		}
+		if lootID != -1 {
+			params["points.loot_id"] = lootID
+		}
+		// Entries of deleted players are hidden with them, entries of deleted raids and loots are kept
+		params["players.deleted_at"] = nil
+		sql, args, err := pg.Builder.Select(pointsColumns...).
+			From("points").
+			Join("players ON players.id = points.player_id").
+			Where(params).
+			OrderBy("points.date", "points.id").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchPointsEntry - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchPointsEntry - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+
+		var entries []entity.PointsEntry
+		for rows.Next() {
+			var entry entity.PointsEntry
+			var player entity.Player
+			var kind string
+			err := rows.Scan(&entry.ID, &player.ID, &player.Name, &entry.Amount, &kind, &entry.Reason,
+				&entry.Author, &entry.Date, &entry.RaidID, &entry.LootID, &entry.Reverts)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchPointsEntry - rows.Scan: %w", err)
+			}
+			entry.Player = &player
+			entry.Kind = entity.PointsKind(kind)
+			entries = append(entries, entry)
+		}
+		return entries, nil
+	}
+}
+
+// CreatePointsEntry adds an entry to the ledger.
+func (pg *PG) CreatePointsEntry(ctx context.Context, entry entity.PointsEntry) (entity.PointsEntry, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Points/CreatePointsEntry")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", entry.Player.ID),
+		attribute.Int("amount", entry.Amount),
+		attribute.String("kind", string(entry.Kind)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.PointsEntry{}, fmt.Errorf(
+			"database - CreatePointsEntry - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, args, err := pg.Builder.
+			Insert("points").
+			Columns("player_id", "amount", "kind", "reason", "author", "date", "raid_id", "loot_id", "reverts").
+			Values(entry.Player.ID, entry.Amount, string(entry.Kind), entry.Reason, entry.Author, entry.Date,
+				nullID(entry.RaidID), nullID(entry.LootID), nullID(entry.Reverts)).
+			Suffix("RETURNING \"id\"").ToSql()
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("database - CreatePointsEntry - r.Builder.Insert: %w", err)
+		}
+		row := pg.Pool.QueryRow(ctx, sql, args...)
+		if row == nil {
+			return entity.PointsEntry{}, fmt.Errorf("call insert points entry, returned row is empty")
+		}
+		err = row.Scan(&entry.ID)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return entity.PointsEntry{}, fmt.Errorf("points entry already reverted")
+			}
+			return entity.PointsEntry{}, fmt.Errorf("database - CreatePointsEntry - row.Scan: %w", err)
+		}
+		return entry, nil
+	}
+}
+
+// ReadPointsEntry returns an entry of the ledger.
+func (pg *PG) ReadPointsEntry(ctx context.Context, entryID int) (entity.PointsEntry, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Points/ReadPointsEntry")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", entryID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.PointsEntry{}, fmt.Errorf(
+			"database - ReadPointsEntry - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, args, err := pg.Builder.Select(pointsColumns...).
+			From("points").
+			Join("players ON players.id = points.player_id").
+			Where(squirrel.Eq{"points.id": entryID, "players.deleted_at": nil}).ToSql()
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("database - ReadPointsEntry - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, args...)
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("database - ReadPointsEntry - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+
+		if !rows.Next() {
+			return entity.PointsEntry{}, fmt.Errorf("points entry not found")
+		}
+		var entry entity.PointsEntry
+		var player entity.Player
+		var kind string
+		err = rows.Scan(&entry.ID, &player.ID, &player.Name, &entry.Amount, &kind, &entry.Reason,
+			&entry.Author, &entry.Date, &entry.RaidID, &entry.LootID, &entry.Reverts)
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("database - ReadPointsEntry - rows.Scan: %w", err)
+		}
+		entry.Player = &player
+		entry.Kind = entity.PointsKind(kind)
+		return entry, nil
+	}
+}
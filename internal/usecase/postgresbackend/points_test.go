@@ -0,0 +1,109 @@
+package postgresbackend_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
+	"github.com/antony-ramos/guildops/pkg/postgres"
+)
+
+func TestPG_SearchPointsEntry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Searching by player", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		date := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+		columns := []string{"id", "player_id", "name", "amount", "kind", "reason", "author", "date",
+			"raid_id", "loot_id", "reverts"}
+		pgxRows := pgxpoolmock.NewRows(columns).
+			AddRow(1, 2, "arthas", 10, "attendance", "raid", "guildops", date, 3, 0, 0).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT points.id, points.player_id, players.name, points.amount, points.kind, points.reason, "+
+				"points.author, points.date, COALESCE(points.raid_id, 0), COALESCE(points.loot_id, 0), "+
+				"COALESCE(points.reverts, 0) FROM points JOIN players ON players.id = points.player_id "+
+				"WHERE players.deleted_at IS NULL AND points.player_id = $1 ORDER BY points.date, points.id", 2).
+			Return(pgxRows, nil)
+
+		entries, err := pgBackend.SearchPointsEntry(context.Background(), 2, -1, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.PointsEntry{{
+			ID:     1,
+			Player: &entity.Player{ID: 2, Name: "arthas"},
+			Amount: 10,
+			Kind:   entity.PointsAttendance,
+			Reason: "raid",
+			Author: "guildops",
+			Date:   date,
+			RaidID: 3,
+		}}, entries)
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := pgBackend.SearchPointsEntry(ctx, -1, -1, -1)
+		assert.Error(t, err)
+	})
+}
+
+func TestPG_CreatePointsEntry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		entry := entity.PointsEntry{
+			Player: &entity.Player{ID: 2, Name: "arthas"},
+			Amount: -20,
+			Kind:   entity.PointsLoot,
+			Reason: "frostmourne",
+			Author: "guildops",
+			Date:   time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+			LootID: 4,
+		}
+		rows := pgxpoolmock.NewRows([]string{"id"}).AddRow(1).ToPgxRows()
+		rows.Next()
+		mockPool.EXPECT().QueryRow(gomock.Any(),
+			"INSERT INTO points (player_id,amount,kind,reason,author,date,raid_id,loot_id,reverts) "+
+				"VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING \"id\"",
+			2, -20, "loot", "frostmourne", "guildops", entry.Date, nil, 4, nil).
+			Return(rows)
+
+		e, err := pgBackend.CreatePointsEntry(context.Background(), entry)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, e.ID)
+	})
+}
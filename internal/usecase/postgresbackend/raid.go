@@ -29,7 +29,8 @@ func (pg *PG) SearchRaid(
 	default:
 		var raids []entity.Raid
 		if raidName != "" {
-			sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty").From("raids").Where("name = $1").ToSql()
+			sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty", "start_time").
+				From("raids").Where("name = $1 AND deleted_at IS NULL").ToSql()
 			if err != nil {
 				return nil, fmt.Errorf("database - SearchRaid - r.Builder: %w", err)
 			}
@@ -40,7 +41,7 @@ func (pg *PG) SearchRaid(
 			defer rows.Close()
 			for rows.Next() {
 				var raid entity.Raid
-				err := rows.Scan(&raid.ID, &raid.Name, &raid.Date, &raid.Difficulty)
+				err := scanRaid(rows, &raid)
 				if err != nil {
 					return nil, fmt.Errorf("database - SearchRaid - rows.Scan: %w", err)
 				}
@@ -48,8 +49,8 @@ func (pg *PG) SearchRaid(
 			}
 		}
 		if difficulty != "" && !date.IsZero() {
-			sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty").
-				From("raids").Where("difficulty = $1 and date = $2").ToSql()
+			sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty", "start_time").
+				From("raids").Where("difficulty = $1 and date = $2 AND deleted_at IS NULL").ToSql()
 			if err != nil {
 				return nil, fmt.Errorf("database - SearchRaid - r.Builder: %w", err)
 			}
@@ -60,7 +61,7 @@ func (pg *PG) SearchRaid(
 			defer rows.Close()
 			for rows.Next() {
 				var raid entity.Raid
-				err := rows.Scan(&raid.ID, &raid.Name, &raid.Date, &raid.Difficulty)
+				err := scanRaid(rows, &raid)
 				if err != nil {
 					return nil, fmt.Errorf("database - SearchRaid - rows.Scan: %w", err)
 				}
@@ -68,7 +69,8 @@ func (pg *PG) SearchRaid(
 			}
 		}
 		if difficulty != "" {
-			sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty").From("raids").Where("difficulty = $1").ToSql()
+			sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty", "start_time").
+				From("raids").Where("difficulty = $1 AND deleted_at IS NULL").ToSql()
 			if err != nil {
 				return nil, fmt.Errorf("database - SearchRaid - r.Builder: %w", err)
 			}
@@ -79,7 +81,7 @@ func (pg *PG) SearchRaid(
 			defer rows.Close()
 			for rows.Next() {
 				var raid entity.Raid
-				err := rows.Scan(&raid.ID, &raid.Name, &raid.Date, &raid.Difficulty)
+				err := scanRaid(rows, &raid)
 				if err != nil {
 					return nil, fmt.Errorf("database - SearchRaid - rows.Scan: %w", err)
 				}
@@ -87,7 +89,8 @@ func (pg *PG) SearchRaid(
 			}
 		}
 		if !date.IsZero() {
-			sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty").From("raids").Where("date = $1").ToSql()
+			sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty", "start_time").
+				From("raids").Where("date = $1 AND deleted_at IS NULL").ToSql()
 			if err != nil {
 				return nil, fmt.Errorf("database - SearchRaid - r.Builder: %w", err)
 			}
@@ -98,7 +101,7 @@ func (pg *PG) SearchRaid(
 			defer rows.Close()
 			for rows.Next() {
 				var raid entity.Raid
-				err := rows.Scan(&raid.ID, &raid.Name, &raid.Date, &raid.Difficulty)
+				err := scanRaid(rows, &raid)
 				if err != nil {
 					return nil, fmt.Errorf("database - SearchRaid - rows.Scan: %w", err)
 				}
@@ -109,6 +112,51 @@ func (pg *PG) SearchRaid(
 	}
 }
 
+// SearchRaidOnRange returns the raids from one date to another, both included, ordered by date.
+// At most limit raids after the first offset ones are returned, all of them when limit is 0.
+func (pg *PG) SearchRaidOnRange(
+	ctx context.Context, from, to time.Time, limit, offset int,
+) ([]entity.Raid, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Raid/SearchRaidOnRange")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("from", from.String()),
+		attribute.String("to", to.String()),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchRaidOnRange - ctx.Done: request took too much time to be proceed")
+	default:
+		query := pg.Builder.Select("id", "name", "date", "difficulty", "start_time").
+			From("raids").Where("date >= $1 and date <= $2 AND deleted_at IS NULL").OrderBy("date", "id")
+		if limit > 0 {
+			query = query.Limit(uint64(limit)).Offset(uint64(offset))
+		}
+		sql, _, err := query.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchRaidOnRange - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchRaidOnRange - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+		var raids []entity.Raid
+		for rows.Next() {
+			var raid entity.Raid
+			err := scanRaid(rows, &raid)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchRaidOnRange - rows.Scan: %w", err)
+			}
+			raids = append(raids, raid)
+		}
+		return raids, nil
+	}
+}
+
 // CreateRaid creates a raid in the database.
 func (pg *PG) CreateRaid(ctx context.Context, raid entity.Raid) (entity.Raid, error) {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Raid/CreateRaid")
@@ -125,7 +173,7 @@ func (pg *PG) CreateRaid(ctx context.Context, raid entity.Raid) (entity.Raid, er
 		sql, _, err := pg.Builder.
 			Select("name", "date", "difficulty").
 			From("raids").
-			Where("date = $1 AND difficulty = $2").ToSql()
+			Where("date = $1 AND difficulty = $2 AND deleted_at IS NULL").ToSql()
 		if err != nil {
 			return entity.Raid{}, fmt.Errorf("database - CreateRaid - r.Builder: %w", err)
 		}
@@ -139,13 +187,13 @@ func (pg *PG) CreateRaid(ctx context.Context, raid entity.Raid) (entity.Raid, er
 		}
 		sql, _, errInsert := pg.Builder.
 			Insert("raids").
-			Columns("name", "date", "difficulty").
-			Values(raid.Name, raid.Date, raid.Difficulty).ToSql()
+			Columns("name", "date", "difficulty", "start_time").
+			Values(raid.Name, raid.Date, raid.Difficulty, entity.FormatStartTime(raid.StartTime)).ToSql()
 		if errInsert != nil {
 			return entity.Raid{}, fmt.Errorf("database - CreateRaid - r.Builder.Insert: %w", errInsert)
 		}
 
-		_, err = pg.Pool.Exec(ctx, sql, raid.Name, raid.Date, raid.Difficulty)
+		_, err = pg.Pool.Exec(ctx, sql, raid.Name, raid.Date, raid.Difficulty, entity.FormatStartTime(raid.StartTime))
 		if err != nil {
 			return entity.Raid{}, fmt.Errorf("database - CreateRaid - r.Pool.Exec: %w", err)
 		}
@@ -181,7 +229,8 @@ func (pg *PG) ReadRaid(ctx context.Context, raidID int) (entity.Raid, error) {
 	case <-ctx.Done():
 		return entity.Raid{}, fmt.Errorf("database - ReadRaid - ctx.Done: request took too much time to be proceed")
 	default:
-		sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty").From("raids").Where("id = $1").ToSql()
+		sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty", "start_time").
+			From("raids").Where("id = $1 AND deleted_at IS NULL").ToSql()
 		if err != nil {
 			return entity.Raid{}, fmt.Errorf("database - ReadRaid - r.Builder: %w", err)
 		}
@@ -192,7 +241,7 @@ func (pg *PG) ReadRaid(ctx context.Context, raidID int) (entity.Raid, error) {
 		defer rows.Close()
 		var raid entity.Raid
 		for rows.Next() {
-			err := rows.Scan(&raid.ID, &raid.Name, &raid.Date, &raid.Difficulty)
+			err := scanRaid(rows, &raid)
 			if err != nil {
 				return entity.Raid{}, fmt.Errorf("database - ReadRaid - rows.Scan: %w", err)
 			}
@@ -212,7 +261,8 @@ func (pg *PG) ReadRaidOnDate(ctx context.Context, date time.Time) (entity.Raid,
 	case <-ctx.Done():
 		return entity.Raid{}, fmt.Errorf("database - ReadRaid - ctx.Done: request took too much time to be proceed")
 	default:
-		sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty").From("raids").Where("date = $1").ToSql()
+		sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty", "start_time").
+			From("raids").Where("date = $1 AND deleted_at IS NULL").ToSql()
 		if err != nil {
 			return entity.Raid{}, fmt.Errorf("database - ReadRaid - r.Builder: %w", err)
 		}
@@ -223,7 +273,7 @@ func (pg *PG) ReadRaidOnDate(ctx context.Context, date time.Time) (entity.Raid,
 		defer rows.Close()
 		var raid entity.Raid
 		for rows.Next() {
-			err := rows.Scan(&raid.ID, &raid.Name, &raid.Date, &raid.Difficulty)
+			err := scanRaid(rows, &raid)
 			if err != nil {
 				return entity.Raid{}, fmt.Errorf("database - ReadRaid - rows.Scan: %w", err)
 			}
@@ -232,6 +282,18 @@ func (pg *PG) ReadRaidOnDate(ctx context.Context, date time.Time) (entity.Raid,
 	}
 }
 
+// scanRaid reads the id, name, date, difficulty and start time of a raid from a row.
+func scanRaid(row interface{ Scan(dest ...any) error }, raid *entity.Raid) error {
+	var startTime string
+	err := row.Scan(&raid.ID, &raid.Name, &raid.Date, &raid.Difficulty, &startTime)
+	if err != nil {
+		return err
+	}
+	raid.StartTime, err = entity.ParseStartTime(startTime)
+	raid.StartTimeSet = err == nil
+	return err
+}
+
 // UpdateRaid updates a raid in the database.
 func (pg *PG) UpdateRaid(ctx context.Context, raid entity.Raid) error {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Raid/UpdateRaid")
@@ -262,6 +324,9 @@ func (pg *PG) UpdateRaid(ctx context.Context, raid entity.Raid) error {
 		if raid.Difficulty != "" {
 			oldRaid.Difficulty = raid.Difficulty
 		}
+		if raid.StartTimeSet {
+			oldRaid.StartTime = raid.StartTime
+		}
 
 		// Update raid in database
 		sql, _, err := pg.Builder.
@@ -269,6 +334,7 @@ func (pg *PG) UpdateRaid(ctx context.Context, raid entity.Raid) error {
 			Set("name", oldRaid.Name).
 			Set("date", oldRaid.Date).
 			Set("difficulty", oldRaid.Difficulty).
+			Set("start_time", entity.FormatStartTime(oldRaid.StartTime)).
 			Where("id = $1").ToSql()
 		if err != nil {
 			return fmt.Errorf("database - UpdateRaid - r.Builder.Update: %w", err)
@@ -281,6 +347,7 @@ func (pg *PG) UpdateRaid(ctx context.Context, raid entity.Raid) error {
 	}
 }
 
+// DeleteRaid marks a raid deleted, until it is restored or purged.
 func (pg *PG) DeleteRaid(ctx context.Context, raidID int) error {
 	ctx, span := otel.Tracer("Backend").Start(ctx, "Raid/DeleteRaid")
 	defer span.End()
@@ -291,15 +358,16 @@ func (pg *PG) DeleteRaid(ctx context.Context, raidID int) error {
 	case <-ctx.Done():
 		return fmt.Errorf("database - DeleteRaid - ctx.Done: request took too much time to be proceed")
 	default:
-		sql, _, err := pg.Builder.Delete("raids").Where("id = $1").ToSql()
+		sql, args, err := pg.Builder.Update("raids").Set("deleted_at", time.Now().UTC()).
+			Where("id = ? AND deleted_at IS NULL", raidID).ToSql()
 		if err != nil {
-			return fmt.Errorf("database - DeleteRaid - r.Builder.Delete: %w", err)
+			return fmt.Errorf("database - DeleteRaid - r.Builder.Update: %w", err)
 		}
-		isDelete, err := pg.Pool.Exec(ctx, sql, raidID)
+		isDelete, err := pg.Pool.Exec(ctx, sql, args...)
 		if err != nil {
 			return fmt.Errorf("database - DeleteRaid - r.Pool.Exec: %w", err)
 		}
-		if isDelete.String() == isNotDeleted {
+		if isDelete.String() == isNotUpdated {
 			return fmt.Errorf("database - DeleteRaid - raid not found")
 		}
 		return nil
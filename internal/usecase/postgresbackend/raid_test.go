@@ -32,7 +32,7 @@ func TestPG_DeleteRaid(t *testing.T) {
 			Pool:    mockPool,
 		}}
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"DELETE FROM raids WHERE id = $1", 1).
+			"UPDATE raids SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL", gomock.Any(), 1).
 			Return(nil, nil)
 
 		err := pgBackend.DeleteRaid(context.Background(), 1)
@@ -66,7 +66,7 @@ func TestPG_DeleteRaid(t *testing.T) {
 			Pool:    mockPool,
 		}}
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"DELETE FROM raids WHERE id = $1", 1).
+			"UPDATE raids SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL", gomock.Any(), 1).
 			Return(nil, errors.New("error"))
 
 		err := pgBackend.DeleteRaid(context.Background(), 1)
@@ -82,9 +82,9 @@ func TestPG_DeleteRaid(t *testing.T) {
 			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
 			Pool:    mockPool,
 		}}
-		commandTag := pgconn.CommandTag("DELETE 0")
+		commandTag := pgconn.CommandTag("UPDATE 0")
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"DELETE FROM raids WHERE id = $1", 1).
+			"UPDATE raids SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL", gomock.Any(), 1).
 			Return(commandTag, nil)
 
 		err := pgBackend.DeleteRaid(context.Background(), 1)
@@ -110,10 +110,11 @@ func TestPG_UpdateRaid(t *testing.T) {
 			Date: time.Now(),
 		}
 
-		columns := []string{"id", "name", "date", "difficulty"}
-		pgxRows := pgxpoolmock.NewRows(columns).AddRow(raid.ID, raid.Name, raid.Date, raid.Difficulty).ToPgxRows()
+		columns := []string{"id", "name", "date", "difficulty", "start_time"}
+		pgxRows := pgxpoolmock.NewRows(columns).
+			AddRow(raid.ID, raid.Name, raid.Date, raid.Difficulty, "21:00").ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, name, date, difficulty FROM raids WHERE id = $1", raid.ID).
+			"SELECT id, name, date, difficulty, start_time FROM raids WHERE id = $1 AND deleted_at IS NULL", raid.ID).
 			Return(pgxRows, nil)
 
 		raid = entity.Raid{
@@ -123,7 +124,7 @@ func TestPG_UpdateRaid(t *testing.T) {
 		}
 
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"UPDATE raids SET name = $1, date = $2, difficulty = $3 WHERE id = $1", raid.ID).
+			"UPDATE raids SET name = $1, date = $2, difficulty = $3, start_time = $4 WHERE id = $1", raid.ID).
 			Return(nil, nil)
 
 		err := pgBackend.UpdateRaid(context.Background(), raid)
@@ -166,20 +167,22 @@ func TestPG_CreateRaid(t *testing.T) {
 		}}
 
 		raid := entity.Raid{
-			ID:   1,
-			Name: "test",
-			Date: time.Now(),
+			ID:        1,
+			Name:      "test",
+			Date:      time.Now(),
+			StartTime: 20*time.Hour + 30*time.Minute,
 		}
 
 		columns := []string{"date", "difficulty"}
 		pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT name, date, difficulty FROM raids WHERE date = $1 AND difficulty = $2",
+			"SELECT name, date, difficulty FROM raids WHERE date = $1 AND difficulty = $2 AND deleted_at IS NULL",
 			raid.Date, raid.Difficulty).
 			Return(pgxRows, nil)
 
 		mockPool.EXPECT().Exec(gomock.Any(),
-			"INSERT INTO raids (name,date,difficulty) VALUES ($1,$2,$3)", raid.Name, raid.Date, raid.Difficulty).
+			"INSERT INTO raids (name,date,difficulty,start_time) VALUES ($1,$2,$3,$4)",
+			raid.Name, raid.Date, raid.Difficulty, "20:30").
 			Return(nil, nil)
 
 		columns = []string{"id"}
@@ -216,3 +219,51 @@ func TestPG_CreateRaid(t *testing.T) {
 		assert.Equal(t, raid, raid)
 	})
 }
+
+func TestPG_SearchRaidOnRange(t *testing.T) {
+	t.Parallel()
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		from := time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, 7)
+		raid := entity.Raid{
+			ID: 1, Name: "raid", Date: from, Difficulty: "heroic", StartTime: 21 * time.Hour, StartTimeSet: true,
+		}
+
+		columns := []string{"id", "name", "date", "difficulty", "start_time"}
+		pgxRows := pgxpoolmock.NewRows(columns).
+			AddRow(raid.ID, raid.Name, raid.Date, raid.Difficulty, "21:00").ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT id, name, date, difficulty, start_time FROM raids "+
+				"WHERE date >= $1 and date <= $2 AND deleted_at IS NULL ORDER BY date, id LIMIT 10 OFFSET 10", from, to).
+			Return(pgxRows, nil)
+
+		raids, err := pgBackend.SearchRaidOnRange(context.Background(), from, to, 10, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Raid{raid}, raids)
+	})
+
+	t.Run("Context cancelled", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := pgBackend.SearchRaidOnRange(ctx, time.Now(), time.Now(), 0, 0)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,159 @@
+package postgresbackend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgconn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchSeason returns the season with the given name, or every season when name is empty.
+// Seasons are ordered by start date.
+func (pg *PG) SearchSeason(ctx context.Context, name string) ([]entity.Season, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Season/SearchSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if name != "" {
+			params["name"] = name
+		}
+		sql, args, err := pg.Builder.Select("id", "name", "start_date", "end_date").
+			From("seasons").
+			Where(params).
+			OrderBy("start_date").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchSeason - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchSeason - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+
+		var seasons []entity.Season
+		for rows.Next() {
+			var season entity.Season
+			err := rows.Scan(&season.ID, &season.Name, &season.Start, &season.End)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchSeason - rows.Scan: %w", err)
+			}
+			seasons = append(seasons, season)
+		}
+		return seasons, nil
+	}
+}
+
+// CreateSeason adds a season to the calendar.
+func (pg *PG) CreateSeason(ctx context.Context, season entity.Season) (entity.Season, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Season/CreateSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", season.Name),
+		attribute.String("start", season.Start.String()),
+		attribute.String("end", season.End.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Season{}, fmt.Errorf("database - CreateSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, args, err := pg.Builder.
+			Insert("seasons").
+			Columns("name", "start_date", "end_date").
+			Values(season.Name, season.Start, season.End).
+			Suffix("RETURNING \"id\"").ToSql()
+		if err != nil {
+			return entity.Season{}, fmt.Errorf("database - CreateSeason - r.Builder.Insert: %w", err)
+		}
+		row := pg.Pool.QueryRow(ctx, sql, args...)
+		if row == nil {
+			return entity.Season{}, fmt.Errorf("call insert season, returned row is empty")
+		}
+		err = row.Scan(&season.ID)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return entity.Season{}, fmt.Errorf("season already exists")
+			}
+			return entity.Season{}, fmt.Errorf("database - CreateSeason - row.Scan: %w", err)
+		}
+		return season, nil
+	}
+}
+
+// UpdateSeason updates name and dates of a season.
+func (pg *PG) UpdateSeason(ctx context.Context, season entity.Season) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Season/UpdateSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", season.ID),
+		attribute.String("name", season.Name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdateSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, args, err := pg.Builder.
+			Update("seasons").
+			Set("name", season.Name).
+			Set("start_date", season.Start).
+			Set("end_date", season.End).
+			Where(squirrel.Eq{"id": season.ID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdateSeason - r.Builder: %w", err)
+		}
+		isUpdated, err := pg.Pool.Exec(ctx, sql, args...)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return fmt.Errorf("season already exists")
+			}
+			return fmt.Errorf("database - UpdateSeason - r.Pool.Exec: %w", err)
+		}
+		if isUpdated.String() == isNotUpdated {
+			return fmt.Errorf("database - UpdateSeason - season not found")
+		}
+		return nil
+	}
+}
+
+// DeleteSeason removes a season from the calendar.
+func (pg *PG) DeleteSeason(ctx context.Context, seasonID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Season/DeleteSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", seasonID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, _, err := pg.Builder.Delete("seasons").Where("id = $1").ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteSeason - r.Builder: %w", err)
+		}
+		isDelete, err := pg.Pool.Exec(ctx, sql, seasonID)
+		if err != nil {
+			return fmt.Errorf("database - DeleteSeason - r.Pool.Exec: %w", err)
+		}
+		if isDelete.String() == isNotDeleted {
+			return fmt.Errorf("database - DeleteSeason - season not found")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,146 @@
+package postgresbackend_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
+	"github.com/antony-ramos/guildops/pkg/postgres"
+)
+
+func TestPG_SearchSeason(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Searching by name", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		start := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+		columns := []string{"id", "name", "start_date", "end_date"}
+		pgxRows := pgxpoolmock.NewRows(columns).AddRow(1, "DF/S2", start, end).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT id, name, start_date, end_date FROM seasons WHERE name = $1 ORDER BY start_date", "DF/S2").
+			Return(pgxRows, nil)
+
+		seasons, err := pgBackend.SearchSeason(context.Background(), "DF/S2")
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Season{{ID: 1, Name: "DF/S2", Start: start, End: end}}, seasons)
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := pgBackend.SearchSeason(ctx, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestPG_CreateSeason(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		season := entity.Season{
+			Name:  "DF/S2",
+			Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+		}
+		rows := pgxpoolmock.NewRows([]string{"id"}).AddRow(1).ToPgxRows()
+		rows.Next()
+		mockPool.EXPECT().QueryRow(gomock.Any(),
+			"INSERT INTO seasons (name,start_date,end_date) VALUES ($1,$2,$3) RETURNING \"id\"",
+			season.Name, season.Start, season.End).
+			Return(rows)
+
+		s, err := pgBackend.CreateSeason(context.Background(), season)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, s.ID)
+	})
+}
+
+func TestPG_UpdateSeason(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Season not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		season := entity.Season{
+			ID:    1,
+			Name:  "DF/S2",
+			Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+		}
+		mockPool.EXPECT().Exec(gomock.Any(),
+			"UPDATE seasons SET name = $1, start_date = $2, end_date = $3 WHERE id = $4",
+			season.Name, season.Start, season.End, season.ID).
+			Return(pgconn.CommandTag("UPDATE 0"), nil)
+
+		err := pgBackend.UpdateSeason(context.Background(), season)
+		assert.Error(t, err)
+	})
+}
+
+func TestPG_DeleteSeason(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		mockPool.EXPECT().Exec(gomock.Any(), "DELETE FROM seasons WHERE id = $1", 1).
+			Return(pgconn.CommandTag("DELETE 1"), nil)
+
+		err := pgBackend.DeleteSeason(context.Background(), 1)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,179 @@
+package postgresbackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchSignup returns the signups to a raid, of a player, or of a player on a raid.
+// raidID and playerID are ignored when -1.
+func (pg *PG) SearchSignup(ctx context.Context, raidID, playerID int) ([]entity.Signup, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Signup/SearchSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if raidID != -1 {
+			params["raid_signups.raid_id"] = raidID
+		}
+		if playerID != -1 {
+			params["raid_signups.player_id"] = playerID
+		}
+		// Signups of deleted players and raids are hidden with them
+		params["players.deleted_at"] = nil
+		params["raids.deleted_at"] = nil
+		sql, args, err := pg.Builder.Select("raid_signups.raid_id", "raids.name", "raids.difficulty", "raids.date",
+			"raid_signups.player_id", "players.name", "players.class", "players.main_spec",
+			"players.off_spec", "players.role", "raid_signups.status").
+			From("raid_signups").
+			Join("raids ON raids.id = raid_signups.raid_id").
+			Join("players ON players.id = raid_signups.player_id").
+			Where(params).
+			OrderBy("raids.date", "players.name").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchSignup - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchSignup - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+
+		var signups []entity.Signup
+		for rows.Next() {
+			var raid entity.Raid
+			var player entity.Player
+			var role, status string
+			err := rows.Scan(&raid.ID, &raid.Name, &raid.Difficulty, &raid.Date, &player.ID, &player.Name,
+				&player.Class, &player.MainSpec, &player.OffSpec, &role, &status)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchSignup - rows.Scan: %w", err)
+			}
+			player.Role = entity.Role(role)
+			signups = append(signups, entity.Signup{
+				Player: &player,
+				Raid:   &raid,
+				Status: entity.SignupStatus(status),
+			})
+		}
+		return signups, nil
+	}
+}
+
+// CreateSignup signs a player up to a raid.
+func (pg *PG) CreateSignup(ctx context.Context, signup entity.Signup) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Signup/CreateSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", signup.Raid.ID),
+		attribute.Int("playerID", signup.Player.ID),
+		attribute.String("status", string(signup.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - CreateSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, _, err := pg.Builder.
+			Select("raid_id", "player_id").
+			From("raid_signups").
+			Where("raid_id = $1 AND player_id = $2").ToSql()
+		if err != nil {
+			return fmt.Errorf("database - CreateSignup - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, signup.Raid.ID, signup.Player.ID)
+		if err != nil {
+			return fmt.Errorf("database - CreateSignup - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+		if rows.Next() {
+			return fmt.Errorf("signup already exists")
+		}
+
+		sql, args, err := pg.Builder.
+			Insert("raid_signups").
+			Columns("raid_id", "player_id", "status").
+			Values(signup.Raid.ID, signup.Player.ID, string(signup.Status)).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - CreateSignup - r.Builder: %w", err)
+		}
+		_, err = pg.Pool.Exec(ctx, sql, args...)
+		if err != nil {
+			return fmt.Errorf("database - CreateSignup - r.Pool.Exec: %w", err)
+		}
+		return nil
+	}
+}
+
+// UpdateSignup updates the status of a player signed up to a raid.
+func (pg *PG) UpdateSignup(ctx context.Context, signup entity.Signup) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Signup/UpdateSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", signup.Raid.ID),
+		attribute.Int("playerID", signup.Player.ID),
+		attribute.String("status", string(signup.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdateSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, args, err := pg.Builder.
+			Update("raid_signups").
+			Set("status", string(signup.Status)).
+			Where("raid_id = ? AND player_id = ?", signup.Raid.ID, signup.Player.ID).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdateSignup - r.Builder: %w", err)
+		}
+		isUpdated, err := pg.Pool.Exec(ctx, sql, args...)
+		if err != nil {
+			return fmt.Errorf("database - UpdateSignup - r.Pool.Exec: %w", err)
+		}
+		if isUpdated.String() == isNotUpdated {
+			return fmt.Errorf("database - UpdateSignup - signup not found")
+		}
+		return nil
+	}
+}
+
+// DeleteSignup removes the signup of a player to a raid.
+func (pg *PG) DeleteSignup(ctx context.Context, raidID, playerID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Signup/DeleteSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, _, err := pg.Builder.Delete("raid_signups").Where("raid_id = $1 AND player_id = $2").ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteSignup - r.Builder: %w", err)
+		}
+		isDelete, err := pg.Pool.Exec(ctx, sql, raidID, playerID)
+		if err != nil {
+			return fmt.Errorf("database - DeleteSignup - r.Pool.Exec: %w", err)
+		}
+		if isDelete.String() == isNotDeleted {
+			return fmt.Errorf("database - DeleteSignup - signup not found")
+		}
+		return nil
+	}
+}
@@ -31,7 +31,8 @@ func (pg *PG) SearchStrikeOnParam(ctx context.Context, paramName string, param i
 		condition := fmt.Sprintf("%s = $1", paramName)
 		sql, _, err := pg.Builder.
 			Select("id", "player_id", "season", "reason", "created_at").
-			From("strikes").Where(condition).ToSql()
+			From("strikes").Where(condition).
+			Where("player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)").ToSql()
 		if err != nil {
 			return nil, fmt.Errorf("database - SearchStrike - searchStrikeOnID - r.Builder: %w", err)
 		}
@@ -156,7 +157,8 @@ func (pg *PG) ReadStrike(ctx context.Context, strikeID int) (entity.Strike, erro
 		sql, _, err := pg.Builder.
 			Select("id", "player_id", "season", "reason", "created_at").
 			From("strikes").
-			Where("id = $1").ToSql()
+			Where("id = $1").
+			Where("player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)").ToSql()
 		if err != nil {
 			return entity.Strike{}, fmt.Errorf("database - ReadStrike - r.Builder: %w", err)
 		}
@@ -35,7 +35,8 @@ func TestPG_searchStrikeOnParam(t *testing.T) {
 		columns := []string{"id", "player_id", "season", "reason", "created_at"}
 		pgxRows := pgxpoolmock.NewRows(columns).AddRow(0, 0, "test", "test", time.Now()).ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, player_id, season, reason, created_at FROM strikes WHERE player_id = $1", 1).
+			"SELECT id, player_id, season, reason, created_at FROM strikes WHERE player_id = $1 "+
+				"AND player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)", 1).
 			Return(pgxRows, nil)
 
 		strike, err := pgBackend.SearchStrikeOnParam(context.Background(), "player_id", 1)
@@ -75,7 +76,8 @@ func TestPG_searchStrikeOnParam(t *testing.T) {
 		}}
 
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, player_id, season, reason, created_at FROM strikes WHERE player_id = $1", 1).
+			"SELECT id, player_id, season, reason, created_at FROM strikes WHERE player_id = $1 "+
+				"AND player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)", 1).
 			Return(nil, errors.New("error"))
 
 		_, err := pgBackend.SearchStrikeOnParam(context.Background(), "player_id", 1)
@@ -97,7 +99,8 @@ func TestPG_searchStrikeOnParam(t *testing.T) {
 		columns := []string{"id", "player_id", "season", "reason", "created_at", "toto"}
 		pgxRows := pgxpoolmock.NewRows(columns).AddRow(0, 0, "test", "test", time.Now(), "toto").ToPgxRows()
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, player_id, season, reason, created_at FROM strikes WHERE player_id = $1", 1).
+			"SELECT id, player_id, season, reason, created_at FROM strikes WHERE player_id = $1 "+
+				"AND player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)", 1).
 			Return(pgxRows, nil)
 
 		_, err := pgBackend.SearchStrikeOnParam(context.Background(), "player_id", 1)
@@ -274,7 +277,8 @@ func TestPG_SearchStrike(t *testing.T) {
 
 		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, player_id, season, reason, created_at FROM strikes WHERE player_id = $1", player.ID).
+			"SELECT id, player_id, season, reason, created_at FROM strikes WHERE player_id = $1 "+
+				"AND player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)", player.ID).
 			Return(pgxRows, nil)
 
 		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
@@ -328,7 +332,8 @@ func TestPG_ReadStrike(t *testing.T) {
 
 		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
 		mockPool.EXPECT().Query(gomock.Any(),
-			"SELECT id, player_id, season, reason, created_at FROM strikes WHERE id = $1", strike.ID).
+			"SELECT id, player_id, season, reason, created_at FROM strikes WHERE id = $1 "+
+				"AND player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)", strike.ID).
 			Return(pgxRows, nil)
 
 		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
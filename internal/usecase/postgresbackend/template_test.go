@@ -0,0 +1,143 @@
+package postgresbackend_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
+	"github.com/antony-ramos/guildops/pkg/postgres"
+)
+
+func TestPG_SearchRaidTemplate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Searching by name", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		columns := []string{"id", "name", "difficulty", "weekdays", "start_time", "timezone"}
+		pgxRows := pgxpoolmock.NewRows(columns).
+			AddRow(1, "nighthold", "heroic", "monday,wednesday", "21:30", "Europe/Paris").ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT id, name, difficulty, weekdays, start_time, timezone FROM raid_templates "+
+				"WHERE name = $1 ORDER BY id", "nighthold").
+			Return(pgxRows, nil)
+
+		templates, err := pgBackend.SearchRaidTemplate(context.Background(), "nighthold")
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.RaidTemplate{{
+			ID:         1,
+			Name:       "nighthold",
+			Difficulty: "heroic",
+			Weekdays:   []time.Weekday{time.Monday, time.Wednesday},
+			StartTime:  21*time.Hour + 30*time.Minute,
+			Timezone:   "Europe/Paris",
+		}}, templates)
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := pgBackend.SearchRaidTemplate(ctx, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestPG_CreateRaidTemplate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		template := entity.RaidTemplate{
+			Name:       "nighthold",
+			Difficulty: "heroic",
+			Weekdays:   []time.Weekday{time.Monday, time.Wednesday},
+			StartTime:  21 * time.Hour,
+		}
+		rows := pgxpoolmock.NewRows([]string{"id"}).AddRow(1).ToPgxRows()
+		rows.Next()
+		mockPool.EXPECT().QueryRow(gomock.Any(),
+			"INSERT INTO raid_templates (name,difficulty,weekdays,start_time,timezone) "+
+				"VALUES ($1,$2,$3,$4,$5) RETURNING \"id\"",
+			"nighthold", "heroic", "monday,wednesday", "21:00", "").
+			Return(rows)
+
+		created, err := pgBackend.CreateRaidTemplate(context.Background(), template)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, created.ID)
+	})
+}
+
+func TestPG_DeleteRaidTemplate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		mockPool.EXPECT().Exec(gomock.Any(), "DELETE FROM raid_templates WHERE id = $1", 1).
+			Return(pgconn.CommandTag("DELETE 1"), nil)
+
+		err := pgBackend.DeleteRaidTemplate(context.Background(), 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		mockPool.EXPECT().Exec(gomock.Any(), "DELETE FROM raid_templates WHERE id = $1", 1).
+			Return(pgconn.CommandTag("DELETE 0"), nil)
+
+		err := pgBackend.DeleteRaidTemplate(context.Background(), 1)
+		assert.ErrorContains(t, err, "raid template not found")
+	})
+}
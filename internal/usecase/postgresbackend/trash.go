@@ -0,0 +1,201 @@
+package postgresbackend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgconn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// deletedTables are the tables of the objects which can be deleted, by kind.
+// Loots are purged first, then raids and players which would purge their loots.
+var deletedTables = []struct {
+	kind  entity.DeletedKind
+	table string
+}{
+	{entity.DeletedLoot, "loots"},
+	{entity.DeletedRaid, "raids"},
+	{entity.DeletedPlayer, "players"},
+}
+
+// discordLinkTaken tells if another player was linked to the discord user of a deleted player since.
+const discordLinkTaken = "EXISTS (SELECT 1 FROM players AS linked " +
+	"WHERE linked.discord_id = players.discord_id AND linked.deleted_at IS NULL)"
+
+// deletedTable returns the table of the objects of kind.
+func deletedTable(kind entity.DeletedKind) (string, error) {
+	for _, deleted := range deletedTables {
+		if deleted.kind == kind {
+			return deleted.table, nil
+		}
+	}
+	return "", fmt.Errorf("unknown kind of deleted object %q", kind)
+}
+
+// SearchDeleted returns the players, raids and loots deleted, the last one deleted first.
+// At most limit objects are returned, all of them when limit is 0.
+func (pg *PG) SearchDeleted(ctx context.Context, limit int) ([]entity.Deleted, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Trash/SearchDeleted")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("limit", limit),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchDeleted - ctx.Done: request took too much time to be proceed")
+	default:
+		var union string
+		for i, deleted := range deletedTables {
+			if i > 0 {
+				union += " UNION ALL "
+			}
+			union += "SELECT '" + string(deleted.kind) + "' AS kind, id, name, deleted_at FROM " + deleted.table +
+				" WHERE deleted_at IS NOT NULL"
+		}
+		query := pg.Builder.Select("kind", "id", "name", "deleted_at").
+			From("("+union+") AS deleted").OrderBy("deleted_at DESC", "id DESC")
+		if limit > 0 {
+			query = query.Limit(uint64(limit))
+		}
+		sql, _, err := query.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchDeleted - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchDeleted - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+
+		var deleted []entity.Deleted
+		for rows.Next() {
+			var object entity.Deleted
+			var kind string
+			err := rows.Scan(&kind, &object.ID, &object.Name, &object.DeletedAt)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchDeleted - rows.Scan: %w", err)
+			}
+			object.Kind = entity.DeletedKind(kind)
+			deleted = append(deleted, object)
+		}
+		return deleted, nil
+	}
+}
+
+// SearchDeletedRaid returns the deleted raids on a date with a difficulty.
+func (pg *PG) SearchDeletedRaid(ctx context.Context, date time.Time, difficulty string) ([]entity.Raid, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Trash/SearchDeletedRaid")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("date", date.String()),
+		attribute.String("difficulty", difficulty),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchDeletedRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, _, err := pg.Builder.Select("id", "name", "date", "difficulty", "start_time").From("raids").
+			Where("date = $1 AND difficulty = $2 AND deleted_at IS NOT NULL").OrderBy("id").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchDeletedRaid - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, date, difficulty)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchDeletedRaid - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+
+		var raids []entity.Raid
+		for rows.Next() {
+			var raid entity.Raid
+			err := scanRaid(rows, &raid)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchDeletedRaid - rows.Scan: %w", err)
+			}
+			raids = append(raids, raid)
+		}
+		return raids, nil
+	}
+}
+
+// RestoreDeleted brings back a deleted player, raid or loot, with what belongs to it.
+// It fails if another one with the same name, or date and difficulty for a raid, was created since.
+// A restored player is unlinked from its discord user when another player was linked to it since.
+func (pg *PG) RestoreDeleted(ctx context.Context, deleted entity.Deleted) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Trash/RestoreDeleted")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("kind", string(deleted.Kind)),
+		attribute.Int("id", deleted.ID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - RestoreDeleted - ctx.Done: request took too much time to be proceed")
+	default:
+		table, err := deletedTable(deleted.Kind)
+		if err != nil {
+			return fmt.Errorf("database - RestoreDeleted - deletedTable: %w", err)
+		}
+		query := pg.Builder.Update(table).Set("deleted_at", nil)
+		if deleted.Kind == entity.DeletedPlayer {
+			query = query.
+				Set("discord_id", squirrel.Expr("CASE WHEN "+discordLinkTaken+" THEN NULL ELSE discord_id END")).
+				Set("discord_name", squirrel.Expr("CASE WHEN "+discordLinkTaken+" THEN '' ELSE discord_name END"))
+		}
+		sql, args, err := query.Where("id = ? AND deleted_at IS NOT NULL", deleted.ID).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - RestoreDeleted - r.Builder.Update: %w", err)
+		}
+		isUpdated, err := pg.Pool.Exec(ctx, sql, args...)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return fmt.Errorf("database - RestoreDeleted - %s already exists", deleted.Kind)
+			}
+			return fmt.Errorf("database - RestoreDeleted - r.Pool.Exec: %w", err)
+		}
+		if isUpdated.String() == isNotUpdated {
+			return fmt.Errorf("database - RestoreDeleted - deleted %s not found", deleted.Kind)
+		}
+		return nil
+	}
+}
+
+// PurgeDeleted removes for good the players, raids and loots deleted before a time,
+// with everything which belongs to them. It returns the number of objects purged.
+func (pg *PG) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Trash/PurgeDeleted")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("before", before.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return 0, fmt.Errorf("database - PurgeDeleted - ctx.Done: request took too much time to be proceed")
+	default:
+		var purged int
+		for _, deleted := range deletedTables {
+			sql, _, err := pg.Builder.Delete(deleted.table).Where("deleted_at < $1").ToSql()
+			if err != nil {
+				return purged, fmt.Errorf("database - PurgeDeleted - r.Builder.Delete: %w", err)
+			}
+			tag, err := pg.Pool.Exec(ctx, sql, before)
+			if err != nil {
+				return purged, fmt.Errorf("database - PurgeDeleted - purge %s: %w", deleted.table, err)
+			}
+			purged += int(tag.RowsAffected())
+		}
+		return purged, nil
+	}
+}
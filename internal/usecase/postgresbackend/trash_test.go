@@ -0,0 +1,255 @@
+package postgresbackend_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
+	"github.com/antony-ramos/guildops/pkg/postgres"
+)
+
+const searchDeletedSQL = "SELECT kind, id, name, deleted_at FROM (" +
+	"SELECT 'loot' AS kind, id, name, deleted_at FROM loots WHERE deleted_at IS NOT NULL UNION ALL " +
+	"SELECT 'raid' AS kind, id, name, deleted_at FROM raids WHERE deleted_at IS NOT NULL UNION ALL " +
+	"SELECT 'player' AS kind, id, name, deleted_at FROM players WHERE deleted_at IS NOT NULL" +
+	") AS deleted ORDER BY deleted_at DESC, id DESC"
+
+const discordLinkTaken = "EXISTS (SELECT 1 FROM players AS linked " +
+	"WHERE linked.discord_id = players.discord_id AND linked.deleted_at IS NULL)"
+
+func TestPG_SearchDeleted(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		deletedAt := time.Date(2023, 10, 1, 21, 0, 0, 0, time.UTC)
+		columns := []string{"kind", "id", "name", "deleted_at"}
+		pgxRows := pgxpoolmock.NewRows(columns).AddRow("raid", 3, "icc", deletedAt).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), searchDeletedSQL+" LIMIT 1").Return(pgxRows, nil)
+
+		deleted, err := pgBackend.SearchDeleted(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Deleted{
+			{Kind: entity.DeletedRaid, ID: 3, Name: "icc", DeletedAt: deletedAt},
+		}, deleted)
+	})
+
+	t.Run("Query failed", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+		mockPool.EXPECT().Query(gomock.Any(), searchDeletedSQL).Return(nil, errors.New("error"))
+
+		_, err := pgBackend.SearchDeleted(context.Background(), 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("Context cancelled", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := pgBackend.SearchDeleted(ctx, 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestPG_SearchDeletedRaid(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	searchSQL := "SELECT id, name, date, difficulty, start_time FROM raids " +
+		"WHERE date = $1 AND difficulty = $2 AND deleted_at IS NOT NULL ORDER BY id"
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		columns := []string{"id", "name", "date", "difficulty", "start_time"}
+		pgxRows := pgxpoolmock.NewRows(columns).AddRow(3, "icc", date, "heroic", "21:00").ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), searchSQL, date, "heroic").Return(pgxRows, nil)
+
+		raids, err := pgBackend.SearchDeletedRaid(context.Background(), date, "heroic")
+		assert.NoError(t, err)
+		assert.Len(t, raids, 1)
+		assert.Equal(t, 3, raids[0].ID)
+		assert.Equal(t, "icc", raids[0].Name)
+	})
+
+	t.Run("Query failed", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+		mockPool.EXPECT().Query(gomock.Any(), searchSQL, date, "heroic").Return(nil, errors.New("error"))
+
+		_, err := pgBackend.SearchDeletedRaid(context.Background(), date, "heroic")
+		assert.Error(t, err)
+	})
+}
+
+func TestPG_RestoreDeleted(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+		mockPool.EXPECT().Exec(gomock.Any(),
+			"UPDATE raids SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NOT NULL", nil, 3).
+			Return(pgconn.CommandTag("UPDATE 1"), nil)
+
+		err := pgBackend.RestoreDeleted(context.Background(), entity.Deleted{Kind: entity.DeletedRaid, ID: 3})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Not deleted", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+		mockPool.EXPECT().Exec(gomock.Any(),
+			"UPDATE players SET deleted_at = $1, "+
+				"discord_id = CASE WHEN "+discordLinkTaken+" THEN NULL ELSE discord_id END, "+
+				"discord_name = CASE WHEN "+discordLinkTaken+" THEN '' ELSE discord_name END "+
+				"WHERE id = $2 AND deleted_at IS NOT NULL", nil, 1).
+			Return(pgconn.CommandTag("UPDATE 0"), nil)
+
+		err := pgBackend.RestoreDeleted(context.Background(), entity.Deleted{Kind: entity.DeletedPlayer, ID: 1})
+		assert.Error(t, err)
+	})
+
+	t.Run("Already exists", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+		mockPool.EXPECT().Exec(gomock.Any(),
+			"UPDATE raids SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NOT NULL", nil, 3).
+			Return(nil, &pgconn.PgError{Code: "23505"})
+
+		err := pgBackend.RestoreDeleted(context.Background(), entity.Deleted{Kind: entity.DeletedRaid, ID: 3})
+		assert.ErrorContains(t, err, "raid already exists")
+	})
+
+	t.Run("Unknown kind", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		err := pgBackend.RestoreDeleted(context.Background(), entity.Deleted{Kind: "season", ID: 1})
+		assert.Error(t, err)
+	})
+}
+
+func TestPG_PurgeDeleted(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+		before := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+		gomock.InOrder(
+			mockPool.EXPECT().Exec(gomock.Any(), "DELETE FROM loots WHERE deleted_at < $1", before).
+				Return(pgconn.CommandTag("DELETE 2"), nil),
+			mockPool.EXPECT().Exec(gomock.Any(), "DELETE FROM raids WHERE deleted_at < $1", before).
+				Return(pgconn.CommandTag("DELETE 0"), nil),
+			mockPool.EXPECT().Exec(gomock.Any(), "DELETE FROM players WHERE deleted_at < $1", before).
+				Return(pgconn.CommandTag("DELETE 1"), nil),
+		)
+
+		purged, err := pgBackend.PurgeDeleted(context.Background(), before)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, purged)
+	})
+
+	t.Run("Query failed", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+		mockPool.EXPECT().Exec(gomock.Any(), "DELETE FROM loots WHERE deleted_at < $1", gomock.Any()).
+			Return(nil, errors.New("error"))
+
+		_, err := pgBackend.PurgeDeleted(context.Background(), time.Now())
+		assert.Error(t, err)
+	})
+}
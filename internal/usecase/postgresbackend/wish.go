@@ -0,0 +1,133 @@
+package postgresbackend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgconn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchWish returns the wishes of a player or on an item, highest priority first.
+// playerID is ignored when -1 and item when empty.
+func (pg *PG) SearchWish(ctx context.Context, playerID int, item string) ([]entity.Wish, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Wish/SearchWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", playerID),
+		attribute.String("item", item),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchWish - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if playerID != -1 {
+			params["wishes.player_id"] = playerID
+		}
+		if item != "" {
+			params["wishes.item"] = item
+		}
+		// Wishes of deleted players are hidden with them
+		params["players.deleted_at"] = nil
+		sql, args, err := pg.Builder.
+			Select("wishes.id", "wishes.player_id", "players.name", "wishes.item", "wishes.priority",
+				"wishes.spec", "wishes.note").
+			From("wishes").
+			Join("players ON players.id = wishes.player_id").
+			Where(params).
+			OrderBy("wishes.priority", "wishes.id").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchWish - r.Builder: %w", err)
+		}
+		rows, err := pg.Pool.Query(ctx, sql, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchWish - r.Pool.Query: %w", err)
+		}
+		defer rows.Close()
+
+		var wishes []entity.Wish
+		for rows.Next() {
+			var wish entity.Wish
+			var player entity.Player
+			err := rows.Scan(&wish.ID, &player.ID, &player.Name, &wish.Item, &wish.Priority, &wish.Spec, &wish.Note)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchWish - rows.Scan: %w", err)
+			}
+			wish.Player = &player
+			wishes = append(wishes, wish)
+		}
+		return wishes, nil
+	}
+}
+
+// CreateWish adds a wish to the wishlist of its player.
+func (pg *PG) CreateWish(ctx context.Context, wish entity.Wish) (entity.Wish, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Wish/CreateWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", wish.Player.ID),
+		attribute.String("item", wish.Item),
+		attribute.Int("priority", wish.Priority),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Wish{}, fmt.Errorf("database - CreateWish - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, args, err := pg.Builder.
+			Insert("wishes").
+			Columns("player_id", "item", "priority", "spec", "note").
+			Values(wish.Player.ID, wish.Item, wish.Priority, wish.Spec, wish.Note).
+			Suffix("RETURNING \"id\"").ToSql()
+		if err != nil {
+			return entity.Wish{}, fmt.Errorf("database - CreateWish - r.Builder.Insert: %w", err)
+		}
+		row := pg.Pool.QueryRow(ctx, sql, args...)
+		if row == nil {
+			return entity.Wish{}, fmt.Errorf("call insert wish, returned row is empty")
+		}
+		err = row.Scan(&wish.ID)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return entity.Wish{}, fmt.Errorf("wish already exists")
+			}
+			return entity.Wish{}, fmt.Errorf("database - CreateWish - row.Scan: %w", err)
+		}
+		return wish, nil
+	}
+}
+
+// DeleteWish removes a wish from the wishlist of its player.
+func (pg *PG) DeleteWish(ctx context.Context, wishID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Wish/DeleteWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", wishID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteWish - ctx.Done: request took too much time to be proceed")
+	default:
+		sql, _, err := pg.Builder.Delete("wishes").Where("id = $1").ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteWish - r.Builder: %w", err)
+		}
+		isDelete, err := pg.Pool.Exec(ctx, sql, wishID)
+		if err != nil {
+			return fmt.Errorf("database - DeleteWish - r.Pool.Exec: %w", err)
+		}
+		if isDelete.String() == isNotDeleted {
+			return fmt.Errorf("database - DeleteWish - wish not found")
+		}
+		return nil
+	}
+}
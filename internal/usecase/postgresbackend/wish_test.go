@@ -0,0 +1,122 @@
+package postgresbackend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
+	"github.com/antony-ramos/guildops/pkg/postgres"
+)
+
+func TestPG_SearchWish(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Searching by item", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		columns := []string{"id", "player_id", "name", "item", "priority", "spec", "note"}
+		pgxRows := pgxpoolmock.NewRows(columns).
+			AddRow(1, 2, "arthas", "frostmourne", 1, "frost", "").ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(),
+			"SELECT wishes.id, wishes.player_id, players.name, wishes.item, wishes.priority, wishes.spec, "+
+				"wishes.note FROM wishes JOIN players ON players.id = wishes.player_id "+
+				"WHERE players.deleted_at IS NULL AND wishes.item = $1 ORDER BY wishes.priority, wishes.id", "frostmourne").
+			Return(pgxRows, nil)
+
+		wishes, err := pgBackend.SearchWish(context.Background(), -1, "frostmourne")
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Wish{{
+			ID:       1,
+			Player:   &entity.Player{ID: 2, Name: "arthas"},
+			Item:     "frostmourne",
+			Priority: 1,
+			Spec:     "frost",
+		}}, wishes)
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := pgBackend.SearchWish(ctx, -1, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestPG_CreateWish(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		wish := entity.Wish{
+			Player:   &entity.Player{ID: 2, Name: "arthas"},
+			Item:     "frostmourne",
+			Priority: 1,
+			Note:     "best in slot",
+		}
+		rows := pgxpoolmock.NewRows([]string{"id"}).AddRow(1).ToPgxRows()
+		rows.Next()
+		mockPool.EXPECT().QueryRow(gomock.Any(),
+			"INSERT INTO wishes (player_id,item,priority,spec,note) VALUES ($1,$2,$3,$4,$5) RETURNING \"id\"",
+			2, "frostmourne", 1, "", "best in slot").
+			Return(rows)
+
+		w, err := pgBackend.CreateWish(context.Background(), wish)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, w.ID)
+	})
+}
+
+func TestPG_DeleteWish(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		pgBackend := postgresbackend.PG{Postgres: &postgres.Postgres{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			Pool:    mockPool,
+		}}
+
+		mockPool.EXPECT().Exec(gomock.Any(), "DELETE FROM wishes WHERE id = $1", 1).
+			Return(pgconn.CommandTag("DELETE 1"), nil)
+
+		err := pgBackend.DeleteWish(context.Background(), 1)
+		assert.NoError(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -12,15 +13,21 @@ import (
 )
 
 type RaidUseCase struct {
-	backend Backend
+	backend  Backend
+	points   entity.PointsPolicy
+	schedule entity.SchedulePolicy
 }
 
-func NewRaidUseCase(bk Backend) *RaidUseCase {
-	return &RaidUseCase{backend: bk}
+// NewRaidUseCase returns a new RaidUseCase giving attendance points of rosters with the points policy.
+// Raids created without a start time start at the raid start of the schedule policy.
+func NewRaidUseCase(bk Backend, points entity.PointsPolicy, schedule entity.SchedulePolicy) *RaidUseCase {
+	return &RaidUseCase{backend: bk, points: points, schedule: schedule}
 }
 
+// CreateRaid creates a raid on date, starting at startTime in the guild time zone.
+// startTime is a time of day like 21:00, the raid start of the schedule policy when empty.
 func (puc RaidUseCase) CreateRaid(
-	ctx context.Context, raidName, difficulty string, date time.Time,
+	ctx context.Context, raidName, difficulty string, date time.Time, startTime string,
 ) (entity.Raid, error) {
 	ctx, span := otel.Tracer("Usecase").Start(ctx, "Raid/CreateRaid")
 	defer span.End()
@@ -28,6 +35,7 @@ func (puc RaidUseCase) CreateRaid(
 		attribute.String("raidName", raidName),
 		attribute.String("difficulty", difficulty),
 		attribute.String("date", date.String()),
+		attribute.String("startTime", startTime),
 	)
 
 	select {
@@ -38,6 +46,14 @@ func (puc RaidUseCase) CreateRaid(
 		if err != nil {
 			return entity.Raid{}, fmt.Errorf("create entity raid for backend: %w", err)
 		}
+		raid.StartTime = puc.schedule.RaidStart
+		raid.StartTimeSet = true
+		if startTime != "" {
+			raid.StartTime, err = entity.ParseStartTime(startTime)
+			if err != nil {
+				return entity.Raid{}, fmt.Errorf("create entity raid for backend: %w", err)
+			}
+		}
 		raid, err = puc.backend.CreateRaid(ctx, raid)
 		if err != nil {
 			return entity.Raid{}, fmt.Errorf("database - CreateRaid - r.CreateRaid: %w", err)
@@ -46,6 +62,49 @@ func (puc RaidUseCase) CreateRaid(
 	}
 }
 
+// CreateRaids creates a raid on each of dates, starting at startTime like CreateRaid.
+// Raids already there are kept as they are and raids deleted by officers are not created again.
+// A raid which can't be created doesn't stop the others, it is reported as failed.
+func (puc RaidUseCase) CreateRaids(
+	ctx context.Context, raidName, difficulty string, dates []time.Time, startTime string,
+) (entity.RaidGeneration, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Raid/CreateRaids")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("raidName", raidName),
+		attribute.String("difficulty", difficulty),
+		attribute.Int("dates", len(dates)),
+		attribute.String("startTime", startTime),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.RaidGeneration{},
+			fmt.Errorf("RaidUseCase - CreateRaids - ctx.Done: request took too much time to be proceed")
+	default:
+		start := puc.schedule.RaidStart
+		if startTime != "" {
+			var err error
+			start, err = entity.ParseStartTime(startTime)
+			if err != nil {
+				return entity.RaidGeneration{}, fmt.Errorf("create entity raid for backend: %w", err)
+			}
+		}
+
+		var generation entity.RaidGeneration
+		for _, date := range dates {
+			raid, err := entity.NewRaid(raidName, difficulty, date)
+			if err != nil {
+				return entity.RaidGeneration{}, fmt.Errorf("create entity raid for backend: %w", err)
+			}
+			raid.StartTime = start
+			raid.StartTimeSet = true
+			createMissingRaid(ctx, puc.backend, raid, &generation)
+		}
+		return generation, nil
+	}
+}
+
 func (puc RaidUseCase) DeleteRaidWithID(ctx context.Context, raidID int) error {
 	ctx, span := otel.Tracer("Usecase").Start(ctx, "Raid/DeleteRaidWithID")
 	defer span.End()
@@ -56,7 +115,11 @@ func (puc RaidUseCase) DeleteRaidWithID(ctx context.Context, raidID int) error {
 	case <-ctx.Done():
 		return fmt.Errorf("RaidUseCase - DeleteRaid - ctx.Done: request took too much time to be proceed")
 	default:
-		err := puc.backend.DeleteRaid(ctx, raidID)
+		err := puc.refundRaid(ctx, raidID)
+		if err != nil {
+			return err
+		}
+		err = puc.backend.DeleteRaid(ctx, raidID)
 		if err != nil {
 			return fmt.Errorf("database - DeleteRaid - r.DeleteRaid: %w", err)
 		}
@@ -83,6 +146,10 @@ func (puc RaidUseCase) DeleteRaidOnDate(ctx context.Context, date time.Time, dif
 		if len(raids) == 0 {
 			return fmt.Errorf("check if there is a raid with this date/difficulty combination: %w", err)
 		}
+		err = puc.refundRaid(ctx, raids[0].ID)
+		if err != nil {
+			return err
+		}
 		err = puc.backend.DeleteRaid(ctx, raids[0].ID)
 		if err != nil {
 			return fmt.Errorf("delete raid previously found with date/difficulty combination: %w", err)
@@ -91,6 +158,78 @@ func (puc RaidUseCase) DeleteRaidOnDate(ctx context.Context, date time.Time, dif
 	return nil
 }
 
+// ReadRaidCascade returns the raid with this ID, or the one on date with difficulty when raidID is -1,
+// with what deleting it removes along with it. Points of the raid are refunded instead of removed.
+func (puc RaidUseCase) ReadRaidCascade(
+	ctx context.Context, raidID int, date time.Time, difficulty string,
+) (entity.Raid, entity.Cascade, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Raid/ReadRaidCascade")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.String("date", date.Format("02/01/06")),
+		attribute.String("difficulty", difficulty),
+	)
+	select {
+	case <-ctx.Done():
+		return entity.Raid{}, entity.Cascade{},
+			fmt.Errorf("RaidUseCase - ReadRaidCascade - ctx.Done: request took too much time to be proceed")
+	default:
+		var raid entity.Raid
+		var err error
+		if raidID != -1 {
+			raid, err = puc.backend.ReadRaid(ctx, raidID)
+		} else {
+			raid, err = findRaid(ctx, puc.backend, date, difficulty)
+		}
+		if err != nil {
+			return entity.Raid{}, entity.Cascade{}, fmt.Errorf("read raid: %w", err)
+		}
+
+		var cascade entity.Cascade
+		loots, err := puc.backend.SearchLoot(ctx, "", raid.Date, raid.Difficulty, "", 0, 0)
+		if err != nil {
+			return entity.Raid{}, entity.Cascade{}, fmt.Errorf("search loots of raid: %w", err)
+		}
+		cascade.Loots = len(loots)
+		absences, err := puc.backend.SearchAbsence(ctx, "", -1, raid.Date)
+		if err != nil {
+			return entity.Raid{}, entity.Cascade{}, fmt.Errorf("search absences of raid: %w", err)
+		}
+		for _, absence := range absences {
+			if absence.Raid != nil && absence.Raid.ID == raid.ID {
+				cascade.Absences++
+			}
+		}
+		fails, err := puc.backend.SearchFail(ctx, "", -1, raid.ID, "", 0, 0)
+		if err != nil {
+			return entity.Raid{}, entity.Cascade{}, fmt.Errorf("search fails of raid: %w", err)
+		}
+		cascade.Fails = len(fails)
+		participants, err := puc.backend.SearchParticipant(ctx, raid.ID, -1)
+		if err != nil {
+			return entity.Raid{}, entity.Cascade{}, fmt.Errorf("search roster of raid: %w", err)
+		}
+		cascade.Roster = len(participants)
+		signups, err := puc.backend.SearchSignup(ctx, raid.ID, -1)
+		if err != nil {
+			return entity.Raid{}, entity.Cascade{}, fmt.Errorf("search signups of raid: %w", err)
+		}
+		cascade.Signups = len(signups)
+		entries, err := puc.backend.SearchPointsEntry(ctx, -1, raid.ID, -1)
+		if err != nil {
+			return entity.Raid{}, entity.Cascade{}, fmt.Errorf("search points of raid: %w", err)
+		}
+		reversed := entity.Reversed(entries)
+		for _, entry := range entries {
+			if entry.Kind != entity.PointsReversal && !reversed[entry.ID] {
+				cascade.Refunds++
+			}
+		}
+		return raid, cascade, nil
+	}
+}
+
 func (puc RaidUseCase) ReadRaid(ctx context.Context, date time.Time) (entity.Raid, error) {
 	ctx, span := otel.Tracer("Usecase").Start(ctx, "Raid/ReadRaid")
 	span.SetAttributes(
@@ -112,3 +251,227 @@ func (puc RaidUseCase) ReadRaid(ctx context.Context, date time.Time) (entity.Rai
 		return raids[0], nil
 	}
 }
+
+// ListRaids returns the raids from one date to another, both included, ordered by date.
+// At most limit raids after the first offset ones are returned, all of them when limit is 0.
+func (puc RaidUseCase) ListRaids(ctx context.Context, from, to time.Time, limit, offset int) ([]entity.Raid, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Raid/ListRaids")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("from", from.Format("02/01/06")),
+		attribute.String("to", to.Format("02/01/06")),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("RaidUseCase - ListRaids - ctx.Done: request took too much time to be proceed")
+	default:
+		raids, err := puc.backend.SearchRaidOnRange(ctx, from, to, limit, offset)
+		if err != nil {
+			return nil, fmt.Errorf("search raids on range: %w", err)
+		}
+		return raids, nil
+	}
+}
+
+// findRaid returns the raid on this date with this difficulty.
+// difficulty can be empty when there is a single raid on this date.
+func findRaid(ctx context.Context, backend Backend, date time.Time, difficulty string) (entity.Raid, error) {
+	raids, err := backend.SearchRaid(ctx, "", date, "")
+	if err != nil {
+		return entity.Raid{}, fmt.Errorf("search raid on this date: %w", err)
+	}
+
+	difficulty = strings.ToLower(difficulty)
+	var found []entity.Raid
+	for _, raid := range raids {
+		if !raid.Date.Equal(date) || (difficulty != "" && raid.Difficulty != difficulty) {
+			continue
+		}
+		found = append(found, raid)
+	}
+
+	switch len(found) {
+	case 0:
+		return entity.Raid{}, fmt.Errorf("check raid exists: no raid found on %s", date.Format("02/01/06"))
+	case 1:
+		return found[0], nil
+	default:
+		return entity.Raid{}, fmt.Errorf("check raid is unique: several raids on %s, difficulty is required",
+			date.Format("02/01/06"))
+	}
+}
+
+// SetRaidRoster records the status of players on the raid of this date.
+// roster lists player names for each status. Players already on the roster get their new status,
+// the others are left as they are. Players of removed are taken off the roster and lose the attendance
+// points of the raid. It returns the raid with its whole roster.
+func (puc RaidUseCase) SetRaidRoster(
+	ctx context.Context, date time.Time, difficulty string, roster map[entity.ParticipantStatus][]string,
+	removed []string,
+) (entity.Raid, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Raid/SetRaidRoster")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("date", date.Format("02/01/06")),
+		attribute.String("difficulty", difficulty),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Raid{}, fmt.Errorf("RaidUseCase - SetRaidRoster - ctx.Done: request took too much time to be proceed")
+	default:
+		raid, err := findRaid(ctx, puc.backend, date, difficulty)
+		if err != nil {
+			return entity.Raid{}, err
+		}
+
+		// Resolve every player before writing anything, so a typo doesn't leave a half set roster
+		var participants []entity.Participant
+		listed := make(map[string]bool)
+		for _, status := range entity.ParticipantStatuses {
+			for _, playerName := range roster[status] {
+				player, err := rosterPlayer(ctx, puc.backend, listed, playerName)
+				if err != nil {
+					return entity.Raid{}, err
+				}
+				if player == nil {
+					continue
+				}
+				participant, err := entity.NewParticipant(player, &raid, string(status))
+				if err != nil {
+					return entity.Raid{}, fmt.Errorf("create entity participant: %w", err)
+				}
+				participants = append(participants, participant)
+			}
+		}
+		var leaving []*entity.Player
+		for _, playerName := range removed {
+			player, err := rosterPlayer(ctx, puc.backend, listed, playerName)
+			if err != nil {
+				return entity.Raid{}, err
+			}
+			if player == nil {
+				continue
+			}
+			existing, err := puc.backend.SearchParticipant(ctx, raid.ID, player.ID)
+			if err != nil {
+				return entity.Raid{}, fmt.Errorf("search participant %s: %w", player.Name, err)
+			}
+			if len(existing) == 0 {
+				return entity.Raid{}, fmt.Errorf("check roster: player %s is not on the roster", player.Name)
+			}
+			leaving = append(leaving, player)
+		}
+		if len(participants) == 0 && len(leaving) == 0 {
+			return entity.Raid{}, fmt.Errorf("check roster: no player given")
+		}
+
+		for _, participant := range participants {
+			existing, err := puc.backend.SearchParticipant(ctx, raid.ID, participant.Player.ID)
+			if err != nil {
+				return entity.Raid{}, fmt.Errorf("search participant %s: %w", participant.Player.Name, err)
+			}
+			if len(existing) == 0 {
+				err = puc.backend.CreateParticipant(ctx, participant)
+			} else {
+				err = puc.backend.UpdateParticipant(ctx, participant)
+			}
+			if err != nil {
+				return entity.Raid{}, fmt.Errorf("save participant %s: %w", participant.Player.Name, err)
+			}
+		}
+		for _, player := range leaving {
+			err = puc.backend.DeleteParticipant(ctx, raid.ID, player.ID)
+			if err != nil {
+				return entity.Raid{}, fmt.Errorf("remove participant %s: %w", player.Name, err)
+			}
+		}
+
+		err = earnOnRaid(ctx, puc.backend, puc.points, raid, participants, leaving)
+		if err != nil {
+			return entity.Raid{}, fmt.Errorf("give attendance points: %w", err)
+		}
+
+		return puc.readRoster(ctx, raid)
+	}
+}
+
+// rosterPlayer returns the player with this name, or nil when the name is blank.
+// listed keeps the names already given, so a player can't be given twice.
+func rosterPlayer(
+	ctx context.Context, backend Backend, listed map[string]bool, playerName string,
+) (*entity.Player, error) {
+	playerName = strings.ToLower(strings.TrimSpace(playerName))
+	if playerName == "" {
+		return nil, nil
+	}
+	if listed[playerName] {
+		return nil, fmt.Errorf("check roster: player %s is listed twice", playerName)
+	}
+	listed[playerName] = true
+
+	players, err := backend.SearchPlayer(ctx, -1, playerName, "")
+	if err != nil {
+		return nil, fmt.Errorf("search player %s: %w", playerName, err)
+	}
+	if len(players) == 0 {
+		return nil, fmt.Errorf("check player exists: player %s not found", playerName)
+	}
+	return &players[0], nil
+}
+
+// ReadRaidRoster returns the raid of this date with the players of its roster selected by filter.
+// difficulty can be empty when there is a single raid on this date.
+func (puc RaidUseCase) ReadRaidRoster(
+	ctx context.Context, date time.Time, difficulty string, filter entity.PlayerFilter,
+) (entity.Raid, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Raid/ReadRaidRoster")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("date", date.Format("02/01/06")),
+		attribute.String("difficulty", difficulty),
+		attribute.String("filter", filter.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Raid{}, fmt.Errorf("RaidUseCase - ReadRaidRoster - ctx.Done: request took too much time to be proceed")
+	default:
+		raid, err := findRaid(ctx, puc.backend, date, difficulty)
+		if err != nil {
+			return entity.Raid{}, err
+		}
+		raid, err = puc.readRoster(ctx, raid)
+		if err != nil {
+			return entity.Raid{}, err
+		}
+		raid.FilterRoster(filter)
+		return raid, nil
+	}
+}
+
+// readRoster fills the roster of a raid.
+func (puc RaidUseCase) readRoster(ctx context.Context, raid entity.Raid) (entity.Raid, error) {
+	participants, err := puc.backend.SearchParticipant(ctx, raid.ID, -1)
+	if err != nil {
+		return entity.Raid{}, fmt.Errorf("search roster of raid: %w", err)
+	}
+	raid.SetRoster(participants)
+	return raid, nil
+}
+
+// refundRaid reverts the points earned and spent on a raid before it is deleted.
+func (puc RaidUseCase) refundRaid(ctx context.Context, raidID int) error {
+	entries, err := puc.backend.SearchPointsEntry(ctx, -1, raidID, -1)
+	if err != nil {
+		return fmt.Errorf("search points of raid: %w", err)
+	}
+	err = revertAll(ctx, puc.backend, entries, raidDeletedReason)
+	if err != nil {
+		return fmt.Errorf("refund points of raid: %w", err)
+	}
+	return nil
+}
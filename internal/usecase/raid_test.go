@@ -21,7 +21,7 @@ func TestRaidUseCase_CreateRaid(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
 		raid := entity.Raid{
 			Name:       "raid name",
@@ -32,12 +32,93 @@ func TestRaidUseCase_CreateRaid(t *testing.T) {
 		mockBackend.On("CreateRaid", mock.Anything, mock.Anything).
 			Return(raid, nil)
 
-		r, err := raidUseCase.CreateRaid(context.Background(), raid.Name, raid.Difficulty, raid.Date)
+		r, err := raidUseCase.CreateRaid(context.Background(), raid.Name, raid.Difficulty, raid.Date, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, raid, r)
 		mockBackend.AssertExpectations(t)
 	})
+
+	t.Run("Start time", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{},
+			entity.SchedulePolicy{RaidStart: 21 * time.Hour})
+
+		date := time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)
+		mockBackend.On("CreateRaid", mock.Anything, entity.Raid{
+			Name: "nighthold", Difficulty: "heroic", Date: date, StartTime: 20*time.Hour + 30*time.Minute, StartTimeSet: true,
+		}).Return(entity.Raid{ID: 1}, nil)
+		mockBackend.On("CreateRaid", mock.Anything, entity.Raid{
+			Name: "nighthold", Difficulty: "mythic", Date: date, StartTime: 21 * time.Hour, StartTimeSet: true,
+		}).Return(entity.Raid{ID: 2}, nil)
+
+		_, err := raidUseCase.CreateRaid(context.Background(), "nighthold", "heroic", date, "20:30")
+		assert.NoError(t, err)
+		_, err = raidUseCase.CreateRaid(context.Background(), "nighthold", "mythic", date, "")
+		assert.NoError(t, err)
+		_, err = raidUseCase.CreateRaid(context.Background(), "nighthold", "normal", date, "8pm")
+		assert.ErrorContains(t, err, "start time must be a time of day like 21:00")
+	})
+}
+
+func TestRaidUseCase_CreateRaids(t *testing.T) {
+	t.Parallel()
+
+	monday := time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+	wednesday := monday.AddDate(0, 0, 2)
+	thursday := monday.AddDate(0, 0, 3)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{},
+			entity.SchedulePolicy{RaidStart: 21 * time.Hour})
+
+		existing := entity.Raid{ID: 1, Name: "nighthold", Difficulty: "heroic", Date: tuesday}
+		deleted := entity.Raid{ID: 2, Name: "nighthold", Difficulty: "heroic", Date: wednesday}
+		mockBackend.On("SearchRaid", mock.Anything, "", monday, "heroic").Return(nil, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", tuesday, "heroic").Return([]entity.Raid{existing}, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", wednesday, "heroic").Return(nil, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", thursday, "heroic").Return(nil, nil)
+		mockBackend.On("SearchDeletedRaid", mock.Anything, monday, "heroic").Return(nil, nil)
+		mockBackend.On("SearchDeletedRaid", mock.Anything, wednesday, "heroic").Return([]entity.Raid{deleted}, nil)
+		mockBackend.On("SearchDeletedRaid", mock.Anything, thursday, "heroic").Return(nil, nil)
+		mockBackend.On("CreateRaid", mock.Anything, entity.Raid{
+			Name: "nighthold", Difficulty: "heroic", Date: monday, StartTime: 21 * time.Hour, StartTimeSet: true,
+		}).Return(entity.Raid{ID: 3, Name: "nighthold", Difficulty: "heroic", Date: monday}, nil)
+		mockBackend.On("CreateRaid", mock.Anything, entity.Raid{
+			Name: "nighthold", Difficulty: "heroic", Date: thursday, StartTime: 21 * time.Hour, StartTimeSet: true,
+		}).Return(entity.Raid{}, errors.New("database is locked"))
+
+		generation, err := raidUseCase.CreateRaids(context.Background(), "Nighthold", "Heroic",
+			[]time.Time{monday, tuesday, wednesday, thursday}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Raid{{ID: 3, Name: "nighthold", Difficulty: "heroic", Date: monday}},
+			generation.Created)
+		assert.Equal(t, []entity.Raid{existing}, generation.Existing)
+		assert.Equal(t, []entity.Raid{deleted}, generation.Deleted)
+		if assert.Len(t, generation.Failed, 1) {
+			assert.Equal(t, thursday, generation.Failed[0].Raid.Date)
+			assert.Equal(t, "database is locked", generation.Failed[0].Reason)
+		}
+	})
+
+	t.Run("Invalid name", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		_, err := raidUseCase.CreateRaids(context.Background(), "raid 1", "heroic", []time.Time{monday}, "")
+		assert.ErrorContains(t, err, "name must only contain letters")
+	})
 }
 
 func TestRaidUseCase_DeleteRaidWithID(t *testing.T) {
@@ -47,8 +128,9 @@ func TestRaidUseCase_DeleteRaidWithID(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, 1, -1).Return(nil, nil)
 		mockBackend.On("DeleteRaid", mock.Anything, mock.Anything).
 			Return(nil)
 
@@ -62,8 +144,9 @@ func TestRaidUseCase_DeleteRaidWithID(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, 1, -1).Return(nil, nil)
 		mockBackend.On("DeleteRaid", mock.Anything, mock.Anything).
 			Return(errors.New("Backend Error"))
 
@@ -77,7 +160,7 @@ func TestRaidUseCase_DeleteRaidWithID(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -95,7 +178,7 @@ func TestRaidUseCase_DeleteRaidOnDate(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
 		mockBackend.On("SearchRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Raid{
@@ -107,6 +190,7 @@ func TestRaidUseCase_DeleteRaidOnDate(t *testing.T) {
 				},
 			}, nil)
 
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, 1, -1).Return(nil, nil)
 		mockBackend.On("DeleteRaid", mock.Anything, mock.Anything, mock.Anything).
 			Return(nil)
 
@@ -120,7 +204,7 @@ func TestRaidUseCase_DeleteRaidOnDate(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
 		mockBackend.On("SearchRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, nil)
@@ -135,7 +219,7 @@ func TestRaidUseCase_DeleteRaidOnDate(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
 		mockBackend.On("SearchRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Raid{
@@ -147,6 +231,7 @@ func TestRaidUseCase_DeleteRaidOnDate(t *testing.T) {
 				},
 			}, nil)
 
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, 1, -1).Return(nil, nil)
 		mockBackend.On("DeleteRaid", mock.Anything, mock.Anything, mock.Anything).
 			Return(errors.New("Backend Error"))
 
@@ -160,7 +245,7 @@ func TestRaidUseCase_DeleteRaidOnDate(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
 		mockBackend.On("SearchRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, errors.New("Backend Error"))
@@ -176,7 +261,7 @@ func TestRaidUseCase_DeleteRaidOnDate(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -194,7 +279,7 @@ func TestRaidUseCase_ReadRaid(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
 		mockBackend.On("SearchRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Raid{
@@ -217,7 +302,7 @@ func TestRaidUseCase_ReadRaid(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
 		mockBackend.On("SearchRaid", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, errors.New("Backend Error"))
@@ -233,7 +318,7 @@ func TestRaidUseCase_ReadRaid(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		raidUseCase := usecase.NewRaidUseCase(mockBackend)
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -244,3 +329,317 @@ func TestRaidUseCase_ReadRaid(t *testing.T) {
 		mockBackend.AssertExpectations(t)
 	})
 }
+
+func TestRaidUseCase_ReadRaidCascade(t *testing.T) {
+	t.Parallel()
+
+	raidDate := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+	raid := entity.Raid{ID: 1, Name: "raid", Difficulty: "heroic", Date: raidDate}
+	other := entity.Raid{ID: 2, Name: "raid", Difficulty: "mythic", Date: raidDate}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		mockBackend.On("ReadRaid", mock.Anything, 1).Return(raid, nil)
+		mockBackend.On("SearchLoot", mock.Anything, "", raidDate, "heroic", "", 0, 0).
+			Return([]entity.Loot{{ID: 1}, {ID: 2}}, nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", -1, raidDate).
+			Return([]entity.Absence{{ID: 1, Raid: &raid}, {ID: 2, Raid: &other}}, nil)
+		mockBackend.On("SearchFail", mock.Anything, "", -1, 1, "", 0, 0).Return(nil, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, -1).
+			Return([]entity.Participant{{Raid: &raid}, {Raid: &raid}}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, -1).Return([]entity.Signup{{Raid: &raid}}, nil)
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, 1, -1).Return([]entity.PointsEntry{
+			{ID: 1, Kind: entity.PointsAttendance},
+			{ID: 2, Kind: entity.PointsAttendance},
+			{ID: 3, Kind: entity.PointsReversal, Reverts: 2},
+		}, nil)
+
+		got, cascade, err := raidUseCase.ReadRaidCascade(context.Background(), 1, time.Time{}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, raid, got)
+		assert.Equal(t, entity.Cascade{Loots: 2, Absences: 1, Roster: 2, Signups: 1, Refunds: 1}, cascade)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Several raids on date", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		mockBackend.On("SearchRaid", mock.Anything, "", raidDate, "").Return([]entity.Raid{raid, other}, nil)
+
+		_, _, err := raidUseCase.ReadRaidCascade(context.Background(), -1, raidDate, "")
+		assert.ErrorContains(t, err, "difficulty is required")
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestRaidUseCase_ListRaids(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		raids := []entity.Raid{{ID: 1, Name: "raid", Difficulty: "heroic", Date: from}}
+		mockBackend.On("SearchRaidOnRange", mock.Anything, from, to, 10, 20).Return(raids, nil)
+
+		got, err := raidUseCase.ListRaids(context.Background(), from, to, 10, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, raids, got)
+		mockBackend.AssertExpectations(t)
+	})
+	t.Run("Backend Error", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		mockBackend.On("SearchRaidOnRange", mock.Anything, from, to, 0, 0).Return(nil, errors.New("Backend Error"))
+
+		_, err := raidUseCase.ListRaids(context.Background(), from, to, 0, 0)
+
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestRaidUseCase_SetRaidRoster(t *testing.T) {
+	t.Parallel()
+
+	raidDate := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+	raid := entity.Raid{ID: 1, Name: "raid", Difficulty: "heroic", Date: raidDate}
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+	jaina := entity.Player{ID: 2, Name: "jaina"}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		mockBackend.On("SearchRaid", mock.Anything, "", raidDate, "").
+			Return([]entity.Raid{raid, {ID: 2, Difficulty: "mythic", Date: raidDate}}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "jaina", "").Return([]entity.Player{jaina}, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, 1).Return(nil, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, 2).
+			Return([]entity.Participant{{Player: &jaina, Raid: &raid, Status: entity.ParticipantPresent}}, nil)
+		mockBackend.On("CreateParticipant", mock.Anything,
+			entity.Participant{Player: &arthas, Raid: &raid, Status: entity.ParticipantLate}).Return(nil)
+		mockBackend.On("UpdateParticipant", mock.Anything,
+			entity.Participant{Player: &jaina, Raid: &raid, Status: entity.ParticipantBench}).Return(nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, -1).
+			Return([]entity.Participant{
+				{Player: &arthas, Raid: &raid, Status: entity.ParticipantLate},
+				{Player: &jaina, Raid: &raid, Status: entity.ParticipantBench},
+			}, nil)
+
+		r, err := raidUseCase.SetRaidRoster(context.Background(), raidDate, "Heroic",
+			map[entity.ParticipantStatus][]string{
+				entity.ParticipantLate:  {"Arthas"},
+				entity.ParticipantBench: {" jaina", ""},
+			}, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entity.Player{&arthas}, r.Late)
+		assert.Equal(t, []*entity.Player{&jaina}, r.Bench)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Attendance points", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{Attendance: 10}, entity.SchedulePolicy{})
+
+		mockBackend.On("SearchRaid", mock.Anything, "", raidDate, "").Return([]entity.Raid{raid}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "jaina", "").Return([]entity.Player{jaina}, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, 1).Return(nil, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, 2).
+			Return([]entity.Participant{{Player: &jaina, Raid: &raid, Status: entity.ParticipantPresent}}, nil)
+		mockBackend.On("CreateParticipant", mock.Anything, mock.Anything).Return(nil)
+		mockBackend.On("UpdateParticipant", mock.Anything, mock.Anything).Return(nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, -1).Return(nil, nil)
+		// jaina earned points while present and is now absent
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, 1, -1).Return([]entity.PointsEntry{
+			{ID: 5, Player: &jaina, Amount: 10, Kind: entity.PointsAttendance, RaidID: 1},
+		}, nil)
+		mockBackend.On("CreatePointsEntry", mock.Anything, mock.MatchedBy(func(entry entity.PointsEntry) bool {
+			return entry.Kind == entity.PointsAttendance && entry.Player.ID == arthas.ID &&
+				entry.Amount == 10 && entry.RaidID == 1 && entry.Reason == "raid heroic on 02/10/23"
+		})).Return(entity.PointsEntry{ID: 6}, nil).Once()
+		mockBackend.On("CreatePointsEntry", mock.Anything, mock.MatchedBy(func(entry entity.PointsEntry) bool {
+			return entry.Kind == entity.PointsReversal && entry.Player.ID == jaina.ID &&
+				entry.Amount == -10 && entry.Reverts == 5
+		})).Return(entity.PointsEntry{ID: 7}, nil).Once()
+
+		_, err := raidUseCase.SetRaidRoster(context.Background(), raidDate, "",
+			map[entity.ParticipantStatus][]string{
+				entity.ParticipantPresent: {"arthas"},
+				entity.ParticipantAbsent:  {"jaina"},
+			}, nil)
+
+		assert.NoError(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Remove players", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{Attendance: 10}, entity.SchedulePolicy{})
+
+		mockBackend.On("SearchRaid", mock.Anything, "", raidDate, "").Return([]entity.Raid{raid}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "jaina", "").Return([]entity.Player{jaina}, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, 2).
+			Return([]entity.Participant{{Player: &jaina, Raid: &raid, Status: entity.ParticipantPresent}}, nil)
+		mockBackend.On("DeleteParticipant", mock.Anything, 1, 2).Return(nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, -1).Return(nil, nil)
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, 1, -1).Return([]entity.PointsEntry{
+			{ID: 5, Player: &jaina, Amount: 10, Kind: entity.PointsAttendance, RaidID: 1},
+		}, nil)
+		mockBackend.On("CreatePointsEntry", mock.Anything, mock.MatchedBy(func(entry entity.PointsEntry) bool {
+			return entry.Kind == entity.PointsReversal && entry.Player.ID == jaina.ID &&
+				entry.Amount == -10 && entry.Reverts == 5 && entry.Reason == "removed from the roster"
+		})).Return(entity.PointsEntry{ID: 6}, nil).Once()
+
+		r, err := raidUseCase.SetRaidRoster(context.Background(), raidDate, "", nil, []string{"Jaina"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, r.Players)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Remove a player not on the roster", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		mockBackend.On("SearchRaid", mock.Anything, "", raidDate, "").Return([]entity.Raid{raid}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, 1).Return(nil, nil)
+
+		_, err := raidUseCase.SetRaidRoster(context.Background(), raidDate, "", nil, []string{"arthas"})
+
+		assert.ErrorContains(t, err, "player arthas is not on the roster")
+		mockBackend.AssertNotCalled(t, "DeleteParticipant", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Several raids on date", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		mockBackend.On("SearchRaid", mock.Anything, "", raidDate, "").
+			Return([]entity.Raid{raid, {ID: 2, Difficulty: "mythic", Date: raidDate}}, nil)
+
+		_, err := raidUseCase.SetRaidRoster(context.Background(), raidDate, "",
+			map[entity.ParticipantStatus][]string{entity.ParticipantPresent: {"arthas"}}, nil)
+
+		assert.ErrorContains(t, err, "difficulty is required")
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		mockBackend.On("SearchRaid", mock.Anything, "", raidDate, "").Return([]entity.Raid{raid}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "sylvanas", "").Return(nil, nil)
+
+		_, err := raidUseCase.SetRaidRoster(context.Background(), raidDate, "",
+			map[entity.ParticipantStatus][]string{entity.ParticipantPresent: {"arthas", "sylvanas"}}, nil)
+
+		assert.ErrorContains(t, err, "player sylvanas not found")
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Player listed twice", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		mockBackend.On("SearchRaid", mock.Anything, "", raidDate, "").Return([]entity.Raid{raid}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+
+		_, err := raidUseCase.SetRaidRoster(context.Background(), raidDate, "",
+			map[entity.ParticipantStatus][]string{
+				entity.ParticipantPresent: {"arthas"},
+				entity.ParticipantAbsent:  {"arthas"},
+			}, nil)
+
+		assert.ErrorContains(t, err, "player arthas is listed twice")
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestRaidUseCase_ReadRaidRoster(t *testing.T) {
+	t.Parallel()
+
+	raidDate := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		raid := entity.Raid{ID: 1, Name: "raid", Difficulty: "heroic", Date: raidDate}
+		arthas := entity.Player{ID: 1, Name: "arthas"}
+		mockBackend.On("SearchRaid", mock.Anything, "", raidDate, "").Return([]entity.Raid{raid}, nil)
+		mockBackend.On("SearchParticipant", mock.Anything, 1, -1).
+			Return([]entity.Participant{{Player: &arthas, Raid: &raid, Status: entity.ParticipantPresent}}, nil)
+
+		r, err := raidUseCase.ReadRaidRoster(context.Background(), raidDate, "", entity.PlayerFilter{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, r.ID)
+		assert.Equal(t, []*entity.Player{&arthas}, r.Players)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("No raid", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		raidUseCase := usecase.NewRaidUseCase(mockBackend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+
+		mockBackend.On("SearchRaid", mock.Anything, "", raidDate, "").Return(nil, nil)
+
+		_, err := raidUseCase.ReadRaidRoster(context.Background(), raidDate, "mythic", entity.PlayerFilter{})
+
+		assert.ErrorContains(t, err, "no raid found on 02/10/23")
+	})
+}
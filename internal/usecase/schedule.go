@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// ScheduleUseCase is the use case of the jobs run on a schedule:
+// raid announcements, signup reminders and the absence summary of officers.
+type ScheduleUseCase struct {
+	backend   Backend
+	policy    entity.SchedulePolicy
+	announcer Announcer
+	notifier  Notifier
+}
+
+// NewScheduleUseCase returns a new ScheduleUseCase posting to the guild with announcer
+// and to officers with notifier. Raid times are read with policy.
+func NewScheduleUseCase(
+	bk Backend, policy entity.SchedulePolicy, announcer Announcer, notifier Notifier,
+) *ScheduleUseCase {
+	return &ScheduleUseCase{backend: bk, policy: policy, announcer: announcer, notifier: notifier}
+}
+
+// AnnounceRaids posts the raids of the next days of the policy which have not started at now,
+// with the count of their signups. Nothing is posted when there is no raid.
+func (suc ScheduleUseCase) AnnounceRaids(ctx context.Context, now time.Time) error {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Schedule/AnnounceRaids")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("now", now.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("ScheduleUseCase - AnnounceRaids - ctx.Done: request took too much time to be proceed")
+	default:
+		if suc.announcer == nil || suc.policy.AnnounceDays <= 0 {
+			return nil
+		}
+
+		raids, err := suc.raidsBetween(ctx, now, now.AddDate(0, 0, suc.policy.AnnounceDays-1))
+		if err != nil {
+			return err
+		}
+
+		msg := "Upcoming raids:\n"
+		var announced int
+		for _, raid := range raids {
+			if !suc.policy.StartOf(raid).After(now) {
+				continue
+			}
+			signups, err := suc.backend.SearchSignup(ctx, raid.ID, -1)
+			if err != nil {
+				return fmt.Errorf("search signups of raid: %w", err)
+			}
+			raid.Signups = signups
+
+			counts := make([]string, 0, len(entity.SignupStatuses))
+			for _, status := range entity.SignupStatuses {
+				counts = append(counts, strconv.Itoa(len(raid.SignedUp(status)))+" "+string(status))
+			}
+			msg += "* " + suc.raidTitle(raid) + " : " + strings.Join(counts, ", ") + "\n"
+			announced++
+		}
+		if announced == 0 {
+			return nil
+		}
+
+		err = suc.announcer.Announce(ctx, msg)
+		if err != nil {
+			return fmt.Errorf("announce raids: %w", err)
+		}
+		return nil
+	}
+}
+
+// RemindSignups mentions the linked players who neither signed up nor declared an absence
+// to the raids whose reminder time of the policy is after last and not after now.
+func (suc ScheduleUseCase) RemindSignups(ctx context.Context, last, now time.Time) error {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Schedule/RemindSignups")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("last", last.String()),
+		attribute.String("now", now.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("ScheduleUseCase - RemindSignups - ctx.Done: request took too much time to be proceed")
+	default:
+		if suc.announcer == nil || suc.policy.ReminderHours <= 0 {
+			return nil
+		}
+
+		before := time.Duration(suc.policy.ReminderHours) * time.Hour
+		raids, err := suc.raidsBetween(ctx, last.Add(before), now.Add(before))
+		if err != nil {
+			return err
+		}
+
+		var players []entity.Player
+		for _, raid := range raids {
+			remindAt := suc.policy.ReminderAt(raid)
+			if !remindAt.After(last) || remindAt.After(now) {
+				continue
+			}
+			if players == nil {
+				players, err = suc.backend.SearchPlayer(ctx, -1, "", "")
+				if err != nil {
+					return fmt.Errorf("search players: %w", err)
+				}
+			}
+
+			missing, err := suc.missingSignups(ctx, raid, players)
+			if err != nil {
+				return err
+			}
+			if len(missing) == 0 {
+				continue
+			}
+
+			mentions := make([]string, 0, len(missing))
+			for _, player := range missing {
+				mentions = append(mentions, "<@"+player.DiscordID+">")
+			}
+			msg := strings.Join(mentions, " ") + "\n" + suc.raidTitle(raid) + " is coming, you haven't signed up yet."
+			err = suc.announcer.Announce(ctx, msg)
+			if err != nil {
+				return fmt.Errorf("remind players to sign up: %w", err)
+			}
+		}
+		return nil
+	}
+}
+
+// SummarizeAbsences sends officers the absences on the raids of the day of now.
+// Nothing is sent when there is no raid this day.
+func (suc ScheduleUseCase) SummarizeAbsences(ctx context.Context, now time.Time) error {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Schedule/SummarizeAbsences")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("now", now.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("ScheduleUseCase - SummarizeAbsences - ctx.Done: request took too much time to be proceed")
+	default:
+		if suc.notifier == nil {
+			return nil
+		}
+
+		raids, err := suc.raidsBetween(ctx, now, now)
+		if err != nil {
+			return err
+		}
+		if len(raids) == 0 {
+			return nil
+		}
+
+		absences, err := suc.backend.SearchAbsence(ctx, "", -1, raids[0].Date)
+		if err != nil {
+			return fmt.Errorf("search absences of the day: %w", err)
+		}
+
+		msg := "Absences tonight:\n"
+		for _, raid := range raids {
+			var names []string
+			for _, absence := range absences {
+				if absence.Raid != nil && absence.Raid.ID == raid.ID && absence.Player != nil {
+					names = append(names, absence.Player.Name)
+				}
+			}
+			list := "-"
+			if len(names) != 0 {
+				list = strings.Join(names, ", ")
+			}
+			msg += "* " + suc.raidTitle(raid) + " **(" + strconv.Itoa(len(names)) + ")** : " + list + "\n"
+		}
+
+		err = suc.notifier.NotifyOfficers(ctx, msg)
+		if err != nil {
+			return fmt.Errorf("notify officers: %w", err)
+		}
+		return nil
+	}
+}
+
+// raidsBetween returns the raids from the day of from to the day of to, both included.
+func (suc ScheduleUseCase) raidsBetween(ctx context.Context, from, to time.Time) ([]entity.Raid, error) {
+	// Raid dates are days without time zone
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	raids, err := suc.backend.SearchRaidOnRange(ctx, day, last, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("search raids from %s to %s: %w", day.Format("02/01/06"), last.Format("02/01/06"), err)
+	}
+	return raids, nil
+}
+
+// missingSignups returns the players linked to a discord user who neither signed up
+// nor declared an absence to raid.
+func (suc ScheduleUseCase) missingSignups(
+	ctx context.Context, raid entity.Raid, players []entity.Player,
+) ([]entity.Player, error) {
+	signups, err := suc.backend.SearchSignup(ctx, raid.ID, -1)
+	if err != nil {
+		return nil, fmt.Errorf("search signups of raid: %w", err)
+	}
+	absences, err := suc.backend.SearchAbsence(ctx, "", -1, raid.Date)
+	if err != nil {
+		return nil, fmt.Errorf("search absences of raid: %w", err)
+	}
+
+	answered := make(map[int]bool)
+	for _, signup := range signups {
+		answered[signup.Player.ID] = true
+	}
+	for _, absence := range absences {
+		if absence.Raid != nil && absence.Raid.ID == raid.ID && absence.Player != nil {
+			answered[absence.Player.ID] = true
+		}
+	}
+
+	var missing []entity.Player
+	for _, player := range players {
+		if answered[player.ID] || !entity.IsDiscordID(player.DiscordID) {
+			continue
+		}
+		missing = append(missing, player)
+	}
+	return missing, nil
+}
+
+// raidTitle returns the name, difficulty, day and start time of raid.
+func (suc ScheduleUseCase) raidTitle(raid entity.Raid) string {
+	return "**" + raid.Name + "** " + raid.Difficulty + " " +
+		suc.policy.StartOf(raid).Format("Mon 02/01/06 at 15:04")
+}
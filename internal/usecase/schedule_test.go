@@ -0,0 +1,216 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/mocks"
+)
+
+func TestScheduleUseCase_AnnounceRaids(t *testing.T) {
+	t.Parallel()
+
+	policy := entity.SchedulePolicy{RaidStart: 21 * time.Hour, AnnounceDays: 2, Location: time.UTC}
+	today := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+	tomorrow := today.AddDate(0, 0, 1)
+	raid := entity.Raid{
+		ID: 1, Name: "nighthold", Difficulty: "heroic", Date: today, StartTime: 21 * time.Hour, StartTimeSet: true,
+	}
+	nextRaid := entity.Raid{
+		ID: 2, Name: "nighthold", Difficulty: "mythic", Date: tomorrow, StartTime: 21 * time.Hour, StartTimeSet: true,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		mockAnnouncer := mocks.NewAnnouncer(t)
+		scheduleUseCase := usecase.NewScheduleUseCase(mockBackend, policy, mockAnnouncer, nil)
+
+		mockBackend.On("SearchRaidOnRange", mock.Anything, today, tomorrow, 0, 0).
+			Return([]entity.Raid{raid, nextRaid}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, -1).Return([]entity.Signup{
+			{Player: &entity.Player{ID: 1, Name: "thrall"}, Raid: &raid, Status: entity.SignupAccepted},
+			{Player: &entity.Player{ID: 2, Name: "jaina"}, Raid: &raid, Status: entity.SignupDeclined},
+		}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 2, -1).Return(nil, nil)
+		mockAnnouncer.On("Announce", mock.Anything, "Upcoming raids:\n"+
+			"* **nighthold** heroic Mon 02/10/23 at 21:00 : 1 accepted, 0 tentative, 0 late, 1 declined\n"+
+			"* **nighthold** mythic Tue 03/10/23 at 21:00 : 0 accepted, 0 tentative, 0 late, 0 declined\n").
+			Return(nil)
+
+		err := scheduleUseCase.AnnounceRaids(context.Background(), today.Add(18*time.Hour))
+
+		assert.NoError(t, err)
+		mockAnnouncer.AssertExpectations(t)
+	})
+
+	t.Run("Started raids are not announced", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		scheduleUseCase := usecase.NewScheduleUseCase(mockBackend,
+			entity.SchedulePolicy{RaidStart: 21 * time.Hour, AnnounceDays: 1, Location: time.UTC},
+			mocks.NewAnnouncer(t), nil)
+
+		mockBackend.On("SearchRaidOnRange", mock.Anything, today, today, 0, 0).Return([]entity.Raid{raid}, nil)
+
+		err := scheduleUseCase.AnnounceRaids(context.Background(), today.Add(22*time.Hour))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Announce fails", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		mockAnnouncer := mocks.NewAnnouncer(t)
+		scheduleUseCase := usecase.NewScheduleUseCase(mockBackend, policy, mockAnnouncer, nil)
+
+		mockBackend.On("SearchRaidOnRange", mock.Anything, today, tomorrow, 0, 0).Return([]entity.Raid{raid}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, -1).Return(nil, nil)
+		mockAnnouncer.On("Announce", mock.Anything, mock.Anything).Return(errors.New("channel not found"))
+
+		err := scheduleUseCase.AnnounceRaids(context.Background(), today.Add(18*time.Hour))
+
+		assert.ErrorContains(t, err, "channel not found")
+	})
+
+	t.Run("No announcer", func(t *testing.T) {
+		t.Parallel()
+
+		scheduleUseCase := usecase.NewScheduleUseCase(mocks.NewBackend(t), policy, nil, nil)
+
+		assert.NoError(t, scheduleUseCase.AnnounceRaids(context.Background(), today))
+	})
+}
+
+func TestScheduleUseCase_RemindSignups(t *testing.T) {
+	t.Parallel()
+
+	policy := entity.SchedulePolicy{RaidStart: 21 * time.Hour, ReminderHours: 4, Location: time.UTC}
+	today := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+	raid := entity.Raid{
+		ID: 1, Name: "nighthold", Difficulty: "heroic", Date: today, StartTime: 21 * time.Hour, StartTimeSet: true,
+	}
+
+	thrall := entity.Player{ID: 1, Name: "thrall", DiscordID: "100000000000000001"}
+	jaina := entity.Player{ID: 2, Name: "jaina", DiscordID: "100000000000000002"}
+	arthas := entity.Player{ID: 3, Name: "arthas", DiscordID: "100000000000000003"}
+	uther := entity.Player{ID: 4, Name: "uther", DiscordID: "100000000000000004"}
+	// sylvanas is not linked to discord, nobody can be mentioned
+	sylvanas := entity.Player{ID: 5, Name: "sylvanas"}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		mockAnnouncer := mocks.NewAnnouncer(t)
+		scheduleUseCase := usecase.NewScheduleUseCase(mockBackend, policy, mockAnnouncer, nil)
+
+		mockBackend.On("SearchRaidOnRange", mock.Anything, today, today, 0, 0).Return([]entity.Raid{raid}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").
+			Return([]entity.Player{thrall, jaina, arthas, uther, sylvanas}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, -1).
+			Return([]entity.Signup{{Player: &thrall, Raid: &raid, Status: entity.SignupAccepted}}, nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", -1, today).
+			Return([]entity.Absence{{ID: 1, Player: &jaina, Raid: &raid}}, nil)
+		mockAnnouncer.On("Announce", mock.Anything,
+			"<@100000000000000003> <@100000000000000004>\n"+
+				"**nighthold** heroic Mon 02/10/23 at 21:00 is coming, you haven't signed up yet.").
+			Return(nil)
+
+		err := scheduleUseCase.RemindSignups(context.Background(),
+			today.Add(16*time.Hour+55*time.Minute), today.Add(17*time.Hour))
+
+		assert.NoError(t, err)
+		mockAnnouncer.AssertExpectations(t)
+	})
+
+	t.Run("Reminder already sent", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		scheduleUseCase := usecase.NewScheduleUseCase(mockBackend, policy, mocks.NewAnnouncer(t), nil)
+
+		mockBackend.On("SearchRaidOnRange", mock.Anything, today, today, 0, 0).Return([]entity.Raid{raid}, nil)
+
+		err := scheduleUseCase.RemindSignups(context.Background(),
+			today.Add(17*time.Hour), today.Add(17*time.Hour+5*time.Minute))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Reminders disabled", func(t *testing.T) {
+		t.Parallel()
+
+		scheduleUseCase := usecase.NewScheduleUseCase(mocks.NewBackend(t),
+			entity.SchedulePolicy{RaidStart: 21 * time.Hour}, mocks.NewAnnouncer(t), nil)
+
+		assert.NoError(t, scheduleUseCase.RemindSignups(context.Background(), today, today.Add(time.Hour)))
+	})
+}
+
+func TestScheduleUseCase_SummarizeAbsences(t *testing.T) {
+	t.Parallel()
+
+	policy := entity.SchedulePolicy{RaidStart: 21 * time.Hour, Location: time.UTC}
+	today := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+	raid := entity.Raid{
+		ID: 1, Name: "nighthold", Difficulty: "heroic", Date: today, StartTime: 21 * time.Hour, StartTimeSet: true,
+	}
+	otherRaid := entity.Raid{
+		ID: 2, Name: "nighthold", Difficulty: "mythic", Date: today, StartTime: 21 * time.Hour, StartTimeSet: true,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		mockNotifier := mocks.NewNotifier(t)
+		scheduleUseCase := usecase.NewScheduleUseCase(mockBackend, policy, nil, mockNotifier)
+
+		mockBackend.On("SearchRaidOnRange", mock.Anything, today, today, 0, 0).Return([]entity.Raid{raid, otherRaid}, nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", -1, today).Return([]entity.Absence{
+			{ID: 1, Player: &entity.Player{Name: "jaina"}, Raid: &raid},
+			{ID: 2, Player: &entity.Player{Name: "thrall"}, Raid: &raid},
+		}, nil)
+		mockNotifier.On("NotifyOfficers", mock.Anything, "Absences tonight:\n"+
+			"* **nighthold** heroic Mon 02/10/23 at 21:00 **(2)** : jaina, thrall\n"+
+			"* **nighthold** mythic Mon 02/10/23 at 21:00 **(0)** : -\n").Return(nil)
+
+		err := scheduleUseCase.SummarizeAbsences(context.Background(), today.Add(12*time.Hour))
+
+		assert.NoError(t, err)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("No raid today", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		scheduleUseCase := usecase.NewScheduleUseCase(mockBackend, policy, nil, mocks.NewNotifier(t))
+
+		mockBackend.On("SearchRaidOnRange", mock.Anything, today, today, 0, 0).Return(nil, nil)
+
+		assert.NoError(t, scheduleUseCase.SummarizeAbsences(context.Background(), today.Add(12*time.Hour)))
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		scheduleUseCase := usecase.NewScheduleUseCase(mocks.NewBackend(t), policy, nil, mocks.NewNotifier(t))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.Error(t, scheduleUseCase.SummarizeAbsences(ctx, today))
+	})
+}
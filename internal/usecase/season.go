@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SeasonUseCase is the use case for the season calendar.
+type SeasonUseCase struct {
+	backend Backend
+}
+
+// NewSeasonUseCase returns a new SeasonUseCase.
+func NewSeasonUseCase(bk Backend) *SeasonUseCase {
+	return &SeasonUseCase{backend: bk}
+}
+
+// CreateSeason adds a season to the calendar. A season can't overlap another one.
+func (suc SeasonUseCase) CreateSeason(ctx context.Context, name string, start, end time.Time) (entity.Season, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Season/CreateSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", name),
+		attribute.String("start", start.String()),
+		attribute.String("end", end.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Season{}, fmt.Errorf("SeasonUseCase - CreateSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		season, err := entity.NewSeason(name, start, end)
+		if err != nil {
+			return entity.Season{}, fmt.Errorf("create season: %w", err)
+		}
+		seasons, err := suc.backend.SearchSeason(ctx, "")
+		if err != nil {
+			return entity.Season{}, fmt.Errorf("search seasons: %w", err)
+		}
+		if err := checkOverlap(season, seasons); err != nil {
+			return entity.Season{}, fmt.Errorf("create season: %w", err)
+		}
+		season, err = suc.backend.CreateSeason(ctx, season)
+		if err != nil {
+			return entity.Season{}, fmt.Errorf("create season: %w", err)
+		}
+		return season, nil
+	}
+}
+
+// ListSeasons returns every season, ordered by start date.
+func (suc SeasonUseCase) ListSeasons(ctx context.Context) ([]entity.Season, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Season/ListSeasons")
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("SeasonUseCase - ListSeasons - ctx.Done: request took too much time to be proceed")
+	default:
+		seasons, err := suc.backend.SearchSeason(ctx, "")
+		if err != nil {
+			return nil, fmt.Errorf("search seasons: %w", err)
+		}
+		return seasons, nil
+	}
+}
+
+// ReadSeason returns the season with the given name.
+func (suc SeasonUseCase) ReadSeason(ctx context.Context, name string) (entity.Season, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Season/ReadSeason")
+	defer span.End()
+	span.SetAttributes(attribute.String("name", name))
+
+	select {
+	case <-ctx.Done():
+		return entity.Season{}, fmt.Errorf("SeasonUseCase - ReadSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		return readSeason(ctx, suc.backend, name)
+	}
+}
+
+// ImportSeasons adds seasons set in config to the calendar.
+// A season which already exists takes the dates set in config.
+func (suc SeasonUseCase) ImportSeasons(ctx context.Context, seasons []entity.Season) error {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Season/ImportSeasons")
+	defer span.End()
+	span.SetAttributes(attribute.Int("seasons", len(seasons)))
+
+	for _, imported := range seasons {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("SeasonUseCase - ImportSeasons - ctx.Done: request took too much time to be proceed")
+		default:
+		}
+
+		season, err := entity.NewSeason(imported.Name, imported.Start, imported.End)
+		if err != nil {
+			return fmt.Errorf("import season %s: %w", imported.Name, err)
+		}
+		existing, err := suc.backend.SearchSeason(ctx, "")
+		if err != nil {
+			return fmt.Errorf("search seasons: %w", err)
+		}
+		others := make([]entity.Season, 0, len(existing))
+		for _, s := range existing {
+			if s.Name != season.Name {
+				others = append(others, s)
+				continue
+			}
+			season.ID = s.ID
+		}
+		if err := checkOverlap(season, others); err != nil {
+			return fmt.Errorf("import season %s: %w", season.Name, err)
+		}
+
+		if season.ID == 0 {
+			_, err = suc.backend.CreateSeason(ctx, season)
+		} else {
+			err = suc.backend.UpdateSeason(ctx, season)
+		}
+		if err != nil {
+			return fmt.Errorf("import season %s: %w", season.Name, err)
+		}
+	}
+	return nil
+}
+
+// checkOverlap returns an error if season shares a day with one of seasons.
+func checkOverlap(season entity.Season, seasons []entity.Season) error {
+	for _, other := range seasons {
+		if season.Overlaps(other) {
+			return fmt.Errorf("season overlaps %s", other.Name)
+		}
+	}
+	return nil
+}
+
+// readSeason returns the season with the given name.
+func readSeason(ctx context.Context, backend Backend, name string) (entity.Season, error) {
+	seasons, err := backend.SearchSeason(ctx, name)
+	if err != nil {
+		return entity.Season{}, fmt.Errorf("search season: %w", err)
+	}
+	if len(seasons) == 0 {
+		return entity.Season{}, fmt.Errorf("season %s not found", name)
+	}
+	return seasons[0], nil
+}
+
+// seasonOn returns the name of the season date is in, or entity.UnknownSeason.
+func seasonOn(ctx context.Context, backend Backend, date time.Time) (string, error) {
+	seasons, err := backend.SearchSeason(ctx, "")
+	if err != nil {
+		return "", fmt.Errorf("search seasons: %w", err)
+	}
+	for _, season := range seasons {
+		if season.Contains(date) {
+			return season.Name, nil
+		}
+	}
+	return entity.UnknownSeason, nil
+}
@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/mocks"
+)
+
+var seasonDFS2 = entity.Season{
+	ID:    1,
+	Name:  "DF/S2",
+	Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+	End:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+}
+
+func TestSeasonUseCase_CreateSeason(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		seasonUseCase := usecase.NewSeasonUseCase(mockBackend)
+
+		start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+		mockBackend.On("SearchSeason", mock.Anything, "").Return([]entity.Season{seasonDFS2}, nil)
+		mockBackend.On("CreateSeason", mock.Anything, entity.Season{Name: "DF/S3", Start: start, End: end}).
+			Return(entity.Season{ID: 2, Name: "DF/S3", Start: start, End: end}, nil)
+
+		season, err := seasonUseCase.CreateSeason(context.Background(), "DF/S3", start, end)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, season.ID)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Overlaps another season", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		seasonUseCase := usecase.NewSeasonUseCase(mockBackend)
+
+		mockBackend.On("SearchSeason", mock.Anything, "").Return([]entity.Season{seasonDFS2}, nil)
+
+		_, err := seasonUseCase.CreateSeason(context.Background(), "DF/S3",
+			time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC))
+		assert.ErrorContains(t, err, "season overlaps DF/S2")
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Invalid season", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		seasonUseCase := usecase.NewSeasonUseCase(mockBackend)
+
+		_, err := seasonUseCase.CreateSeason(context.Background(), "DF/S3", seasonDFS2.End, seasonDFS2.Start)
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		seasonUseCase := usecase.NewSeasonUseCase(mockBackend)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := seasonUseCase.CreateSeason(ctx, "DF/S3", seasonDFS2.Start, seasonDFS2.End)
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestSeasonUseCase_ReadSeason(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		seasonUseCase := usecase.NewSeasonUseCase(mockBackend)
+
+		mockBackend.On("SearchSeason", mock.Anything, "DF/S2").Return([]entity.Season{seasonDFS2}, nil)
+
+		season, err := seasonUseCase.ReadSeason(context.Background(), "DF/S2")
+		assert.NoError(t, err)
+		assert.Equal(t, seasonDFS2, season)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Season not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		seasonUseCase := usecase.NewSeasonUseCase(mockBackend)
+
+		mockBackend.On("SearchSeason", mock.Anything, "DF/S9").Return(nil, nil)
+
+		_, err := seasonUseCase.ReadSeason(context.Background(), "DF/S9")
+		assert.ErrorContains(t, err, "season DF/S9 not found")
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestSeasonUseCase_ImportSeasons(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Create new seasons and update existing ones", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		seasonUseCase := usecase.NewSeasonUseCase(mockBackend)
+
+		updated := seasonDFS2
+		updated.End = time.Date(2023, 11, 13, 0, 0, 0, 0, time.UTC)
+		s3 := entity.Season{
+			Name:  "DF/S3",
+			Start: time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 4, 22, 0, 0, 0, 0, time.UTC),
+		}
+		mockBackend.On("SearchSeason", mock.Anything, "").Return([]entity.Season{seasonDFS2}, nil).Once()
+		mockBackend.On("UpdateSeason", mock.Anything, updated).Return(nil)
+		mockBackend.On("SearchSeason", mock.Anything, "").Return([]entity.Season{updated}, nil).Once()
+		mockBackend.On("CreateSeason", mock.Anything, s3).Return(s3, nil)
+
+		err := seasonUseCase.ImportSeasons(context.Background(), []entity.Season{
+			{Name: "DF/S2", Start: updated.Start, End: updated.End},
+			s3,
+		})
+		assert.NoError(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Backend error", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		seasonUseCase := usecase.NewSeasonUseCase(mockBackend)
+
+		mockBackend.On("SearchSeason", mock.Anything, "").Return(nil, errors.New("backend error"))
+
+		err := seasonUseCase.ImportSeasons(context.Background(), []entity.Season{seasonDFS2})
+		assert.Error(t, err)
+		mockBackend.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SignupUseCase is the use case for the signups of players to upcoming raids.
+type SignupUseCase struct {
+	backend  Backend
+	absences AbsenceRecorder
+	location *time.Location
+}
+
+// NewSignupUseCase returns a new SignupUseCase recording declined signups as absences with absences.
+// Past raids are the ones before today in the guild time zone location.
+func NewSignupUseCase(bk Backend, absences AbsenceRecorder, location *time.Location) *SignupUseCase {
+	return &SignupUseCase{backend: bk, absences: absences, location: location}
+}
+
+// OpenSignup returns the raid of this date with its signups, so players can answer it.
+// difficulty can be empty when there is a single raid on this date.
+func (suc SignupUseCase) OpenSignup(ctx context.Context, date time.Time, difficulty string) (entity.Raid, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Signup/OpenSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("date", date.Format("02/01/06")),
+		attribute.String("difficulty", difficulty),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Raid{}, fmt.Errorf("SignupUseCase - OpenSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		raid, err := findRaid(ctx, suc.backend, date, difficulty)
+		if err != nil {
+			return entity.Raid{}, err
+		}
+		if raid.Date.Before(entity.Today(suc.location)) {
+			return entity.Raid{}, errors.New("can't open signups of a raid in the past")
+		}
+		return suc.readSignups(ctx, raid)
+	}
+}
+
+// SignUp records the answer of the player linked to discordID to a raid.
+// A declined signup creates the absence of the player, changing it back removes the absence.
+// It returns the raid with all its signups.
+func (suc SignupUseCase) SignUp(
+	ctx context.Context, raidID int, discordID string, status string,
+) (entity.Raid, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Signup/SignUp")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.String("discordID", discordID),
+		attribute.String("status", status),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Raid{}, fmt.Errorf("SignupUseCase - SignUp - ctx.Done: request took too much time to be proceed")
+	default:
+		raid, err := suc.backend.ReadRaid(ctx, raidID)
+		if err != nil {
+			return entity.Raid{}, fmt.Errorf("read raid: %w", err)
+		}
+		if raid.Date.Before(entity.Today(suc.location)) {
+			return entity.Raid{}, errors.New("can't sign up to a raid in the past")
+		}
+
+		players, err := suc.backend.SearchPlayer(ctx, -1, "", discordID)
+		if err != nil {
+			return entity.Raid{}, fmt.Errorf("search player linked to discord user: %w", err)
+		}
+		if len(players) == 0 {
+			return entity.Raid{}, fmt.Errorf("didn't find a player linked to this discord user")
+		}
+		player := players[0]
+
+		signup, err := entity.NewSignup(&player, &raid, status)
+		if err != nil {
+			return entity.Raid{}, fmt.Errorf("create entity signup: %w", err)
+		}
+
+		existing, err := suc.backend.SearchSignup(ctx, raid.ID, player.ID)
+		if err != nil {
+			return entity.Raid{}, fmt.Errorf("search signup of player: %w", err)
+		}
+		var previous entity.SignupStatus
+		if len(existing) == 0 {
+			err = suc.backend.CreateSignup(ctx, signup)
+		} else {
+			previous = existing[0].Status
+			err = suc.backend.UpdateSignup(ctx, signup)
+		}
+		if err != nil {
+			return entity.Raid{}, fmt.Errorf("save signup: %w", err)
+		}
+
+		err = suc.syncAbsence(ctx, player, raid, previous, signup.Status)
+		if err != nil {
+			return entity.Raid{}, err
+		}
+
+		return suc.readSignups(ctx, raid)
+	}
+}
+
+// syncAbsence keeps the absence of a player in line with their signup.
+func (suc SignupUseCase) syncAbsence(
+	ctx context.Context, player entity.Player, raid entity.Raid, previous, status entity.SignupStatus,
+) error {
+	declined := status == entity.SignupDeclined
+	if declined == (previous == entity.SignupDeclined) {
+		return nil
+	}
+
+	// The player may have declared an absence on their own before answering
+	absences, err := suc.backend.SearchAbsence(ctx, "", player.ID, raid.Date)
+	if err != nil {
+		return fmt.Errorf("search absence of player: %w", err)
+	}
+	switch {
+	case declined && len(absences) == 0:
+		err = suc.absences.CreateAbsence(ctx, player.Name, raid.Date)
+		if err != nil {
+			return fmt.Errorf("create absence of declined player: %w", err)
+		}
+	case !declined && len(absences) != 0:
+		err = suc.absences.DeleteAbsence(ctx, player.Name, raid.Date)
+		if err != nil {
+			return fmt.Errorf("delete absence of player: %w", err)
+		}
+	}
+	return nil
+}
+
+// readSignups fills the signups of a raid.
+func (suc SignupUseCase) readSignups(ctx context.Context, raid entity.Raid) (entity.Raid, error) {
+	signups, err := suc.backend.SearchSignup(ctx, raid.ID, -1)
+	if err != nil {
+		return entity.Raid{}, fmt.Errorf("search signups of raid: %w", err)
+	}
+	raid.Signups = signups
+	return raid, nil
+}
@@ -0,0 +1,201 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/mocks"
+)
+
+func TestSignupUseCase_OpenSignup(t *testing.T) {
+	t.Parallel()
+
+	date := time.Now().AddDate(0, 0, 2).Truncate(24 * time.Hour)
+	raid := entity.Raid{ID: 1, Name: "nighthold", Difficulty: "heroic", Date: date}
+	thrall := entity.Player{ID: 1, Name: "thrall"}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		signupUseCase := usecase.NewSignupUseCase(mockBackend, mocks.NewAbsenceRecorder(t), time.UTC)
+
+		mockBackend.On("SearchRaid", mock.Anything, "", date, "").Return([]entity.Raid{raid}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, -1).
+			Return([]entity.Signup{{Player: &thrall, Raid: &raid, Status: entity.SignupLate}}, nil)
+
+		got, err := signupUseCase.OpenSignup(context.Background(), date, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, got.ID)
+		assert.Len(t, got.Signups, 1)
+	})
+
+	t.Run("Raid in the past", func(t *testing.T) {
+		t.Parallel()
+
+		past := entity.Raid{ID: 2, Name: "nighthold", Difficulty: "heroic", Date: date.AddDate(0, 0, -7)}
+		mockBackend := mocks.NewBackend(t)
+		signupUseCase := usecase.NewSignupUseCase(mockBackend, mocks.NewAbsenceRecorder(t), time.UTC)
+
+		mockBackend.On("SearchRaid", mock.Anything, "", past.Date, "").Return([]entity.Raid{past}, nil)
+
+		_, err := signupUseCase.OpenSignup(context.Background(), past.Date, "")
+
+		assert.ErrorContains(t, err, "in the past")
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		signupUseCase := usecase.NewSignupUseCase(mocks.NewBackend(t), mocks.NewAbsenceRecorder(t), time.UTC)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := signupUseCase.OpenSignup(ctx, date, "")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSignupUseCase_SignUp(t *testing.T) {
+	t.Parallel()
+
+	date := time.Now().AddDate(0, 0, 2).Truncate(24 * time.Hour)
+	raid := entity.Raid{ID: 1, Name: "nighthold", Difficulty: "heroic", Date: date}
+	thrall := entity.Player{ID: 1, Name: "thrall", DiscordID: "100000000000000001"}
+
+	t.Run("First answer", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		signupUseCase := usecase.NewSignupUseCase(mockBackend, mocks.NewAbsenceRecorder(t), time.UTC)
+
+		signup := entity.Signup{Player: &thrall, Raid: &raid, Status: entity.SignupTentative}
+		mockBackend.On("ReadRaid", mock.Anything, 1).Return(raid, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", thrall.DiscordID).Return([]entity.Player{thrall}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, 1).Return(nil, nil)
+		mockBackend.On("CreateSignup", mock.Anything, signup).Return(nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, -1).Return([]entity.Signup{signup}, nil)
+
+		got, err := signupUseCase.SignUp(context.Background(), 1, thrall.DiscordID, "tentative")
+
+		assert.NoError(t, err)
+		assert.Len(t, got.Signups, 1)
+	})
+
+	t.Run("Decline creates an absence", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		mockAbsences := mocks.NewAbsenceRecorder(t)
+		signupUseCase := usecase.NewSignupUseCase(mockBackend, mockAbsences, time.UTC)
+
+		signup := entity.Signup{Player: &thrall, Raid: &raid, Status: entity.SignupDeclined}
+		mockBackend.On("ReadRaid", mock.Anything, 1).Return(raid, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", thrall.DiscordID).Return([]entity.Player{thrall}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, 1).
+			Return([]entity.Signup{{Player: &thrall, Raid: &raid, Status: entity.SignupAccepted}}, nil)
+		mockBackend.On("UpdateSignup", mock.Anything, signup).Return(nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", 1, date).Return(nil, nil)
+		mockAbsences.On("CreateAbsence", mock.Anything, "thrall", date).Return(nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, -1).Return([]entity.Signup{signup}, nil)
+
+		_, err := signupUseCase.SignUp(context.Background(), 1, thrall.DiscordID, "declined")
+
+		assert.NoError(t, err)
+		mockAbsences.AssertExpectations(t)
+	})
+
+	t.Run("Decline keeps an absence already declared", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		signupUseCase := usecase.NewSignupUseCase(mockBackend, mocks.NewAbsenceRecorder(t), time.UTC)
+
+		signup := entity.Signup{Player: &thrall, Raid: &raid, Status: entity.SignupDeclined}
+		mockBackend.On("ReadRaid", mock.Anything, 1).Return(raid, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", thrall.DiscordID).Return([]entity.Player{thrall}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, 1).Return(nil, nil)
+		mockBackend.On("CreateSignup", mock.Anything, signup).Return(nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", 1, date).
+			Return([]entity.Absence{{ID: 1, Player: &thrall, Raid: &raid}}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, -1).Return([]entity.Signup{signup}, nil)
+
+		_, err := signupUseCase.SignUp(context.Background(), 1, thrall.DiscordID, "declined")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Coming back removes the absence", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		mockAbsences := mocks.NewAbsenceRecorder(t)
+		signupUseCase := usecase.NewSignupUseCase(mockBackend, mockAbsences, time.UTC)
+
+		signup := entity.Signup{Player: &thrall, Raid: &raid, Status: entity.SignupLate}
+		mockBackend.On("ReadRaid", mock.Anything, 1).Return(raid, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", thrall.DiscordID).Return([]entity.Player{thrall}, nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, 1).
+			Return([]entity.Signup{{Player: &thrall, Raid: &raid, Status: entity.SignupDeclined}}, nil)
+		mockBackend.On("UpdateSignup", mock.Anything, signup).Return(nil)
+		mockBackend.On("SearchAbsence", mock.Anything, "", 1, date).
+			Return([]entity.Absence{{ID: 1, Player: &thrall, Raid: &raid}}, nil)
+		mockAbsences.On("DeleteAbsence", mock.Anything, "thrall", date).Return(nil)
+		mockBackend.On("SearchSignup", mock.Anything, 1, -1).Return([]entity.Signup{signup}, nil)
+
+		_, err := signupUseCase.SignUp(context.Background(), 1, thrall.DiscordID, "late")
+
+		assert.NoError(t, err)
+		mockAbsences.AssertExpectations(t)
+	})
+
+	t.Run("Player not linked", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		signupUseCase := usecase.NewSignupUseCase(mockBackend, mocks.NewAbsenceRecorder(t), time.UTC)
+
+		mockBackend.On("ReadRaid", mock.Anything, 1).Return(raid, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "42").Return(nil, nil)
+
+		_, err := signupUseCase.SignUp(context.Background(), 1, "42", "accepted")
+
+		assert.ErrorContains(t, err, "didn't find a player linked")
+	})
+
+	t.Run("Unknown status", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		signupUseCase := usecase.NewSignupUseCase(mockBackend, mocks.NewAbsenceRecorder(t), time.UTC)
+
+		mockBackend.On("ReadRaid", mock.Anything, 1).Return(raid, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", thrall.DiscordID).Return([]entity.Player{thrall}, nil)
+
+		_, err := signupUseCase.SignUp(context.Background(), 1, thrall.DiscordID, "maybe")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Raid in the past", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		signupUseCase := usecase.NewSignupUseCase(mockBackend, mocks.NewAbsenceRecorder(t), time.UTC)
+
+		mockBackend.On("ReadRaid", mock.Anything, 2).
+			Return(entity.Raid{ID: 2, Name: "nighthold", Difficulty: "heroic", Date: date.AddDate(0, 0, -7)}, nil)
+
+		_, err := signupUseCase.SignUp(context.Background(), 2, thrall.DiscordID, "accepted")
+
+		assert.ErrorContains(t, err, "in the past")
+	})
+}
@@ -0,0 +1,182 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// searchAbsenceOnParam returns absences, with their raid and player, matching every given column value.
+func (s *SQLite) searchAbsenceOnParam(ctx context.Context, params squirrel.Eq) ([]entity.Absence, error) {
+	query, args, err := s.Builder.Select("absences.id", "absences.player_id", "absences.raid_id",
+		"raids.name", "raids.difficulty", "raids.date", "players.name").
+		From("absences").
+		Join("raids ON raids.id = absences.raid_id").
+		Join("players ON players.id = absences.player_id").
+		Where(params).Where(squirrel.Eq{"raids.deleted_at": nil, "players.deleted_at": nil}).
+		OrderBy("absences.id").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("database - SearchAbsence - searchAbsenceOnParam - s.Builder: %w", err)
+	}
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database - SearchAbsence - searchAbsenceOnParam - s.DB.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var absences []entity.Absence
+	for rows.Next() {
+		var absence entity.Absence
+		var raid entity.Raid
+		var player entity.Player
+		err := rows.Scan(&absence.ID, &player.ID, &raid.ID, &raid.Name, &raid.Difficulty, &raid.Date, &player.Name)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchAbsence - searchAbsenceOnParam - rows.Scan: %w", err)
+		}
+		absence.Player = &player
+		absence.Raid = &raid
+		absences = append(absences, absence)
+	}
+	return absences, rows.Err()
+}
+
+// SearchAbsence returns absences of a player on a raid date, of a player, or on a raid date.
+// playerID is ignored when -1. At least one parameter is required.
+func (s *SQLite) SearchAbsence(
+	ctx context.Context, playerName string, playerID int, date time.Time,
+) ([]entity.Absence, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Absence/SearchAbsence")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+		attribute.Int("playerID", playerID),
+		attribute.String("date", date.Format("02/01/2006")),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchAbsence - ctx.Done: request took too much time to be proceed")
+	default:
+		switch {
+		case playerID != -1 && !date.IsZero():
+			return s.searchAbsenceOnParam(ctx, squirrel.Eq{"absences.player_id": playerID, "raids.date": timestamp(date)})
+		case playerID != -1 && playerName == "":
+			return s.searchAbsenceOnParam(ctx, squirrel.Eq{"absences.player_id": playerID})
+		case playerID == -1 && playerName != "":
+			return s.searchAbsenceOnParam(ctx, squirrel.Eq{"players.name": playerName})
+		case playerID == -1 && playerName == "" && !date.IsZero():
+			return s.searchAbsenceOnParam(ctx, squirrel.Eq{"raids.date": timestamp(date)})
+		}
+		return nil, errors.New("database - SearchAbsence: no param given")
+	}
+}
+
+// CreateAbsence creates an absence in the database and returns it with its ID.
+func (s *SQLite) CreateAbsence(ctx context.Context, absence entity.Absence) (entity.Absence, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Absence/CreateAbsence")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("absenceID", absence.ID),
+		attribute.Int("playerID", absence.Player.ID),
+		attribute.Int("raidID", absence.Raid.ID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Absence{}, fmt.Errorf("database - CreateAbsence:  ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("absences").
+			Columns("player_id", "raid_id").
+			Values(absence.Player.ID, absence.Raid.ID).
+			Suffix("RETURNING \"id\"").
+			ToSql()
+		if err != nil {
+			return entity.Absence{}, fmt.Errorf("database - CreateAbsence:  s.Builder: %w", err)
+		}
+		err = s.DB.QueryRowContext(ctx, query, args...).Scan(&absence.ID)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return absence, fmt.Errorf("absence already exists")
+			}
+			return entity.Absence{}, fmt.Errorf("database - CreateAbsence:  row.Scan: %w", err)
+		}
+		return absence, nil
+	}
+}
+
+// ReadAbsence returns an absence with its raid and player.
+func (s *SQLite) ReadAbsence(ctx context.Context, absenceID int) (entity.Absence, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Absence/ReadAbsence")
+	defer span.End()
+	span.SetAttributes(attribute.Int("absenceID", absenceID))
+
+	select {
+	case <-ctx.Done():
+		return entity.Absence{}, fmt.Errorf("database - ReadAbsence - ctx.Done: request took too much time to be proceed")
+	default:
+		absences, err := s.searchAbsenceOnParam(ctx, squirrel.Eq{"absences.id": absenceID})
+		if err != nil {
+			return entity.Absence{}, fmt.Errorf("database - ReadAbsence - s.searchAbsenceOnParam: %w", err)
+		}
+		if len(absences) == 0 {
+			return entity.Absence{}, fmt.Errorf("absence not found")
+		}
+		return absences[0], nil
+	}
+}
+
+// UpdateAbsence updates player and raid of an absence.
+func (s *SQLite) UpdateAbsence(ctx context.Context, absence entity.Absence) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Absence/UpdateAbsence")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("absenceID", absence.ID),
+		attribute.Int("playerID", absence.Player.ID),
+		attribute.Int("raidID", absence.Raid.ID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdateAbsence - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Update("absences").
+			Set("player_id", absence.Player.ID).
+			Set("raid_id", absence.Raid.ID).
+			Where(squirrel.Eq{"id": absence.ID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdateAbsence - s.Builder: %w", err)
+		}
+		_, err = s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("database - UpdateAbsence - s.DB.ExecContext: %w", err)
+		}
+		return nil
+	}
+}
+
+// DeleteAbsence deletes an absence from the database.
+func (s *SQLite) DeleteAbsence(ctx context.Context, absenceID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Absence/DeleteAbsence")
+	defer span.End()
+	span.SetAttributes(attribute.Int("absenceID", absenceID))
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteAbsence - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Delete("absences").Where(squirrel.Eq{"id": absenceID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteAbsence - s.Builder: %w", err)
+		}
+		return s.deleteRows(ctx, "DeleteAbsence", "absence", query, args...)
+	}
+}
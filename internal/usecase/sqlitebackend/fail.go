@@ -0,0 +1,186 @@
+package sqlitebackend
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// searchFailOnParam returns fails matching every given column value.
+// At most limit fails after the first offset ones are returned, all of them when limit is 0.
+func (s *SQLite) searchFailOnParam(ctx context.Context, params squirrel.Eq, limit, offset int) ([]entity.Fail, error) {
+	selectSQL := s.Builder.
+		Select("id", "player_id", "raid_id", "reason").
+		From("fails").Where(params).
+		Where("player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)").
+		Where("raid_id IN (SELECT id FROM raids WHERE deleted_at IS NULL)").OrderBy("id")
+	if limit > 0 {
+		selectSQL = selectSQL.Limit(uint64(limit)).Offset(uint64(offset))
+	}
+	query, args, err := selectSQL.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "create query to search fail with param")
+	}
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query to search fail with param")
+	}
+	defer rows.Close()
+
+	var fails []entity.Fail
+	for rows.Next() {
+		fail := entity.Fail{Player: &entity.Player{}, Raid: &entity.Raid{}}
+		err := rows.Scan(&fail.ID, &fail.Player.ID, &fail.Raid.ID, &fail.Reason)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan query to search fail with param")
+		}
+		fails = append(fails, fail)
+	}
+	return fails, rows.Err()
+}
+
+// SearchFail is a function which call backend to Search an entity.Fail.
+// It returns fails matching each of playerID, raidID and reason, not combined.
+// At most limit fails after the first offset ones are returned, all of them when limit is 0.
+func (s *SQLite) SearchFail(
+	ctx context.Context, playerName string, playerID int, raidID int, reason string, limit, offset int,
+) ([]entity.Fail, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Fail/SearchFail")
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+		attribute.Int("playerID", playerID),
+		attribute.Int("raidID", raidID),
+		attribute.String("reason", reason),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "search fail from sqlite database")
+	default:
+		var params []squirrel.Eq
+		if playerID != -1 {
+			params = append(params, squirrel.Eq{"player_id": playerID})
+		}
+		if raidID != -1 {
+			params = append(params, squirrel.Eq{"raid_id": raidID})
+		}
+		if len(reason) != 0 {
+			params = append(params, squirrel.Eq{"reason": reason})
+		}
+
+		// a single criteria is paged by the database, results of several ones are paged once appended
+		if len(params) == 1 {
+			return s.searchFailOnParam(ctx, params[0], limit, offset)
+		}
+		var fails []entity.Fail
+		for _, param := range params {
+			f, err := s.searchFailOnParam(ctx, param, 0, 0)
+			if err != nil {
+				return nil, err
+			}
+			fails = append(fails, f...)
+		}
+		return entity.Page(fails, limit, offset), nil
+	}
+}
+
+// CreateFail create an entity.Fail in database and returns it with its ID.
+func (s *SQLite) CreateFail(ctx context.Context, fail entity.Fail) (entity.Fail, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Fail/CreateFail")
+	span.SetAttributes(
+		attribute.String("failReason", fail.Reason),
+		attribute.String("playerName", fail.Player.Name),
+		attribute.String("date", fail.Raid.Date.String()))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Fail{}, errors.Wrap(ctx.Err(), "create fail from sqlite database")
+	default:
+		query, args, err := s.Builder.
+			Insert("fails").
+			Columns("player_id", "raid_id", "reason").
+			Values(fail.Player.ID, fail.Raid.ID, fail.Reason).
+			Suffix("RETURNING \"id\"").
+			ToSql()
+		if err != nil {
+			return entity.Fail{}, errors.Wrap(err, "create query to create fail")
+		}
+		err = s.DB.QueryRowContext(ctx, query, args...).Scan(&fail.ID)
+		if err != nil {
+			return entity.Fail{}, errors.Wrap(err, "exec query to create fail")
+		}
+		return fail, nil
+	}
+}
+
+// ReadFail read an entity.Fail from database.
+// Like the postgres backend, an empty fail is returned if it doesn't exist.
+func (s *SQLite) ReadFail(ctx context.Context, failID int) (entity.Fail, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Fail/ReadFail")
+	span.SetAttributes(attribute.Int("failID", failID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Fail{}, errors.Wrap(ctx.Err(), "read fail from sqlite database")
+	default:
+		fails, err := s.searchFailOnParam(ctx, squirrel.Eq{"id": failID}, 0, 0)
+		if err != nil {
+			return entity.Fail{}, errors.Wrap(err, "read fail")
+		}
+		if len(fails) == 0 {
+			return entity.Fail{}, nil
+		}
+		return fails[0], nil
+	}
+}
+
+// UpdateFail update an entity.Fail from database.
+func (s *SQLite) UpdateFail(ctx context.Context, fail entity.Fail) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Fail/UpdateFail")
+	span.SetAttributes(attribute.Int("failID", fail.ID), attribute.String("failReason", fail.Reason))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "update fail from sqlite database")
+	default:
+		query, args, err := s.Builder.Update("fails").
+			Set("reason", fail.Reason).
+			Where(squirrel.Eq{"id": fail.ID}).ToSql()
+		if err != nil {
+			return errors.Wrap(err, "create query to update fail")
+		}
+		_, err = s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			return errors.Wrap(err, "exec query to update fail")
+		}
+		return nil
+	}
+}
+
+// DeleteFail delete an entity.Fail from database based on entity.Fail ID.
+func (s *SQLite) DeleteFail(ctx context.Context, failID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Fail/DeleteFail")
+	span.SetAttributes(attribute.Int("failID", failID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "delete fail from sqlite database")
+	default:
+		query, args, err := s.Builder.Delete("fails").Where(squirrel.Eq{"id": failID}).ToSql()
+		if err != nil {
+			return errors.Wrap(err, "create query to delete fail")
+		}
+		return s.deleteRows(ctx, "DeleteFail", "fail", query, args...)
+	}
+}
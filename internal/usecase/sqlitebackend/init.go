@@ -0,0 +1,56 @@
+package sqlitebackend
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/antony-ramos/guildops/pkg/sqlite"
+	sqlitedriver "modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+type SQLite struct {
+	*sqlite.SQLite
+}
+
+// Init Database Tables.
+// It applies every migration which is not applied yet on the database file at path.
+func (s *SQLite) Init(ctx context.Context, path string, database *sql.DB) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Init")
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - Init - ctx.Done: request took too much time to be proceed")
+	default:
+		err := s.MigrateUp(ctx, path, database)
+		if err != nil {
+			return fmt.Errorf("database - Init - s.MigrateUp: %w", err)
+		}
+		return nil
+	}
+}
+
+// isConstraintViolation checks if err comes from a unique constraint, like postgres error code 23505.
+func isConstraintViolation(err error) bool {
+	var sqliteErr *sqlitedriver.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE ||
+		sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY
+}
+
+// timestamp returns t as stored in a timestamp without time zone column:
+// the wall clock is kept and the location is dropped, like postgres does.
+func timestamp(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
@@ -0,0 +1,142 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// lootItemColumns are the columns of the item of a loot, joined with LEFT JOIN items.
+// They are zero values when the loot is not in the catalogue.
+var lootItemColumns = []string{
+	"COALESCE(items.id, 0)", "COALESCE(items.name, '')", "COALESCE(items.slot, '')",
+	"COALESCE(items.item_level, 0)", "COALESCE(items.armor_type, '')",
+}
+
+// lootItem returns the item scanned from lootItemColumns, nil when the loot is not in the catalogue.
+func lootItem(item entity.Item) *entity.Item {
+	if item.ID == 0 {
+		return nil
+	}
+	return &item
+}
+
+// itemID returns the ID of an item to store, nil when there is no item.
+func itemID(item *entity.Item) any {
+	if item == nil {
+		return nil
+	}
+	return item.ID
+}
+
+// SearchItem returns the items of the catalogue whose name contains name, ordered by name.
+// Every item is returned when name is empty.
+func (s *SQLite) SearchItem(ctx context.Context, name string) ([]entity.Item, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Item/SearchItem")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchItem - ctx.Done: request took too much time to be proceed")
+	default:
+		builder := s.Builder.Select("id", "name", "slot", "item_level", "armor_type").From("items")
+		if name != "" {
+			builder = builder.Where(squirrel.Like{"name": "%" + strings.ToLower(name) + "%"})
+		}
+		query, args, err := builder.OrderBy("name", "id").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchItem - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchItem - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var items []entity.Item
+		for rows.Next() {
+			var item entity.Item
+			err := rows.Scan(&item.ID, &item.Name, &item.Slot, &item.ItemLevel, &item.ArmorType)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchItem - rows.Scan: %w", err)
+			}
+			items = append(items, item)
+		}
+		return items, rows.Err()
+	}
+}
+
+// ReadItem returns the item of the catalogue with this game ID.
+func (s *SQLite) ReadItem(ctx context.Context, itemID int) (entity.Item, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Item/ReadItem")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", itemID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Item{}, fmt.Errorf("database - ReadItem - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Select("id", "name", "slot", "item_level", "armor_type").
+			From("items").
+			Where(squirrel.Eq{"id": itemID}).ToSql()
+		if err != nil {
+			return entity.Item{}, fmt.Errorf("database - ReadItem - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return entity.Item{}, fmt.Errorf("database - ReadItem - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		if !rows.Next() {
+			return entity.Item{}, fmt.Errorf("item %d not found", itemID)
+		}
+		var item entity.Item
+		err = rows.Scan(&item.ID, &item.Name, &item.Slot, &item.ItemLevel, &item.ArmorType)
+		if err != nil {
+			return entity.Item{}, fmt.Errorf("database - ReadItem - rows.Scan: %w", err)
+		}
+		return item, nil
+	}
+}
+
+// SaveItem adds an item to the catalogue, or updates it when its game ID is already there.
+func (s *SQLite) SaveItem(ctx context.Context, item entity.Item) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Item/SaveItem")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", item.ID),
+		attribute.String("name", item.Name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - SaveItem - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("items").
+			Columns("id", "name", "slot", "item_level", "armor_type").
+			Values(item.ID, item.Name, item.Slot, item.ItemLevel, item.ArmorType).
+			Suffix("ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, slot = EXCLUDED.slot, " +
+				"item_level = EXCLUDED.item_level, armor_type = EXCLUDED.armor_type").ToSql()
+		if err != nil {
+			return fmt.Errorf("database - SaveItem - s.Builder.Insert: %w", err)
+		}
+		_, err = s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("database - SaveItem - s.DB.ExecContext: %w", err)
+		}
+		return nil
+	}
+}
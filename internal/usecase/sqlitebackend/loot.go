@@ -0,0 +1,244 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// searchLootOnParam returns loots, with their raid and player, matching every given column value.
+// At most limit loots after the first offset ones are returned, all of them when limit is 0.
+func (s *SQLite) searchLootOnParam(ctx context.Context, params squirrel.Eq, limit, offset int) ([]entity.Loot, error) {
+	selectSQL := s.Builder.
+		Select("loots.id", "loots.name", "loots.raid_id",
+			"raids.name", "raids.difficulty", "raids.date",
+			"loots.player_id", "players.name").
+		Columns(lootItemColumns...).
+		From("loots").
+		Join("raids ON raids.id = loots.raid_id").Join("players ON players.id = loots.player_id").
+		LeftJoin("items ON items.id = loots.item_id").
+		Where(params).
+		Where(squirrel.Eq{"loots.deleted_at": nil, "raids.deleted_at": nil, "players.deleted_at": nil}).
+		OrderBy("loots.id")
+	if limit > 0 {
+		selectSQL = selectSQL.Limit(uint64(limit)).Offset(uint64(offset))
+	}
+	query, args, err := selectSQL.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("create query to search loot: %w", err)
+	}
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("send query to search loot: %w", err)
+	}
+	defer rows.Close()
+
+	var loots []entity.Loot
+	for rows.Next() {
+		var loot entity.Loot
+		var raid entity.Raid
+		var player entity.Player
+		var item entity.Item
+		err := rows.Scan(&loot.ID, &loot.Name, &raid.ID, &raid.Name, &raid.Difficulty, &raid.Date, &player.ID, &player.Name,
+			&item.ID, &item.Name, &item.Slot, &item.ItemLevel, &item.ArmorType)
+		if err != nil {
+			return nil, fmt.Errorf("populate loots table with row data: %w", err)
+		}
+		loot.Raid = &raid
+		loot.Player = &player
+		loot.Item = lootItem(item)
+		loots = append(loots, loot)
+	}
+	return loots, rows.Err()
+}
+
+// SearchLoot returns loots matching every given criteria, ordered by ID. Empty criteria are ignored.
+// At most limit loots after the first offset ones are returned, all of them when limit is 0.
+func (s *SQLite) SearchLoot(
+	ctx context.Context, name string, date time.Time, difficulty, playerName string, limit, offset int,
+) ([]entity.Loot, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Loot/SearchLoot")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("date", date.String()),
+		attribute.String("difficulty", difficulty),
+		attribute.String("playerName", playerName),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if name != "" {
+			params["loots.name"] = name
+		}
+		if !date.IsZero() {
+			params["raids.date"] = timestamp(date)
+		}
+		if difficulty != "" {
+			params["raids.difficulty"] = difficulty
+		}
+		if playerName != "" {
+			params["players.name"] = playerName
+		}
+		return s.searchLootOnParam(ctx, params, limit, offset)
+	}
+}
+
+// SearchLootName returns the names of the loots starting with prefix, once each and ordered.
+// At most limit names are returned, all of them when limit is 0.
+func (s *SQLite) SearchLootName(ctx context.Context, prefix string, limit int) ([]string, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Loot/SearchLootName")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("prefix", prefix),
+		attribute.Int("limit", limit),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchLootName - ctx.Done: request took too much time to be proceed")
+	default:
+		builder := s.Builder.Select("DISTINCT name").From("loots").
+			Where(squirrel.Like{"name": prefix + "%"}).Where(squirrel.Eq{"deleted_at": nil}).OrderBy("name")
+		if limit > 0 {
+			builder = builder.Limit(uint64(limit))
+		}
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchLootName - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchLootName - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var names []string
+		for rows.Next() {
+			var name string
+			err := rows.Scan(&name)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchLootName - rows.Scan: %w", err)
+			}
+			names = append(names, name)
+		}
+		return names, rows.Err()
+	}
+}
+
+// CreateLoot creates a loot in the database and returns it with its ID.
+func (s *SQLite) CreateLoot(ctx context.Context, loot entity.Loot) (entity.Loot, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Loot/CreateLoot")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("lootName", loot.Name),
+		attribute.String("raidName", loot.Raid.Date.Format("02/01/2006")),
+		attribute.String("playerName", loot.Player.Name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Loot{}, fmt.Errorf("database - CreateLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("loots").
+			Columns("name", "raid_id", "player_id", "item_id").
+			Values(loot.Name, loot.Raid.ID, loot.Player.ID, itemID(loot.Item)).
+			Suffix("RETURNING \"id\"").
+			ToSql()
+		if err != nil {
+			return entity.Loot{}, fmt.Errorf("database - CreateLoot - s.Builder.Insert: %w", err)
+		}
+		err = s.DB.QueryRowContext(ctx, query, args...).Scan(&loot.ID)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return entity.Loot{}, fmt.Errorf("database - CreateLoot - loot already exists")
+			}
+			return entity.Loot{}, fmt.Errorf("database - CreateLoot - row.Scan: %w", err)
+		}
+		return loot, nil
+	}
+}
+
+// ReadLoot returns a loot with its raid and player.
+func (s *SQLite) ReadLoot(ctx context.Context, lootID int) (entity.Loot, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Loot/ReadLoot")
+	defer span.End()
+	span.SetAttributes(attribute.Int("lootID", lootID))
+
+	select {
+	case <-ctx.Done():
+		return entity.Loot{}, fmt.Errorf("database - ReadLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		loots, err := s.searchLootOnParam(ctx, squirrel.Eq{"loots.id": lootID}, 0, 0)
+		if err != nil {
+			return entity.Loot{}, fmt.Errorf("database - ReadLoot - s.searchLootOnParam: %w", err)
+		}
+		if len(loots) == 0 {
+			return entity.Loot{}, fmt.Errorf("database - ReadLoot - loot not found")
+		}
+		return loots[0], nil
+	}
+}
+
+// UpdateLoot updates name, raid, player and item of a loot.
+func (s *SQLite) UpdateLoot(ctx context.Context, loot entity.Loot) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Loot/UpdateLoot")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("lootID", loot.ID),
+		attribute.String("lootName", loot.Name),
+		attribute.Int("raidID", loot.Raid.ID),
+		attribute.Int("playerID", loot.Player.ID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdateLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Update("loots").
+			Set("name", loot.Name).
+			Set("raid_id", loot.Raid.ID).
+			Set("player_id", loot.Player.ID).
+			Set("item_id", itemID(loot.Item)).
+			Where(squirrel.Eq{"id": loot.ID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdateLoot - s.Builder: %w", err)
+		}
+		_, err = s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("database - UpdateLoot - s.DB.ExecContext: %w", err)
+		}
+		return nil
+	}
+}
+
+// DeleteLoot marks a loot deleted, until it is restored or purged.
+func (s *SQLite) DeleteLoot(ctx context.Context, lootID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Loot/DeleteLoot")
+	defer span.End()
+	span.SetAttributes(attribute.Int("lootID", lootID))
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteLoot - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Update("loots").Set("deleted_at", timestamp(time.Now().UTC())).
+			Where(squirrel.Eq{"id": lootID, "deleted_at": nil}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteLoot - s.Builder: %w", err)
+		}
+		return s.deleteRows(ctx, "DeleteLoot", "loot", query, args...)
+	}
+}
@@ -0,0 +1,176 @@
+package sqlitebackend
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratesqlite "github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/pkg/logger"
+	"github.com/antony-ramos/guildops/pkg/sqlite"
+)
+
+// migrationFiles contains numbered up and down migrations of the schema.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// newMigrate returns a migrate instance applying embedded migrations.
+// If database is nil, the database file at path is opened.
+// Database is closed when the migrate instance is closed.
+func newMigrate(path string, database *sql.DB) (*migrate.Migrate, error) {
+	var err error
+	if database == nil {
+		database, err = sql.Open("sqlite", sqlite.DSN(path))
+		if err != nil {
+			return nil, fmt.Errorf("open database: %w", err)
+		}
+	}
+
+	// Test the connection
+	err = database.Ping()
+	if err != nil {
+		_ = database.Close()
+		return nil, fmt.Errorf("ping database: %w", err)
+	}
+
+	source, err := iofs.New(migrationFiles, "migrations")
+	if err != nil {
+		_ = database.Close()
+		return nil, fmt.Errorf("load embedded migrations: %w", err)
+	}
+
+	driver, err := migratesqlite.WithInstance(database, &migratesqlite.Config{})
+	if err != nil {
+		_ = database.Close()
+		return nil, fmt.Errorf("create migration driver: %w", err)
+	}
+
+	migration, err := migrate.NewWithInstance("iofs", source, "sqlite", driver)
+	if err != nil {
+		_ = driver.Close()
+		return nil, fmt.Errorf("create migration instance: %w", err)
+	}
+	return migration, nil
+}
+
+// closeMigrate closes the migrate instance and logs errors.
+func closeMigrate(ctx context.Context, migration *migrate.Migrate) {
+	sourceErr, databaseErr := migration.Close()
+	if sourceErr != nil {
+		logger.FromContext(ctx).Error(sourceErr.Error())
+	}
+	if databaseErr != nil {
+		logger.FromContext(ctx).Error(databaseErr.Error())
+	}
+}
+
+// MigrateUp applies every migration which is not applied yet.
+func (s *SQLite) MigrateUp(ctx context.Context, path string, database *sql.DB) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Migrate/MigrateUp")
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - MigrateUp - ctx.Done: request took too much time to be proceed")
+	default:
+		migration, err := newMigrate(path, database)
+		if err != nil {
+			return fmt.Errorf("database - MigrateUp - newMigrate: %w", err)
+		}
+		defer closeMigrate(ctx, migration)
+
+		err = migration.Up()
+		if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return fmt.Errorf("database - MigrateUp - migration.Up: %w", err)
+		}
+		return nil
+	}
+}
+
+// MigrateDown reverts the last steps migrations.
+func (s *SQLite) MigrateDown(ctx context.Context, path string, database *sql.DB, steps int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Migrate/MigrateDown")
+	defer span.End()
+	span.SetAttributes(attribute.Int("steps", steps))
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - MigrateDown - ctx.Done: request took too much time to be proceed")
+	default:
+		if steps < 1 {
+			return fmt.Errorf("database - MigrateDown: steps must be greater than 0")
+		}
+
+		migration, err := newMigrate(path, database)
+		if err != nil {
+			return fmt.Errorf("database - MigrateDown - newMigrate: %w", err)
+		}
+		defer closeMigrate(ctx, migration)
+
+		err = migration.Steps(-steps)
+		if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return fmt.Errorf("database - MigrateDown - migration.Steps: %w", err)
+		}
+		return nil
+	}
+}
+
+// MigrationStatus returns the version of the schema and if the last migration failed.
+// Version is 0 when no migration has been applied.
+func (s *SQLite) MigrationStatus(ctx context.Context, path string, database *sql.DB) (uint, bool, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Migrate/MigrationStatus")
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return 0, false, fmt.Errorf("database - MigrationStatus - ctx.Done: request took too much time to be proceed")
+	default:
+		migration, err := newMigrate(path, database)
+		if err != nil {
+			return 0, false, fmt.Errorf("database - MigrationStatus - newMigrate: %w", err)
+		}
+		defer closeMigrate(ctx, migration)
+
+		version, dirty, err := migration.Version()
+		if errors.Is(err, migrate.ErrNilVersion) {
+			return 0, false, nil
+		}
+		if err != nil {
+			return 0, false, fmt.Errorf("database - MigrationStatus - migration.Version: %w", err)
+		}
+		return version, dirty, nil
+	}
+}
+
+// LatestMigration returns the version of the last embedded migration.
+func LatestMigration() (uint, error) {
+	source, err := iofs.New(migrationFiles, "migrations")
+	if err != nil {
+		return 0, fmt.Errorf("load embedded migrations: %w", err)
+	}
+	defer source.Close()
+
+	version, err := source.First()
+	if err != nil {
+		return 0, fmt.Errorf("read first migration: %w", err)
+	}
+	for {
+		next, err := source.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("read migration after %d: %w", version, err)
+		}
+		version = next
+	}
+}
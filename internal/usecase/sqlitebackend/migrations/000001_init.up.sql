@@ -0,0 +1,46 @@
+CREATE TABLE IF NOT EXISTS players (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) UNIQUE,
+    discord_id VARCHAR(255) UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS raids (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255),
+    date TIMESTAMP,
+    difficulty VARCHAR(50),
+    CONSTRAINT unique_raid_entry UNIQUE (date, difficulty)
+);
+
+CREATE TABLE IF NOT EXISTS strikes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    season VARCHAR(50),
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS loots (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(20),
+    raid_id INTEGER REFERENCES raids(id) ON DELETE CASCADE,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_loot_entry UNIQUE (name, raid_id, player_id)
+);
+
+CREATE TABLE IF NOT EXISTS absences (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    raid_id INTEGER REFERENCES raids(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_absence_entry UNIQUE (player_id, raid_id)
+);
+
+CREATE TABLE IF NOT EXISTS fails (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    raid_id INTEGER REFERENCES raids(id) ON DELETE CASCADE,
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
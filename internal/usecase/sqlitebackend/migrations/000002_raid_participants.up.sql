@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS raid_participants (
+    raid_id INTEGER REFERENCES raids(id) ON DELETE CASCADE,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('present', 'bench', 'late', 'absent')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (raid_id, player_id)
+);
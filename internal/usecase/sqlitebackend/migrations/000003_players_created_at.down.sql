@@ -0,0 +1 @@
+ALTER TABLE players DROP COLUMN created_at;
@@ -0,0 +1,3 @@
+-- Players created before this migration are considered there since the beginning.
+-- SQLite can't add a column defaulting to CURRENT_TIMESTAMP, created_at is set on insert.
+ALTER TABLE players ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS loots_item_update;
+DROP TRIGGER IF EXISTS loots_item_insert;
+ALTER TABLE loots DROP COLUMN item_id;
+DROP TABLE IF EXISTS items;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS items (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    slot VARCHAR(20) NOT NULL DEFAULT '',
+    item_level INTEGER NOT NULL DEFAULT 0,
+    armor_type VARCHAR(20) NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS items_name ON items (name);
+-- SQLite doesn't enforce VARCHAR lengths, loot names and wish items need no change.
+-- SQLite can't drop a column used by a foreign key, triggers check item_id refers to an item instead.
+ALTER TABLE loots ADD COLUMN item_id INTEGER;
+CREATE TRIGGER IF NOT EXISTS loots_item_insert BEFORE INSERT ON loots
+WHEN NEW.item_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM items WHERE id = NEW.item_id)
+BEGIN
+    SELECT RAISE(ABORT, 'item not found');
+END;
+CREATE TRIGGER IF NOT EXISTS loots_item_update BEFORE UPDATE OF item_id ON loots
+WHEN NEW.item_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM items WHERE id = NEW.item_id)
+BEGIN
+    SELECT RAISE(ABORT, 'item not found');
+END;
@@ -0,0 +1,4 @@
+ALTER TABLE players DROP COLUMN role;
+ALTER TABLE players DROP COLUMN off_spec;
+ALTER TABLE players DROP COLUMN main_spec;
+ALTER TABLE players DROP COLUMN class;
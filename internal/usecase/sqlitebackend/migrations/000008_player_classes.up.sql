@@ -0,0 +1,4 @@
+ALTER TABLE players ADD COLUMN class VARCHAR(20) NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN main_spec VARCHAR(20) NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN off_spec VARCHAR(20) NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN role VARCHAR(10) NOT NULL DEFAULT '';
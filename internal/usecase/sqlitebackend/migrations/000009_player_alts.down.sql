@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS players_main_delete;
+DROP TRIGGER IF EXISTS players_main_update;
+DROP TRIGGER IF EXISTS players_main_insert;
+ALTER TABLE players DROP COLUMN main_id;
@@ -0,0 +1,17 @@
+-- SQLite can't drop a column used by a foreign key, triggers check main_id refers to a player instead.
+-- Alts become mains when their main is deleted.
+ALTER TABLE players ADD COLUMN main_id INTEGER;
+CREATE TRIGGER IF NOT EXISTS players_main_insert BEFORE INSERT ON players
+WHEN NEW.main_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM players WHERE id = NEW.main_id)
+BEGIN
+    SELECT RAISE(ABORT, 'main not found');
+END;
+CREATE TRIGGER IF NOT EXISTS players_main_update BEFORE UPDATE OF main_id ON players
+WHEN NEW.main_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM players WHERE id = NEW.main_id)
+BEGIN
+    SELECT RAISE(ABORT, 'main not found');
+END;
+CREATE TRIGGER IF NOT EXISTS players_main_delete AFTER DELETE ON players
+BEGIN
+    UPDATE players SET main_id = NULL WHERE main_id = OLD.id;
+END;
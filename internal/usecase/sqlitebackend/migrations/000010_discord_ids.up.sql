@@ -0,0 +1,2 @@
+-- Players are linked by discord user ID, the discord username is only kept to be shown
+ALTER TABLE players ADD COLUMN discord_name VARCHAR(255) NOT NULL DEFAULT '';
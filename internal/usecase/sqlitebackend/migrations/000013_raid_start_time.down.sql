@@ -0,0 +1 @@
+ALTER TABLE raids DROP COLUMN start_time;
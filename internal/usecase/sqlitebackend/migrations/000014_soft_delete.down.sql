@@ -0,0 +1,16 @@
+-- Deleted rows are purged with what belongs to them before their column is dropped.
+-- Unique constraints come back as unique indexes, which SQLite can add without a rebuild.
+DELETE FROM loots WHERE deleted_at IS NOT NULL;
+DELETE FROM raids WHERE deleted_at IS NOT NULL;
+DELETE FROM players WHERE deleted_at IS NOT NULL;
+DROP INDEX unique_loot_entry;
+DROP INDEX unique_raid_entry;
+DROP INDEX players_discord_id_key;
+DROP INDEX players_name_key;
+CREATE UNIQUE INDEX unique_loot_entry ON loots (name, raid_id, player_id);
+CREATE UNIQUE INDEX unique_raid_entry ON raids (date, difficulty);
+CREATE UNIQUE INDEX players_discord_id_key ON players (discord_id);
+CREATE UNIQUE INDEX players_name_key ON players (name);
+ALTER TABLE loots DROP COLUMN deleted_at;
+ALTER TABLE raids DROP COLUMN deleted_at;
+ALTER TABLE players DROP COLUMN deleted_at;
@@ -0,0 +1,173 @@
+-- Players, raids and loots are kept when deleted until they are purged, so they can be restored.
+-- Deleted rows are left out of unique constraints, so they can be replaced while they are kept.
+-- SQLite can't drop a unique constraint, the tables are rebuilt. Foreign keys can't be disabled
+-- in the transaction of a migration and dropping a table deletes its rows, so every table referring
+-- to players, raids or loots is rebuilt too, and old tables are dropped children first.
+ALTER TABLE players RENAME TO players_old;
+ALTER TABLE raids RENAME TO raids_old;
+ALTER TABLE loots RENAME TO loots_old;
+ALTER TABLE strikes RENAME TO strikes_old;
+ALTER TABLE absences RENAME TO absences_old;
+ALTER TABLE fails RENAME TO fails_old;
+ALTER TABLE raid_participants RENAME TO raid_participants_old;
+ALTER TABLE points RENAME TO points_old;
+ALTER TABLE wishes RENAME TO wishes_old;
+ALTER TABLE raid_signups RENAME TO raid_signups_old;
+
+CREATE TABLE players (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255),
+    discord_id VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00',
+    class VARCHAR(20) NOT NULL DEFAULT '',
+    main_spec VARCHAR(20) NOT NULL DEFAULT '',
+    off_spec VARCHAR(20) NOT NULL DEFAULT '',
+    role VARCHAR(10) NOT NULL DEFAULT '',
+    main_id INTEGER,
+    discord_name VARCHAR(255) NOT NULL DEFAULT '',
+    deleted_at TIMESTAMP
+);
+INSERT INTO players (id, name, discord_id, created_at, class, main_spec, off_spec, role, main_id, discord_name)
+SELECT id, name, discord_id, created_at, class, main_spec, off_spec, role, main_id, discord_name FROM players_old;
+
+CREATE TABLE raids (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255),
+    date TIMESTAMP,
+    difficulty VARCHAR(50),
+    start_time VARCHAR(5) NOT NULL DEFAULT '21:00',
+    deleted_at TIMESTAMP
+);
+INSERT INTO raids (id, name, date, difficulty, start_time)
+SELECT id, name, date, difficulty, start_time FROM raids_old;
+
+CREATE TABLE loots (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(20),
+    raid_id INTEGER REFERENCES raids(id) ON DELETE CASCADE,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    item_id INTEGER,
+    deleted_at TIMESTAMP
+);
+INSERT INTO loots (id, name, raid_id, player_id, created_at, item_id)
+SELECT id, name, raid_id, player_id, created_at, item_id FROM loots_old;
+
+CREATE TABLE strikes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    season VARCHAR(50),
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO strikes SELECT * FROM strikes_old;
+
+CREATE TABLE absences (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    raid_id INTEGER REFERENCES raids(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_absence_entry UNIQUE (player_id, raid_id)
+);
+INSERT INTO absences SELECT * FROM absences_old;
+
+CREATE TABLE fails (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    raid_id INTEGER REFERENCES raids(id) ON DELETE CASCADE,
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO fails SELECT * FROM fails_old;
+
+CREATE TABLE raid_participants (
+    raid_id INTEGER REFERENCES raids(id) ON DELETE CASCADE,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('present', 'bench', 'late', 'absent')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (raid_id, player_id)
+);
+INSERT INTO raid_participants SELECT * FROM raid_participants_old;
+
+CREATE TABLE points (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    amount INTEGER NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('attendance', 'award', 'loot', 'decay', 'reversal')),
+    reason VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    date TIMESTAMP NOT NULL,
+    raid_id INTEGER REFERENCES raids(id) ON DELETE SET NULL,
+    loot_id INTEGER REFERENCES loots(id) ON DELETE SET NULL,
+    reverts INTEGER UNIQUE REFERENCES points(id) ON DELETE CASCADE
+);
+INSERT INTO points SELECT * FROM points_old ORDER BY id;
+
+CREATE TABLE wishes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    item VARCHAR(30) NOT NULL,
+    priority INTEGER NOT NULL CHECK (priority BETWEEN 1 AND 5),
+    spec VARCHAR(20) NOT NULL DEFAULT '',
+    note VARCHAR(255) NOT NULL DEFAULT '',
+    UNIQUE (player_id, item)
+);
+INSERT INTO wishes SELECT * FROM wishes_old;
+
+CREATE TABLE raid_signups (
+    raid_id INTEGER REFERENCES raids(id) ON DELETE CASCADE,
+    player_id INTEGER REFERENCES players(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('accepted', 'tentative', 'late', 'declined')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (raid_id, player_id)
+);
+INSERT INTO raid_signups SELECT * FROM raid_signups_old;
+
+-- IDs of rows deleted before the rebuild are not given again
+DELETE FROM sqlite_sequence
+WHERE name IN ('players', 'raids', 'loots', 'strikes', 'absences', 'fails', 'points', 'wishes');
+UPDATE sqlite_sequence SET name = substr(name, 1, length(name) - length('_old'))
+WHERE name IN ('players_old', 'raids_old', 'loots_old', 'strikes_old', 'absences_old', 'fails_old', 'points_old',
+    'wishes_old');
+
+DROP TABLE raid_signups_old;
+DROP TABLE wishes_old;
+DROP TABLE points_old;
+DROP TABLE raid_participants_old;
+DROP TABLE fails_old;
+DROP TABLE absences_old;
+DROP TABLE strikes_old;
+DROP TABLE loots_old;
+DROP TABLE raids_old;
+DROP TABLE players_old;
+
+CREATE UNIQUE INDEX players_name_key ON players (name) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX players_discord_id_key ON players (discord_id) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX unique_raid_entry ON raids (date, difficulty) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX unique_loot_entry ON loots (name, raid_id, player_id) WHERE deleted_at IS NULL;
+
+-- Triggers are dropped with the old tables
+CREATE TRIGGER players_main_insert BEFORE INSERT ON players
+WHEN NEW.main_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM players WHERE id = NEW.main_id)
+BEGIN
+    SELECT RAISE(ABORT, 'main not found');
+END;
+CREATE TRIGGER players_main_update BEFORE UPDATE OF main_id ON players
+WHEN NEW.main_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM players WHERE id = NEW.main_id)
+BEGIN
+    SELECT RAISE(ABORT, 'main not found');
+END;
+CREATE TRIGGER players_main_delete AFTER DELETE ON players
+BEGIN
+    UPDATE players SET main_id = NULL WHERE main_id = OLD.id;
+END;
+CREATE TRIGGER loots_item_insert BEFORE INSERT ON loots
+WHEN NEW.item_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM items WHERE id = NEW.item_id)
+BEGIN
+    SELECT RAISE(ABORT, 'item not found');
+END;
+CREATE TRIGGER loots_item_update BEFORE UPDATE OF item_id ON loots
+WHEN NEW.item_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM items WHERE id = NEW.item_id)
+BEGIN
+    SELECT RAISE(ABORT, 'item not found');
+END;
@@ -0,0 +1,164 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchParticipant returns the roster entries of a raid, of a player, or of a player on a raid,
+// ordered by raid date and player name. raidID and playerID are ignored when -1.
+func (s *SQLite) SearchParticipant(ctx context.Context, raidID, playerID int) ([]entity.Participant, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Participant/SearchParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if raidID != -1 {
+			params["raid_participants.raid_id"] = raidID
+		}
+		if playerID != -1 {
+			params["raid_participants.player_id"] = playerID
+		}
+		// Roster entries of deleted players and raids are hidden with them
+		params["players.deleted_at"] = nil
+		params["raids.deleted_at"] = nil
+		query, args, err := s.Builder.Select("raid_participants.raid_id", "raids.name", "raids.difficulty", "raids.date",
+			"raid_participants.player_id", "players.name", "players.class", "players.main_spec",
+			"players.off_spec", "players.role", "raid_participants.status").
+			From("raid_participants").
+			Join("raids ON raids.id = raid_participants.raid_id").
+			Join("players ON players.id = raid_participants.player_id").
+			Where(params).
+			OrderBy("raids.date", "players.name").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchParticipant - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchParticipant - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var participants []entity.Participant
+		for rows.Next() {
+			var raid entity.Raid
+			var player entity.Player
+			var role, status string
+			err := rows.Scan(&raid.ID, &raid.Name, &raid.Difficulty, &raid.Date, &player.ID, &player.Name,
+				&player.Class, &player.MainSpec, &player.OffSpec, &role, &status)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchParticipant - rows.Scan: %w", err)
+			}
+			player.Role = entity.Role(role)
+			participants = append(participants, entity.Participant{
+				Player: &player,
+				Raid:   &raid,
+				Status: entity.ParticipantStatus(status),
+			})
+		}
+		return participants, rows.Err()
+	}
+}
+
+// CreateParticipant adds a player to the roster of a raid.
+func (s *SQLite) CreateParticipant(ctx context.Context, participant entity.Participant) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Participant/CreateParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", participant.Raid.ID),
+		attribute.Int("playerID", participant.Player.ID),
+		attribute.String("status", string(participant.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - CreateParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("raid_participants").
+			Columns("raid_id", "player_id", "status").
+			Values(participant.Raid.ID, participant.Player.ID, string(participant.Status)).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - CreateParticipant - s.Builder: %w", err)
+		}
+		_, err = s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return fmt.Errorf("participant already exists")
+			}
+			return fmt.Errorf("database - CreateParticipant - s.DB.ExecContext: %w", err)
+		}
+		return nil
+	}
+}
+
+// UpdateParticipant updates the status of a player on the roster of a raid.
+func (s *SQLite) UpdateParticipant(ctx context.Context, participant entity.Participant) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Participant/UpdateParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", participant.Raid.ID),
+		attribute.Int("playerID", participant.Player.ID),
+		attribute.String("status", string(participant.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdateParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Update("raid_participants").
+			Set("status", string(participant.Status)).
+			Where(squirrel.Eq{"raid_id": participant.Raid.ID, "player_id": participant.Player.ID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdateParticipant - s.Builder: %w", err)
+		}
+		result, err := s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("database - UpdateParticipant - s.DB.ExecContext: %w", err)
+		}
+		updated, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("database - UpdateParticipant - result.RowsAffected: %w", err)
+		}
+		if updated == 0 {
+			return fmt.Errorf("database - UpdateParticipant - participant not found")
+		}
+		return nil
+	}
+}
+
+// DeleteParticipant removes a player from the roster of a raid.
+func (s *SQLite) DeleteParticipant(ctx context.Context, raidID, playerID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Participant/DeleteParticipant")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteParticipant - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Delete("raid_participants").
+			Where(squirrel.Eq{"raid_id": raidID, "player_id": playerID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteParticipant - s.Builder: %w", err)
+		}
+		return s.deleteRows(ctx, "DeleteParticipant", "participant", query, args...)
+	}
+}
@@ -0,0 +1,274 @@
+package sqlitebackend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// nullString stores empty strings as NULL, so several players can have no discord_id.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// mainID returns the ID of the main of a player to store, nil when the player is a main.
+func mainID(player entity.Player) any {
+	if !player.IsAlt() {
+		return nil
+	}
+	return player.MainID
+}
+
+// mainIDColumn selects the ID of the main of a player, 0 when the player is a main
+// or while its main is deleted.
+const mainIDColumn = "COALESCE((SELECT main.id FROM players AS main " +
+	"WHERE main.id = players.main_id AND main.deleted_at IS NULL), 0)"
+
+// SearchPlayer is a function which call backend to Search a Player Object.
+// It can search by playerID, name or discordID.
+// players returned doesn't contain strikes, fails, missed raids and loots.
+func (s *SQLite) SearchPlayer(ctx context.Context, playerID int, name, discordID string) ([]entity.Player, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/SearchPlayer")
+	span.SetAttributes(
+		attribute.String("playerName", name),
+		attribute.String("discordID", discordID),
+		attribute.Int("playerID", playerID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchPlayer - ctx.Done: request took too much time to be proceed")
+	default:
+		sqlQuery := s.Builder.Select("id", "name", "discord_id", "discord_name", "created_at",
+			"class", "main_spec", "off_spec", "role", mainIDColumn).From("players").
+			Where(squirrel.Eq{"deleted_at": nil}).OrderBy("id")
+		if playerID != -1 {
+			sqlQuery = sqlQuery.Where(squirrel.Eq{"id": playerID})
+		}
+		if name != "" {
+			sqlQuery = sqlQuery.Where(squirrel.Eq{"name": name})
+		}
+		if discordID != "" {
+			sqlQuery = sqlQuery.Where(squirrel.Eq{"discord_id": discordID})
+		}
+		query, args, err := sqlQuery.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchPlayer - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchPlayer - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var players []entity.Player
+		for rows.Next() {
+			var player entity.Player
+			var discordID sql.NullString
+			var role string
+			err := rows.Scan(&player.ID, &player.Name, &discordID, &player.DiscordName, &player.CreatedAt,
+				&player.Class, &player.MainSpec, &player.OffSpec, &role, &player.MainID)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchPlayer - rows.Scan: %w", err)
+			}
+			player.DiscordID = discordID.String
+			player.Role = entity.Role(role)
+			players = append(players, player)
+		}
+		return players, rows.Err()
+	}
+}
+
+// SearchPlayerOnPrefix returns the players whose name starts with prefix, ordered by name.
+// At most limit players are returned, all of them when limit is 0.
+// players returned doesn't contain strikes, fails, missed raids and loots.
+func (s *SQLite) SearchPlayerOnPrefix(ctx context.Context, prefix string, limit int) ([]entity.Player, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/SearchPlayerOnPrefix")
+	span.SetAttributes(
+		attribute.String("prefix", prefix),
+		attribute.Int("limit", limit))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchPlayerOnPrefix - ctx.Done: request took too much time to be proceed")
+	default:
+		sqlQuery := s.Builder.Select("id", "name", "discord_id", "discord_name", "created_at",
+			"class", "main_spec", "off_spec", "role", mainIDColumn).From("players").
+			Where(squirrel.Like{"name": prefix + "%"}).Where(squirrel.Eq{"deleted_at": nil}).OrderBy("name")
+		if limit > 0 {
+			sqlQuery = sqlQuery.Limit(uint64(limit))
+		}
+		query, args, err := sqlQuery.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchPlayerOnPrefix - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchPlayerOnPrefix - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var players []entity.Player
+		for rows.Next() {
+			var player entity.Player
+			var discordID sql.NullString
+			var role string
+			err := rows.Scan(&player.ID, &player.Name, &discordID, &player.DiscordName, &player.CreatedAt,
+				&player.Class, &player.MainSpec, &player.OffSpec, &role, &player.MainID)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchPlayerOnPrefix - rows.Scan: %w", err)
+			}
+			player.DiscordID = discordID.String
+			player.Role = entity.Role(role)
+			players = append(players, player)
+		}
+		return players, rows.Err()
+	}
+}
+
+// CreatePlayer is a function which call backend to Create a Player Object.
+func (s *SQLite) CreatePlayer(ctx context.Context, player entity.Player) (entity.Player, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/CreatePlayer")
+	span.SetAttributes(attribute.String("playerName", player.Name))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Player{}, fmt.Errorf("database - CreatePlayer - ctx.Done: request took too much time to be proceed")
+	default:
+		player.CreatedAt = timestamp(time.Now().UTC())
+		query, args, err := s.Builder.
+			Insert("players").
+			Columns("name", "discord_id", "discord_name", "created_at", "class", "main_spec", "off_spec", "role").
+			Values(player.Name, nullString(player.DiscordID), player.DiscordName, player.CreatedAt,
+				player.Class, player.MainSpec, player.OffSpec, string(player.Role)).
+			Suffix("RETURNING \"id\"").
+			ToSql()
+		if err != nil {
+			return entity.Player{}, fmt.Errorf("database - CreatePlayer - s.Builder.Insert: %w", err)
+		}
+		err = s.DB.QueryRowContext(ctx, query, args...).Scan(&player.ID)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return entity.Player{}, fmt.Errorf("player already exists")
+			}
+			return entity.Player{}, fmt.Errorf("scan row from query row on insert player: %w", err)
+		}
+		return player, nil
+	}
+}
+
+// ReadPlayer is a function which call backend to Read a Player Object.
+func (s *SQLite) ReadPlayer(ctx context.Context, playerID int) (entity.Player, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/ReadPlayer")
+	span.SetAttributes(attribute.Int("playerID", playerID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Player{}, fmt.Errorf("database - ReadPlayer - ctx.Done: request took too much time to be proceed")
+	default:
+		players, err := s.SearchPlayer(ctx, playerID, "", "")
+		if err != nil {
+			return entity.Player{}, fmt.Errorf("database - ReadPlayer - s.SearchPlayer: %w", err)
+		}
+		if len(players) == 0 {
+			return entity.Player{}, fmt.Errorf("database - ReadPlayer - player not found")
+		}
+		return players[0], nil
+	}
+}
+
+// UpdatePlayer is a function which call backend to Update a Player Object.
+func (s *SQLite) UpdatePlayer(ctx context.Context, player entity.Player) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/UpdatePlayer")
+	span.SetAttributes(
+		attribute.String("playerName", player.Name),
+		attribute.String("discordID", player.DiscordID),
+		attribute.Int("playerID", player.ID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdatePlayer - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Update("players").
+			Set("name", player.Name).
+			Set("discord_id", nullString(player.DiscordID)).
+			Set("discord_name", player.DiscordName).
+			Set("class", player.Class).
+			Set("main_spec", player.MainSpec).
+			Set("off_spec", player.OffSpec).
+			Set("role", string(player.Role)).
+			Set("main_id", mainID(player)).
+			Where(squirrel.Eq{"id": player.ID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdatePlayer - s.Builder.Update: %w", err)
+		}
+		_, err = s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return fmt.Errorf("database - UpdatePlayer - player already exists")
+			}
+			return fmt.Errorf("database - UpdatePlayer - s.DB.ExecContext: %w", err)
+		}
+		return nil
+	}
+}
+
+// DeletePlayer is a function which call backend to Delete a Player Object.
+// The player is marked deleted, until it is restored or purged.
+func (s *SQLite) DeletePlayer(ctx context.Context, player entity.Player) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Player/DeletePlayer")
+	span.SetAttributes(
+		attribute.String("playerName", player.Name),
+		attribute.String("discordID", player.DiscordID),
+		attribute.Int("playerID", player.ID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeletePlayer - ctx.Done: request took too much time to be proceed")
+	default:
+		sqlQuery := s.Builder.Update("players").Set("deleted_at", timestamp(time.Now().UTC())).
+			Where(squirrel.Eq{"deleted_at": nil})
+		if player.ID != 0 {
+			sqlQuery = sqlQuery.Where(squirrel.Eq{"id": player.ID})
+		}
+		if player.Name != "" {
+			sqlQuery = sqlQuery.Where(squirrel.Eq{"name": player.Name})
+		}
+		if player.DiscordID != "" {
+			sqlQuery = sqlQuery.Where(squirrel.Eq{"discord_id": player.DiscordID})
+		}
+		query, args, err := sqlQuery.ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeletePlayer - s.Builder.Update: %w", err)
+		}
+		return s.deleteRows(ctx, "DeletePlayer", "player", query, args...)
+	}
+}
+
+// deleteRows runs a delete query, or an update marking rows deleted, and returns an error if nothing was deleted.
+func (s *SQLite) deleteRows(ctx context.Context, method, object, query string, args ...any) error {
+	result, err := s.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("database - %s - s.DB.ExecContext: %w", method, err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("database - %s - result.RowsAffected: %w", method, err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("database - %s - %s not found", method, object)
+	}
+	return nil
+}
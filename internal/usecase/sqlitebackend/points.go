@@ -0,0 +1,164 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// pointsColumns are the columns of a points entry, with the name of its player.
+var pointsColumns = []string{
+	"points.id", "points.player_id", "players.name", "points.amount", "points.kind", "points.reason",
+	"points.author", "points.date", "COALESCE(points.raid_id, 0)", "COALESCE(points.loot_id, 0)",
+	"COALESCE(points.reverts, 0)",
+}
+
+// nullID returns nil for 0, so an optional reference is stored as NULL.
+func nullID(id int) any {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// SearchPointsEntry returns the ledger entries of a player, of a raid or of a loot.
+// playerID, raidID and lootID are ignored when -1. Entries are ordered by date.
+func (s *SQLite) SearchPointsEntry(ctx context.Context, playerID, raidID, lootID int) ([]entity.PointsEntry, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Points/SearchPointsEntry")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", playerID),
+		attribute.Int("raidID", raidID),
+		attribute.Int("lootID", lootID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchPointsEntry - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if playerID != -1 {
+			params["points.player_id"] = playerID
+		}
+		if raidID != -1 {
+			params["points.raid_id"] = raidID
+		}
+		if lootID != -1 {
+			params["points.loot_id"] = lootID
+		}
+		// Entries of deleted players are hidden with them, entries of deleted raids and loots are kept
+		params["players.deleted_at"] = nil
+		query, args, err := s.Builder.Select(pointsColumns...).
+			From("points").
+			Join("players ON players.id = points.player_id").
+			Where(params).
+			OrderBy("points.date", "points.id").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchPointsEntry - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchPointsEntry - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var entries []entity.PointsEntry
+		for rows.Next() {
+			var entry entity.PointsEntry
+			var player entity.Player
+			var kind string
+			err := rows.Scan(&entry.ID, &player.ID, &player.Name, &entry.Amount, &kind, &entry.Reason,
+				&entry.Author, &entry.Date, &entry.RaidID, &entry.LootID, &entry.Reverts)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchPointsEntry - rows.Scan: %w", err)
+			}
+			entry.Player = &player
+			entry.Kind = entity.PointsKind(kind)
+			entries = append(entries, entry)
+		}
+		return entries, rows.Err()
+	}
+}
+
+// CreatePointsEntry adds an entry to the ledger.
+func (s *SQLite) CreatePointsEntry(ctx context.Context, entry entity.PointsEntry) (entity.PointsEntry, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Points/CreatePointsEntry")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", entry.Player.ID),
+		attribute.Int("amount", entry.Amount),
+		attribute.String("kind", string(entry.Kind)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.PointsEntry{}, fmt.Errorf(
+			"database - CreatePointsEntry - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("points").
+			Columns("player_id", "amount", "kind", "reason", "author", "date", "raid_id", "loot_id", "reverts").
+			Values(entry.Player.ID, entry.Amount, string(entry.Kind), entry.Reason, entry.Author, timestamp(entry.Date),
+				nullID(entry.RaidID), nullID(entry.LootID), nullID(entry.Reverts)).
+			Suffix("RETURNING \"id\"").ToSql()
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("database - CreatePointsEntry - s.Builder.Insert: %w", err)
+		}
+		err = s.DB.QueryRowContext(ctx, query, args...).Scan(&entry.ID)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return entity.PointsEntry{}, fmt.Errorf("points entry already reverted")
+			}
+			return entity.PointsEntry{}, fmt.Errorf("database - CreatePointsEntry - row.Scan: %w", err)
+		}
+		return entry, nil
+	}
+}
+
+// ReadPointsEntry returns an entry of the ledger.
+func (s *SQLite) ReadPointsEntry(ctx context.Context, entryID int) (entity.PointsEntry, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Points/ReadPointsEntry")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", entryID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.PointsEntry{}, fmt.Errorf(
+			"database - ReadPointsEntry - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Select(pointsColumns...).
+			From("points").
+			Join("players ON players.id = points.player_id").
+			Where(squirrel.Eq{"points.id": entryID, "players.deleted_at": nil}).ToSql()
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("database - ReadPointsEntry - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("database - ReadPointsEntry - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		if !rows.Next() {
+			return entity.PointsEntry{}, fmt.Errorf("points entry not found")
+		}
+		var entry entity.PointsEntry
+		var player entity.Player
+		var kind string
+		err = rows.Scan(&entry.ID, &player.ID, &player.Name, &entry.Amount, &kind, &entry.Reason,
+			&entry.Author, &entry.Date, &entry.RaidID, &entry.LootID, &entry.Reverts)
+		if err != nil {
+			return entity.PointsEntry{}, fmt.Errorf("database - ReadPointsEntry - rows.Scan: %w", err)
+		}
+		entry.Player = &player
+		entry.Kind = entity.PointsKind(kind)
+		return entry, nil
+	}
+}
@@ -0,0 +1,244 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// searchRaidOnParam returns raids matching every given column value.
+func (s *SQLite) searchRaidOnParam(ctx context.Context, params squirrel.Eq) ([]entity.Raid, error) {
+	return s.selectRaids(ctx, s.Builder.Select("id", "name", "date", "difficulty", "start_time").
+		From("raids").Where(params).Where(squirrel.Eq{"deleted_at": nil}).OrderBy("id"))
+}
+
+// selectRaids returns the raids selected by a query of their id, name, date, difficulty and start time.
+func (s *SQLite) selectRaids(ctx context.Context, selectSQL squirrel.SelectBuilder) ([]entity.Raid, error) {
+	query, args, err := selectSQL.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("database - SearchRaid - s.Builder: %w", err)
+	}
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database - SearchRaid - s.DB.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var raids []entity.Raid
+	for rows.Next() {
+		var raid entity.Raid
+		var startTime string
+		err := rows.Scan(&raid.ID, &raid.Name, &raid.Date, &raid.Difficulty, &startTime)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchRaid - rows.Scan: %w", err)
+		}
+		raid.StartTime, err = entity.ParseStartTime(startTime)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchRaid - entity.ParseStartTime: %w", err)
+		}
+		raid.StartTimeSet = true
+		raids = append(raids, raid)
+	}
+	return raids, rows.Err()
+}
+
+// SearchRaid searches a raid in the database.
+// Like the postgres backend, each criteria is looked up on its own
+// (name, date and difficulty, difficulty, date) and results are appended.
+func (s *SQLite) SearchRaid(
+	ctx context.Context, raidName string, date time.Time, difficulty string,
+) ([]entity.Raid, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Raid/SearchRaid")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("raidName", raidName),
+		attribute.String("date", date.String()),
+		attribute.String("difficulty", difficulty),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		var params []squirrel.Eq
+		if raidName != "" {
+			params = append(params, squirrel.Eq{"name": raidName})
+		}
+		if difficulty != "" && !date.IsZero() {
+			params = append(params, squirrel.Eq{"difficulty": difficulty, "date": timestamp(date)})
+		}
+		if difficulty != "" {
+			params = append(params, squirrel.Eq{"difficulty": difficulty})
+		}
+		if !date.IsZero() {
+			params = append(params, squirrel.Eq{"date": timestamp(date)})
+		}
+
+		var raids []entity.Raid
+		for _, param := range params {
+			r, err := s.searchRaidOnParam(ctx, param)
+			if err != nil {
+				return nil, err
+			}
+			raids = append(raids, r...)
+		}
+		return raids, nil
+	}
+}
+
+// SearchRaidOnRange returns the raids from one date to another, both included, ordered by date.
+// At most limit raids after the first offset ones are returned, all of them when limit is 0.
+func (s *SQLite) SearchRaidOnRange(
+	ctx context.Context, from, to time.Time, limit, offset int,
+) ([]entity.Raid, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Raid/SearchRaidOnRange")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("from", from.String()),
+		attribute.String("to", to.String()),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchRaidOnRange - ctx.Done: request took too much time to be proceed")
+	default:
+		selectSQL := s.Builder.Select("id", "name", "date", "difficulty", "start_time").
+			From("raids").
+			Where(squirrel.And{squirrel.GtOrEq{"date": timestamp(from)}, squirrel.LtOrEq{"date": timestamp(to)}}).
+			Where(squirrel.Eq{"deleted_at": nil}).
+			OrderBy("date", "id")
+		if limit > 0 {
+			selectSQL = selectSQL.Limit(uint64(limit)).Offset(uint64(offset))
+		}
+		return s.selectRaids(ctx, selectSQL)
+	}
+}
+
+// CreateRaid creates a raid in the database.
+func (s *SQLite) CreateRaid(ctx context.Context, raid entity.Raid) (entity.Raid, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Raid/CreateRaid")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("raidName", raid.Name),
+		attribute.String("difficulty", raid.Difficulty),
+		attribute.String("date", raid.Date.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Raid{}, fmt.Errorf("database - CreateRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("raids").
+			Columns("name", "date", "difficulty", "start_time").
+			Values(raid.Name, timestamp(raid.Date), raid.Difficulty, entity.FormatStartTime(raid.StartTime)).
+			Suffix("RETURNING \"id\"").
+			ToSql()
+		if err != nil {
+			return entity.Raid{}, fmt.Errorf("database - CreateRaid - s.Builder.Insert: %w", err)
+		}
+		err = s.DB.QueryRowContext(ctx, query, args...).Scan(&raid.ID)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return entity.Raid{}, fmt.Errorf("raid already exists")
+			}
+			return entity.Raid{}, fmt.Errorf("database - CreateRaid - row.Scan: %w", err)
+		}
+		return raid, nil
+	}
+}
+
+// ReadRaid returns a raid from the database.
+// Like the postgres backend, an empty raid is returned if it doesn't exist.
+func (s *SQLite) ReadRaid(ctx context.Context, raidID int) (entity.Raid, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Raid/ReadRaid")
+	defer span.End()
+	span.SetAttributes(attribute.Int("raidID", raidID))
+
+	select {
+	case <-ctx.Done():
+		return entity.Raid{}, fmt.Errorf("database - ReadRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		raids, err := s.searchRaidOnParam(ctx, squirrel.Eq{"id": raidID})
+		if err != nil {
+			return entity.Raid{}, fmt.Errorf("database - ReadRaid - s.searchRaidOnParam: %w", err)
+		}
+		if len(raids) == 0 {
+			return entity.Raid{}, nil
+		}
+		return raids[0], nil
+	}
+}
+
+// UpdateRaid updates a raid in the database. Empty fields and an unset start time are not updated.
+func (s *SQLite) UpdateRaid(ctx context.Context, raid entity.Raid) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Raid/UpdateRaid")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raid.ID),
+		attribute.String("raidName", raid.Name),
+		attribute.String("difficulty", raid.Difficulty),
+		attribute.String("date", raid.Date.Format("02/01/2006")),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdateRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		sqlQuery := s.Builder.Update("raids").Where(squirrel.Eq{"id": raid.ID})
+		if raid.Name != "" {
+			sqlQuery = sqlQuery.Set("name", raid.Name)
+		}
+		if !raid.Date.IsZero() {
+			sqlQuery = sqlQuery.Set("date", timestamp(raid.Date))
+		}
+		if raid.Difficulty != "" {
+			sqlQuery = sqlQuery.Set("difficulty", raid.Difficulty)
+		}
+		if raid.StartTimeSet {
+			sqlQuery = sqlQuery.Set("start_time", entity.FormatStartTime(raid.StartTime))
+		}
+		if raid.Name == "" && raid.Date.IsZero() && raid.Difficulty == "" && !raid.StartTimeSet {
+			return nil
+		}
+		query, args, err := sqlQuery.ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdateRaid - s.Builder.Update: %w", err)
+		}
+		_, err = s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return fmt.Errorf("database - UpdateRaid - raid already exists")
+			}
+			return fmt.Errorf("database - UpdateRaid - s.DB.ExecContext: %w", err)
+		}
+		return nil
+	}
+}
+
+// DeleteRaid marks a raid deleted, with its loots, absences and fails hidden, until it is restored or purged.
+func (s *SQLite) DeleteRaid(ctx context.Context, raidID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Raid/DeleteRaid")
+	defer span.End()
+	span.SetAttributes(attribute.Int("raidID", raidID))
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Update("raids").Set("deleted_at", timestamp(time.Now().UTC())).
+			Where(squirrel.Eq{"id": raidID, "deleted_at": nil}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteRaid - s.Builder.Update: %w", err)
+		}
+		return s.deleteRows(ctx, "DeleteRaid", "raid", query, args...)
+	}
+}
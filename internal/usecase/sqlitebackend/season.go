@@ -0,0 +1,149 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchSeason returns the season with the given name, or every season when name is empty.
+// Seasons are ordered by start date.
+func (s *SQLite) SearchSeason(ctx context.Context, name string) ([]entity.Season, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Season/SearchSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if name != "" {
+			params["name"] = name
+		}
+		query, args, err := s.Builder.Select("id", "name", "start_date", "end_date").
+			From("seasons").
+			Where(params).
+			OrderBy("start_date").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchSeason - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchSeason - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var seasons []entity.Season
+		for rows.Next() {
+			var season entity.Season
+			err := rows.Scan(&season.ID, &season.Name, &season.Start, &season.End)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchSeason - rows.Scan: %w", err)
+			}
+			seasons = append(seasons, season)
+		}
+		return seasons, rows.Err()
+	}
+}
+
+// CreateSeason adds a season to the calendar.
+func (s *SQLite) CreateSeason(ctx context.Context, season entity.Season) (entity.Season, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Season/CreateSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", season.Name),
+		attribute.String("start", season.Start.String()),
+		attribute.String("end", season.End.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Season{}, fmt.Errorf("database - CreateSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("seasons").
+			Columns("name", "start_date", "end_date").
+			Values(season.Name, timestamp(season.Start), timestamp(season.End)).
+			Suffix("RETURNING \"id\"").
+			ToSql()
+		if err != nil {
+			return entity.Season{}, fmt.Errorf("database - CreateSeason - s.Builder.Insert: %w", err)
+		}
+		err = s.DB.QueryRowContext(ctx, query, args...).Scan(&season.ID)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return entity.Season{}, fmt.Errorf("season already exists")
+			}
+			return entity.Season{}, fmt.Errorf("database - CreateSeason - row.Scan: %w", err)
+		}
+		return season, nil
+	}
+}
+
+// UpdateSeason updates name and dates of a season.
+func (s *SQLite) UpdateSeason(ctx context.Context, season entity.Season) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Season/UpdateSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", season.ID),
+		attribute.String("name", season.Name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdateSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Update("seasons").
+			Set("name", season.Name).
+			Set("start_date", timestamp(season.Start)).
+			Set("end_date", timestamp(season.End)).
+			Where(squirrel.Eq{"id": season.ID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdateSeason - s.Builder: %w", err)
+		}
+		result, err := s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return fmt.Errorf("season already exists")
+			}
+			return fmt.Errorf("database - UpdateSeason - s.DB.ExecContext: %w", err)
+		}
+		updated, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("database - UpdateSeason - result.RowsAffected: %w", err)
+		}
+		if updated == 0 {
+			return fmt.Errorf("database - UpdateSeason - season not found")
+		}
+		return nil
+	}
+}
+
+// DeleteSeason removes a season from the calendar.
+func (s *SQLite) DeleteSeason(ctx context.Context, seasonID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Season/DeleteSeason")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", seasonID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteSeason - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Delete("seasons").Where(squirrel.Eq{"id": seasonID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteSeason - s.Builder: %w", err)
+		}
+		return s.deleteRows(ctx, "DeleteSeason", "season", query, args...)
+	}
+}
@@ -0,0 +1,164 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchSignup returns the signups to a raid, of a player, or of a player on a raid,
+// ordered by raid date and player name. raidID and playerID are ignored when -1.
+func (s *SQLite) SearchSignup(ctx context.Context, raidID, playerID int) ([]entity.Signup, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Signup/SearchSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if raidID != -1 {
+			params["raid_signups.raid_id"] = raidID
+		}
+		if playerID != -1 {
+			params["raid_signups.player_id"] = playerID
+		}
+		// Signups of deleted players and raids are hidden with them
+		params["players.deleted_at"] = nil
+		params["raids.deleted_at"] = nil
+		query, args, err := s.Builder.Select("raid_signups.raid_id", "raids.name", "raids.difficulty", "raids.date",
+			"raid_signups.player_id", "players.name", "players.class", "players.main_spec",
+			"players.off_spec", "players.role", "raid_signups.status").
+			From("raid_signups").
+			Join("raids ON raids.id = raid_signups.raid_id").
+			Join("players ON players.id = raid_signups.player_id").
+			Where(params).
+			OrderBy("raids.date", "players.name").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchSignup - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchSignup - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var signups []entity.Signup
+		for rows.Next() {
+			var raid entity.Raid
+			var player entity.Player
+			var role, status string
+			err := rows.Scan(&raid.ID, &raid.Name, &raid.Difficulty, &raid.Date, &player.ID, &player.Name,
+				&player.Class, &player.MainSpec, &player.OffSpec, &role, &status)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchSignup - rows.Scan: %w", err)
+			}
+			player.Role = entity.Role(role)
+			signups = append(signups, entity.Signup{
+				Player: &player,
+				Raid:   &raid,
+				Status: entity.SignupStatus(status),
+			})
+		}
+		return signups, rows.Err()
+	}
+}
+
+// CreateSignup signs a player up to a raid.
+func (s *SQLite) CreateSignup(ctx context.Context, signup entity.Signup) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Signup/CreateSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", signup.Raid.ID),
+		attribute.Int("playerID", signup.Player.ID),
+		attribute.String("status", string(signup.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - CreateSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("raid_signups").
+			Columns("raid_id", "player_id", "status").
+			Values(signup.Raid.ID, signup.Player.ID, string(signup.Status)).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - CreateSignup - s.Builder: %w", err)
+		}
+		_, err = s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return fmt.Errorf("signup already exists")
+			}
+			return fmt.Errorf("database - CreateSignup - s.DB.ExecContext: %w", err)
+		}
+		return nil
+	}
+}
+
+// UpdateSignup updates the status of a player signed up to a raid.
+func (s *SQLite) UpdateSignup(ctx context.Context, signup entity.Signup) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Signup/UpdateSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", signup.Raid.ID),
+		attribute.Int("playerID", signup.Player.ID),
+		attribute.String("status", string(signup.Status)),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdateSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Update("raid_signups").
+			Set("status", string(signup.Status)).
+			Where(squirrel.Eq{"raid_id": signup.Raid.ID, "player_id": signup.Player.ID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdateSignup - s.Builder: %w", err)
+		}
+		result, err := s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("database - UpdateSignup - s.DB.ExecContext: %w", err)
+		}
+		updated, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("database - UpdateSignup - result.RowsAffected: %w", err)
+		}
+		if updated == 0 {
+			return fmt.Errorf("database - UpdateSignup - signup not found")
+		}
+		return nil
+	}
+}
+
+// DeleteSignup removes the signup of a player to a raid.
+func (s *SQLite) DeleteSignup(ctx context.Context, raidID, playerID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Signup/DeleteSignup")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("raidID", raidID),
+		attribute.Int("playerID", playerID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteSignup - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Delete("raid_signups").
+			Where(squirrel.Eq{"raid_id": raidID, "player_id": playerID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteSignup - s.Builder: %w", err)
+		}
+		return s.deleteRows(ctx, "DeleteSignup", "signup", query, args...)
+	}
+}
@@ -0,0 +1,64 @@
+package sqlitebackend_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/backendtest"
+	"github.com/antony-ramos/guildops/internal/usecase/sqlitebackend"
+	"github.com/antony-ramos/guildops/pkg/sqlite"
+)
+
+func newSQLite(t *testing.T) (*sqlitebackend.SQLite, string) {
+	t.Helper()
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "guildops.db")
+	handler, err := sqlite.New(ctx, path)
+	require.NoError(t, err)
+	t.Cleanup(handler.Close)
+
+	backend := &sqlitebackend.SQLite{SQLite: handler}
+	require.NoError(t, backend.Init(ctx, path, nil))
+	return backend, path
+}
+
+func TestSQLite_Conformance(t *testing.T) {
+	t.Parallel()
+
+	backendtest.Run(t, func(t *testing.T) usecase.Backend {
+		t.Helper()
+		backend, _ := newSQLite(t)
+		return backend
+	})
+}
+
+func TestSQLite_Migrate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	backend, path := newSQLite(t)
+
+	latest, err := sqlitebackend.LatestMigration()
+	require.NoError(t, err)
+
+	version, dirty, err := backend.MigrationStatus(ctx, path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, latest, version)
+	assert.False(t, dirty)
+
+	require.NoError(t, backend.MigrateDown(ctx, path, nil, int(latest)))
+	version, _, err = backend.MigrationStatus(ctx, path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint(0), version)
+
+	require.NoError(t, backend.MigrateUp(ctx, path, nil))
+	version, _, err = backend.MigrationStatus(ctx, path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, latest, version)
+}
@@ -0,0 +1,179 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// searchStrikeOnParam returns strikes matching every given column value.
+func (s *SQLite) searchStrikeOnParam(ctx context.Context, params squirrel.Eq) ([]entity.Strike, error) {
+	query, args, err := s.Builder.
+		Select("id", "season", "reason", "created_at").
+		From("strikes").Where(params).
+		Where("player_id IN (SELECT id FROM players WHERE deleted_at IS NULL)").OrderBy("id").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("database - SearchStrike - s.Builder: %w", err)
+	}
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database - SearchStrike - s.DB.QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var strikes []entity.Strike
+	for rows.Next() {
+		var strike entity.Strike
+		err := rows.Scan(&strike.ID, &strike.Season, &strike.Reason, &strike.Date)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchStrike - rows.Scan: %w", err)
+		}
+		strikes = append(strikes, strike)
+	}
+	return strikes, rows.Err()
+}
+
+// SearchStrike is a function which call backend to Search a Strike Object
+// It returns a list of strikes matching the given parameters not combined.
+func (s *SQLite) SearchStrike(
+	ctx context.Context, playerID int, date time.Time, season, reason string,
+) ([]entity.Strike, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Strike/SearchStrike")
+	span.SetAttributes(
+		attribute.Int("playerID", playerID),
+		attribute.String("season", season),
+		attribute.String("reason", reason),
+		attribute.String("date", date.String()))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchStrike - ctx.Done: request took too much time to be proceed")
+	default:
+		var params []squirrel.Eq
+		if playerID != -1 {
+			params = append(params, squirrel.Eq{"player_id": playerID})
+		}
+		if len(season) != 0 {
+			params = append(params, squirrel.Eq{"season": season})
+		}
+		if len(reason) != 0 {
+			params = append(params, squirrel.Eq{"reason": reason})
+		}
+		if !date.IsZero() {
+			params = append(params, squirrel.Eq{"created_at": timestamp(date)})
+		}
+
+		var strikes []entity.Strike
+		for _, param := range params {
+			st, err := s.searchStrikeOnParam(ctx, param)
+			if err != nil {
+				return nil, err
+			}
+			strikes = append(strikes, st...)
+		}
+		return strikes, nil
+	}
+}
+
+// CreateStrike is a function which call backend to Create a Strike Object.
+func (s *SQLite) CreateStrike(ctx context.Context, strike entity.Strike, playerID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Strike/CreateStrike")
+	span.SetAttributes(
+		attribute.String("season", strike.Season),
+		attribute.String("reason", strike.Reason),
+		attribute.Int("playerID", playerID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - CreateStrike - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("strikes").
+			Columns("player_id", "season", "reason", "created_at").
+			Values(playerID, strike.Season, strike.Reason, timestamp(time.Now().UTC())).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - CreateStrike - s.Builder: %w", err)
+		}
+		_, err = s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("database - CreateStrike - s.DB.ExecContext: %w", err)
+		}
+		return nil
+	}
+}
+
+// ReadStrike returns a strike from the database.
+// Like the postgres backend, an empty strike is returned if it doesn't exist.
+func (s *SQLite) ReadStrike(ctx context.Context, strikeID int) (entity.Strike, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Strike/ReadStrike")
+	span.SetAttributes(attribute.Int("strikeID", strikeID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Strike{}, fmt.Errorf("database - ReadStrike - ctx.Done: request took too much time to be proceed")
+	default:
+		strikes, err := s.searchStrikeOnParam(ctx, squirrel.Eq{"id": strikeID})
+		if err != nil {
+			return entity.Strike{}, fmt.Errorf("database - ReadStrike - s.searchStrikeOnParam: %w", err)
+		}
+		if len(strikes) == 0 {
+			return entity.Strike{}, nil
+		}
+		return strikes[0], nil
+	}
+}
+
+// UpdateStrike updates season and reason of a strike.
+func (s *SQLite) UpdateStrike(ctx context.Context, strike entity.Strike) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Strike/UpdateStrike")
+	span.SetAttributes(
+		attribute.Int("strikeID", strike.ID),
+		attribute.String("season", strike.Season),
+		attribute.String("reason", strike.Reason))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - UpdateStrike - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Update("strikes").
+			Set("season", strike.Season).
+			Set("reason", strike.Reason).
+			Where(squirrel.Eq{"id": strike.ID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - UpdateStrike - s.Builder: %w", err)
+		}
+		_, err = s.DB.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("database - UpdateStrike - s.DB.ExecContext: %w", err)
+		}
+		return nil
+	}
+}
+
+// DeleteStrike deletes a strike from the database.
+func (s *SQLite) DeleteStrike(ctx context.Context, strikeID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Strike/DeleteStrike")
+	span.SetAttributes(attribute.Int("strikeID", strikeID))
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database DeleteStrike: ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Delete("strikes").Where(squirrel.Eq{"id": strikeID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database DeleteStrike: s.Builder: %w", err)
+		}
+		return s.deleteRows(ctx, "DeleteStrike", "strike", query, args...)
+	}
+}
@@ -0,0 +1,119 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchRaidTemplate returns the raid templates with this name, all of them when name is empty.
+func (s *SQLite) SearchRaidTemplate(ctx context.Context, name string) ([]entity.RaidTemplate, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "RaidTemplate/SearchRaidTemplate")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchRaidTemplate - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if name != "" {
+			params["name"] = name
+		}
+		query, args, err := s.Builder.
+			Select("id", "name", "difficulty", "weekdays", "start_time", "timezone").
+			From("raid_templates").
+			Where(params).
+			OrderBy("id").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchRaidTemplate - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchRaidTemplate - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var templates []entity.RaidTemplate
+		for rows.Next() {
+			var template entity.RaidTemplate
+			var weekdays, startTime string
+			err := rows.Scan(&template.ID, &template.Name, &template.Difficulty, &weekdays, &startTime,
+				&template.Timezone)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchRaidTemplate - rows.Scan: %w", err)
+			}
+			template.Weekdays, err = entity.ParseWeekdays(weekdays)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchRaidTemplate - entity.ParseWeekdays: %w", err)
+			}
+			template.StartTime, err = entity.ParseStartTime(startTime)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchRaidTemplate - entity.ParseStartTime: %w", err)
+			}
+			templates = append(templates, template)
+		}
+		return templates, rows.Err()
+	}
+}
+
+// CreateRaidTemplate adds a raid template.
+func (s *SQLite) CreateRaidTemplate(ctx context.Context, template entity.RaidTemplate) (entity.RaidTemplate, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "RaidTemplate/CreateRaidTemplate")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", template.Name),
+		attribute.String("difficulty", template.Difficulty),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.RaidTemplate{},
+			fmt.Errorf("database - CreateRaidTemplate - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("raid_templates").
+			Columns("name", "difficulty", "weekdays", "start_time", "timezone").
+			Values(template.Name, template.Difficulty, entity.FormatWeekdays(template.Weekdays),
+				entity.FormatStartTime(template.StartTime), template.Timezone).
+			Suffix("RETURNING \"id\"").ToSql()
+		if err != nil {
+			return entity.RaidTemplate{}, fmt.Errorf("database - CreateRaidTemplate - s.Builder.Insert: %w", err)
+		}
+		err = s.DB.QueryRowContext(ctx, query, args...).Scan(&template.ID)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return entity.RaidTemplate{}, fmt.Errorf("raid template already exists")
+			}
+			return entity.RaidTemplate{}, fmt.Errorf("database - CreateRaidTemplate - row.Scan: %w", err)
+		}
+		return template, nil
+	}
+}
+
+// DeleteRaidTemplate removes a raid template, raids it created are kept.
+func (s *SQLite) DeleteRaidTemplate(ctx context.Context, templateID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "RaidTemplate/DeleteRaidTemplate")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", templateID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteRaidTemplate - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Delete("raid_templates").Where(squirrel.Eq{"id": templateID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteRaidTemplate - s.Builder: %w", err)
+		}
+		return s.deleteRows(ctx, "DeleteRaidTemplate", "raid template", query, args...)
+	}
+}
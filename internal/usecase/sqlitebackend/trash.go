@@ -0,0 +1,180 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// deletedTables are the tables of the objects which can be deleted, by kind.
+// Loots are purged first, then raids and players which would purge their loots.
+var deletedTables = []struct {
+	kind  entity.DeletedKind
+	table string
+}{
+	{entity.DeletedLoot, "loots"},
+	{entity.DeletedRaid, "raids"},
+	{entity.DeletedPlayer, "players"},
+}
+
+// discordLinkTaken tells if another player was linked to the discord user of a deleted player since.
+const discordLinkTaken = "EXISTS (SELECT 1 FROM players AS linked " +
+	"WHERE linked.discord_id = players.discord_id AND linked.deleted_at IS NULL)"
+
+// deletedTable returns the table of the objects of kind.
+func deletedTable(kind entity.DeletedKind) (string, error) {
+	for _, deleted := range deletedTables {
+		if deleted.kind == kind {
+			return deleted.table, nil
+		}
+	}
+	return "", fmt.Errorf("unknown kind of deleted object %q", kind)
+}
+
+// SearchDeleted returns the players, raids and loots deleted, the last one deleted first.
+// At most limit objects are returned, all of them when limit is 0.
+func (s *SQLite) SearchDeleted(ctx context.Context, limit int) ([]entity.Deleted, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Trash/SearchDeleted")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("limit", limit),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchDeleted - ctx.Done: request took too much time to be proceed")
+	default:
+		var union string
+		for i, deleted := range deletedTables {
+			if i > 0 {
+				union += " UNION ALL "
+			}
+			union += "SELECT '" + string(deleted.kind) + "' AS kind, id, name, deleted_at FROM " + deleted.table +
+				" WHERE deleted_at IS NOT NULL"
+		}
+		selectSQL := s.Builder.Select("kind", "id", "name", "deleted_at").
+			From("("+union+") AS deleted").OrderBy("deleted_at DESC", "id DESC")
+		if limit > 0 {
+			selectSQL = selectSQL.Limit(uint64(limit))
+		}
+		query, args, err := selectSQL.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchDeleted - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchDeleted - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var deleted []entity.Deleted
+		for rows.Next() {
+			var object entity.Deleted
+			var kind string
+			err := rows.Scan(&kind, &object.ID, &object.Name, &object.DeletedAt)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchDeleted - rows.Scan: %w", err)
+			}
+			object.Kind = entity.DeletedKind(kind)
+			deleted = append(deleted, object)
+		}
+		return deleted, rows.Err()
+	}
+}
+
+// SearchDeletedRaid returns the deleted raids on a date with a difficulty.
+func (s *SQLite) SearchDeletedRaid(ctx context.Context, date time.Time, difficulty string) ([]entity.Raid, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Trash/SearchDeletedRaid")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("date", date.String()),
+		attribute.String("difficulty", difficulty),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchDeletedRaid - ctx.Done: request took too much time to be proceed")
+	default:
+		return s.selectRaids(ctx, s.Builder.Select("id", "name", "date", "difficulty", "start_time").
+			From("raids").Where(squirrel.Eq{"date": timestamp(date), "difficulty": difficulty}).
+			Where(squirrel.NotEq{"deleted_at": nil}).OrderBy("id"))
+	}
+}
+
+// RestoreDeleted brings back a deleted player, raid or loot, with what belongs to it.
+// It fails if another one with the same name, or date and difficulty for a raid, was created since.
+// A restored player is unlinked from its discord user when another player was linked to it since.
+func (s *SQLite) RestoreDeleted(ctx context.Context, deleted entity.Deleted) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Trash/RestoreDeleted")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("kind", string(deleted.Kind)),
+		attribute.Int("id", deleted.ID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - RestoreDeleted - ctx.Done: request took too much time to be proceed")
+	default:
+		table, err := deletedTable(deleted.Kind)
+		if err != nil {
+			return fmt.Errorf("database - RestoreDeleted - deletedTable: %w", err)
+		}
+		update := s.Builder.Update(table).Set("deleted_at", nil)
+		if deleted.Kind == entity.DeletedPlayer {
+			update = update.
+				Set("discord_id", squirrel.Expr("CASE WHEN "+discordLinkTaken+" THEN NULL ELSE discord_id END")).
+				Set("discord_name", squirrel.Expr("CASE WHEN "+discordLinkTaken+" THEN '' ELSE discord_name END"))
+		}
+		query, args, err := update.
+			Where(squirrel.Eq{"id": deleted.ID}).Where(squirrel.NotEq{"deleted_at": nil}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - RestoreDeleted - s.Builder.Update: %w", err)
+		}
+		err = s.deleteRows(ctx, "RestoreDeleted", "deleted "+string(deleted.Kind), query, args...)
+		if isConstraintViolation(err) {
+			return fmt.Errorf("database - RestoreDeleted - %s already exists", deleted.Kind)
+		}
+		return err
+	}
+}
+
+// PurgeDeleted removes for good the players, raids and loots deleted before a time,
+// with everything which belongs to them. It returns the number of objects purged.
+func (s *SQLite) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Trash/PurgeDeleted")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("before", before.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return 0, fmt.Errorf("database - PurgeDeleted - ctx.Done: request took too much time to be proceed")
+	default:
+		var purged int
+		for _, deleted := range deletedTables {
+			query, args, err := s.Builder.Delete(deleted.table).
+				Where(squirrel.Lt{"deleted_at": timestamp(before)}).ToSql()
+			if err != nil {
+				return purged, fmt.Errorf("database - PurgeDeleted - s.Builder.Delete: %w", err)
+			}
+			result, err := s.DB.ExecContext(ctx, query, args...)
+			if err != nil {
+				return purged, fmt.Errorf("database - PurgeDeleted - purge %s: %w", deleted.table, err)
+			}
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return purged, fmt.Errorf("database - PurgeDeleted - result.RowsAffected: %w", err)
+			}
+			purged += int(rows)
+		}
+		return purged, nil
+	}
+}
@@ -0,0 +1,119 @@
+package sqlitebackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// SearchWish returns the wishes of a player or on an item, highest priority first.
+// playerID is ignored when -1 and item when empty.
+func (s *SQLite) SearchWish(ctx context.Context, playerID int, item string) ([]entity.Wish, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Wish/SearchWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", playerID),
+		attribute.String("item", item),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("database - SearchWish - ctx.Done: request took too much time to be proceed")
+	default:
+		params := squirrel.Eq{}
+		if playerID != -1 {
+			params["wishes.player_id"] = playerID
+		}
+		if item != "" {
+			params["wishes.item"] = item
+		}
+		// Wishes of deleted players are hidden with them
+		params["players.deleted_at"] = nil
+		query, args, err := s.Builder.
+			Select("wishes.id", "wishes.player_id", "players.name", "wishes.item", "wishes.priority",
+				"wishes.spec", "wishes.note").
+			From("wishes").
+			Join("players ON players.id = wishes.player_id").
+			Where(params).
+			OrderBy("wishes.priority", "wishes.id").ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchWish - s.Builder: %w", err)
+		}
+		rows, err := s.DB.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("database - SearchWish - s.DB.QueryContext: %w", err)
+		}
+		defer rows.Close()
+
+		var wishes []entity.Wish
+		for rows.Next() {
+			var wish entity.Wish
+			var player entity.Player
+			err := rows.Scan(&wish.ID, &player.ID, &player.Name, &wish.Item, &wish.Priority, &wish.Spec, &wish.Note)
+			if err != nil {
+				return nil, fmt.Errorf("database - SearchWish - rows.Scan: %w", err)
+			}
+			wish.Player = &player
+			wishes = append(wishes, wish)
+		}
+		return wishes, rows.Err()
+	}
+}
+
+// CreateWish adds a wish to the wishlist of its player.
+func (s *SQLite) CreateWish(ctx context.Context, wish entity.Wish) (entity.Wish, error) {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Wish/CreateWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("playerID", wish.Player.ID),
+		attribute.String("item", wish.Item),
+		attribute.Int("priority", wish.Priority),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Wish{}, fmt.Errorf("database - CreateWish - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.
+			Insert("wishes").
+			Columns("player_id", "item", "priority", "spec", "note").
+			Values(wish.Player.ID, wish.Item, wish.Priority, wish.Spec, wish.Note).
+			Suffix("RETURNING \"id\"").ToSql()
+		if err != nil {
+			return entity.Wish{}, fmt.Errorf("database - CreateWish - s.Builder.Insert: %w", err)
+		}
+		err = s.DB.QueryRowContext(ctx, query, args...).Scan(&wish.ID)
+		if err != nil {
+			if isConstraintViolation(err) {
+				return entity.Wish{}, fmt.Errorf("wish already exists")
+			}
+			return entity.Wish{}, fmt.Errorf("database - CreateWish - row.Scan: %w", err)
+		}
+		return wish, nil
+	}
+}
+
+// DeleteWish removes a wish from the wishlist of its player.
+func (s *SQLite) DeleteWish(ctx context.Context, wishID int) error {
+	ctx, span := otel.Tracer("Backend").Start(ctx, "Wish/DeleteWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("id", wishID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("database - DeleteWish - ctx.Done: request took too much time to be proceed")
+	default:
+		query, args, err := s.Builder.Delete("wishes").Where(squirrel.Eq{"id": wishID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("database - DeleteWish - s.Builder: %w", err)
+		}
+		return s.deleteRows(ctx, "DeleteWish", "wish", query, args...)
+	}
+}
@@ -9,17 +9,22 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
 
 	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/pkg/logger"
 )
 
 type StrikeUseCase struct {
-	backend Backend
+	backend  Backend
+	policy   entity.StrikePolicy
+	notifier Notifier
 }
 
 // NewStrikeUseCase is a StrikeUseCase Object generator.
-func NewStrikeUseCase(bk Backend) *StrikeUseCase {
-	return &StrikeUseCase{backend: bk}
+// Officers are alerted through notifier when a player reaches the alert threshold of policy.
+func NewStrikeUseCase(bk Backend, policy entity.StrikePolicy, notifier Notifier) *StrikeUseCase {
+	return &StrikeUseCase{backend: bk, policy: policy, notifier: notifier}
 }
 
 // CreateStrike is a function which call backend to Create a Strike Object.
@@ -48,12 +53,78 @@ func (puc StrikeUseCase) CreateStrike(ctx context.Context, strikeReason, playerN
 		if len(player) == 0 {
 			return errors.New("player not found")
 		}
+		strike.Season, err = seasonOn(ctx, puc.backend, strike.Date)
+		if err != nil {
+			return fmt.Errorf("get season of strike: %w", err)
+		}
 		err = puc.backend.CreateStrike(ctx, strike, player[0].ID)
 		if err != nil {
 			return fmt.Errorf("database - CreateStrike - r.CreateStrike: %w", err)
 		}
+
+		err = puc.alertOfficers(ctx, player[0])
+		if err != nil {
+			logger.FromContext(ctx).Warn("alert officers about strikes", zap.Error(err))
+		}
+		return nil
+	}
+}
+
+// alertOfficers notifies officers when the player reaches the alert threshold of active strikes.
+// Officers are alerted once, not again for each strike past the threshold.
+func (puc StrikeUseCase) alertOfficers(ctx context.Context, player entity.Player) error {
+	if puc.policy.AlertThreshold <= 0 || puc.notifier == nil {
+		return nil
+	}
+
+	// Strikes of every character of the person count
+	player, err := withCharacters(ctx, puc.backend, player)
+	if err != nil {
+		return err
+	}
+	strikes, err := personStrikes(ctx, puc.backend, player)
+	if err != nil {
+		return fmt.Errorf("search strikes: %w", err)
+	}
+	strikes, err = setExpiry(ctx, puc.backend, puc.policy, strikes)
+	if err != nil {
+		return fmt.Errorf("set expiry of strikes: %w", err)
+	}
+	active := 0
+	now := time.Now()
+	for _, strike := range strikes {
+		if !strike.Expired(now) {
+			active++
+		}
+	}
+	if active != puc.policy.AlertThreshold {
 		return nil
 	}
+
+	msg := fmt.Sprintf("%s has %d active strikes, the limit is %d", player.Name, active, puc.policy.AlertThreshold)
+	err = puc.notifier.NotifyOfficers(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("notify officers: %w", err)
+	}
+	return nil
+}
+
+// setExpiry returns strikes with their expiry date set following policy.
+func setExpiry(
+	ctx context.Context, backend Backend, policy entity.StrikePolicy, strikes []entity.Strike,
+) ([]entity.Strike, error) {
+	var seasons []entity.Season
+	if policy.ExpireOnSeasonEnd {
+		var err error
+		seasons, err = backend.SearchSeason(ctx, "")
+		if err != nil {
+			return nil, fmt.Errorf("search seasons: %w", err)
+		}
+	}
+	for i := range strikes {
+		strikes[i].ExpiresAt = policy.ExpiresAt(strikes[i], seasons)
+	}
+	return strikes, nil
 }
 
 // DeleteStrike is a function which call backend to Delete a Strike Object.
@@ -75,12 +146,36 @@ func (puc StrikeUseCase) DeleteStrike(ctx context.Context, strikeID int) error {
 	}
 }
 
-// ReadStrikes is a function which call backend to Read all strikes on a player.
-func (puc StrikeUseCase) ReadStrikes(ctx context.Context, playerName string) ([]entity.Strike, error) {
+// ReadStrike returns the strike with this ID.
+func (puc StrikeUseCase) ReadStrike(ctx context.Context, strikeID int) (entity.Strike, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Strike/ReadStrike")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("strikeID", strikeID),
+	)
+	select {
+	case <-ctx.Done():
+		return entity.Strike{}, fmt.Errorf("StrikeUseCase - ReadStrike - ctx.Done: request took too much time to be proceed")
+	default:
+		strike, err := puc.backend.ReadStrike(ctx, strikeID)
+		if err != nil {
+			return entity.Strike{}, fmt.Errorf("database ReadStrike: r.ReadStrike: %w", err)
+		}
+		if strike.ID == 0 {
+			return entity.Strike{}, errors.New("strike not found")
+		}
+		return strike, nil
+	}
+}
+
+// ReadStrikes is a function which call backend to Read all strikes on a player and on the other characters
+// of its person, with their expiry date. If season is not empty, only strikes given during this season are returned.
+func (puc StrikeUseCase) ReadStrikes(ctx context.Context, playerName, season string) ([]entity.Strike, error) {
 	ctx, span := otel.Tracer("Usecase").Start(ctx, "Strike/ReadStrikes")
 	defer span.End()
 	span.SetAttributes(
 		attribute.String("playerName", playerName),
+		attribute.String("season", season),
 	)
 
 	select {
@@ -95,13 +190,34 @@ func (puc StrikeUseCase) ReadStrikes(ctx context.Context, playerName string) ([]
 		if len(player) == 0 {
 			return nil, errors.New("player not found")
 		}
-		strikes, err := puc.backend.SearchStrike(ctx, player[0].ID, time.Time{}, "", "")
+		person, err := withCharacters(ctx, puc.backend, player[0])
+		if err != nil {
+			return nil, err
+		}
+		strikes, err := personStrikes(ctx, puc.backend, person)
 		if err != nil {
 			return nil, fmt.Errorf("database - ReadStrikes - r.SearchStrike: %w", err)
 		}
+		if season != "" {
+			s, err := readSeason(ctx, puc.backend, season)
+			if err != nil {
+				return nil, fmt.Errorf("read season: %w", err)
+			}
+			var onSeason []entity.Strike
+			for _, strike := range strikes {
+				if s.Contains(strike.Date) {
+					onSeason = append(onSeason, strike)
+				}
+			}
+			strikes = onSeason
+		}
 		if len(strikes) == 0 {
 			return nil, errors.New("no strikes found")
 		}
+		strikes, err = setExpiry(ctx, puc.backend, puc.policy, strikes)
+		if err != nil {
+			return nil, fmt.Errorf("set expiry of strikes: %w", err)
+		}
 
 		return strikes, nil
 	}
@@ -22,7 +22,7 @@ func TestStrikeUseCase_CreateStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		player := entity.Player{
 			ID:   1,
@@ -31,7 +31,13 @@ func TestStrikeUseCase_CreateStrike(t *testing.T) {
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Player{player}, nil)
 
-		mockBackend.On("CreateStrike", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockBackend.On("SearchSeason", mock.Anything, "").Return([]entity.Season{{
+			Name:  "TWW/S1",
+			Start: time.Now().AddDate(0, -1, 0),
+			End:   time.Now().AddDate(0, 1, 0),
+		}}, nil)
+		mockBackend.On("CreateStrike", mock.Anything,
+			mock.MatchedBy(func(strike entity.Strike) bool { return strike.Season == "TWW/S1" }), 1).Return(nil)
 
 		err := strikeUseCase.CreateStrike(context.Background(), "valid reason", "playername")
 
@@ -44,7 +50,7 @@ func TestStrikeUseCase_CreateStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		err := strikeUseCase.CreateStrike(context.Background(),
 			"ZBNSZVmKQwgZCBU9KjsbEOEewrPl5U1XkH10K4uXYVTuZiZiWzcydA1ISnH7iapcneGp"+
@@ -61,7 +67,7 @@ func TestStrikeUseCase_CreateStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, errors.New("bug SearchPlayer"))
@@ -77,7 +83,7 @@ func TestStrikeUseCase_CreateStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
 
@@ -92,7 +98,7 @@ func TestStrikeUseCase_CreateStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		player := entity.Player{
 			ID:   1,
@@ -101,6 +107,7 @@ func TestStrikeUseCase_CreateStrike(t *testing.T) {
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]entity.Player{player}, nil)
 
+		mockBackend.On("SearchSeason", mock.Anything, "").Return(nil, nil)
 		mockBackend.On("CreateStrike", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("bug Create Strike"))
 
 		err := strikeUseCase.CreateStrike(context.Background(), "valid reason", "playername")
@@ -114,7 +121,7 @@ func TestStrikeUseCase_CreateStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -125,6 +132,119 @@ func TestStrikeUseCase_CreateStrike(t *testing.T) {
 	})
 }
 
+type notifier struct {
+	messages []string
+}
+
+func (n *notifier) NotifyOfficers(_ context.Context, msg string) error {
+	n.messages = append(n.messages, msg)
+	return nil
+}
+
+func TestStrikeUseCase_CreateStrike_Alert(t *testing.T) {
+	t.Parallel()
+
+	player := entity.Player{ID: 1, Name: "playername"}
+	policy := entity.StrikePolicy{ExpiryWeeks: 4, AlertThreshold: 2}
+
+	t.Run("Threshold reached", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		officers := &notifier{}
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, policy, officers)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "playername", "").Return([]entity.Player{player}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{player}, nil)
+		mockBackend.On("SearchSeason", mock.Anything, "").Return(nil, nil)
+		mockBackend.On("CreateStrike", mock.Anything, mock.Anything, 1).Return(nil)
+		mockBackend.On("SearchStrike", mock.Anything, 1, time.Time{}, "", "").Return([]entity.Strike{
+			{ID: 1, Date: time.Now().AddDate(0, 0, -60)},
+			{ID: 2, Date: time.Now().AddDate(0, 0, -7)},
+			{ID: 3, Date: time.Now()},
+		}, nil)
+
+		err := strikeUseCase.CreateStrike(context.Background(), "valid reason", "playername")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"playername has 2 active strikes, the limit is 2"}, officers.messages)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Past the threshold", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		officers := &notifier{}
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, policy, officers)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "playername", "").Return([]entity.Player{player}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{player}, nil)
+		mockBackend.On("SearchSeason", mock.Anything, "").Return(nil, nil)
+		mockBackend.On("CreateStrike", mock.Anything, mock.Anything, 1).Return(nil)
+		mockBackend.On("SearchStrike", mock.Anything, 1, time.Time{}, "", "").Return([]entity.Strike{
+			{ID: 1, Date: time.Now().AddDate(0, 0, -14)},
+			{ID: 2, Date: time.Now().AddDate(0, 0, -7)},
+			{ID: 3, Date: time.Now()},
+		}, nil)
+
+		err := strikeUseCase.CreateStrike(context.Background(), "valid reason", "playername")
+
+		assert.NoError(t, err)
+		assert.Empty(t, officers.messages)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Expired strikes do not count", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		officers := &notifier{}
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, policy, officers)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "playername", "").Return([]entity.Player{player}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{player}, nil)
+		mockBackend.On("SearchSeason", mock.Anything, "").Return(nil, nil)
+		mockBackend.On("CreateStrike", mock.Anything, mock.Anything, 1).Return(nil)
+		mockBackend.On("SearchStrike", mock.Anything, 1, time.Time{}, "", "").Return([]entity.Strike{
+			{ID: 1, Date: time.Now().AddDate(0, 0, -60)},
+			{ID: 2, Date: time.Now()},
+		}, nil)
+
+		err := strikeUseCase.CreateStrike(context.Background(), "valid reason", "playername")
+
+		assert.NoError(t, err)
+		assert.Empty(t, officers.messages)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Strikes of alts count", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		officers := &notifier{}
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, policy, officers)
+
+		alt := entity.Player{ID: 2, Name: "altname", MainID: 1}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "playername", "").Return([]entity.Player{player}, nil)
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "", "").Return([]entity.Player{player, alt}, nil)
+		mockBackend.On("SearchSeason", mock.Anything, "").Return(nil, nil)
+		mockBackend.On("CreateStrike", mock.Anything, mock.Anything, 1).Return(nil)
+		mockBackend.On("SearchStrike", mock.Anything, 1, time.Time{}, "", "").Return([]entity.Strike{
+			{ID: 2, Date: time.Now()},
+		}, nil)
+		mockBackend.On("SearchStrike", mock.Anything, 2, time.Time{}, "", "").Return([]entity.Strike{
+			{ID: 1, Date: time.Now().AddDate(0, 0, -7)},
+		}, nil)
+
+		err := strikeUseCase.CreateStrike(context.Background(), "valid reason", "playername")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"playername has 2 active strikes, the limit is 2"}, officers.messages)
+		mockBackend.AssertExpectations(t)
+	})
+}
+
 func TestStrikeUseCase_ReadStrike(t *testing.T) {
 	t.Parallel()
 
@@ -133,7 +253,7 @@ func TestStrikeUseCase_ReadStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		player := entity.Player{
 			ID:   1,
@@ -161,7 +281,7 @@ func TestStrikeUseCase_ReadStrike(t *testing.T) {
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(strikes, nil)
 
-		strikes, err := strikeUseCase.ReadStrikes(context.Background(), "playername")
+		strikes, err := strikeUseCase.ReadStrikes(context.Background(), "playername", "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, strikes, strikes)
@@ -173,11 +293,11 @@ func TestStrikeUseCase_ReadStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
-		_, err := strikeUseCase.ReadStrikes(ctx, "playername")
+		_, err := strikeUseCase.ReadStrikes(ctx, "playername", "")
 
 		assert.Error(t, err)
 	})
@@ -187,12 +307,12 @@ func TestStrikeUseCase_ReadStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, errors.New("error on search player"))
 
-		_, err := strikeUseCase.ReadStrikes(context.Background(), "playername")
+		_, err := strikeUseCase.ReadStrikes(context.Background(), "playername", "")
 
 		assert.Error(t, err)
 		mockBackend.AssertExpectations(t)
@@ -202,7 +322,7 @@ func TestStrikeUseCase_ReadStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		player := entity.Player{
 			ID:   1,
@@ -215,7 +335,7 @@ func TestStrikeUseCase_ReadStrike(t *testing.T) {
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, errors.New("error on search strike"))
 
-		_, err := strikeUseCase.ReadStrikes(context.Background(), "playername")
+		_, err := strikeUseCase.ReadStrikes(context.Background(), "playername", "")
 
 		assert.Error(t, err)
 		mockBackend.AssertExpectations(t)
@@ -226,12 +346,12 @@ func TestStrikeUseCase_ReadStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, nil)
 
-		_, err := strikeUseCase.ReadStrikes(context.Background(), "playername")
+		_, err := strikeUseCase.ReadStrikes(context.Background(), "playername", "")
 
 		assert.Equal(t, "player not found", err.Error())
 		mockBackend.AssertExpectations(t)
@@ -242,7 +362,7 @@ func TestStrikeUseCase_ReadStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		player := entity.Player{
 			ID:   1,
@@ -255,11 +375,97 @@ func TestStrikeUseCase_ReadStrike(t *testing.T) {
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, nil)
 
-		_, err := strikeUseCase.ReadStrikes(context.Background(), "playername")
+		_, err := strikeUseCase.ReadStrikes(context.Background(), "playername", "")
 
 		assert.Equal(t, "no strikes found", err.Error())
 		mockBackend.AssertExpectations(t)
 	})
+
+	t.Run("Filter on season", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
+
+		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]entity.Player{{ID: 1, Name: "playername"}}, nil)
+		mockBackend.On("SearchStrike",
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]entity.Strike{
+				{ID: 1, Reason: "late", Date: time.Date(2023, 6, 1, 20, 0, 0, 0, time.UTC)},
+				{ID: 2, Reason: "afk", Date: time.Date(2024, 2, 1, 20, 0, 0, 0, time.UTC)},
+			}, nil)
+		mockBackend.On("SearchSeason", mock.Anything, "DF/S2").Return([]entity.Season{{
+			Name:  "DF/S2",
+			Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+		}}, nil)
+
+		strikes, err := strikeUseCase.ReadStrikes(context.Background(), "playername", "DF/S2")
+
+		assert.NoError(t, err)
+		assert.Len(t, strikes, 1)
+		assert.Equal(t, 1, strikes[0].ID)
+		mockBackend.AssertExpectations(t)
+	})
+
+	t.Run("Expiry at season end", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{ExpireOnSeasonEnd: true}, nil)
+
+		mockBackend.On("SearchPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]entity.Player{{ID: 1, Name: "playername"}}, nil)
+		mockBackend.On("SearchStrike",
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]entity.Strike{{ID: 1, Reason: "late", Date: time.Date(2023, 6, 1, 20, 0, 0, 0, time.UTC)}}, nil)
+		mockBackend.On("SearchSeason", mock.Anything, "").Return([]entity.Season{{
+			Name:  "DF/S2",
+			Start: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, 11, 13, 0, 0, 0, 0, time.UTC),
+		}}, nil)
+
+		strikes, err := strikeUseCase.ReadStrikes(context.Background(), "playername", "")
+
+		assert.NoError(t, err)
+		assert.Len(t, strikes, 1)
+		assert.Equal(t, time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC), strikes[0].ExpiresAt)
+		mockBackend.AssertExpectations(t)
+	})
+}
+
+func TestStrikeUseCase_ReadStrike_ByID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
+
+		mockBackend.On("ReadStrike", mock.Anything, 1).Return(entity.Strike{ID: 1, Reason: "late"}, nil)
+
+		strike, err := strikeUseCase.ReadStrike(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "late", strike.Reason)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
+
+		mockBackend.On("ReadStrike", mock.Anything, 1).Return(entity.Strike{}, nil)
+
+		_, err := strikeUseCase.ReadStrike(context.Background(), 1)
+		assert.ErrorContains(t, err, "strike not found")
+	})
 }
 
 func TestStrikeUseCase_DeleteStrike(t *testing.T) {
@@ -270,7 +476,7 @@ func TestStrikeUseCase_DeleteStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		mockBackend.On("DeleteStrike", mock.Anything, mock.Anything).Return(nil)
 
@@ -285,7 +491,7 @@ func TestStrikeUseCase_DeleteStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		mockBackend.On("DeleteStrike", mock.Anything, mock.Anything).Return(errors.New("Backend Error"))
 
@@ -300,7 +506,7 @@ func TestStrikeUseCase_DeleteStrike(t *testing.T) {
 
 		mockBackend := mocks.NewBackend(t)
 
-		strikeUseCase := usecase.NewStrikeUseCase(mockBackend)
+		strikeUseCase := usecase.NewStrikeUseCase(mockBackend, entity.StrikePolicy{}, nil)
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
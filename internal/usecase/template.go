@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// RaidTemplateUseCase is the use case of raid templates, which create the raids of the weeks ahead.
+type RaidTemplateUseCase struct {
+	backend    Backend
+	weeksAhead int
+	holidays   []entity.Holiday
+	location   *time.Location
+	notifier   Notifier
+}
+
+// NewRaidTemplateUseCase returns a new RaidTemplateUseCase creating the raids of weeksAhead weeks
+// by default, and no raid on holidays. Templates without time zone are in the guild time zone location.
+// Officers are sent the reports of scheduled generations with notifier.
+func NewRaidTemplateUseCase(
+	bk Backend, weeksAhead int, holidays []entity.Holiday, location *time.Location, notifier Notifier,
+) *RaidTemplateUseCase {
+	if location == nil {
+		location = time.UTC
+	}
+	return &RaidTemplateUseCase{
+		backend:    bk,
+		weeksAhead: weeksAhead,
+		holidays:   holidays,
+		location:   location,
+		notifier:   notifier,
+	}
+}
+
+// CreateRaidTemplate adds a template creating the raid on weekdays, starting at startTime in timezone.
+func (tuc RaidTemplateUseCase) CreateRaidTemplate(
+	ctx context.Context, name, difficulty, weekdays, startTime, timezone string,
+) (entity.RaidTemplate, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "RaidTemplate/CreateRaidTemplate")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("name", name),
+		attribute.String("difficulty", difficulty),
+		attribute.String("weekdays", weekdays),
+		attribute.String("startTime", startTime),
+		attribute.String("timezone", timezone),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.RaidTemplate{},
+			fmt.Errorf("RaidTemplateUseCase - CreateRaidTemplate - ctx.Done: request took too much time to be proceed")
+	default:
+		template, err := entity.NewRaidTemplate(name, difficulty, weekdays, startTime, timezone)
+		if err != nil {
+			return entity.RaidTemplate{}, fmt.Errorf("create raid template: %w", err)
+		}
+		template, err = tuc.backend.CreateRaidTemplate(ctx, template)
+		if err != nil {
+			return entity.RaidTemplate{}, fmt.Errorf("create raid template: %w", err)
+		}
+		return template, nil
+	}
+}
+
+// ListRaidTemplates returns every raid template.
+func (tuc RaidTemplateUseCase) ListRaidTemplates(ctx context.Context) ([]entity.RaidTemplate, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "RaidTemplate/ListRaidTemplates")
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("RaidTemplateUseCase - ListRaidTemplates - ctx.Done: request took too much time to be proceed")
+	default:
+		templates, err := tuc.backend.SearchRaidTemplate(ctx, "")
+		if err != nil {
+			return nil, fmt.Errorf("search raid templates: %w", err)
+		}
+		return templates, nil
+	}
+}
+
+// DeleteRaidTemplate removes a raid template. Raids it already created are kept.
+func (tuc RaidTemplateUseCase) DeleteRaidTemplate(ctx context.Context, templateID int) error {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "RaidTemplate/DeleteRaidTemplate")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("templateID", templateID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("RaidTemplateUseCase - DeleteRaidTemplate - ctx.Done: request took too much time to be proceed")
+	default:
+		err := tuc.backend.DeleteRaidTemplate(ctx, templateID)
+		if err != nil {
+			return fmt.Errorf("delete raid template: %w", err)
+		}
+		return nil
+	}
+}
+
+// GenerateRaids creates the raids of every template for the weeks starting on the day of now,
+// the weeks ahead of the use case when weeks is 0.
+// Raids on holidays are skipped and raids already there are kept as they are.
+// A raid which can't be created doesn't stop the others, it is reported as failed.
+func (tuc RaidTemplateUseCase) GenerateRaids(
+	ctx context.Context, now time.Time, weeks int,
+) (entity.RaidGeneration, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "RaidTemplate/GenerateRaids")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("now", now.String()),
+		attribute.Int("weeks", weeks),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.RaidGeneration{},
+			fmt.Errorf("RaidTemplateUseCase - GenerateRaids - ctx.Done: request took too much time to be proceed")
+	default:
+		if weeks == 0 {
+			weeks = tuc.weeksAhead
+		}
+		if weeks <= 0 {
+			return entity.RaidGeneration{}, fmt.Errorf("weeks must be a positive number")
+		}
+
+		templates, err := tuc.backend.SearchRaidTemplate(ctx, "")
+		if err != nil {
+			return entity.RaidGeneration{}, fmt.Errorf("search raid templates: %w", err)
+		}
+
+		var generation entity.RaidGeneration
+		for _, template := range templates {
+			location := tuc.location
+			if template.Timezone != "" {
+				location, err = time.LoadLocation(template.Timezone)
+				if err != nil {
+					return entity.RaidGeneration{}, fmt.Errorf("load time zone of template %s: %w", template, err)
+				}
+			}
+			// Today is the day of the template time zone, raids are in the guild time zone
+			for _, date := range template.Dates(now.In(location), weeks) {
+				start := entity.Raid{Date: date, StartTime: template.StartTime}.StartIn(location).In(tuc.location)
+				raid := entity.Raid{
+					Name:         template.Name,
+					Difficulty:   template.Difficulty,
+					Date:         entity.Day(start),
+					StartTime:    entity.TimeOfDay(start),
+					StartTimeSet: true,
+				}
+				tuc.generateRaid(ctx, raid, &generation)
+			}
+		}
+		return generation, nil
+	}
+}
+
+// ScheduleRaids creates the raids of the weeks ahead and sends officers the report
+// when raids were created or failed.
+func (tuc RaidTemplateUseCase) ScheduleRaids(ctx context.Context, now time.Time) error {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "RaidTemplate/ScheduleRaids")
+	defer span.End()
+
+	generation, err := tuc.GenerateRaids(ctx, now, 0)
+	if err != nil {
+		return err
+	}
+	if tuc.notifier == nil || (len(generation.Created) == 0 && len(generation.Failed) == 0) {
+		return nil
+	}
+
+	err = tuc.notifier.NotifyOfficers(ctx, "Raids from templates:\n"+generation.String())
+	if err != nil {
+		return fmt.Errorf("notify officers: %w", err)
+	}
+	return nil
+}
+
+// generateRaid creates raid unless it is on holidays, already there or deleted by officers,
+// and records what happened.
+func (tuc RaidTemplateUseCase) generateRaid(ctx context.Context, raid entity.Raid, generation *entity.RaidGeneration) {
+	for _, holiday := range tuc.holidays {
+		if holiday.Contains(raid.Date) {
+			generation.Skipped = append(generation.Skipped, raid)
+			return
+		}
+	}
+	createMissingRaid(ctx, tuc.backend, raid, generation)
+}
+
+// createMissingRaid creates raid unless it is already there or deleted by officers, and records what happened.
+func createMissingRaid(ctx context.Context, backend Backend, raid entity.Raid, generation *entity.RaidGeneration) {
+	existing, err := backend.SearchRaid(ctx, "", raid.Date, raid.Difficulty)
+	if err != nil {
+		generation.Failed = append(generation.Failed, entity.FailedRaid{Raid: raid, Reason: err.Error()})
+		return
+	}
+	if len(existing) != 0 {
+		generation.Existing = append(generation.Existing, existing[0])
+		return
+	}
+
+	// A raid deleted by officers stays cancelled, it can be restored with the undo command
+	deleted, err := backend.SearchDeletedRaid(ctx, raid.Date, raid.Difficulty)
+	if err != nil {
+		generation.Failed = append(generation.Failed, entity.FailedRaid{Raid: raid, Reason: err.Error()})
+		return
+	}
+	if len(deleted) != 0 {
+		generation.Deleted = append(generation.Deleted, deleted[0])
+		return
+	}
+
+	created, err := backend.CreateRaid(ctx, raid)
+	if err != nil {
+		generation.Failed = append(generation.Failed, entity.FailedRaid{Raid: raid, Reason: err.Error()})
+		return
+	}
+	generation.Created = append(generation.Created, created)
+}
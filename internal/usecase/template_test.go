@@ -0,0 +1,202 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/mocks"
+)
+
+func TestRaidTemplateUseCase_CreateRaidTemplate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		templateUseCase := usecase.NewRaidTemplateUseCase(mockBackend, 2, nil, time.UTC, nil)
+
+		mockBackend.On("CreateRaidTemplate", mock.Anything, entity.RaidTemplate{
+			Name:       "nighthold",
+			Difficulty: "heroic",
+			Weekdays:   []time.Weekday{time.Monday, time.Wednesday},
+			StartTime:  21 * time.Hour,
+		}).Return(entity.RaidTemplate{ID: 1}, nil)
+
+		template, err := templateUseCase.CreateRaidTemplate(context.Background(),
+			"nighthold", "Heroic", "wednesday, monday", "21:00", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, template.ID)
+	})
+
+	t.Run("Invalid week days", func(t *testing.T) {
+		t.Parallel()
+
+		templateUseCase := usecase.NewRaidTemplateUseCase(mocks.NewBackend(t), 2, nil, time.UTC, nil)
+
+		_, err := templateUseCase.CreateRaidTemplate(context.Background(),
+			"nighthold", "heroic", "someday", "21:00", "")
+
+		assert.ErrorContains(t, err, "week days must be one of")
+	})
+}
+
+func TestRaidTemplateUseCase_GenerateRaids(t *testing.T) {
+	t.Parallel()
+
+	// Monday
+	today := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+	template := entity.RaidTemplate{
+		ID:         1,
+		Name:       "nighthold",
+		Difficulty: "heroic",
+		Weekdays:   []time.Weekday{time.Monday, time.Wednesday},
+		StartTime:  21 * time.Hour,
+	}
+	raid := func(date time.Time) entity.Raid {
+		return entity.Raid{Name: "nighthold", Difficulty: "heroic", Date: date, StartTime: 21 * time.Hour, StartTimeSet: true}
+	}
+	monday := raid(today)
+	wednesday := raid(today.AddDate(0, 0, 2))
+	nextMonday := raid(today.AddDate(0, 0, 7))
+	nextWednesday := raid(today.AddDate(0, 0, 9))
+	lastMonday := raid(today.AddDate(0, 0, 14))
+	lastWednesday := raid(today.AddDate(0, 0, 16))
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		templateUseCase := usecase.NewRaidTemplateUseCase(mockBackend, 3, []entity.Holiday{
+			{Name: "blizzcon", Start: nextWednesday.Date, End: nextWednesday.Date.AddDate(0, 0, 3)},
+		}, time.UTC, nil)
+
+		existing := monday
+		existing.ID = 1
+		mockBackend.On("SearchRaidTemplate", mock.Anything, "").Return([]entity.RaidTemplate{template}, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", monday.Date, "heroic").Return([]entity.Raid{existing}, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", wednesday.Date, "heroic").Return(nil, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", nextMonday.Date, "heroic").Return(nil, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", lastMonday.Date, "heroic").Return(nil, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", lastWednesday.Date, "heroic").Return(nil, nil)
+		deleted := lastMonday
+		deleted.ID = 3
+		mockBackend.On("SearchDeletedRaid", mock.Anything, lastMonday.Date, "heroic").Return([]entity.Raid{deleted}, nil)
+		mockBackend.On("SearchDeletedRaid", mock.Anything, mock.Anything, "heroic").Return(nil, nil)
+		mockBackend.On("CreateRaid", mock.Anything, wednesday).Return(entity.Raid{ID: 2}, nil)
+		mockBackend.On("CreateRaid", mock.Anything, nextMonday).Return(entity.Raid{}, errors.New("raid already exists"))
+		mockBackend.On("CreateRaid", mock.Anything, lastWednesday).Return(entity.Raid{ID: 4}, nil)
+
+		generation, err := templateUseCase.GenerateRaids(context.Background(), today.Add(18*time.Hour), 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.RaidGeneration{
+			Created:  []entity.Raid{{ID: 2}, {ID: 4}},
+			Existing: []entity.Raid{existing},
+			Skipped:  []entity.Raid{nextWednesday},
+			Deleted:  []entity.Raid{deleted},
+			Failed:   []entity.FailedRaid{{Raid: nextMonday, Reason: "raid already exists"}},
+		}, generation)
+	})
+
+	t.Run("Template time zone", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		templateUseCase := usecase.NewRaidTemplateUseCase(mockBackend, 1, nil, time.UTC, nil)
+
+		// It is already Tuesday in Tokyo, Monday has passed
+		tokyo := template
+		tokyo.Timezone = "Asia/Tokyo"
+		mockBackend.On("SearchRaidTemplate", mock.Anything, "").Return([]entity.RaidTemplate{tokyo}, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", wednesday.Date, "heroic").Return(nil, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", nextMonday.Date, "heroic").Return(nil, nil)
+		mockBackend.On("SearchDeletedRaid", mock.Anything, mock.Anything, "heroic").Return(nil, nil)
+		// 21:00 in Tokyo is 12:00 in the guild time zone
+		wednesday, nextMonday := wednesday, nextMonday
+		wednesday.StartTime, nextMonday.StartTime = 12*time.Hour, 12*time.Hour
+		mockBackend.On("CreateRaid", mock.Anything, wednesday).Return(wednesday, nil)
+		mockBackend.On("CreateRaid", mock.Anything, nextMonday).Return(nextMonday, nil)
+
+		generation, err := templateUseCase.GenerateRaids(context.Background(), today.Add(18*time.Hour), 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Raid{wednesday, nextMonday}, generation.Created)
+	})
+
+	t.Run("Invalid weeks", func(t *testing.T) {
+		t.Parallel()
+
+		templateUseCase := usecase.NewRaidTemplateUseCase(mocks.NewBackend(t), 0, nil, time.UTC, nil)
+
+		_, err := templateUseCase.GenerateRaids(context.Background(), today, 0)
+
+		assert.ErrorContains(t, err, "weeks must be a positive number")
+	})
+
+	t.Run("Context is done", func(t *testing.T) {
+		t.Parallel()
+
+		templateUseCase := usecase.NewRaidTemplateUseCase(mocks.NewBackend(t), 2, nil, time.UTC, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := templateUseCase.GenerateRaids(ctx, today, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestRaidTemplateUseCase_ScheduleRaids(t *testing.T) {
+	t.Parallel()
+
+	today := time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)
+	template := entity.RaidTemplate{
+		ID:         1,
+		Name:       "nighthold",
+		Difficulty: "heroic",
+		Weekdays:   []time.Weekday{time.Monday},
+		StartTime:  21 * time.Hour,
+	}
+	monday := entity.Raid{
+		Name: "nighthold", Difficulty: "heroic", Date: today, StartTime: 21 * time.Hour, StartTimeSet: true,
+	}
+
+	t.Run("Officers are sent created raids", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		mockNotifier := mocks.NewNotifier(t)
+		templateUseCase := usecase.NewRaidTemplateUseCase(mockBackend, 1, nil, time.UTC, mockNotifier)
+
+		mockBackend.On("SearchRaidTemplate", mock.Anything, "").Return([]entity.RaidTemplate{template}, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", today, "heroic").Return(nil, nil)
+		mockBackend.On("SearchDeletedRaid", mock.Anything, today, "heroic").Return(nil, nil)
+		mockBackend.On("CreateRaid", mock.Anything, monday).Return(monday, nil)
+		mockNotifier.On("NotifyOfficers", mock.Anything,
+			"Raids from templates:\n**Created (1)**\n* nighthold heroic Mon 02/10/23\n").Return(nil)
+
+		assert.NoError(t, templateUseCase.ScheduleRaids(context.Background(), today))
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("Nothing new", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		templateUseCase := usecase.NewRaidTemplateUseCase(mockBackend, 1, nil, time.UTC, mocks.NewNotifier(t))
+
+		mockBackend.On("SearchRaidTemplate", mock.Anything, "").Return([]entity.RaidTemplate{template}, nil)
+		mockBackend.On("SearchRaid", mock.Anything, "", today, "heroic").Return([]entity.Raid{monday}, nil)
+
+		assert.NoError(t, templateUseCase.ScheduleRaids(context.Background(), today))
+	})
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// TrashUseCase is the use case of deleted players, raids and loots, which can be restored
+// until they are purged.
+type TrashUseCase struct {
+	backend   Backend
+	retention time.Duration
+}
+
+// NewTrashUseCase returns a new TrashUseCase purging objects deleted for longer than retention.
+// Deleted objects are never purged when retention is 0.
+func NewTrashUseCase(bk Backend, retention time.Duration) *TrashUseCase {
+	return &TrashUseCase{
+		backend:   bk,
+		retention: retention,
+	}
+}
+
+// RestoreLast brings back the last player, raid or loot deleted, with what belongs to it,
+// and returns it. Points refunded when a raid or a loot was deleted are charged again.
+func (tuc TrashUseCase) RestoreLast(ctx context.Context) (entity.Deleted, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Trash/RestoreLast")
+	defer span.End()
+
+	select {
+	case <-ctx.Done():
+		return entity.Deleted{},
+			fmt.Errorf("TrashUseCase - RestoreLast - ctx.Done: request took too much time to be proceed")
+	default:
+		deleted, err := tuc.backend.SearchDeleted(ctx, 1)
+		if err != nil {
+			return entity.Deleted{}, fmt.Errorf("search last deleted: %w", err)
+		}
+		if len(deleted) == 0 {
+			return entity.Deleted{}, fmt.Errorf("nothing to restore")
+		}
+		last := deleted[0]
+		span.SetAttributes(
+			attribute.String("kind", string(last.Kind)),
+			attribute.Int("id", last.ID),
+		)
+
+		err = tuc.backend.RestoreDeleted(ctx, last)
+		if err != nil {
+			return entity.Deleted{}, fmt.Errorf("restore %s: %w", last, err)
+		}
+
+		var entries []entity.PointsEntry
+		var reason string
+		switch last.Kind {
+		case entity.DeletedRaid:
+			entries, err = tuc.backend.SearchPointsEntry(ctx, -1, last.ID, -1)
+			reason = raidDeletedReason
+		case entity.DeletedLoot:
+			entries, err = tuc.backend.SearchPointsEntry(ctx, -1, -1, last.ID)
+			reason = lootDeletedReason
+		default:
+			return last, nil
+		}
+		if err != nil {
+			return last, fmt.Errorf("search points of %s: %w", last, err)
+		}
+		err = reapplyAll(ctx, tuc.backend, entries, reason)
+		if err != nil {
+			return last, fmt.Errorf("charge points of %s again: %w", last, err)
+		}
+		return last, nil
+	}
+}
+
+// PurgeDeleted removes for good the players, raids and loots deleted for longer than the retention
+// and returns how many were purged.
+func (tuc TrashUseCase) PurgeDeleted(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Trash/PurgeDeleted")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("now", now.String()),
+	)
+
+	select {
+	case <-ctx.Done():
+		return 0, fmt.Errorf("TrashUseCase - PurgeDeleted - ctx.Done: request took too much time to be proceed")
+	default:
+		if tuc.retention <= 0 {
+			return 0, nil
+		}
+		purged, err := tuc.backend.PurgeDeleted(ctx, now.Add(-tuc.retention))
+		if err != nil {
+			return purged, fmt.Errorf("purge deleted objects: %w", err)
+		}
+		return purged, nil
+	}
+}
+
+// reapplyAll writes again the entries reverted by the last reversals of entries, the ones written
+// with reason when their raid or loot was deleted. Entries are ordered by date, so these reversals
+// are the last ones as nothing is added to a deleted raid or loot.
+func reapplyAll(ctx context.Context, backend Backend, entries []entity.PointsEntry, reason string) error {
+	byID := make(map[int]entity.PointsEntry, len(entries))
+	for _, entry := range entries {
+		byID[entry.ID] = entry
+	}
+	last := len(entries)
+	for last > 0 && entries[last-1].Kind == entity.PointsReversal && entries[last-1].Reason == reason {
+		last--
+	}
+	for _, reversal := range entries[last:] {
+		reverted, ok := byID[reversal.Reverts]
+		if !ok {
+			continue
+		}
+		entry, err := entity.NewPointsEntry(reverted.Player, reverted.Amount, reverted.Kind, reverted.Reason,
+			reverted.Author)
+		if err != nil {
+			return fmt.Errorf("create entity points entry: %w", err)
+		}
+		entry.RaidID = reverted.RaidID
+		entry.LootID = reverted.LootID
+		_, err = backend.CreatePointsEntry(ctx, entry)
+		if err != nil {
+			return fmt.Errorf("save points entry %d again: %w", reverted.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/mocks"
+)
+
+func TestTrashUseCase_RestoreLast(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Raid", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		trashUseCase := usecase.NewTrashUseCase(mockBackend, 0)
+
+		raid := entity.Deleted{Kind: entity.DeletedRaid, ID: 3, Name: "icc"}
+		player := &entity.Player{ID: 1, Name: "arthas"}
+		mockBackend.On("SearchDeleted", mock.Anything, 1).Return([]entity.Deleted{raid}, nil)
+		mockBackend.On("RestoreDeleted", mock.Anything, raid).Return(nil)
+		mockBackend.On("SearchPointsEntry", mock.Anything, -1, 3, -1).Return([]entity.PointsEntry{
+			// restored once already: the first reversals were charged again
+			{ID: 1, Player: player, Amount: 10, Kind: entity.PointsAttendance, Reason: "raid", RaidID: 3},
+			{ID: 2, Player: player, Amount: -10, Kind: entity.PointsReversal, Reason: "raid deleted", RaidID: 3, Reverts: 1},
+			{ID: 3, Player: player, Amount: 10, Kind: entity.PointsAttendance, Reason: "raid", RaidID: 3},
+			{ID: 4, Player: player, Amount: -20, Kind: entity.PointsLoot, Reason: "frostmourne", RaidID: 3, LootID: 5},
+			{ID: 5, Player: player, Amount: -10, Kind: entity.PointsReversal, Reason: "raid deleted", RaidID: 3, Reverts: 3},
+			{ID: 6, Player: player, Amount: 20, Kind: entity.PointsReversal, Reason: "raid deleted", RaidID: 3, Reverts: 4},
+		}, nil)
+		mockBackend.On("CreatePointsEntry", mock.Anything, mock.MatchedBy(func(entry entity.PointsEntry) bool {
+			return entry.Kind == entity.PointsAttendance && entry.Amount == 10 && entry.RaidID == 3
+		})).Return(entity.PointsEntry{ID: 7}, nil).Once()
+		mockBackend.On("CreatePointsEntry", mock.Anything, mock.MatchedBy(func(entry entity.PointsEntry) bool {
+			return entry.Kind == entity.PointsLoot && entry.Amount == -20 && entry.LootID == 5
+		})).Return(entity.PointsEntry{ID: 8}, nil).Once()
+
+		restored, err := trashUseCase.RestoreLast(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, raid, restored)
+	})
+
+	t.Run("Player", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		trashUseCase := usecase.NewTrashUseCase(mockBackend, 0)
+
+		player := entity.Deleted{Kind: entity.DeletedPlayer, ID: 1, Name: "arthas"}
+		mockBackend.On("SearchDeleted", mock.Anything, 1).Return([]entity.Deleted{player}, nil)
+		mockBackend.On("RestoreDeleted", mock.Anything, player).Return(nil)
+
+		restored, err := trashUseCase.RestoreLast(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "player arthas (ID 1)", restored.String())
+	})
+
+	t.Run("Nothing to restore", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		trashUseCase := usecase.NewTrashUseCase(mockBackend, 0)
+
+		mockBackend.On("SearchDeleted", mock.Anything, 1).Return(nil, nil)
+
+		_, err := trashUseCase.RestoreLast(context.Background())
+		assert.ErrorContains(t, err, "nothing to restore")
+	})
+
+	t.Run("Restore failed", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		trashUseCase := usecase.NewTrashUseCase(mockBackend, 0)
+
+		loot := entity.Deleted{Kind: entity.DeletedLoot, ID: 5, Name: "frostmourne"}
+		mockBackend.On("SearchDeleted", mock.Anything, 1).Return([]entity.Deleted{loot}, nil)
+		mockBackend.On("RestoreDeleted", mock.Anything, loot).Return(errors.New("database - RestoreDeleted - error"))
+
+		_, err := trashUseCase.RestoreLast(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestTrashUseCase_PurgeDeleted(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.October, 31, 4, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+		trashUseCase := usecase.NewTrashUseCase(mockBackend, 30*24*time.Hour)
+
+		mockBackend.On("PurgeDeleted", mock.Anything, time.Date(2023, time.October, 1, 4, 0, 0, 0, time.UTC)).
+			Return(2, nil)
+
+		purged, err := trashUseCase.PurgeDeleted(context.Background(), now)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, purged)
+	})
+
+	t.Run("Kept forever", func(t *testing.T) {
+		t.Parallel()
+
+		trashUseCase := usecase.NewTrashUseCase(mocks.NewBackend(t), 0)
+
+		purged, err := trashUseCase.PurgeDeleted(context.Background(), now)
+		assert.NoError(t, err)
+		assert.Zero(t, purged)
+	})
+}
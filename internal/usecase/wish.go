@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+)
+
+// WishlistUseCase is the use case for the items players want.
+type WishlistUseCase struct {
+	backend Backend
+}
+
+func NewWishlistUseCase(bk Backend) *WishlistUseCase {
+	return &WishlistUseCase{backend: bk}
+}
+
+// AddWish adds an item to the wishlist of a player.
+func (w WishlistUseCase) AddWish(
+	ctx context.Context, playerName, item string, priority int, spec, note string,
+) (entity.Wish, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Wish/AddWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+		attribute.String("item", item),
+		attribute.Int("priority", priority),
+		attribute.String("spec", spec),
+	)
+
+	select {
+	case <-ctx.Done():
+		return entity.Wish{}, fmt.Errorf("WishlistUseCase - AddWish - ctx.Done: request took too much time to be proceed")
+	default:
+		player, err := findPlayer(ctx, w.backend, playerName)
+		if err != nil {
+			return entity.Wish{}, err
+		}
+		name, err := itemName(ctx, w.backend, item)
+		if err != nil {
+			return entity.Wish{}, fmt.Errorf("find item: %w", err)
+		}
+		wish, err := entity.NewWish(&player, name, priority, spec, note)
+		if err != nil {
+			return entity.Wish{}, fmt.Errorf("create entity wish: %w", err)
+		}
+		wish, err = w.backend.CreateWish(ctx, wish)
+		if err != nil {
+			return entity.Wish{}, fmt.Errorf("save wish: %w", err)
+		}
+		return wish, nil
+	}
+}
+
+// RemoveWish removes a wish from the wishlist of its player.
+func (w WishlistUseCase) RemoveWish(ctx context.Context, wishID int) error {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Wish/RemoveWish")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("wishID", wishID),
+	)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("WishlistUseCase - RemoveWish - ctx.Done: request took too much time to be proceed")
+	default:
+		err := w.backend.DeleteWish(ctx, wishID)
+		if err != nil {
+			return fmt.Errorf("delete wish: %w", err)
+		}
+		return nil
+	}
+}
+
+// ListWishes returns the wishes of a player, on an item, or every wish when both are empty,
+// highest priority first.
+func (w WishlistUseCase) ListWishes(ctx context.Context, playerName, item string) ([]entity.Wish, error) {
+	ctx, span := otel.Tracer("Usecase").Start(ctx, "Wish/ListWishes")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("playerName", playerName),
+		attribute.String("item", item),
+	)
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("WishlistUseCase - ListWishes - ctx.Done: request took too much time to be proceed")
+	default:
+		playerID := -1
+		if playerName != "" {
+			player, err := findPlayer(ctx, w.backend, playerName)
+			if err != nil {
+				return nil, err
+			}
+			playerID = player.ID
+		}
+		if item != "" {
+			var err error
+			item, err = itemName(ctx, w.backend, item)
+			if err != nil {
+				return nil, fmt.Errorf("find item: %w", err)
+			}
+		}
+		wishes, err := w.backend.SearchWish(ctx, playerID, item)
+		if err != nil {
+			return nil, fmt.Errorf("search wishes: %w", err)
+		}
+		return wishes, nil
+	}
+}
+
+// wishesByPlayer returns the wishes on an item by player ID.
+func wishesByPlayer(ctx context.Context, backend Backend, item string) (map[int]entity.Wish, error) {
+	wishes, err := backend.SearchWish(ctx, -1, strings.ToLower(strings.TrimSpace(item)))
+	if err != nil {
+		return nil, fmt.Errorf("search wishes on %s: %w", item, err)
+	}
+	byPlayer := make(map[int]entity.Wish, len(wishes))
+	for _, wish := range wishes {
+		byPlayer[wish.Player.ID] = wish
+	}
+	return byPlayer, nil
+}
@@ -0,0 +1,148 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/antony-ramos/guildops/internal/entity"
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/mocks"
+)
+
+func TestWishlistUseCase_AddWish(t *testing.T) {
+	t.Parallel()
+
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		wishlistUseCase := usecase.NewWishlistUseCase(mockBackend)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchItem", mock.Anything, "frostmorne").Return(nil, nil)
+		mockBackend.On("SearchItem", mock.Anything, "").
+			Return([]entity.Item{{ID: 1, Name: "frostmourne"}, {ID: 2, Name: "ashbringer"}}, nil)
+		mockBackend.On("CreateWish", mock.Anything, entity.Wish{
+			Player: &arthas, Item: "frostmourne", Priority: 1, Spec: "frost", Note: "best in slot",
+		}).Return(entity.Wish{ID: 3, Player: &arthas, Item: "frostmourne", Priority: 1}, nil)
+
+		wish, err := wishlistUseCase.AddWish(context.Background(), "arthas", "Frostmorne", 1, "Frost", "best in slot")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, wish.ID)
+	})
+
+	t.Run("Invalid priority", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		wishlistUseCase := usecase.NewWishlistUseCase(mockBackend)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchItem", mock.Anything, mock.Anything).Return(nil, nil)
+
+		_, err := wishlistUseCase.AddWish(context.Background(), "arthas", "frostmourne", 9, "", "")
+		assert.ErrorContains(t, err, "priority must be between 1 and 5")
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		wishlistUseCase := usecase.NewWishlistUseCase(mockBackend)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return(nil, nil)
+
+		_, err := wishlistUseCase.AddWish(context.Background(), "arthas", "frostmourne", 1, "", "")
+		assert.ErrorContains(t, err, "player arthas not found")
+	})
+
+	t.Run("Several items match", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		wishlistUseCase := usecase.NewWishlistUseCase(mockBackend)
+
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchItem", mock.Anything, "frost").
+			Return([]entity.Item{{ID: 1, Name: "frostmourne"}, {ID: 2, Name: "frostmourne hilt"}}, nil)
+
+		_, err := wishlistUseCase.AddWish(context.Background(), "arthas", "frost", 1, "", "")
+		assert.ErrorContains(t, err,
+			"several items match frost, use one of their IDs: frostmourne (#1), frostmourne hilt (#2)")
+	})
+}
+
+func TestWishlistUseCase_RemoveWish(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		wishlistUseCase := usecase.NewWishlistUseCase(mockBackend)
+
+		mockBackend.On("DeleteWish", mock.Anything, 3).Return(nil)
+
+		assert.NoError(t, wishlistUseCase.RemoveWish(context.Background(), 3))
+	})
+
+	t.Run("Backend Error", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		wishlistUseCase := usecase.NewWishlistUseCase(mockBackend)
+
+		mockBackend.On("DeleteWish", mock.Anything, 3).Return(errors.New("wish not found"))
+
+		assert.ErrorContains(t, wishlistUseCase.RemoveWish(context.Background(), 3), "wish not found")
+	})
+}
+
+func TestWishlistUseCase_ListWishes(t *testing.T) {
+	t.Parallel()
+
+	arthas := entity.Player{ID: 1, Name: "arthas"}
+
+	t.Run("By player", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		wishlistUseCase := usecase.NewWishlistUseCase(mockBackend)
+
+		wishes := []entity.Wish{{ID: 3, Player: &arthas, Item: "frostmourne", Priority: 1}}
+		mockBackend.On("SearchPlayer", mock.Anything, -1, "arthas", "").Return([]entity.Player{arthas}, nil)
+		mockBackend.On("SearchWish", mock.Anything, 1, "").Return(wishes, nil)
+
+		list, err := wishlistUseCase.ListWishes(context.Background(), "arthas", "")
+		assert.NoError(t, err)
+		assert.Equal(t, wishes, list)
+	})
+
+	t.Run("By item", func(t *testing.T) {
+		t.Parallel()
+
+		mockBackend := mocks.NewBackend(t)
+
+		wishlistUseCase := usecase.NewWishlistUseCase(mockBackend)
+
+		mockBackend.On("SearchItem", mock.Anything, mock.Anything).Return(nil, nil)
+		mockBackend.On("SearchWish", mock.Anything, -1, "frostmourne").Return(nil, nil)
+
+		list, err := wishlistUseCase.ListWishes(context.Background(), "", " Frostmourne ")
+		assert.NoError(t, err)
+		assert.Empty(t, list)
+	})
+}
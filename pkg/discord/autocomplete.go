@@ -0,0 +1,87 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// MaxChoices is the most choices discord shows for an option.
+const MaxChoices = 25
+
+// maxChoiceLength is the most characters of the name and the value of a choice.
+const maxChoiceLength = 100
+
+// Completer returns the choices suggested for the value typed so far in an option of a command.
+type Completer func(ctx context.Context, value string) ([]*discordgo.ApplicationCommandOptionChoice, error)
+
+// Completers are the completers of the options of commands, keyed by the command name then by the option name.
+type Completers map[string]map[string]Completer
+
+// Choice returns a choice showing name which sets the option to value.
+func Choice(name, value string) *discordgo.ApplicationCommandOptionChoice {
+	return &discordgo.ApplicationCommandOptionChoice{
+		Name:  truncate(name, maxChoiceLength),
+		Value: truncate(value, maxChoiceLength),
+	}
+}
+
+// Choices returns choices setting the option to each of values, named after them.
+func Choices(values ...string) []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(values))
+	for _, value := range values {
+		choices = append(choices, Choice(value, value))
+	}
+	return choices
+}
+
+// Mark sets Autocomplete on the options of commands which have a completer.
+// Options with fixed choices are left as they are, discord refuses both.
+func (c Completers) Mark(commands []*discordgo.ApplicationCommand) {
+	for _, command := range commands {
+		for _, option := range command.Options {
+			if _, ok := c[command.Name][option.Name]; ok && len(option.Choices) == 0 {
+				option.Autocomplete = true
+			}
+		}
+	}
+}
+
+// Complete returns the choices of the focused option of an autocomplete interaction.
+// There are no choices when the option has no completer.
+func (c Completers) Complete(
+	ctx context.Context, data discordgo.ApplicationCommandInteractionData,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	option := focusedOption(data.Options)
+	if option == nil {
+		return nil, nil
+	}
+	completer, ok := c[data.Name][option.Name]
+	if !ok {
+		return nil, nil
+	}
+	value, _ := option.Value.(string)
+	choices, err := completer(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+	if len(choices) > MaxChoices {
+		choices = choices[:MaxChoices]
+	}
+	return choices, nil
+}
+
+// focusedOption returns the option being typed, looking into sub commands.
+func focusedOption(
+	options []*discordgo.ApplicationCommandInteractionDataOption,
+) *discordgo.ApplicationCommandInteractionDataOption {
+	for _, option := range options {
+		if option.Focused {
+			return option
+		}
+		if focused := focusedOption(option.Options); focused != nil {
+			return focused
+		}
+	}
+	return nil
+}
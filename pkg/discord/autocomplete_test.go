@@ -0,0 +1,123 @@
+package discord_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+// prefixCompleter suggests the value typed followed by 1 to count.
+func prefixCompleter(count int) discord.Completer {
+	return func(ctx context.Context, value string) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+		var values []string
+		for i := 1; i <= count; i++ {
+			values = append(values, value+strconv.Itoa(i))
+		}
+		return discord.Choices(values...), nil
+	}
+}
+
+// autocompleteData returns the data of an autocomplete interaction of command, typing value in option.
+func autocompleteData(command, option, value string) discordgo.ApplicationCommandInteractionData {
+	return discordgo.ApplicationCommandInteractionData{
+		Name: command,
+		Options: []*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "other", Type: discordgo.ApplicationCommandOptionString, Value: "x"},
+			{Name: option, Type: discordgo.ApplicationCommandOptionString, Value: value, Focused: true},
+		},
+	}
+}
+
+func TestCompleters_Complete(t *testing.T) {
+	t.Parallel()
+
+	completers := discord.Completers{
+		"guildops-player-get": {"name": prefixCompleter(2)},
+		"guildops-loot-list":  {"name": prefixCompleter(40)},
+		"guildops-raid-list": {"date": func(ctx context.Context, value string) (
+			[]*discordgo.ApplicationCommandOptionChoice, error,
+		) {
+			return nil, errors.New("backend unavailable")
+		}},
+	}
+
+	tests := []struct {
+		name    string
+		data    discordgo.ApplicationCommandInteractionData
+		want    []string
+		wantErr bool
+	}{
+		{name: "Focused option", data: autocompleteData("guildops-player-get", "name", "ar"), want: []string{"ar1", "ar2"}},
+		{name: "Unknown option", data: autocompleteData("guildops-player-get", "season", "ar")},
+		{name: "Unknown command", data: autocompleteData("guildops-player-create", "name", "ar")},
+		{name: "Too many choices", data: autocompleteData("guildops-loot-list", "name", ""), want: make([]string, 25)},
+		{name: "Error", data: autocompleteData("guildops-raid-list", "date", ""), wantErr: true},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			choices, err := completers.Complete(context.Background(), test.data)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Complete() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if len(choices) != len(test.want) {
+				t.Fatalf("Complete() = %d choices, want %d", len(choices), len(test.want))
+			}
+			for i, want := range test.want {
+				if want != "" && choices[i].Value != want {
+					t.Errorf("Complete()[%d] = %v, want %v", i, choices[i].Value, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCompleters_Mark(t *testing.T) {
+	t.Parallel()
+
+	commands := []*discordgo.ApplicationCommand{
+		{
+			Name: "guildops-player-get",
+			Options: []*discordgo.ApplicationCommandOption{
+				{Name: "name", Type: discordgo.ApplicationCommandOptionString},
+				{Name: "season", Type: discordgo.ApplicationCommandOptionString},
+				{Name: "role", Type: discordgo.ApplicationCommandOptionString, Choices: discord.Choices("tank")},
+			},
+		},
+	}
+	discord.Completers{
+		"guildops-player-get": {"name": prefixCompleter(1), "role": prefixCompleter(1)},
+	}.Mark(commands)
+
+	options := commands[0].Options
+	if !options[0].Autocomplete {
+		t.Errorf("Mark() option name is not autocompleted")
+	}
+	if options[1].Autocomplete {
+		t.Errorf("Mark() option season without completer is autocompleted")
+	}
+	if options[2].Autocomplete {
+		t.Errorf("Mark() option role with choices is autocompleted")
+	}
+}
+
+func TestChoice(t *testing.T) {
+	t.Parallel()
+
+	choice := discord.Choice("Sun 01/10/23 mythic", "01/10/23")
+	if choice.Name != "Sun 01/10/23 mythic" || choice.Value != "01/10/23" {
+		t.Errorf("Choice() = %v, %v", choice.Name, choice.Value)
+	}
+
+	choice = discord.Choice(strings.Repeat("a", 150), "a")
+	if name := []rune(choice.Name); len(name) != 100 || name[99] != '…' {
+		t.Errorf("Choice() name is not truncated to 100 characters: %v", choice.Name)
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/bwmarrin/discordgo"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -18,8 +21,17 @@ type Discord struct {
 	guildID         int
 	DeleteCommands  bool
 	commands        []*discordgo.ApplicationCommand
-	commandHandlers map[string]func(ctx context.Context, interaction *discordgo.InteractionCreate) (string, error)
-	s               *discordgo.Session
+	commandHandlers map[string]Handler
+	// components routes the interactions of buttons and menus to their handler.
+	components *Router
+	// completers suggests values of command options as they are typed.
+	completers          Completers
+	policy              *Policy
+	officerChannel      string
+	announcementChannel string
+	s                   *discordgo.Session
+	// api calls the discord API out of the session, before it is opened.
+	api *discordgo.Session
 }
 
 func New(opts ...Option) *Discord {
@@ -49,37 +61,20 @@ func (d *Discord) Run(ctx context.Context) error {
 	logger.FromContext(ctx).Debug("create handlers to discord interaction create event")
 	loggerHandler := logger.FromContext(ctx)
 	d.s.AddHandler(func(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
-		if handler, ok := d.commandHandlers[interaction.ApplicationCommandData().Name]; ok {
-			ctx := context.Background()
-			ctx = logger.AddLoggerToContext(ctx, loggerHandler)
-
-			logger.FromContext(ctx).Debug("handling command " + interaction.ApplicationCommandData().Name)
-			ctx, span := otel.Tracer("discordHandler").Start(ctx, interaction.ApplicationCommandData().Name)
-			ctx = logger.AddLoggerToContext(ctx, logger.FromContext(ctx).
-				With(zap.String("discordHandler", interaction.ApplicationCommandData().Name)))
-			defer span.End()
-			msg, err := handler(ctx, interaction)
-			if err != nil {
-				logger.FromContext(ctx).Error(
-					fmt.Sprintf("handle command %s : %s", interaction.ApplicationCommandData().Name, err.Error()))
-			}
-			data := discordgo.InteractionResponseData{
-				Content: msg,
-			}
-			if interaction.ApplicationCommandData().Name == "guildops-player-info" {
-				data = discordgo.InteractionResponseData{
-					Content: msg,
-					Flags:   discordgo.MessageFlagsEphemeral,
-				}
-			}
-			_ = session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
-				Type: discordgo.InteractionResponseChannelMessageWithSource,
-				Data: &data,
-			})
+		ctx := logger.AddLoggerToContext(context.Background(), loggerHandler)
+		switch interaction.Type {
+		case discordgo.InteractionApplicationCommand:
+			d.handleCommand(ctx, session, interaction)
+		case discordgo.InteractionMessageComponent:
+			d.handleComponent(ctx, session, interaction)
+		case discordgo.InteractionApplicationCommandAutocomplete:
+			d.handleAutocomplete(ctx, session, interaction)
+		default:
 		}
 	})
 
 	logger.FromContext(ctx).Debug("register commands to discord")
+	d.completers.Mark(d.commands)
 	registeredCommands := make([]*discordgo.ApplicationCommand, len(d.commands))
 	pool := pond.New(100, 1000)
 	group, _ := pool.GroupContext(ctx)
@@ -127,3 +122,213 @@ func (d *Discord) Run(ctx context.Context) error {
 	}
 	return nil
 }
+
+// handleCommand calls the handler of the application command of the interaction
+// once the member is authorized to run it.
+func (d *Discord) handleCommand(
+	ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate,
+) {
+	name := interaction.ApplicationCommandData().Name
+	handler, ok := d.commandHandlers[name]
+	if !ok {
+		return
+	}
+
+	logger.FromContext(ctx).Debug("handling command " + name)
+	ctx, span := otel.Tracer("discordHandler").Start(ctx, name)
+	ctx = logger.AddLoggerToContext(ctx, logger.FromContext(ctx).With(zap.String("discordHandler", name)))
+	defer span.End()
+
+	err := d.authorize(session, interaction, name)
+	if err != nil {
+		deny(ctx, session, interaction, "You are not allowed to use this command: ", err)
+		return
+	}
+
+	response, err := handler(ctx, interaction)
+	if err != nil {
+		logger.FromContext(ctx).Error(fmt.Sprintf("handle command %s : %s", name, err.Error()))
+		span.SetStatus(codes.Error, err.Error())
+		response = errorResponse(response, err)
+	}
+	err = respond(ctx, session, interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: response.Data(),
+	})
+	if err != nil || response.Sent == nil {
+		return
+	}
+	message, err := session.InteractionResponse(interaction.Interaction, discordgo.WithContext(ctx))
+	if err != nil {
+		logger.FromContext(ctx).Error(fmt.Sprintf("get response message of command %s : %s", name, err.Error()))
+		return
+	}
+	response.Sent(message.ID)
+}
+
+// handleComponent calls the handler of the button or menu of the interaction.
+// The message holding the component is updated with the response of the handler,
+// errors are only shown to the member who used it.
+// The member must be allowed to run the command whose policy the component follows, see Router.Command.
+func (d *Discord) handleComponent(
+	ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate,
+) {
+	customID := interaction.MessageComponentData().CustomID
+	name := ComponentName(customID)
+	handler, ok := d.components.Route(customID)
+	if !ok {
+		return
+	}
+
+	logger.FromContext(ctx).Debug("handling component " + customID)
+	ctx, span := otel.Tracer("discordHandler").Start(ctx, name)
+	ctx = logger.AddLoggerToContext(ctx, logger.FromContext(ctx).With(zap.String("discordHandler", name)))
+	defer span.End()
+
+	err := d.authorize(session, interaction, d.components.Command(interaction))
+	if err != nil {
+		deny(ctx, session, interaction, "You are not allowed to use this button: ", err)
+		return
+	}
+
+	response, err := handler(ctx, interaction)
+	if err != nil {
+		logger.FromContext(ctx).Error(fmt.Sprintf("handle component %s : %s", customID, err.Error()))
+		span.SetStatus(codes.Error, err.Error())
+		response = errorResponse(response, err)
+		response.Ephemeral = true
+		_ = respond(ctx, session, interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: response.Data(),
+		})
+		return
+	}
+	_ = respond(ctx, session, interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: response.Data(),
+	})
+}
+
+// handleAutocomplete suggests values for the option being typed in a command.
+// Members who can't run the command get no suggestion, errors are logged and leave the option without suggestion.
+func (d *Discord) handleAutocomplete(
+	ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate,
+) {
+	data := interaction.ApplicationCommandData()
+	ctx, span := otel.Tracer("discordHandler").Start(ctx, data.Name+"/autocomplete")
+	ctx = logger.AddLoggerToContext(ctx, logger.FromContext(ctx).With(zap.String("discordHandler", data.Name)))
+	defer span.End()
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	err := d.authorize(session, interaction, data.Name)
+	if err != nil {
+		logger.FromContext(ctx).Debug(fmt.Sprintf("no suggestion for command %s : %s", data.Name, err.Error()))
+	} else {
+		choices, err = d.completers.Complete(ctx, data)
+		if err != nil {
+			logger.FromContext(ctx).Error(fmt.Sprintf("autocomplete command %s : %s", data.Name, err.Error()))
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	_ = respond(ctx, session, interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{Choices: choices},
+	})
+}
+
+// respond answers the interaction. Errors are logged and set on the span of ctx,
+// discord shows the interaction as failed to the member.
+func respond(
+	ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate,
+	response *discordgo.InteractionResponse,
+) error {
+	err := session.InteractionRespond(interaction.Interaction, response, discordgo.WithContext(ctx))
+	if err != nil {
+		logger.FromContext(ctx).Error("respond to interaction", zap.Error(err))
+		trace.SpanFromContext(ctx).SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// errorResponse returns the response explaining err, keeping the message of the handler if it gave one.
+// Components of the response are dropped.
+func errorResponse(response *Response, err error) *Response {
+	if response == nil || (response.Title == "" && response.Description == "" && len(response.Fields) == 0) {
+		return &Response{Description: err.Error(), Colour: ColourError}
+	}
+	return &Response{
+		Title:       response.Title,
+		Description: response.Description,
+		Fields:      response.Fields,
+		Colour:      ColourError,
+		Footer:      response.Footer,
+		Ephemeral:   response.Ephemeral,
+	}
+}
+
+// NotifyOfficers sends a message to the officer channel.
+func (d *Discord) NotifyOfficers(ctx context.Context, msg string) error {
+	if d.officerChannel == "" {
+		return errors.New("no officer channel set")
+	}
+	if d.s == nil {
+		return errors.New("discord session is not open")
+	}
+	_, err := d.s.ChannelMessageSendComplex(d.officerChannel, Text(msg).Message(), discordgo.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "send message to officer channel")
+	}
+	return nil
+}
+
+// Announce sends a message to the announcement channel.
+func (d *Discord) Announce(ctx context.Context, msg string) error {
+	if d.announcementChannel == "" {
+		return errors.New("no announcement channel set")
+	}
+	if d.s == nil {
+		return errors.New("discord session is not open")
+	}
+	_, err := d.s.ChannelMessageSendComplex(d.announcementChannel, Text(msg).Message(), discordgo.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "send message to announcement channel")
+	}
+	return nil
+}
+
+// authorize checks the permission policy of command for the member who sent the interaction.
+// Every command is allowed when no policy is set.
+func (d *Discord) authorize(
+	session *discordgo.Session, interaction *discordgo.InteractionCreate, command string,
+) error {
+	if d.policy == nil {
+		return nil
+	}
+	return d.policy.Authorize(command, memberRoles(session, interaction), memberPermissions(interaction))
+}
+
+// deny logs and traces a permission denied to the member who sent the interaction,
+// and answers them with msg followed by the reason. Only them see the answer.
+func deny(
+	ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate, msg string, err error,
+) {
+	user := interactionUser(interaction)
+	logger.FromContext(ctx).Warn("permission denied",
+		zap.String("user", user.Username),
+		zap.String("user_id", user.ID),
+		zap.Error(err))
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request_from", user.Username),
+		attribute.Bool("permission_denied", true))
+	span.SetStatus(codes.Error, err.Error())
+	response := &Response{
+		Description: msg + err.Error(),
+		Colour:      ColourError,
+		Ephemeral:   true,
+	}
+	_ = respond(ctx, session, interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: response.Data(),
+	})
+}
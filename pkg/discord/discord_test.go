@@ -0,0 +1,48 @@
+package discord_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+func TestDiscord_NotifyOfficers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("No officer channel", func(t *testing.T) {
+		t.Parallel()
+		err := discord.New().NotifyOfficers(context.Background(), "hello")
+		if err == nil || err.Error() != "no officer channel set" {
+			t.Errorf("NotifyOfficers() error = %v, want no officer channel set", err)
+		}
+	})
+
+	t.Run("Session not open", func(t *testing.T) {
+		t.Parallel()
+		err := discord.New(discord.OfficerChannel("42")).NotifyOfficers(context.Background(), "hello")
+		if err == nil || err.Error() != "discord session is not open" {
+			t.Errorf("NotifyOfficers() error = %v, want discord session is not open", err)
+		}
+	})
+}
+
+func TestDiscord_Announce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("No announcement channel", func(t *testing.T) {
+		t.Parallel()
+		err := discord.New(discord.OfficerChannel("42")).Announce(context.Background(), "hello")
+		if err == nil || err.Error() != "no announcement channel set" {
+			t.Errorf("Announce() error = %v, want no announcement channel set", err)
+		}
+	})
+
+	t.Run("Session not open", func(t *testing.T) {
+		t.Parallel()
+		err := discord.New(discord.AnnouncementChannel("42")).Announce(context.Background(), "hello")
+		if err == nil || err.Error() != "discord session is not open" {
+			t.Errorf("Announce() error = %v, want discord session is not open", err)
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package discord
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/pkg/errors"
+)
+
+// membersSearchLimit is the maximum number of members returned by a search in the guild.
+const membersSearchLimit = 1000
+
+// DiscordUserID returns the ID of the member of the guild with the given username.
+// Members are searched through the discord API, the session doesn't need to be opened.
+func (d *Discord) DiscordUserID(ctx context.Context, username string) (string, bool, error) {
+	if username == "" {
+		return "", false, nil
+	}
+	if d.api == nil {
+		session, err := discordgo.New("Bot " + d.token)
+		if err != nil {
+			return "", false, errors.Wrap(err, "new discord session")
+		}
+		d.api = session
+	}
+
+	members, err := d.api.GuildMembersSearch(strconv.Itoa(d.guildID), username, membersSearchLimit,
+		discordgo.WithContext(ctx))
+	if err != nil {
+		return "", false, errors.Wrap(err, "search guild members")
+	}
+	for _, member := range members {
+		if member.User != nil && strings.EqualFold(member.User.Username, username) {
+			return member.User.ID, true, nil
+		}
+	}
+	return "", false, nil
+}
@@ -1,8 +1,6 @@
 package discord
 
 import (
-	"context"
-
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -21,14 +19,29 @@ func GuildID(guildID int) Option {
 	}
 }
 
-func CommandHandlers(m map[string]func(
-	ctx context.Context, interaction *discordgo.InteractionCreate) (string, error),
-) Option {
+// CommandHandlers sets the handlers of application commands, keyed by the name of their command.
+func CommandHandlers(m map[string]Handler) Option {
 	return func(d *Discord) {
 		d.commandHandlers = m
 	}
 }
 
+// Components sets the router of message components to their handler.
+// The message of the component is updated with the response of its handler.
+func Components(router *Router) Option {
+	return func(d *Discord) {
+		d.components = router
+	}
+}
+
+// Autocomplete sets the completers suggesting values of command options as they are typed.
+// Options with a completer are marked for autocomplete when commands are registered.
+func Autocomplete(completers Completers) Option {
+	return func(d *Discord) {
+		d.completers = completers
+	}
+}
+
 func Command(m []*discordgo.ApplicationCommand) Option {
 	return func(d *Discord) {
 		d.commands = m
@@ -40,3 +53,24 @@ func DeleteCommands(b bool) Option {
 		d.DeleteCommands = b
 	}
 }
+
+// Permissions sets the policy checked before calling a command handler.
+func Permissions(policy Policy) Option {
+	return func(d *Discord) {
+		d.policy = &policy
+	}
+}
+
+// OfficerChannel sets the channel where officers are notified.
+func OfficerChannel(channelID string) Option {
+	return func(d *Discord) {
+		d.officerChannel = channelID
+	}
+}
+
+// AnnouncementChannel sets the channel where raids are announced to guild members.
+func AnnouncementChannel(channelID string) Option {
+	return func(d *Discord) {
+		d.announcementChannel = channelID
+	}
+}
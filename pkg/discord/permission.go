@@ -0,0 +1,130 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Level is a permission level a command can require.
+type Level string
+
+const (
+	// LevelEveryone lets any member of the guild run the command.
+	LevelEveryone Level = "everyone"
+	// LevelOfficer requires one of the officer roles.
+	LevelOfficer Level = "officer"
+	// LevelAdmin requires the discord administrator permission.
+	LevelAdmin Level = "admin"
+)
+
+// Policy tells who is allowed to run each command.
+// A requirement is either a Level or the name (or ID) of a discord role.
+// Members with the discord administrator permission can run every command.
+type Policy struct {
+	OfficerRoles []string
+	Default      string
+	Commands     map[string]string
+}
+
+// Requirement returns the requirement to run the given command.
+func (p Policy) Requirement(command string) string {
+	if requirement, ok := p.Commands[command]; ok && requirement != "" {
+		return requirement
+	}
+	if p.Default != "" {
+		return p.Default
+	}
+	return string(LevelOfficer)
+}
+
+// Authorize returns an error if a member owning the given roles and permissions
+// can't run the command. roles can contain role IDs as well as role names.
+func (p Policy) Authorize(command string, roles []string, permissions int64) error {
+	if permissions&discordgo.PermissionAdministrator != 0 {
+		return nil
+	}
+
+	requirement := p.Requirement(command)
+	switch Level(strings.ToLower(requirement)) {
+	case LevelEveryone:
+		return nil
+	case LevelAdmin:
+		return fmt.Errorf("only administrators can use %s", command)
+	case LevelOfficer:
+		if hasRole(roles, p.OfficerRoles...) {
+			return nil
+		}
+		return fmt.Errorf("%s is restricted to officers (%s)", command, strings.Join(p.OfficerRoles, ", "))
+	default:
+		if hasRole(roles, requirement) {
+			return nil
+		}
+		return fmt.Errorf("%s requires the role %s", command, requirement)
+	}
+}
+
+// hasRole checks if one of the member roles is in wanted. Comparison is case-insensitive.
+func hasRole(roles []string, wanted ...string) bool {
+	for _, role := range roles {
+		for _, w := range wanted {
+			if strings.EqualFold(role, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// memberRoles returns IDs and names of the roles of the member who sent the interaction.
+func memberRoles(session *discordgo.Session, interaction *discordgo.InteractionCreate) []string {
+	if interaction.Member == nil {
+		return nil
+	}
+
+	roles := make([]string, 0, 2*len(interaction.Member.Roles))
+	var guildRoles []*discordgo.Role
+	for _, roleID := range interaction.Member.Roles {
+		roles = append(roles, roleID)
+
+		role, err := session.State.Role(interaction.GuildID, roleID)
+		if err == nil {
+			roles = append(roles, role.Name)
+			continue
+		}
+
+		// State is not populated yet, ask discord once for the guild roles
+		if guildRoles == nil {
+			guildRoles, err = session.GuildRoles(interaction.GuildID)
+			if err != nil {
+				continue
+			}
+		}
+		for _, role := range guildRoles {
+			if role.ID == roleID {
+				roles = append(roles, role.Name)
+			}
+		}
+	}
+	return roles
+}
+
+// interactionUser returns the user who sent the interaction, in a guild or in a direct message.
+func interactionUser(interaction *discordgo.InteractionCreate) *discordgo.User {
+	if interaction.Member != nil && interaction.Member.User != nil {
+		return interaction.Member.User
+	}
+	if interaction.User != nil {
+		return interaction.User
+	}
+	return &discordgo.User{}
+}
+
+// memberPermissions returns the permissions of the member who sent the interaction.
+func memberPermissions(interaction *discordgo.InteractionCreate) int64 {
+	if interaction.Member == nil {
+		return 0
+	}
+	return interaction.Member.Permissions
+}
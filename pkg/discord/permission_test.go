@@ -0,0 +1,108 @@
+package discord_test
+
+import (
+	"testing"
+
+	"github.com/antony-ramos/guildops/pkg/discord"
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestPolicy_Authorize(t *testing.T) {
+	t.Parallel()
+
+	policy := discord.Policy{
+		OfficerRoles: []string{"Staff"},
+		Default:      "officer",
+		Commands: map[string]string{
+			"guildops-player-info":  "everyone",
+			"guildops-raid-delete":  "admin",
+			"guildops-strike-list":  "Raid Leader",
+			"guildops-loot-delete":  "",
+			"guildops-absence-list": "OFFICER",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		command     string
+		roles       []string
+		permissions int64
+		wantErr     bool
+	}{
+		{
+			name:    "everyone can use player command",
+			command: "guildops-player-info",
+			wantErr: false,
+		},
+		{
+			name:    "default level requires officer",
+			command: "guildops-strike-create",
+			roles:   []string{"123", "Raider"},
+			wantErr: true,
+		},
+		{
+			name:    "officer role is case insensitive",
+			command: "guildops-strike-create",
+			roles:   []string{"123", "staff"},
+			wantErr: false,
+		},
+		{
+			name:    "empty requirement falls back to default",
+			command: "guildops-loot-delete",
+			roles:   []string{"Raider"},
+			wantErr: true,
+		},
+		{
+			name:    "level is case insensitive",
+			command: "guildops-absence-list",
+			roles:   []string{"Staff"},
+			wantErr: false,
+		},
+		{
+			name:    "officer is not admin",
+			command: "guildops-raid-delete",
+			roles:   []string{"Staff"},
+			wantErr: true,
+		},
+		{
+			name:        "administrator can use everything",
+			command:     "guildops-raid-delete",
+			permissions: discordgo.PermissionAdministrator,
+			wantErr:     false,
+		},
+		{
+			name:    "custom role",
+			command: "guildops-strike-list",
+			roles:   []string{"raid leader"},
+			wantErr: false,
+		},
+		{
+			name:    "custom role missing",
+			command: "guildops-strike-list",
+			roles:   []string{"Staff"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			err := policy.Authorize(test.command, test.roles, test.permissions)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Authorize() error = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicy_Requirement(t *testing.T) {
+	t.Parallel()
+
+	t.Run("officer when nothing is set", func(t *testing.T) {
+		t.Parallel()
+		policy := discord.Policy{}
+		if got := policy.Requirement("guildops-raid-create"); got != string(discord.LevelOfficer) {
+			t.Errorf("Requirement() = %v, want %v", got, discord.LevelOfficer)
+		}
+	})
+}
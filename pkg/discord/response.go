@@ -0,0 +1,278 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Handler answers an interaction with a response.
+type Handler func(ctx context.Context, interaction *discordgo.InteractionCreate) (*Response, error)
+
+// Colours of responses.
+const (
+	ColourInfo    = 0x5865F2
+	ColourSuccess = 0x57F287
+	ColourWarning = 0xFEE75C
+	ColourError   = 0xED4245
+)
+
+// Limits of discord messages, in characters.
+const (
+	maxTitle       = 256
+	maxDescription = 4096
+	maxFieldName   = 256
+	maxFieldValue  = 1024
+	maxFields      = 25
+	maxFooter      = 2048
+	maxEmbeds      = 10
+	maxMessage     = 6000
+	// summaryLength is the length of the description kept in the message when the response is attached as a file.
+	summaryLength = 1500
+)
+
+// Response is the answer of a handler, rendered as embeds.
+// Responses too long for one embed are split on several embeds of the message,
+// and attached as a text file when they don't fit in a message.
+type Response struct {
+	Title       string
+	Description string
+	Fields      []Field
+	// Colour is the colour of the side of the embeds, ColourInfo when zero.
+	Colour int
+	Footer string
+	// Ephemeral responses are only shown to the member who used the command.
+	Ephemeral   bool
+	Attachments []Attachment
+	// Components are the buttons and menus under the response.
+	Components []discordgo.MessageComponent
+	// Sent is called with the ID of the message of a command response once it is sent,
+	// for handlers keeping a state about the message, like the page shown by a list.
+	Sent func(messageID string)
+}
+
+// Field is a titled part of a response.
+type Field struct {
+	Name   string
+	Value  string
+	Inline bool
+}
+
+// Attachment is a file sent with a response.
+type Attachment struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// Text returns a response made of a message.
+func Text(msg string) *Response {
+	return &Response{Description: msg}
+}
+
+// Success returns a response made of a message telling a command succeeded.
+func Success(msg string) *Response {
+	return &Response{Description: msg, Colour: ColourSuccess}
+}
+
+// Data returns the data of the interaction response rendering the response.
+func (r *Response) Data() *discordgo.InteractionResponseData {
+	embeds, files := r.render()
+	data := &discordgo.InteractionResponseData{
+		Embeds:     embeds,
+		Files:      files,
+		Components: r.Components,
+	}
+	if r.Ephemeral {
+		data.Flags = discordgo.MessageFlagsEphemeral
+	}
+	return data
+}
+
+// Message returns the message rendering the response, to send it to a channel.
+func (r *Response) Message() *discordgo.MessageSend {
+	embeds, files := r.render()
+	return &discordgo.MessageSend{
+		Embeds:     embeds,
+		Files:      files,
+		Components: r.Components,
+	}
+}
+
+// String returns the response as plain text, as it is written in attached files.
+func (r *Response) String() string {
+	var parts []string
+	if r.Title != "" {
+		parts = append(parts, r.Title)
+	}
+	if r.Description != "" {
+		parts = append(parts, r.Description)
+	}
+	for _, field := range r.Fields {
+		parts = append(parts, field.Name+"\n"+field.Value)
+	}
+	if r.Footer != "" {
+		parts = append(parts, r.Footer)
+	}
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// render returns the embeds and the files of the response.
+func (r *Response) render() ([]*discordgo.MessageEmbed, []*discordgo.File) {
+	files := make([]*discordgo.File, 0, len(r.Attachments)+1)
+	for _, attachment := range r.Attachments {
+		files = append(files, &discordgo.File{
+			Name:        attachment.Name,
+			ContentType: attachment.ContentType,
+			Reader:      bytes.NewReader(attachment.Content),
+		})
+	}
+
+	embeds := r.embeds()
+	if len(embeds) <= maxEmbeds && length(embeds) <= maxMessage {
+		return embeds, files
+	}
+
+	name := r.fileName()
+	files = append(files, &discordgo.File{
+		Name:        name,
+		ContentType: "text/plain",
+		Reader:      strings.NewReader(r.String()),
+	})
+	summary := split(r.Description, summaryLength)
+	description := ""
+	if len(summary) > 0 {
+		description = summary[0]
+		if len(summary) > 1 {
+			description += "\n…"
+		}
+	}
+	return []*discordgo.MessageEmbed{{
+		Title:       truncate(r.Title, maxTitle),
+		Description: description,
+		Color:       r.colour(),
+		Footer:      &discordgo.MessageEmbedFooter{Text: "Full response in " + name},
+	}}, files
+}
+
+// embeds splits the response on as many embeds as it needs.
+// The first embed has the title, the description follows and the fields are after it, the last has the footer.
+func (r *Response) embeds() []*discordgo.MessageEmbed {
+	var embeds []*discordgo.MessageEmbed
+	for _, description := range split(r.Description, maxDescription) {
+		embeds = append(embeds, &discordgo.MessageEmbed{Description: description, Color: r.colour()})
+	}
+
+	for _, field := range r.Fields {
+		values := split(field.Value, maxFieldValue)
+		if len(values) == 0 {
+			values = []string{"-"}
+		}
+		for index, value := range values {
+			name := field.Name
+			if index > 0 {
+				name += " (continued)"
+			}
+			if len(embeds) == 0 || len(embeds[len(embeds)-1].Fields) == maxFields {
+				embeds = append(embeds, &discordgo.MessageEmbed{Color: r.colour()})
+			}
+			last := embeds[len(embeds)-1]
+			last.Fields = append(last.Fields, &discordgo.MessageEmbedField{
+				Name:   truncate(name, maxFieldName),
+				Value:  value,
+				Inline: field.Inline && len(values) == 1,
+			})
+		}
+	}
+
+	if len(embeds) == 0 {
+		embeds = append(embeds, &discordgo.MessageEmbed{Color: r.colour()})
+	}
+	embeds[0].Title = truncate(r.Title, maxTitle)
+	if r.Footer != "" {
+		embeds[len(embeds)-1].Footer = &discordgo.MessageEmbedFooter{Text: truncate(r.Footer, maxFooter)}
+	}
+	return embeds
+}
+
+func (r *Response) colour() int {
+	if r.Colour == 0 {
+		return ColourInfo
+	}
+	return r.Colour
+}
+
+// nonFileName matches what is replaced in the title of a response to name its file.
+var nonFileName = regexp.MustCompile(`[^a-z0-9]+`)
+
+// fileName returns the name of the file a response too long for a message is attached as.
+func (r *Response) fileName() string {
+	name := strings.Trim(nonFileName.ReplaceAllString(strings.ToLower(r.Title), "-"), "-")
+	if name == "" {
+		name = "response"
+	}
+	return name + ".txt"
+}
+
+// length returns the number of characters of embeds counting against the limit of a message.
+func length(embeds []*discordgo.MessageEmbed) int {
+	count := 0
+	for _, embed := range embeds {
+		count += utf8.RuneCountInString(embed.Title) + utf8.RuneCountInString(embed.Description)
+		for _, field := range embed.Fields {
+			count += utf8.RuneCountInString(field.Name) + utf8.RuneCountInString(field.Value)
+		}
+		if embed.Footer != nil {
+			count += utf8.RuneCountInString(embed.Footer.Text)
+		}
+	}
+	return count
+}
+
+// split cuts text in parts of at most limit characters, between lines when it can.
+func split(text string, limit int) []string {
+	text = strings.TrimRight(text, "\n")
+	var parts []string
+	var current strings.Builder
+	currentLength := 0
+	for _, line := range strings.Split(text, "\n") {
+		for utf8.RuneCountInString(line) > limit {
+			if currentLength > 0 {
+				parts = append(parts, current.String())
+				current.Reset()
+				currentLength = 0
+			}
+			runes := []rune(line)
+			parts = append(parts, string(runes[:limit]))
+			line = string(runes[limit:])
+		}
+		lineLength := utf8.RuneCountInString(line)
+		if currentLength > 0 && currentLength+1+lineLength > limit {
+			parts = append(parts, current.String())
+			current.Reset()
+			currentLength = 0
+		}
+		if currentLength > 0 {
+			current.WriteString("\n")
+			currentLength++
+		}
+		current.WriteString(line)
+		currentLength += lineLength
+	}
+	if currentLength > 0 {
+		parts = append(parts, current.String())
+	}
+	return parts
+}
+
+// truncate cuts text to limit characters, ending it with an ellipsis when it is cut.
+func truncate(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+	return string([]rune(text)[:limit-1]) + "…"
+}
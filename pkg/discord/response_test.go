@@ -0,0 +1,129 @@
+package discord_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+// lines returns count lines of length characters.
+func lines(count, length int) string {
+	list := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		list = append(list, strings.Repeat("a", length))
+	}
+	return strings.Join(list, "\n")
+}
+
+func TestResponse_Data(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Embed", func(t *testing.T) {
+		t.Parallel()
+
+		data := (&discord.Response{
+			Title:       "Raid List",
+			Description: "* raid Mon 02/10/23 21:00 heroic 1",
+			Fields:      []discord.Field{{Name: "ID", Value: "1", Inline: true}},
+			Colour:      discord.ColourSuccess,
+			Footer:      "1 raids",
+			Ephemeral:   true,
+		}).Data()
+
+		assert.Equal(t, discordgo.MessageFlagsEphemeral, data.Flags)
+		assert.Empty(t, data.Files)
+		if assert.Len(t, data.Embeds, 1) {
+			embed := data.Embeds[0]
+			assert.Equal(t, "Raid List", embed.Title)
+			assert.Equal(t, "* raid Mon 02/10/23 21:00 heroic 1", embed.Description)
+			assert.Equal(t, discord.ColourSuccess, embed.Color)
+			assert.Equal(t, "1 raids", embed.Footer.Text)
+			assert.Equal(t, []*discordgo.MessageEmbedField{{Name: "ID", Value: "1", Inline: true}}, embed.Fields)
+		}
+	})
+
+	t.Run("Default colour", func(t *testing.T) {
+		t.Parallel()
+
+		data := discord.Text("hello").Data()
+
+		assert.Equal(t, discord.ColourInfo, data.Embeds[0].Color)
+		assert.Zero(t, data.Flags)
+	})
+
+	t.Run("Long description is split between lines", func(t *testing.T) {
+		t.Parallel()
+
+		// 50 lines of 99 characters and a new line, 5000 characters
+		data := discord.Text(lines(50, 99)).Data()
+
+		assert.Empty(t, data.Files)
+		if assert.Len(t, data.Embeds, 2) {
+			assert.Equal(t, lines(40, 99), data.Embeds[0].Description)
+			assert.Equal(t, lines(10, 99), data.Embeds[1].Description)
+		}
+	})
+
+	t.Run("Long field is split", func(t *testing.T) {
+		t.Parallel()
+
+		data := (&discord.Response{Fields: []discord.Field{{Name: "Loots (20)", Value: lines(20, 99), Inline: true}}}).Data()
+
+		if assert.Len(t, data.Embeds, 1) && assert.Len(t, data.Embeds[0].Fields, 2) {
+			assert.Equal(t, "Loots (20)", data.Embeds[0].Fields[0].Name)
+			assert.Equal(t, lines(10, 99), data.Embeds[0].Fields[0].Value)
+			assert.Equal(t, "Loots (20) (continued)", data.Embeds[0].Fields[1].Name)
+			assert.False(t, data.Embeds[0].Fields[1].Inline)
+		}
+	})
+
+	t.Run("Too long for a message is attached", func(t *testing.T) {
+		t.Parallel()
+
+		response := &discord.Response{Title: "Raid List", Description: lines(100, 99), Footer: "100 raids"}
+		data := response.Data()
+
+		if assert.Len(t, data.Embeds, 1) {
+			assert.Equal(t, "Raid List", data.Embeds[0].Title)
+			assert.LessOrEqual(t, utf8.RuneCountInString(data.Embeds[0].Description), 1502)
+			assert.True(t, strings.HasSuffix(data.Embeds[0].Description, "\n…"))
+			assert.Equal(t, "Full response in raid-list.txt", data.Embeds[0].Footer.Text)
+		}
+		if assert.Len(t, data.Files, 1) {
+			assert.Equal(t, "raid-list.txt", data.Files[0].Name)
+			content, err := io.ReadAll(data.Files[0].Reader)
+			assert.NoError(t, err)
+			assert.Equal(t, response.String(), string(content))
+			assert.Equal(t, "Raid List\n\n"+lines(100, 99)+"\n\n100 raids\n", string(content))
+		}
+	})
+
+	t.Run("Attachments", func(t *testing.T) {
+		t.Parallel()
+
+		data := (&discord.Response{
+			Description: "report",
+			Attachments: []discord.Attachment{{Name: "report.csv", ContentType: "text/csv", Content: []byte("a,b\n")}},
+		}).Data()
+
+		if assert.Len(t, data.Files, 1) {
+			assert.Equal(t, "report.csv", data.Files[0].Name)
+			assert.Equal(t, "text/csv", data.Files[0].ContentType)
+		}
+	})
+}
+
+func TestSuccess(t *testing.T) {
+	t.Parallel()
+
+	response := discord.Success("Raid icc created successfully")
+
+	assert.Equal(t, "Raid icc created successfully", response.Description)
+	assert.Equal(t, discord.ColourSuccess, response.Data().Embeds[0].Color)
+}
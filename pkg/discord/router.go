@@ -0,0 +1,100 @@
+package discord
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// componentSeparator separates the name of a component from the arguments in its custom ID.
+const componentSeparator = ":"
+
+// Router routes interactions of message components, like buttons, to their handler.
+// Components are named by the start of their custom ID, the rest carries the arguments of their handler:
+// the button "guildops-signup:accepted:12" is handled by the handler of guildops-signup.
+// Components follow the permission policy of a command, see Command.
+type Router struct {
+	mu       sync.RWMutex
+	handlers map[string]Handler
+	// commands are the commands whose permission policy components follow, keyed by component name.
+	commands map[string]string
+}
+
+// NewRouter returns a router without handlers.
+func NewRouter() *Router {
+	return &Router{handlers: make(map[string]Handler), commands: make(map[string]string)}
+}
+
+// Handle sets the handler of the components named name.
+func (r *Router) Handle(name string, handler Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[name] = handler
+}
+
+// HandleAll sets handlers keyed by the name of their components.
+func (r *Router) HandleAll(handlers map[string]Handler) {
+	for name, handler := range handlers {
+		r.Handle(name, handler)
+	}
+}
+
+// Permission makes the components named name follow the permission policy of command,
+// whatever command sent the message holding them.
+func (r *Router) Permission(name, command string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.commands[name] = command
+}
+
+// Command returns the command whose permission policy applies to the component of the interaction:
+// the command set with Permission, else the command which sent the message holding the component.
+// A component on a message sent out of a command follows the policy of its own name.
+func (r *Router) Command(interaction *discordgo.InteractionCreate) string {
+	name := ComponentName(interaction.MessageComponentData().CustomID)
+	if r != nil {
+		r.mu.RLock()
+		command, ok := r.commands[name]
+		r.mu.RUnlock()
+		if ok {
+			return command
+		}
+	}
+	if interaction.Message != nil && interaction.Message.Interaction != nil && interaction.Message.Interaction.Name != "" {
+		return interaction.Message.Interaction.Name
+	}
+	return name
+}
+
+// Route returns the handler of the component with this custom ID.
+func (r *Router) Route(customID string) (Handler, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	handler, ok := r.handlers[ComponentName(customID)]
+	return handler, ok
+}
+
+// CustomID returns the custom ID of a component named name, carrying args to its handler.
+func CustomID(name string, args ...string) string {
+	return strings.Join(append([]string{name}, args...), componentSeparator)
+}
+
+// ComponentName returns the name of the handler of a component, the part of its custom ID before ":".
+// The rest of the custom ID carries the arguments of the handler.
+func ComponentName(customID string) string {
+	name, _, _ := strings.Cut(customID, componentSeparator)
+	return name
+}
+
+// ComponentArgs returns the arguments a custom ID carries to the handler of its component.
+func ComponentArgs(customID string) []string {
+	_, args, found := strings.Cut(customID, componentSeparator)
+	if !found {
+		return nil
+	}
+	return strings.Split(args, componentSeparator)
+}
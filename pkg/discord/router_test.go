@@ -0,0 +1,154 @@
+package discord_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/antony-ramos/guildops/pkg/discord"
+)
+
+func TestRouter_Route(t *testing.T) {
+	t.Parallel()
+
+	router := discord.NewRouter()
+	router.HandleAll(map[string]discord.Handler{
+		"guildops-signup": func(ctx context.Context, interaction *discordgo.InteractionCreate) (*discord.Response, error) {
+			return discord.Text("signup"), nil
+		},
+	})
+	router.Handle("guildops-page",
+		func(ctx context.Context, interaction *discordgo.InteractionCreate) (*discord.Response, error) {
+			return discord.Text("page"), nil
+		})
+
+	tests := []struct {
+		customID string
+		want     string
+	}{
+		{customID: "guildops-signup:accepted:12", want: "signup"},
+		{customID: "guildops-page:next", want: "page"},
+		{customID: "guildops-page", want: "page"},
+		{customID: "guildops-unknown:next", want: ""},
+	}
+	for _, tt := range tests {
+		handler, ok := router.Route(tt.customID)
+		if ok != (tt.want != "") {
+			t.Errorf("Route(%q) found = %v, want %v", tt.customID, ok, tt.want != "")
+			continue
+		}
+		if !ok {
+			continue
+		}
+		response, err := handler(context.Background(), &discordgo.InteractionCreate{})
+		if err != nil || response.Description != tt.want {
+			t.Errorf("Route(%q) handler = %v, %v, want %v", tt.customID, response.Description, err, tt.want)
+		}
+	}
+
+	var none *discord.Router
+	if _, ok := none.Route("guildops-page:next"); ok {
+		t.Errorf("Route() of a nil router found a handler")
+	}
+}
+
+func TestRouter_Command(t *testing.T) {
+	t.Parallel()
+
+	router := discord.NewRouter()
+	router.Permission("guildops-signup", "guildops-signup")
+
+	component := func(customID string, command string) *discordgo.InteractionCreate {
+		interaction := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			Type:    discordgo.InteractionMessageComponent,
+			Data:    discordgo.MessageComponentInteractionData{CustomID: customID},
+			Message: &discordgo.Message{},
+		}}
+		if command != "" {
+			interaction.Message.Interaction = &discordgo.MessageInteraction{Name: command}
+		}
+		return interaction
+	}
+
+	tests := []struct {
+		name        string
+		interaction *discordgo.InteractionCreate
+		want        string
+	}{
+		{
+			name:        "Component with a permission",
+			interaction: component("guildops-signup:accepted:12", "guildops-raid-signup"),
+			want:        "guildops-signup",
+		},
+		{
+			name:        "Component on the message of a command",
+			interaction: component("guildops-confirm:confirm:ab12", "guildops-raid-delete"),
+			want:        "guildops-raid-delete",
+		},
+		{
+			name:        "Component on a message sent out of a command",
+			interaction: component("guildops-page:next", ""),
+			want:        "guildops-page",
+		},
+	}
+	for _, tt := range tests {
+		if got := router.Command(tt.interaction); got != tt.want {
+			t.Errorf("%s: Command() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	var none *discord.Router
+	if got := none.Command(component("guildops-page:next", "guildops-loot-list")); got != "guildops-loot-list" {
+		t.Errorf("Command() of a nil router = %q, want guildops-loot-list", got)
+	}
+}
+
+func TestCustomID(t *testing.T) {
+	t.Parallel()
+
+	customID := discord.CustomID("guildops-signup", "accepted", "12")
+	if customID != "guildops-signup:accepted:12" {
+		t.Errorf("CustomID() = %v, want guildops-signup:accepted:12", customID)
+	}
+	if got := discord.CustomID("guildops-page"); got != "guildops-page" {
+		t.Errorf("CustomID() = %v, want guildops-page", got)
+	}
+}
+
+func TestComponentName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		customID string
+		want     string
+	}{
+		{customID: "guildops-signup:accepted:12", want: "guildops-signup"},
+		{customID: "guildops-signup", want: "guildops-signup"},
+		{customID: "", want: ""},
+	}
+	for _, tt := range tests {
+		if got := discord.ComponentName(tt.customID); got != tt.want {
+			t.Errorf("ComponentName(%q) = %v, want %v", tt.customID, got, tt.want)
+		}
+	}
+}
+
+func TestComponentArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		customID string
+		want     []string
+	}{
+		{customID: "guildops-signup:accepted:12", want: []string{"accepted", "12"}},
+		{customID: "guildops-page:next", want: []string{"next"}},
+		{customID: "guildops-page", want: nil},
+	}
+	for _, tt := range tests {
+		if got := discord.ComponentArgs(tt.customID); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ComponentArgs(%q) = %v, want %v", tt.customID, got, tt.want)
+		}
+	}
+}
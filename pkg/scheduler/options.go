@@ -0,0 +1,27 @@
+package scheduler
+
+import "time"
+
+// Option -.
+type Option func(*Scheduler)
+
+// Jobs adds jobs to the scheduler.
+func Jobs(jobs ...Job) Option {
+	return func(s *Scheduler) {
+		s.jobs = append(s.jobs, jobs...)
+	}
+}
+
+// Timeout sets how long a job can run before its context is done.
+func Timeout(timeout time.Duration) Option {
+	return func(s *Scheduler) {
+		s.timeout = timeout
+	}
+}
+
+// Location sets the location of the times given to schedules and jobs.
+func Location(location *time.Location) Option {
+	return func(s *Scheduler) {
+		s.location = location
+	}
+}
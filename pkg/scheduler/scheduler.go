@@ -0,0 +1,124 @@
+// Package scheduler runs jobs at the times given by their schedule until its context is done.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/antony-ramos/guildops/pkg/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+const (
+	_defaultTimeout = time.Minute
+)
+
+// Schedule returns the next time to run a job, strictly after the given time.
+type Schedule func(after time.Time) time.Time
+
+// Daily runs a job every day at hour:minute, in the location of the scheduler.
+func Daily(hour, minute int) Schedule {
+	return func(after time.Time) time.Time {
+		next := time.Date(after.Year(), after.Month(), after.Day(), hour, minute, 0, 0, after.Location())
+		if !next.After(after) {
+			next = time.Date(after.Year(), after.Month(), after.Day()+1, hour, minute, 0, 0, after.Location())
+		}
+		return next
+	}
+}
+
+// Every runs a job at a fixed interval, aligned on the interval since midnight UTC.
+func Every(interval time.Duration) Schedule {
+	return func(after time.Time) time.Time {
+		return after.Truncate(interval).Add(interval)
+	}
+}
+
+// Job is a task run by the scheduler.
+// Run is given the time of its previous run, or the start of the scheduler, and the time of this run.
+type Job struct {
+	Name     string
+	Schedule Schedule
+	Run      func(ctx context.Context, last, now time.Time) error
+}
+
+// Scheduler -.
+type Scheduler struct {
+	jobs     []Job
+	timeout  time.Duration
+	location *time.Location
+}
+
+// New returns a scheduler running no job until they are given with the Jobs option.
+func New(opts ...Option) *Scheduler {
+	s := &Scheduler{
+		timeout:  _defaultTimeout,
+		location: time.Local,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Run runs every job on its schedule and returns once ctx is done and running jobs are over.
+// A job failing is logged, it runs again at its next time.
+func (s *Scheduler) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for _, job := range s.jobs {
+		job := job
+		logger.FromContext(ctx).Info("schedule job " + job.Name)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.loop(ctx, job)
+		}()
+	}
+	<-ctx.Done()
+	wg.Wait()
+	logger.FromContext(ctx).Info("scheduler stopped")
+	return nil
+}
+
+// loop runs a job each time its schedule tells until ctx is done.
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	last := time.Now().In(s.location)
+	for {
+		next := job.Schedule(last)
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		now := time.Now().In(s.location)
+		err := s.run(ctx, job, last, now)
+		if err != nil {
+			logger.FromContext(ctx).Error("run job "+job.Name, zap.Error(err))
+		}
+		last = now
+	}
+}
+
+// run runs a job once, within the timeout of the scheduler.
+func (s *Scheduler) run(ctx context.Context, job Job, last, now time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	ctx, span := otel.Tracer("Scheduler").Start(ctx, job.Name)
+	defer span.End()
+	logger.FromContext(ctx).Debug("run job " + job.Name)
+
+	err := job.Run(ctx, last, now)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("scheduler - run - %s: %w", job.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/antony-ramos/guildops/pkg/scheduler"
+)
+
+func TestDaily(t *testing.T) {
+	t.Parallel()
+
+	daily := scheduler.Daily(18, 30)
+	tests := []struct {
+		name  string
+		after time.Time
+		want  time.Time
+	}{
+		{
+			name:  "Later today",
+			after: time.Date(2023, time.October, 2, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2023, time.October, 2, 18, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "Right on time runs tomorrow",
+			after: time.Date(2023, time.October, 2, 18, 30, 0, 0, time.UTC),
+			want:  time.Date(2023, time.October, 3, 18, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "End of month",
+			after: time.Date(2023, time.October, 31, 20, 0, 0, 0, time.UTC),
+			want:  time.Date(2023, time.November, 1, 18, 30, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if got := daily(test.after); !got.Equal(test.want) {
+				t.Errorf("Daily() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEvery(t *testing.T) {
+	t.Parallel()
+
+	after := time.Date(2023, time.October, 2, 9, 7, 12, 0, time.UTC)
+	want := time.Date(2023, time.October, 2, 9, 15, 0, 0, time.UTC)
+	if got := scheduler.Every(15 * time.Minute)(after); !got.Equal(want) {
+		t.Errorf("Every() = %v, want %v", got, want)
+	}
+}
+
+func TestScheduler_Run(t *testing.T) {
+	t.Parallel()
+
+	var runs, fails atomic.Int32
+	var lastAfterNow atomic.Bool
+	s := scheduler.New(scheduler.Jobs(
+		scheduler.Job{
+			Name:     "count",
+			Schedule: scheduler.Every(10 * time.Millisecond),
+			Run: func(ctx context.Context, last, now time.Time) error {
+				if !last.Before(now) {
+					lastAfterNow.Store(true)
+				}
+				runs.Add(1)
+				return nil
+			},
+		},
+		scheduler.Job{
+			Name:     "fail",
+			Schedule: scheduler.Every(10 * time.Millisecond),
+			Run: func(ctx context.Context, last, now time.Time) error {
+				fails.Add(1)
+				return errors.New("failed")
+			},
+		},
+	))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	done := make(chan error)
+	go func() {
+		done <- s.Run(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Run() did not stop when its context was done")
+	}
+	if runs.Load() < 2 {
+		t.Errorf("job ran %d times, want at least 2", runs.Load())
+	}
+	if fails.Load() < 2 {
+		t.Errorf("failing job ran %d times, want it to run again", fails.Load())
+	}
+	if lastAfterNow.Load() {
+		t.Errorf("job was given a last run which is not before this run")
+	}
+}
@@ -0,0 +1,13 @@
+package sqlite
+
+import "time"
+
+// Option -.
+type Option func(*SQLite)
+
+// BusyTimeout -.
+func BusyTimeout(timeout time.Duration) Option {
+	return func(c *SQLite) {
+		c.busyTimeout = timeout
+	}
+}
@@ -0,0 +1,75 @@
+// Package sqlite implements sqlite connection.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	_ "modernc.org/sqlite" // registers the sqlite driver
+)
+
+const (
+	_defaultBusyTimeout = 5 * time.Second
+)
+
+// SQLite -.
+type SQLite struct {
+	busyTimeout time.Duration
+
+	Builder squirrel.StatementBuilderType
+	DB      *sql.DB
+}
+
+// DSN returns the data source name of the database file at path.
+// Foreign keys are enforced and times are stored in a format sqlite functions understand.
+func DSN(path string, opts ...Option) string {
+	sqlite := &SQLite{
+		busyTimeout: _defaultBusyTimeout,
+	}
+	for _, opt := range opts {
+		opt(sqlite)
+	}
+
+	query := url.Values{}
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", sqlite.busyTimeout.Milliseconds()))
+	query.Set("_time_format", "sqlite")
+	return "file:" + path + "?" + query.Encode()
+}
+
+// New -.
+func New(ctx context.Context, path string, opts ...Option) (*SQLite, error) {
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("sqlite - New - ctx.Done: request took too much time to be proceed")
+	default:
+		sqlite := &SQLite{
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Question),
+		}
+
+		db, err := sql.Open("sqlite", DSN(path, opts...))
+		if err != nil {
+			return nil, fmt.Errorf("sqlite - New - sql.Open: %w", err)
+		}
+		err = db.PingContext(ctx)
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("sqlite - New - db.Ping: %w", err)
+		}
+		sqlite.DB = db
+
+		return sqlite, nil
+	}
+}
+
+// Close -.
+func (s *SQLite) Close() {
+	if s.DB != nil {
+		_ = s.DB.Close()
+	}
+}
@@ -0,0 +1,59 @@
+package e2e_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/antony-ramos/guildops/internal/usecase"
+	"github.com/antony-ramos/guildops/internal/usecase/backendtest"
+	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
+	"github.com/antony-ramos/guildops/pkg/postgres"
+)
+
+// TestPostgresConformance runs the backend conformance suite on postgres.
+// Each test gets its own database so tests don't see each other data.
+func TestPostgresConformance(t *testing.T) {
+	t.Parallel()
+
+	var databases atomic.Int32
+
+	backendtest.Run(t, func(t *testing.T) usecase.Backend {
+		t.Helper()
+		ctx := context.Background()
+
+		name := fmt.Sprintf("conformance_%d", databases.Add(1))
+		admin, err := sql.Open("pgx", pgURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer admin.Close()
+		_, err = admin.ExecContext(ctx, "CREATE DATABASE "+name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		url := strings.Replace(pgURL, "dbname="+DBName, "dbname="+name, 1)
+		pgHandler, err := postgres.New(
+			ctx,
+			url,
+			postgres.MaxPoolSize(1),
+			postgres.ConnAttempts(5),
+			postgres.ConnTimeout(2*time.Second))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(pgHandler.Close)
+
+		backend := &postgresbackend.PG{Postgres: pgHandler}
+		err = backend.Init(ctx, url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return backend
+	})
+}
@@ -4,13 +4,16 @@ package e2e_test
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"log"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	discordHandler "github.com/antony-ramos/guildops/internal/controller/discord"
 
+	"github.com/antony-ramos/guildops/internal/entity"
 	"github.com/antony-ramos/guildops/internal/usecase"
 	"github.com/antony-ramos/guildops/internal/usecase/postgresbackend"
 	"github.com/antony-ramos/guildops/pkg/logger"
@@ -30,7 +33,17 @@ const (
 	DBPass = "test_password"
 )
 
-var discord discordHandler.Discord
+var (
+	discord discordHandler.Discord
+	pgURL   string
+)
+
+// discordUserID returns the ID of the discord user of the tests with the given username.
+func discordUserID(discordName string) string {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(discordName))
+	return strconv.FormatUint(hash.Sum64(), 10)
+}
 
 func guildOpsInfo(discordName string) *discordgo.InteractionCreate {
 	return &discordgo.InteractionCreate{
@@ -38,6 +51,7 @@ func guildOpsInfo(discordName string) *discordgo.InteractionCreate {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -52,12 +66,65 @@ func guildOpsInfo(discordName string) *discordgo.InteractionCreate {
 	}
 }
 
+// playerCommand returns the interaction of discordName running the player command named command on playerName.
+func playerCommand(discordName, command, playerName string) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			Type: discordgo.InteractionApplicationCommand,
+			Member: &discordgo.Member{
+				User: &discordgo.User{
+					ID:       discordUserID(discordName),
+					Username: discordName,
+				},
+			},
+			Data: discordgo.ApplicationCommandInteractionData{
+				ID:       "mock",
+				Name:     command,
+				TargetID: "mock",
+				Resolved: &discordgo.ApplicationCommandInteractionDataResolved{},
+				Options: []*discordgo.ApplicationCommandInteractionDataOption{
+					{
+						Name:  "name",
+						Type:  discordgo.ApplicationCommandOptionString,
+						Value: playerName,
+					},
+				},
+			},
+		},
+	}
+}
+
+// confirmButton returns the interaction of discordName pressing the Confirm button of a confirmation.
+func confirmButton(discordName string, components []discordgo.MessageComponent) *discordgo.InteractionCreate {
+	var customID string
+	if len(components) > 0 {
+		if row, ok := components[0].(discordgo.ActionsRow); ok && len(row.Components) > 0 {
+			if button, ok := row.Components[0].(discordgo.Button); ok {
+				customID = button.CustomID
+			}
+		}
+	}
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			Type: discordgo.InteractionMessageComponent,
+			Member: &discordgo.Member{
+				User: &discordgo.User{
+					ID:       discordUserID(discordName),
+					Username: discordName,
+				},
+			},
+			Data: discordgo.MessageComponentInteractionData{CustomID: customID},
+		},
+	}
+}
+
 func init() {
 	ctx := context.Background()
 	url, err := createContainer(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
+	pgURL = url
 	pgHandler, err := postgres.New(
 		ctx,
 		url,
@@ -74,11 +141,11 @@ func init() {
 		return
 	}
 
-	auc := usecase.NewAbsenceUseCase(&backend)
-	puc := usecase.NewPlayerUseCase(&backend)
-	luc := usecase.NewLootUseCase(&backend)
-	ruc := usecase.NewRaidUseCase(&backend)
-	suc := usecase.NewStrikeUseCase(&backend)
+	auc := usecase.NewAbsenceUseCase(&backend, time.UTC)
+	puc := usecase.NewPlayerUseCase(&backend, entity.StrikePolicy{})
+	luc := usecase.NewLootUseCase(&backend, entity.LootStrategyLowestCount, entity.PointsPolicy{})
+	ruc := usecase.NewRaidUseCase(&backend, entity.PointsPolicy{}, entity.SchedulePolicy{})
+	suc := usecase.NewStrikeUseCase(&backend, entity.StrikePolicy{}, nil)
 	fuc := usecase.NewFailUseCase(&backend)
 
 	discord = discordHandler.Discord{
@@ -88,6 +155,8 @@ func init() {
 		RaidUseCase:    ruc,
 		StrikeUseCase:  suc,
 		FailUseCase:    fuc,
+
+		Confirmations: discordHandler.NewConfirmations(),
 	}
 }
 
@@ -100,6 +169,7 @@ func TestPlayer(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -121,11 +191,11 @@ func TestPlayer(t *testing.T) {
 
 	t.Run(fmt.Sprintf("create user %s", name), func(t *testing.T) {
 		msg, _ := discord.PlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Player %s created successfully: ID 1", strings.ToLower(name)), msg)
+		assert.Equal(t, fmt.Sprintf("Player %s created successfully: ID 1", strings.ToLower(name)), msg.Description)
 	})
 	t.Run("try to wrongly recreate it", func(t *testing.T) {
 		msg, _ := discord.PlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Player %s already exists", strings.ToLower(name)), msg)
+		assert.Equal(t, fmt.Sprintf("Player %s already exists", strings.ToLower(name)), msg.Description)
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -133,6 +203,7 @@ func TestPlayer(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -154,20 +225,50 @@ func TestPlayer(t *testing.T) {
 
 	t.Run("link discord to previously created player", func(t *testing.T) {
 		msg, _ := discord.LinkPlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("You are now linked to this player : \nName : **%s**\nDiscord Name : **%s**\n",
-			strings.ToLower(name), strings.ToLower(discordName)), msg)
+		assert.Equal(t, fmt.Sprintf("You are now linked to %s\n\nName\n%s\n\nDiscord Name\n%s\n",
+			strings.ToLower(name), strings.ToLower(name), strings.ToLower(discordName)), msg.String())
 	})
 
 	t.Run("try to wrongly link discord again", func(t *testing.T) {
 		msg, _ := discord.LinkPlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Error while linking player: discord account already linked to player name %s. "+
-			"Contact Staff for modification", strings.ToLower(name)), msg)
+		assert.Equal(t, fmt.Sprintf("Error while linking player: player %s is already linked to %s",
+			strings.ToLower(name), strings.ToLower(name)), msg.Description)
 	})
 
 	t.Run("get info on player", func(t *testing.T) {
 		msg, _ := discord.GetPlayerHandler(context.Background(), guildOpsInfo(discordName))
-		assert.Equal(t, fmt.Sprintf("Name : **%s**\nID : **1**\nDiscord ID : **%s**\n",
-			strings.ToLower(name), strings.ToLower(discordName)), msg)
+		assert.Equal(t, fmt.Sprintf("%s\n\nID\n1\n\nDiscord Name\n%s\n",
+			strings.ToLower(name), strings.ToLower(discordName)), msg.String())
+	})
+
+	altName := "testAlt"
+
+	t.Run("create an alt", func(t *testing.T) {
+		msg, _ := discord.PlayerHandler(context.Background(), playerCommand(discordName, "guildops-player-create", altName))
+		assert.Equal(t, fmt.Sprintf("Player %s created successfully: ID 3", strings.ToLower(altName)), msg.Description)
+	})
+
+	t.Run("link the alt to the same discord account", func(t *testing.T) {
+		msg, _ := discord.LinkPlayerHandler(context.Background(), playerCommand(discordName, "guildops-player-link", altName))
+		assert.Equal(t, fmt.Sprintf("You are now linked to %s\n\nName\n%s\n\nAlt of\n%s\n\nDiscord Name\n%s\n",
+			strings.ToLower(altName), strings.ToLower(altName), strings.ToLower(name), strings.ToLower(discordName)),
+			msg.String())
+	})
+
+	t.Run("get info on player with its alt", func(t *testing.T) {
+		msg, _ := discord.GetPlayerHandler(context.Background(), guildOpsInfo(discordName))
+		assert.Equal(t, fmt.Sprintf("%s\n\nID\n1\n\nDiscord Name\n%s\n\n"+
+			"Characters (2)\n* %s (main) | 0 loots\n* %s | 0 loots\n",
+			strings.ToLower(name), strings.ToLower(discordName), strings.ToLower(name), strings.ToLower(altName)),
+			msg.String())
+	})
+
+	t.Run("delete the alt", func(t *testing.T) {
+		interaction := playerCommand(discordName, "guildops-player-delete", altName)
+		msg, _ := discord.DeletePlayerHandler(context.Background(), interaction)
+		assert.Equal(t, "Are you sure?", msg.Title)
+		msg, _ = discord.ConfirmButtonHandler(context.Background(), confirmButton(discordName, msg.Components))
+		assert.Equal(t, fmt.Sprintf("Player %s deleted successfully", strings.ToLower(altName)), msg.Description)
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -175,6 +276,7 @@ func TestPlayer(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -195,14 +297,16 @@ func TestPlayer(t *testing.T) {
 	}
 
 	t.Run("delete player", func(t *testing.T) {
-		msg, _ := discord.PlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Player %s deleted successfully", strings.ToLower(name)), msg)
+		msg, _ := discord.DeletePlayerHandler(context.Background(), interaction)
+		assert.Equal(t, "Are you sure?", msg.Title)
+		msg, _ = discord.ConfirmButtonHandler(context.Background(), confirmButton(discordName, msg.Components))
+		assert.Equal(t, fmt.Sprintf("Player %s deleted successfully", strings.ToLower(name)), msg.Description)
 	})
 
 	t.Run("get info on deleted linked player", func(t *testing.T) {
 		msg, _ := discord.GetPlayerHandler(context.Background(), guildOpsInfo(discordName))
-		assert.Equal(t, fmt.Sprintf("Error while getting player infos: "+
-			"didn't find a player linked to this discord user named %s", strings.ToLower(discordName)), msg)
+		assert.Equal(t, "Error while getting player infos: didn't find a player linked to this discord user",
+			msg.Description)
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -230,7 +334,8 @@ func TestPlayer(t *testing.T) {
 	}
 	t.Run("get info on deleted player", func(t *testing.T) {
 		msg, _ := discord.GetPlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Error while getting player infos: player %s not found", strings.ToLower(name)), msg)
+		assert.Equal(t, fmt.Sprintf("Error while getting player infos: player %s not found", strings.ToLower(name)),
+			msg.Description)
 	})
 }
 
@@ -243,6 +348,7 @@ func TestAbsence(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -264,7 +370,7 @@ func TestAbsence(t *testing.T) {
 
 	t.Run(fmt.Sprintf("create user %s", name), func(t *testing.T) {
 		msg, _ := discord.PlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Player %s created successfully: ID 3", strings.ToLower(name)), msg)
+		assert.Equal(t, fmt.Sprintf("Player %s created successfully: ID 4", strings.ToLower(name)), msg.Description)
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -272,6 +378,7 @@ func TestAbsence(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -292,8 +399,8 @@ func TestAbsence(t *testing.T) {
 	}
 	t.Run("link discord to previously created player", func(t *testing.T) {
 		msg, _ := discord.LinkPlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("You are now linked to this player :"+
-			" \nName : **%s**\nDiscord Name : **%s**\n", strings.ToLower(name), strings.ToLower(discordName)), msg)
+		assert.Equal(t, fmt.Sprintf("You are now linked to %s\n\nName\n%s\n\nDiscord Name\n%s\n",
+			strings.ToLower(name), strings.ToLower(name), strings.ToLower(discordName)), msg.String())
 	})
 
 	// Create a range of raid for 5 days in september 2030
@@ -303,6 +410,7 @@ func TestAbsence(t *testing.T) {
 				Type: discordgo.InteractionApplicationCommand,
 				Member: &discordgo.Member{
 					User: &discordgo.User{
+						ID:       discordUserID(discordName),
 						Username: discordName,
 					},
 				},
@@ -333,7 +441,8 @@ func TestAbsence(t *testing.T) {
 		}
 		t.Run(fmt.Sprintf("Create Raid on %02d/09/30", index), func(t *testing.T) {
 			msg, _ := discord.CreateRaidHandler(context.Background(), interaction)
-			assert.Equal(t, fmt.Sprintf("Raid successfully created with ID %d", index), msg)
+			date := time.Date(2030, time.September, index, 0, 0, 0, 0, time.UTC).Format("Mon 02/01/06 15:04")
+			assert.Equal(t, fmt.Sprintf("Raid successfully created with ID %d on %s", index, date), msg.Description)
 		})
 	}
 
@@ -342,6 +451,7 @@ func TestAbsence(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -368,15 +478,15 @@ func TestAbsence(t *testing.T) {
 
 	t.Run("Create Absence from 01/09/30 to 03/09/30", func(t *testing.T) {
 		msg, _ := discord.AbsenceHandler(context.Background(), interaction)
-		assert.Equal(t, "Absence(s) created for :\n* Sun 01/09/30\n* Mon 02/09/30\n* Tue 03/09/30\n", msg)
+		assert.Equal(t, "* Sun 01/09/30\n* Mon 02/09/30\n* Tue 03/09/30", msg.Description)
 	})
 
 	t.Run("Check if absences appears in player info", func(t *testing.T) {
 		msg, _ := discord.GetPlayerHandler(context.Background(), guildOpsInfo(discordName))
-		assert.Equal(t, fmt.Sprintf("Name : **%s**\nID : **3**\n"+
-			"Discord ID : **%s**\n**Absences (3) :**\n*  01/09/30 | normal | raidname\n"+
-			"*  02/09/30 | normal | raidname\n"+
-			"*  03/09/30 | normal | raidname\n", strings.ToLower(name), strings.ToLower(discordName)), msg)
+		assert.Equal(t, fmt.Sprintf("%s\n\nID\n4\n\n"+
+			"Discord Name\n%s\n\nAbsences (3)\n* 01/09/30 | normal | raidname\n"+
+			"* 02/09/30 | normal | raidname\n"+
+			"* 03/09/30 | normal | raidname\n", strings.ToLower(name), strings.ToLower(discordName)), msg.String())
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -384,6 +494,7 @@ func TestAbsence(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -405,15 +516,17 @@ func TestAbsence(t *testing.T) {
 
 	t.Run("Delete Raid on 02/09/30", func(t *testing.T) {
 		msg, _ := discord.DeleteRaidHandler(context.Background(), interaction)
-		assert.Equal(t, "Raid with ID 2 successfully deleted", msg)
+		assert.Equal(t, "Are you sure?", msg.Title)
+		msg, _ = discord.ConfirmButtonHandler(context.Background(), confirmButton(discordName, msg.Components))
+		assert.Equal(t, "Raid with ID 2 successfully deleted", msg.Description)
 	})
 
 	t.Run("Check if absences appears in player info for deleted raid", func(t *testing.T) {
 		msg, _ := discord.GetPlayerHandler(context.Background(), guildOpsInfo(discordName))
-		assert.Equal(t, fmt.Sprintf("Name : **%s**\nID : **3**\n"+
-			"Discord ID : **%s**\n**Absences (2) :**\n"+
-			"*  01/09/30 | normal | raidname\n*  03/09/30 | normal | raidname\n",
-			strings.ToLower(name), strings.ToLower(discordName)), msg)
+		assert.Equal(t, fmt.Sprintf("%s\n\nID\n4\n\n"+
+			"Discord Name\n%s\n\nAbsences (2)\n"+
+			"* 01/09/30 | normal | raidname\n* 03/09/30 | normal | raidname\n",
+			strings.ToLower(name), strings.ToLower(discordName)), msg.String())
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -421,6 +534,7 @@ func TestAbsence(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -447,13 +561,13 @@ func TestAbsence(t *testing.T) {
 
 	t.Run("Delete Absence from 03/09/30 to 04/09/30", func(t *testing.T) {
 		msg, _ := discord.AbsenceHandler(context.Background(), interaction)
-		assert.Equal(t, "Absence(s) deleted for :\n* Tue 03/09/30\n", msg)
+		assert.Equal(t, "* Tue 03/09/30", msg.Description)
 	})
 
 	t.Run("Check if absences appears in player info for deleted absences", func(t *testing.T) {
 		msg, _ := discord.GetPlayerHandler(context.Background(), guildOpsInfo(discordName))
-		assert.Equal(t, fmt.Sprintf("Name : **%s**\nID : **3**\nDiscord ID : **%s**\n**Absences (1) :**\n"+
-			"*  01/09/30 | normal | raidname\n", strings.ToLower(name), strings.ToLower(discordName)), msg)
+		assert.Equal(t, fmt.Sprintf("%s\n\nID\n4\n\nDiscord Name\n%s\n\nAbsences (1)\n"+
+			"* 01/09/30 | normal | raidname\n", strings.ToLower(name), strings.ToLower(discordName)), msg.String())
 	})
 
 	for index := 1; index <= 3; index++ {
@@ -465,6 +579,7 @@ func TestAbsence(t *testing.T) {
 				Type: discordgo.InteractionApplicationCommand,
 				Member: &discordgo.Member{
 					User: &discordgo.User{
+						ID:       discordUserID(discordName),
 						Username: discordName,
 					},
 				},
@@ -485,14 +600,16 @@ func TestAbsence(t *testing.T) {
 		}
 		t.Run(fmt.Sprintf("Delete Raid on %02d/09/30", index), func(t *testing.T) {
 			msg, _ := discord.DeleteRaidHandler(context.Background(), interaction)
-			assert.Equal(t, fmt.Sprintf("Raid with ID %d successfully deleted", index), msg)
+			assert.Equal(t, "Are you sure?", msg.Title)
+			msg, _ = discord.ConfirmButtonHandler(context.Background(), confirmButton(discordName, msg.Components))
+			assert.Equal(t, fmt.Sprintf("Raid with ID %d successfully deleted", index), msg.Description)
 		})
 	}
 
 	t.Run("Check if absences appears after remove all raids", func(t *testing.T) {
 		msg, _ := discord.GetPlayerHandler(context.Background(), guildOpsInfo(discordName))
-		assert.Equal(t, fmt.Sprintf("Name : **%s**\nID : **3**\nDiscord ID : **%s**\n",
-			strings.ToLower(name), strings.ToLower(discordName)), msg)
+		assert.Equal(t, fmt.Sprintf("%s\n\nID\n4\n\nDiscord Name\n%s\n",
+			strings.ToLower(name), strings.ToLower(discordName)), msg.String())
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -500,6 +617,7 @@ func TestAbsence(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -520,8 +638,10 @@ func TestAbsence(t *testing.T) {
 	}
 
 	t.Run("delete player and finish this test", func(t *testing.T) {
-		msg, _ := discord.PlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Player %s deleted successfully", strings.ToLower(name)), msg)
+		msg, _ := discord.DeletePlayerHandler(context.Background(), interaction)
+		assert.Equal(t, "Are you sure?", msg.Title)
+		msg, _ = discord.ConfirmButtonHandler(context.Background(), confirmButton(discordName, msg.Components))
+		assert.Equal(t, fmt.Sprintf("Player %s deleted successfully", strings.ToLower(name)), msg.Description)
 	})
 }
 
@@ -534,6 +654,7 @@ func TestStrike(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -555,7 +676,7 @@ func TestStrike(t *testing.T) {
 
 	t.Run(fmt.Sprintf("create user %s", name), func(t *testing.T) {
 		msg, _ := discord.PlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Player %s created successfully: ID 4", strings.ToLower(name)), msg)
+		assert.Equal(t, fmt.Sprintf("Player %s created successfully: ID 5", strings.ToLower(name)), msg.Description)
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -563,6 +684,7 @@ func TestStrike(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -584,9 +706,8 @@ func TestStrike(t *testing.T) {
 
 	t.Run("link discord to previously created player", func(t *testing.T) {
 		msg, _ := discord.LinkPlayerHandler(context.Background(), interaction)
-		assert.Equal(t,
-			fmt.Sprintf("You are now linked to this player : \nName : **%s**\nDiscord Name : **%s**\n",
-				strings.ToLower(name), strings.ToLower(discordName)), msg)
+		assert.Equal(t, fmt.Sprintf("You are now linked to %s\n\nName\n%s\n\nDiscord Name\n%s\n",
+			strings.ToLower(name), strings.ToLower(name), strings.ToLower(discordName)), msg.String())
 	})
 
 	// Create two strike
@@ -597,6 +718,7 @@ func TestStrike(t *testing.T) {
 				Type: discordgo.InteractionApplicationCommand,
 				Member: &discordgo.Member{
 					User: &discordgo.User{
+						ID:       discordUserID(discordName),
 						Username: discordName,
 					},
 				},
@@ -622,17 +744,17 @@ func TestStrike(t *testing.T) {
 		}
 		t.Run("create a strike", func(t *testing.T) {
 			msg, _ := discord.StrikeOnPlayerHandler(context.Background(), interaction)
-			assert.Equal(t, "Strike created successfully", msg)
+			assert.Equal(t, "Strike created successfully", msg.Description)
 		})
 	}
 
 	// Get PLayer info
 	t.Run("check if strikes are showed in player info", func(t *testing.T) {
 		msg, _ := discord.GetPlayerHandler(context.Background(), guildOpsInfo(discordName))
-		assert.Equal(t, fmt.Sprintf("Name : **%s**\nID : **4**\nDiscord ID : **%s**\n**Strikes (2) :**\n"+
-			"*  "+time.Now().Format("02/01/06")+" | testReason | DF/S2 | 1\n*  "+
-			time.Now().Format("02/01/06")+" | testReason | DF/S2 | 2\n",
-			strings.ToLower(name), strings.ToLower(discordName)), msg)
+		assert.Equal(t, fmt.Sprintf("%s\n\nID\n5\n\nDiscord Name\n%s\n\nStrikes (2)\n"+
+			"* "+time.Now().Format("02/01/06")+" | testReason | Unknown | 1\n* "+
+			time.Now().Format("02/01/06")+" | testReason | Unknown | 2\n",
+			strings.ToLower(name), strings.ToLower(discordName)), msg.String())
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -640,6 +762,7 @@ func TestStrike(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -660,9 +783,9 @@ func TestStrike(t *testing.T) {
 	}
 	t.Run("use command to show all strikes on player", func(t *testing.T) {
 		msg, _ := discord.ListStrikesOnPlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Strikes of %s (2) :\n"+
+		assert.Equal(t, fmt.Sprintf("Strikes of %s\n\nActive (2)\n"+
 			"* "+time.Now().Format("02/01/06")+" | testReason | 1\n* "+time.Now().Format("02/01/06")+" | testReason | 2\n",
-			strings.ToLower(name)), msg)
+			strings.ToLower(name)), msg.String())
 	})
 
 	// Delete a strike
@@ -671,6 +794,7 @@ func TestStrike(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -691,7 +815,9 @@ func TestStrike(t *testing.T) {
 	}
 	t.Run("delete a strike", func(t *testing.T) {
 		msg, _ := discord.DeleteStrikeHandler(context.Background(), interaction)
-		assert.Equal(t, "Strike deleted successfully", msg)
+		assert.Equal(t, "Are you sure?", msg.Title)
+		msg, _ = discord.ConfirmButtonHandler(context.Background(), confirmButton(discordName, msg.Components))
+		assert.Equal(t, "Strike deleted successfully", msg.Description)
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -699,6 +825,7 @@ func TestStrike(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -721,9 +848,9 @@ func TestStrike(t *testing.T) {
 	// Get PLayer info
 	t.Run("show if deleted strike is visible in player info", func(t *testing.T) {
 		msg, _ := discord.GetPlayerHandler(context.Background(), guildOpsInfo(discordName))
-		assert.Equal(t, fmt.Sprintf("Name : **%s**\nID : **4**\nDiscord ID : **%s**\n**Strikes (1) :**\n"+
-			"*  "+time.Now().Format("02/01/06")+" | testReason | DF/S2 | 2\n",
-			strings.ToLower(name), strings.ToLower(discordName)), msg)
+		assert.Equal(t, fmt.Sprintf("%s\n\nID\n5\n\nDiscord Name\n%s\n\nStrikes (1)\n"+
+			"* "+time.Now().Format("02/01/06")+" | testReason | Unknown | 2\n",
+			strings.ToLower(name), strings.ToLower(discordName)), msg.String())
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -731,6 +858,7 @@ func TestStrike(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -751,8 +879,8 @@ func TestStrike(t *testing.T) {
 	}
 	t.Run("check if deleted strike is visible with list strike on player", func(t *testing.T) {
 		msg, _ := discord.ListStrikesOnPlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Strikes of %s (1) :\n* "+
-			time.Now().Format("02/01/06")+" | testReason | 2\n", strings.ToLower(name)), msg)
+		assert.Equal(t, fmt.Sprintf("Strikes of %s\n\nActive (1)\n* "+
+			time.Now().Format("02/01/06")+" | testReason | 2\n", strings.ToLower(name)), msg.String())
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -760,6 +888,7 @@ func TestStrike(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -780,8 +909,10 @@ func TestStrike(t *testing.T) {
 	}
 
 	t.Run("delete player and finish test", func(t *testing.T) {
-		msg, _ := discord.PlayerHandler(context.Background(), interaction)
-		assert.Equal(t, fmt.Sprintf("Player %s deleted successfully", strings.ToLower(name)), msg)
+		msg, _ := discord.DeletePlayerHandler(context.Background(), interaction)
+		assert.Equal(t, "Are you sure?", msg.Title)
+		msg, _ = discord.ConfirmButtonHandler(context.Background(), confirmButton(discordName, msg.Components))
+		assert.Equal(t, fmt.Sprintf("Player %s deleted successfully", strings.ToLower(name)), msg.Description)
 	})
 }
 
@@ -794,6 +925,7 @@ func TestRaid(t *testing.T) {
 				Type: discordgo.InteractionApplicationCommand,
 				Member: &discordgo.Member{
 					User: &discordgo.User{
+						ID:       discordUserID(discordName),
 						Username: discordName,
 					},
 				},
@@ -824,7 +956,8 @@ func TestRaid(t *testing.T) {
 		}
 		t.Run(fmt.Sprintf("Create Raid on %02d/10/30", index), func(t *testing.T) {
 			msg, _ := discord.CreateRaidHandler(context.Background(), interaction)
-			assert.Equal(t, fmt.Sprintf("Raid successfully created with ID %d", index+5), msg)
+			date := time.Date(2030, time.October, index, 0, 0, 0, 0, time.UTC).Format("Mon 02/01/06 15:04")
+			assert.Equal(t, fmt.Sprintf("Raid successfully created with ID %d on %s", index+5, date), msg.Description)
 		})
 	}
 
@@ -833,6 +966,7 @@ func TestRaid(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -859,7 +993,9 @@ func TestRaid(t *testing.T) {
 
 	t.Run("Delete Raid on 02/10/30", func(t *testing.T) {
 		msg, _ := discord.DeleteRaidHandler(context.Background(), interaction)
-		assert.Equal(t, "Raid on 02/10/30 with difficulty normal successfully deleted", msg)
+		assert.Equal(t, "Are you sure?", msg.Title)
+		msg, _ = discord.ConfirmButtonHandler(context.Background(), confirmButton(discordName, msg.Components))
+		assert.Equal(t, "Raid on 02/10/30 with difficulty normal successfully deleted", msg.Description)
 	})
 
 	interaction = &discordgo.InteractionCreate{
@@ -867,6 +1003,7 @@ func TestRaid(t *testing.T) {
 			Type: discordgo.InteractionApplicationCommand,
 			Member: &discordgo.Member{
 				User: &discordgo.User{
+					ID:       discordUserID(discordName),
 					Username: discordName,
 				},
 			},
@@ -893,8 +1030,8 @@ func TestRaid(t *testing.T) {
 
 	t.Run("List all raids", func(t *testing.T) {
 		msg, _ := discord.ListRaidHandler(context.Background(), interaction)
-		assert.Equal(t, "Raid List:\n* raidname Tue 01/10/30 normal 6\n* raidname Thu 03/10/30 normal 8\n"+
-			"* raidname Fri 04/10/30 normal 9\n* raidname Sat 05/10/30 normal 10\n", msg)
+		assert.Equal(t, "Raid List\n\n* raidname Tue 01/10/30 00:00 normal 6\n* raidname Thu 03/10/30 00:00 normal 8\n"+
+			"* raidname Fri 04/10/30 00:00 normal 9\n* raidname Sat 05/10/30 00:00 normal 10\n", msg.String())
 	})
 }
 